@@ -7,7 +7,7 @@ import (
 
 func main() {
 	// Initialize logger
-	logger.Setup()
+	logger.Setup(logger.Options{})
 
 	// Execute commands
 	commands.Execute()
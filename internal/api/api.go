@@ -0,0 +1,310 @@
+// Package api serves batch operations over an authenticated REST API. Every operation runs as a background
+// job whose status and result are polled by its ID.
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/metrics"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Operations accepted by POST /v1/jobs/{operation}.
+const (
+	OperationListRepos   = "list-repos"
+	OperationIssueStats  = "issue-stats"
+	OperationFileRollout = "file-rollout"
+)
+
+// tracer creates the span of every job.
+var tracer = otel.Tracer("go-repo-manager/internal/api")
+
+// maxRequestBodySize bounds the size of a request body, which holds at most a rolled out file.
+const maxRequestBodySize = 10 << 20
+
+// Config configures a Server.
+type Config struct {
+	// Token is the bearer token clients must send in the Authorization header.
+	Token string
+	// Owners are the owners jobs may target; empty allows any owner. When there is exactly one, requests
+	// may leave the owner out.
+	Owners []ghbatch.Owner
+	// MaxJobs is how many jobs run at once; further jobs wait in the queue. Below one runs one at a time.
+	MaxJobs int
+}
+
+// Server runs the operations of the API against GitHub.
+type Server struct {
+	service ghbatch.GitHubClient
+	config  Config
+	jobs    *jobStore
+	slots   chan struct{}
+	ctx     context.Context
+	wg      sync.WaitGroup
+}
+
+// NewServer returns a server running jobs with service. Jobs run with ctx, which is not cancelled when
+// the HTTP server stops; use Wait to let them finish.
+func NewServer(ctx context.Context, service ghbatch.GitHubClient, config Config) *Server {
+	return &Server{
+		service: service,
+		config:  config,
+		jobs:    newJobStore(),
+		slots:   make(chan struct{}, max(config.MaxJobs, 1)),
+		ctx:     ctx,
+	}
+}
+
+// Handler returns the HTTP handler of the API:
+//
+//	GET  /healthz                  liveness probe, without authentication
+//	POST /v1/jobs/{operation}      start a job, answered with 202 and the queued job
+//	GET  /v1/jobs                  list the jobs, oldest first
+//	GET  /v1/jobs/{id}             get a job with its result once finished
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.Handle("POST /v1/jobs/{operation}", s.authenticate(http.HandlerFunc(s.createJob)))
+	mux.Handle("GET /v1/jobs", s.authenticate(http.HandlerFunc(s.listJobs)))
+	mux.Handle("GET /v1/jobs/{id}", s.authenticate(http.HandlerFunc(s.getJob)))
+
+	return mux
+}
+
+// Wait blocks until every accepted job has finished.
+func (s *Server) Wait() {
+	s.wg.Wait()
+}
+
+// authenticate rejects requests without the configured bearer token.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.config.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// targetRequest selects the repositories of a job: the named ones, or all those of the owner matching the
+// prefix.
+type targetRequest struct {
+	Owner      string   `json:"owner"`
+	User       bool     `json:"user"`
+	RepoPrefix string   `json:"repo_prefix"`
+	Repos      []string `json:"repos"`
+}
+
+// issueStatsRequest is the body of an issue-stats job.
+type issueStatsRequest struct {
+	targetRequest
+	Labels    []string  `json:"labels"`
+	Since     time.Time `json:"since"`
+	Until     time.Time `json:"until"`
+	Assignee  string    `json:"assignee"`
+	Milestone string    `json:"milestone"`
+}
+
+// fileRolloutRequest is the body of a file-rollout job.
+type fileRolloutRequest struct {
+	targetRequest
+	Path          string `json:"path"`
+	Content       string `json:"content"`
+	CommitMessage string `json:"commit_message"`
+	SkipIfExists  bool   `json:"skip_if_exists"`
+	PullRequest   *struct {
+		Branch string `json:"branch"`
+		Title  string `json:"title"`
+		Body   string `json:"body"`
+	} `json:"pull_request"`
+}
+
+func (s *Server) createJob(w http.ResponseWriter, r *http.Request) {
+	operation := r.PathValue("operation")
+	body := http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+
+	var run func(ctx context.Context) (any, error)
+	var err error
+
+	switch operation {
+	case OperationListRepos:
+		var req targetRequest
+		if err = decodeRequest(body, &req); err == nil {
+			err = s.resolveOwner(&req)
+		}
+		run = func(ctx context.Context) (any, error) { return s.listRepos(ctx, req) }
+	case OperationIssueStats:
+		var req issueStatsRequest
+		if err = decodeRequest(body, &req); err == nil {
+			err = s.resolveOwner(&req.targetRequest)
+		}
+		run = func(ctx context.Context) (any, error) { return s.issueStats(ctx, req) }
+	case OperationFileRollout:
+		var req fileRolloutRequest
+		if err = decodeRequest(body, &req); err == nil {
+			err = s.resolveOwner(&req.targetRequest)
+		}
+		if err == nil {
+			err = validateFileRollout(req)
+		}
+		run = func(ctx context.Context) (any, error) { return s.fileRollout(ctx, req) }
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown operation %q, must be one of %s", operation,
+			strings.Join([]string{OperationListRepos, OperationIssueStats, OperationFileRollout}, ", ")))
+		return
+	}
+
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, errOwnerNotAllowed) {
+			status = http.StatusForbidden
+		}
+		writeError(w, status, err)
+		return
+	}
+
+	job := s.jobs.add(operation)
+	logger.GetLogger().Info("Accepted API job", "job", job.ID, "operation", operation)
+
+	s.wg.Add(1)
+	go s.runJob(job.ID, operation, run)
+
+	w.Header().Set("Location", "/v1/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+// runJob runs a job once a slot is free and records its outcome, also in the metrics under the job's
+// operation.
+func (s *Server) runJob(id, operation string, run func(ctx context.Context) (any, error)) {
+	defer s.wg.Done()
+
+	s.slots <- struct{}{}
+	defer func() { <-s.slots }()
+
+	log := logger.GetLogger()
+
+	s.jobs.start(id)
+	log.Info("Started API job", "job", id)
+
+	ctx, span := tracer.Start(metrics.WithOperation(s.ctx, operation), "api job "+operation,
+		trace.WithAttributes(attribute.String("job.id", id)))
+	defer span.End()
+
+	start := time.Now()
+	result, err := run(ctx)
+	s.jobs.finish(id, result, err)
+	metrics.ObserveOperation(operation, start, err)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	if err != nil {
+		log.Error("API job failed", "job", id, "error", err)
+		return
+	}
+	log.Info("Finished API job", "job", id)
+}
+
+func (s *Server) listJobs(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.jobs.list())
+}
+
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.jobs.get(r.PathValue("id"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("job %s not found", r.PathValue("id")))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+// errOwnerNotAllowed is returned for requests targeting an owner outside Config.Owners.
+var errOwnerNotAllowed = errors.New("owner not allowed")
+
+// resolveOwner fills in the owner when the server is limited to a single one and checks the request
+// against the allowed owners.
+func (s *Server) resolveOwner(req *targetRequest) error {
+	if req.Owner == "" && len(s.config.Owners) == 1 {
+		req.Owner, req.User = s.config.Owners[0].Login, s.config.Owners[0].IsUser
+	}
+
+	if req.Owner == "" {
+		return errors.New("owner is required")
+	}
+
+	if req.RepoPrefix != "" && len(req.Repos) > 0 {
+		return errors.New("cannot specify both repos and repo_prefix")
+	}
+
+	if len(s.config.Owners) > 0 && !slices.ContainsFunc(s.config.Owners, func(owner ghbatch.Owner) bool {
+		return strings.EqualFold(owner.Login, req.Owner)
+	}) {
+		return fmt.Errorf("%w: %s", errOwnerNotAllowed, req.Owner)
+	}
+
+	return nil
+}
+
+func validateFileRollout(req fileRolloutRequest) error {
+	if req.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if req.CommitMessage == "" {
+		return errors.New("commit_message is required")
+	}
+
+	if req.PullRequest != nil && (req.PullRequest.Branch == "" || req.PullRequest.Title == "") {
+		return errors.New("pull_request needs a branch and a title")
+	}
+
+	return nil
+}
+
+// decodeRequest decodes a JSON request body, rejecting unknown fields.
+func decodeRequest(body io.Reader, v any) error {
+	decoder := json.NewDecoder(body)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/metrics"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+const testToken = "api-token"
+
+// fakeService implements the operations the API uses; calling any other method panics.
+type fakeService struct {
+	ghbatch.GitHubClient
+	discoverErr error
+	rollouts    []ghbatch.FileRollout
+}
+
+func (f *fakeService) GetRepositoriesWithPrefix(ctx context.Context, owner, prefix string, isUser bool) ([]*github.Repository, error) {
+	if f.discoverErr != nil {
+		return nil, f.discoverErr
+	}
+
+	return []*github.Repository{
+		{Name: github.String(prefix + "one"), FullName: github.String(owner + "/" + prefix + "one"), DefaultBranch: github.String("main")},
+		{Name: github.String(prefix + "two"), FullName: github.String(owner + "/" + prefix + "two"), Archived: github.Bool(true)},
+	}, nil
+}
+
+func (f *fakeService) GetRepositories(ctx context.Context, owner string, repoNames []string) []ghbatch.RepoResult[*github.Repository] {
+	results := make([]ghbatch.RepoResult[*github.Repository], 0, len(repoNames))
+	for _, name := range repoNames {
+		if name == "missing" {
+			results = append(results, ghbatch.RepoResult[*github.Repository]{Owner: owner, RepoName: name, Err: errors.New("not found")})
+			continue
+		}
+		results = append(results, ghbatch.RepoResult[*github.Repository]{Owner: owner, RepoName: name,
+			Value: &github.Repository{Name: github.String(name), FullName: github.String(owner + "/" + name)}})
+	}
+
+	return results
+}
+
+func (f *fakeService) GetIssueStatsGraphQL(ctx context.Context, owner string, repoNames []string,
+	filter ghbatch.IssueStatsFilter,
+) ([]*ghbatch.IssueStats, error) {
+	stats := make([]*ghbatch.IssueStats, 0, len(repoNames))
+	for i, name := range repoNames {
+		stats = append(stats, &ghbatch.IssueStats{Owner: owner, RepoName: name, TotalIssues: 3 + i, OpenIssues: 1, ClosedIssues: 2 + i})
+	}
+
+	return stats, nil
+}
+
+func (f *fakeService) ApplyFileToRepos(ctx context.Context, owner string, repoNames []string,
+	rollout ghbatch.FileRollout,
+) []ghbatch.FileRolloutResult {
+	f.rollouts = append(f.rollouts, rollout)
+
+	results := make([]ghbatch.FileRolloutResult, 0, len(repoNames))
+	for _, name := range repoNames {
+		results = append(results, ghbatch.FileRolloutResult{RepoName: name, Status: ghbatch.FileStatusCreated})
+	}
+
+	return results
+}
+
+func newTestServer(t *testing.T, service *fakeService, owners ...ghbatch.Owner) (*Server, *httptest.Server) {
+	t.Helper()
+
+	server := NewServer(context.Background(), service, Config{Token: testToken, Owners: owners})
+	httpServer := httptest.NewServer(server.Handler())
+	t.Cleanup(httpServer.Close)
+
+	return server, httpServer
+}
+
+func doRequest(t *testing.T, method, url, token, body string) (*http.Response, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var decoded map[string]any
+	json.NewDecoder(resp.Body).Decode(&decoded)
+
+	return resp, decoded
+}
+
+// runJob starts a job, waits for it and returns it as polled from the API.
+func runJob(t *testing.T, server *Server, url, operation, body string) map[string]any {
+	t.Helper()
+
+	resp, job := doRequest(t, http.MethodPost, url+"/v1/jobs/"+operation, testToken, body)
+	require.Equal(t, http.StatusAccepted, resp.StatusCode, job)
+	assert.Equal(t, "/v1/jobs/"+job["id"].(string), resp.Header.Get("Location"))
+	assert.Equal(t, operation, job["operation"])
+
+	server.Wait()
+
+	resp, job = doRequest(t, http.MethodGet, url+resp.Header.Get("Location"), testToken, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	return job
+}
+
+func TestServer_Authentication(t *testing.T) {
+	_, httpServer := newTestServer(t, &fakeService{})
+
+	resp, _ := doRequest(t, http.MethodGet, httpServer.URL+"/healthz", "", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, body := doRequest(t, http.MethodGet, httpServer.URL+"/v1/jobs", "", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "Bearer", resp.Header.Get("WWW-Authenticate"))
+	assert.Equal(t, "missing or invalid bearer token", body["error"])
+
+	resp, _ = doRequest(t, http.MethodPost, httpServer.URL+"/v1/jobs/list-repos", "wrong", `{"owner":"testorg"}`)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = doRequest(t, http.MethodGet, httpServer.URL+"/v1/jobs", testToken, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServer_ListRepos(t *testing.T) {
+	server, httpServer := newTestServer(t, &fakeService{})
+
+	job := runJob(t, server, httpServer.URL, OperationListRepos, `{"owner":"testorg","repo_prefix":"svc-"}`)
+
+	assert.Equal(t, JobStatusSucceeded, job["status"])
+	assert.NotEmpty(t, job["finished_at"])
+	result := job["result"].([]any)
+	require.Len(t, result, 2)
+	assert.Equal(t, "testorg/svc-one", result[0].(map[string]any)["full_name"])
+	assert.Equal(t, true, result[1].(map[string]any)["archived"])
+
+	job = runJob(t, server, httpServer.URL, OperationListRepos, `{"owner":"testorg","repos":["api","missing"]}`)
+	result = job["result"].([]any)
+	require.Len(t, result, 2)
+	assert.Equal(t, "api", result[0].(map[string]any)["name"])
+	assert.Equal(t, "not found", result[1].(map[string]any)["error"])
+}
+
+func TestServer_IssueStats(t *testing.T) {
+	server, httpServer := newTestServer(t, &fakeService{}, ghbatch.Owner{Login: "testorg"})
+
+	job := runJob(t, server, httpServer.URL, OperationIssueStats, `{"repos":["api","web"],"labels":["bug"]}`)
+
+	assert.Equal(t, JobStatusSucceeded, job["status"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "api", "total": float64(3), "open": float64(1), "closed": float64(2)},
+		map[string]any{"name": "web", "total": float64(4), "open": float64(1), "closed": float64(3)},
+	}, job["result"])
+}
+
+func TestServer_FileRollout(t *testing.T) {
+	service := &fakeService{}
+	server, httpServer := newTestServer(t, service)
+
+	job := runJob(t, server, httpServer.URL, OperationFileRollout,
+		`{"owner":"testorg","repos":["api"],"path":"SECURITY.md","content":"Report issues to security@example.com\n","commit_message":"Add SECURITY.md","pull_request":{"branch":"add-security","title":"Add SECURITY.md"}}`)
+
+	assert.Equal(t, JobStatusSucceeded, job["status"])
+	assert.Equal(t, []any{map[string]any{"name": "api", "status": ghbatch.FileStatusCreated}}, job["result"])
+
+	require.Len(t, service.rollouts, 1)
+	content, err := service.rollouts[0].Render("api")
+	require.NoError(t, err)
+	assert.Equal(t, "Report issues to security@example.com\n", content)
+	assert.Equal(t, "add-security", service.rollouts[0].PullRequest.Branch)
+}
+
+func TestServer_FailedJob(t *testing.T) {
+	server, httpServer := newTestServer(t, &fakeService{discoverErr: errors.New("rate limited")})
+
+	job := runJob(t, server, httpServer.URL, OperationIssueStats, `{"owner":"testorg"}`)
+
+	assert.Equal(t, JobStatusFailed, job["status"])
+	assert.Equal(t, "rate limited", job["error"])
+	assert.Nil(t, job["result"])
+
+	var out strings.Builder
+	require.NoError(t, metrics.Default.WriteText(&out))
+	assert.Contains(t, out.String(), `grm_operation_duration_seconds_count{operation="issue-stats",result="failure"} 1`)
+}
+
+func TestServer_InvalidRequests(t *testing.T) {
+	_, httpServer := newTestServer(t, &fakeService{}, ghbatch.Owner{Login: "testorg"}, ghbatch.Owner{Login: "alice", IsUser: true})
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{"Unknown operation", "/v1/jobs/delete-everything", `{}`, http.StatusNotFound},
+		{"Malformed body", "/v1/jobs/list-repos", `{`, http.StatusBadRequest},
+		{"Unknown field", "/v1/jobs/list-repos", `{"owner":"testorg","org":"x"}`, http.StatusBadRequest},
+		{"Missing owner", "/v1/jobs/list-repos", `{}`, http.StatusBadRequest},
+		{"Repos and prefix", "/v1/jobs/list-repos", `{"owner":"testorg","repos":["a"],"repo_prefix":"b"}`, http.StatusBadRequest},
+		{"Owner not allowed", "/v1/jobs/issue-stats", `{"owner":"other"}`, http.StatusForbidden},
+		{"Missing path", "/v1/jobs/file-rollout", `{"owner":"testorg","commit_message":"x"}`, http.StatusBadRequest},
+		{"Incomplete pull request", "/v1/jobs/file-rollout", `{"owner":"testorg","path":"a","commit_message":"x","pull_request":{}}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, body := doRequest(t, http.MethodPost, httpServer.URL+tt.path, testToken, tt.body)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.NotEmpty(t, body["error"])
+		})
+	}
+
+	resp, _ := doRequest(t, http.MethodGet, httpServer.URL+"/v1/jobs/unknown", testToken, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// Job statuses.
+const (
+	JobStatusQueued    = "queued"
+	JobStatusRunning   = "running"
+	JobStatusSucceeded = "succeeded"
+	JobStatusFailed    = "failed"
+)
+
+// maxFinishedJobs is how many finished jobs are kept for their results; older ones are forgotten.
+const maxFinishedJobs = 100
+
+// Job is an operation accepted by the API and run in the background.
+type Job struct {
+	ID        string    `json:"id"`
+	Operation string    `json:"operation"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	// StartedAt and FinishedAt are nil until the job starts and finishes.
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Error is why the job failed as a whole; failures of single repositories are part of Result.
+	Error  string `json:"error,omitempty"`
+	Result any    `json:"result,omitempty"`
+}
+
+// Finished reports whether the job succeeded or failed.
+func (j Job) Finished() bool {
+	return j.Status == JobStatusSucceeded || j.Status == JobStatusFailed
+}
+
+// jobStore holds the jobs in the order they were created.
+type jobStore struct {
+	mu    sync.Mutex
+	jobs  map[string]*Job
+	order []string
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{jobs: make(map[string]*Job)}
+}
+
+// add registers a new queued job for an operation, forgetting the oldest finished jobs beyond
+// maxFinishedJobs, and returns a copy of it.
+func (s *jobStore) add(operation string) Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job := &Job{ID: newJobID(), Operation: operation, Status: JobStatusQueued, CreatedAt: time.Now().UTC()}
+	s.jobs[job.ID] = job
+	s.order = append(s.order, job.ID)
+
+	finished := 0
+	for _, id := range s.order {
+		if s.jobs[id].Finished() {
+			finished++
+		}
+	}
+
+	kept := s.order[:0]
+	for _, id := range s.order {
+		if finished > maxFinishedJobs && s.jobs[id].Finished() {
+			delete(s.jobs, id)
+			finished--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	s.order = kept
+
+	return *job
+}
+
+// get returns a copy of a job.
+func (s *jobStore) get(id string) (Job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return Job{}, false
+	}
+
+	return *job, true
+}
+
+// list returns copies of all jobs, oldest first.
+func (s *jobStore) list() []Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]Job, 0, len(s.order))
+	for _, id := range s.order {
+		jobs = append(jobs, *s.jobs[id])
+	}
+
+	return jobs
+}
+
+// start marks a job as running.
+func (s *jobStore) start(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	s.jobs[id].Status = JobStatusRunning
+	s.jobs[id].StartedAt = &now
+}
+
+// finish records the result of a job, or the error failing it.
+func (s *jobStore) finish(id string, result any, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	job := s.jobs[id]
+	job.FinishedAt = &now
+
+	if err != nil {
+		job.Status = JobStatusFailed
+		job.Error = err.Error()
+		return
+	}
+
+	job.Status = JobStatusSucceeded
+	job.Result = result
+}
+
+// newJobID returns a random job ID.
+func newJobID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
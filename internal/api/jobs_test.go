@@ -0,0 +1,52 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobStore_Lifecycle(t *testing.T) {
+	store := newJobStore()
+
+	job := store.add(OperationListRepos)
+	assert.Equal(t, JobStatusQueued, job.Status)
+	assert.Len(t, job.ID, 16)
+
+	store.start(job.ID)
+	running, ok := store.get(job.ID)
+	require.True(t, ok)
+	assert.Equal(t, JobStatusRunning, running.Status)
+	assert.NotNil(t, running.StartedAt)
+
+	store.finish(job.ID, nil, errors.New("boom"))
+	failed, _ := store.get(job.ID)
+	assert.Equal(t, JobStatusFailed, failed.Status)
+	assert.Equal(t, "boom", failed.Error)
+	assert.True(t, failed.Finished())
+}
+
+func TestJobStore_ForgetsOldestFinishedJobs(t *testing.T) {
+	store := newJobStore()
+
+	running := store.add(OperationFileRollout)
+	store.start(running.ID)
+
+	var first string
+	for i := 0; i < maxFinishedJobs+5; i++ {
+		job := store.add(OperationIssueStats)
+		store.finish(job.ID, []string{}, nil)
+		if i == 0 {
+			first = job.ID
+		}
+	}
+	store.add(OperationIssueStats)
+
+	jobs := store.list()
+	assert.Len(t, jobs, maxFinishedJobs+2)
+	assert.Equal(t, running.ID, jobs[0].ID)
+	_, ok := store.get(first)
+	assert.False(t, ok)
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// RepoSummary is a repository in the result of a list-repos job.
+type RepoSummary struct {
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	Archived      bool   `json:"archived"`
+	DefaultBranch string `json:"default_branch"`
+	URL           string `json:"url"`
+	Error         string `json:"error,omitempty"`
+}
+
+// RepoIssueStats is a repository in the result of an issue-stats job.
+type RepoIssueStats struct {
+	Name   string `json:"name"`
+	Total  int    `json:"total"`
+	Open   int    `json:"open"`
+	Closed int    `json:"closed"`
+}
+
+// RepoFileRollout is a repository in the result of a file-rollout job.
+type RepoFileRollout struct {
+	Name string `json:"name"`
+	// Status is one of the ghbatch.FileStatus constants.
+	Status         string `json:"status"`
+	PullRequestURL string `json:"pull_request_url,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// repoNames returns the repositories a request names, or discovers those of the owner matching its prefix.
+func (s *Server) repoNames(ctx context.Context, req targetRequest) ([]string, error) {
+	if len(req.Repos) > 0 {
+		return req.Repos, nil
+	}
+
+	repos, err := s.service.GetRepositoriesWithPrefix(ctx, req.Owner, req.RepoPrefix, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(repos))
+	for _, repository := range repos {
+		names = append(names, repository.GetName())
+	}
+
+	return names, nil
+}
+
+func (s *Server) listRepos(ctx context.Context, req targetRequest) (any, error) {
+	summaries := []RepoSummary{}
+
+	if len(req.Repos) > 0 {
+		for _, result := range s.service.GetRepositories(ctx, req.Owner, req.Repos) {
+			summary := RepoSummary{Name: result.RepoName, FullName: req.Owner + "/" + result.RepoName}
+			if result.Err != nil {
+				summary.Error = result.Err.Error()
+			} else {
+				summary = newRepoSummary(result.Value)
+			}
+			summaries = append(summaries, summary)
+		}
+
+		return summaries, nil
+	}
+
+	repos, err := s.service.GetRepositoriesWithPrefix(ctx, req.Owner, req.RepoPrefix, req.User)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range repos {
+		summaries = append(summaries, newRepoSummary(repository))
+	}
+
+	return summaries, nil
+}
+
+func newRepoSummary(repository *github.Repository) RepoSummary {
+	return RepoSummary{
+		Name:          repository.GetName(),
+		FullName:      repository.GetFullName(),
+		Private:       repository.GetPrivate(),
+		Archived:      repository.GetArchived(),
+		DefaultBranch: repository.GetDefaultBranch(),
+		URL:           repository.GetHTMLURL(),
+	}
+}
+
+func (s *Server) issueStats(ctx context.Context, req issueStatsRequest) (any, error) {
+	names, err := s.repoNames(ctx, req.targetRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := ghbatch.IssueStatsFilter{
+		Labels:    req.Labels,
+		Since:     req.Since,
+		Until:     req.Until,
+		Assignee:  req.Assignee,
+		Milestone: req.Milestone,
+	}
+
+	allStats, err := s.service.GetIssueStatsGraphQL(ctx, req.Owner, names, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RepoIssueStats, 0, len(allStats))
+	for _, stats := range allStats {
+		results = append(results, RepoIssueStats{
+			Name:   stats.RepoName,
+			Total:  stats.TotalIssues,
+			Open:   stats.OpenIssues,
+			Closed: stats.ClosedIssues,
+		})
+	}
+
+	return results, nil
+}
+
+func (s *Server) fileRollout(ctx context.Context, req fileRolloutRequest) (any, error) {
+	names, err := s.repoNames(ctx, req.targetRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	rollout := ghbatch.FileRollout{
+		Path:          req.Path,
+		Render:        func(string) (string, error) { return req.Content, nil },
+		CommitMessage: req.CommitMessage,
+		SkipIfExists:  req.SkipIfExists,
+	}
+	if req.PullRequest != nil {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: req.PullRequest.Branch,
+			Title:  req.PullRequest.Title,
+			Body:   req.PullRequest.Body,
+		}
+	}
+
+	results := make([]RepoFileRollout, 0, len(names))
+	for _, result := range s.service.ApplyFileToRepos(ctx, req.Owner, names, rollout) {
+		rolledOut := RepoFileRollout{Name: result.RepoName, Status: result.Status, PullRequestURL: result.PullRequestURL}
+		if result.Err != nil {
+			rolledOut.Error = result.Err.Error()
+		}
+		results = append(results, rolledOut)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,102 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Record is a single entry in the audit log.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	// RunID identifies the batch run that made the change.
+	RunID string `json:"runId,omitempty"`
+	// Actor is the login of the authenticated user that made the change.
+	Actor     string `json:"actor,omitempty"`
+	Operation string `json:"operation"`
+	Owner     string `json:"owner"`
+	Repo      string `json:"repo"`
+	// Target names what a change other than a file change applies to, e.g. an issue, a secret or the new
+	// visibility of the repository.
+	Target string `json:"target,omitempty"`
+	// Path and Branch locate a changed file; an empty Branch is the default branch.
+	Path   string `json:"path,omitempty"`
+	Branch string `json:"branch,omitempty"`
+	// OldSHA and NewSHA are the blob SHAs of a file before and after the change; OldSHA is empty for a
+	// created file.
+	OldSHA    string `json:"oldSha,omitempty"`
+	NewSHA    string `json:"newSha,omitempty"`
+	CommitSHA string `json:"commitSha,omitempty"`
+	CommitURL string `json:"commitUrl,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Writer is implemented by the destinations of audit records.
+type Writer interface {
+	Write(record Record) error
+}
+
+// Log appends audit records as JSON lines to a file. It is safe for concurrent use.
+type Log struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// Open opens the audit log at path for appending, creating it if needed.
+func Open(path string) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+
+	return &Log{file: file}, nil
+}
+
+// Write appends a record to the log. A zero Timestamp is replaced by the current time.
+func (l *Log) Write(record Record) error {
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+
+	return nil
+}
+
+// Read returns all the records of the audit log at path, skipping lines that are not valid records.
+func Read(path string) ([]Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audit log %s: %w", path, err)
+	}
+
+	var records []Record
+
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		var record Record
+		if len(line) > 0 && json.Unmarshal(line, &record) == nil {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
+
+// Close closes the underlying file.
+func (l *Log) Close() error {
+	return l.file.Close()
+}
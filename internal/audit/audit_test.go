@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLog_WriteAppendsJSONLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Write(Record{Operation: "delete", Owner: "testorg", Repo: "repo1", Status: "success"}))
+	require.NoError(t, log.Close())
+
+	// Reopening must append rather than truncate
+	log, err = Open(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Write(Record{Operation: "delete", Owner: "testorg", Repo: "repo2", Status: "failed", Error: "boom"}))
+	require.NoError(t, log.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 2)
+	assert.Equal(t, "repo1", records[0].Repo)
+	assert.False(t, records[0].Timestamp.IsZero())
+	assert.Equal(t, "boom", records[1].Error)
+}
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	log, err := Open(path)
+	require.NoError(t, err)
+	require.NoError(t, log.Write(Record{RunID: "run1", Operation: "commit-file", Repo: "api", OldSHA: "abc", NewSHA: "def", Status: "success"}))
+	require.NoError(t, log.Close())
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	file.WriteString(`{"runId":"run1","ope`)
+	file.Close()
+
+	records, err := Read(path)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "def", records[0].NewSHA)
+}
+
+func TestEndpoint_Write(t *testing.T) {
+	var received Record
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewEndpoint(server.URL).Write(Record{Operation: "commit-file", Repo: "api", Status: "success"}))
+	assert.Equal(t, "api", received.Repo)
+	assert.False(t, received.Timestamp.IsZero())
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	assert.ErrorContains(t, NewEndpoint(failing.URL).Write(Record{}), "503")
+}
@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// endpointTimeout bounds how long posting a record to a central endpoint may take.
+const endpointTimeout = 10 * time.Second
+
+// Endpoint posts audit records as JSON to a central HTTP endpoint.
+type Endpoint struct {
+	url    string
+	client *http.Client
+}
+
+// NewEndpoint returns an endpoint posting records to url.
+func NewEndpoint(url string) *Endpoint {
+	return &Endpoint{url: url, client: &http.Client{Timeout: endpointTimeout}}
+}
+
+// Write posts a record to the endpoint. A zero Timestamp is replaced by the current time.
+func (e *Endpoint) Write(record Record) error {
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now().UTC()
+	}
+
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to post audit record: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post audit record to %s: %w", e.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("failed to post audit record to %s: %s", e.url, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultScopes are the OAuth scopes requested by Login; they cover the repository, organization and
+// workflow operations of the commands.
+var DefaultScopes = []string{"repo", "read:org", "workflow", "delete_repo"}
+
+// slowDownIncrease is how much the polling interval grows when GitHub answers slow_down.
+const slowDownIncrease = 5 * time.Second
+
+// DeviceCode is the code the user enters at the verification URL to authorize the device.
+type DeviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// DeviceFlow runs the OAuth device authorization flow of a GitHub OAuth app.
+type DeviceFlow struct {
+	// ClientID is the client ID of the OAuth app, which must have the device flow enabled.
+	ClientID string
+	Scopes   []string
+	// BaseURL is the GitHub web URL, https://github.com when empty.
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// RequestCode starts the flow and returns the code the user has to enter.
+func (f *DeviceFlow) RequestCode(ctx context.Context) (*DeviceCode, error) {
+	form := url.Values{"client_id": {f.ClientID}, "scope": {strings.Join(f.Scopes, " ")}}
+
+	var code struct {
+		DeviceCode
+		oauthError
+	}
+	if err := f.post(ctx, "/login/device/code", form, &code); err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+	if code.Code != "" {
+		return nil, fmt.Errorf("failed to request device code: %w", code.oauthError)
+	}
+
+	return &code.DeviceCode, nil
+}
+
+// PollToken waits until the user has authorized the device code and returns the access token. It polls at
+// the interval requested by GitHub and fails when the code expires or the user denies access.
+func (f *DeviceFlow) PollToken(ctx context.Context, code *DeviceCode) (string, error) {
+	interval := time.Duration(code.Interval) * time.Second
+	form := url.Values{
+		"client_id":   {f.ClientID},
+		"device_code": {code.DeviceCode},
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(interval):
+		}
+
+		var token struct {
+			AccessToken string `json:"access_token"`
+			oauthError
+		}
+		if err := f.post(ctx, "/login/oauth/access_token", form, &token); err != nil {
+			return "", fmt.Errorf("failed to request access token: %w", err)
+		}
+
+		switch token.Code {
+		case "":
+			return token.AccessToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			interval += slowDownIncrease
+		default:
+			return "", fmt.Errorf("device authorization failed: %w", token.oauthError)
+		}
+	}
+}
+
+// post sends a form to the GitHub web endpoint and decodes the JSON response.
+func (f *DeviceFlow) post(ctx context.Context, path string, form url.Values, v any) error {
+	baseURL := f.BaseURL
+	if baseURL == "" {
+		baseURL = "https://github.com"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(baseURL, "/")+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client := f.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// ErrAccessDenied is returned by PollToken when the user cancels the authorization.
+var ErrAccessDenied = errors.New("access denied by the user")
+
+// oauthError is the error reported in the body of OAuth responses.
+type oauthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e oauthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
+
+func (e oauthError) Unwrap() error {
+	if e.Code == "access_denied" {
+		return ErrAccessDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeviceFlow(t *testing.T) {
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		assert.Equal(t, "client-123", r.Form.Get("client_id"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/login/device/code":
+			assert.Equal(t, "repo read:org", r.Form.Get("scope"))
+			fmt.Fprint(w, `{"device_code":"dev-1","user_code":"ABCD-1234","verification_uri":"https://github.com/login/device","expires_in":900,"interval":0}`)
+		case "/login/oauth/access_token":
+			assert.Equal(t, "dev-1", r.Form.Get("device_code"))
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.Form.Get("grant_type"))
+			if polls.Add(1) < 3 {
+				fmt.Fprint(w, `{"error":"authorization_pending"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"gho_device","token_type":"bearer","scope":"repo,read:org"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	flow := &DeviceFlow{ClientID: "client-123", Scopes: []string{"repo", "read:org"}, BaseURL: server.URL}
+
+	code, err := flow.RequestCode(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD-1234", code.UserCode)
+	assert.Equal(t, "https://github.com/login/device", code.VerificationURI)
+
+	token, err := flow.PollToken(context.Background(), code)
+	require.NoError(t, err)
+	assert.Equal(t, "gho_device", token)
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestDeviceFlow_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		wantIs   error
+		wantText string
+	}{
+		{"Access denied", `{"error":"access_denied","error_description":"The user has denied your application access."}`, ErrAccessDenied, "access_denied"},
+		{"Expired", `{"error":"expired_token"}`, nil, "expired_token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.response)
+			}))
+			defer server.Close()
+
+			flow := &DeviceFlow{ClientID: "client-123", BaseURL: server.URL}
+			_, err := flow.PollToken(context.Background(), &DeviceCode{DeviceCode: "dev-1"})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantText)
+			if tt.wantIs != nil {
+				assert.ErrorIs(t, err, tt.wantIs)
+			}
+		})
+	}
+}
+
+func TestDeviceFlow_RequestCodeRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"error":"device_flow_disabled","error_description":"Device Flow must be explicitly enabled for this App"}`)
+	}))
+	defer server.Close()
+
+	flow := &DeviceFlow{ClientID: "client-123", BaseURL: server.URL}
+	_, err := flow.RequestCode(context.Background())
+	assert.ErrorContains(t, err, "device_flow_disabled: Device Flow must be explicitly enabled")
+}
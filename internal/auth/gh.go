@@ -0,0 +1,106 @@
+// Package auth finds GitHub tokens configured outside of the command line.
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultHost is the GitHub host tokens are looked up for.
+const DefaultHost = "github.com"
+
+// ErrNoGHToken is returned when the gh CLI has no credential for the host.
+var ErrNoGHToken = errors.New("no gh CLI credential found")
+
+// ghCommand is the gh executable; tests replace it.
+var ghCommand = "gh"
+
+// GHToken returns the token the gh CLI is authenticated with for the host. It asks `gh auth token`,
+// which also reads credentials gh stored in the OS keyring, and falls back to the oauth_token in gh's
+// hosts.yml when gh is not installed.
+func GHToken(ctx context.Context, host string) (string, error) {
+	if token, err := ghAuthToken(ctx, host); err == nil {
+		return token, nil
+	}
+
+	path, err := ghHostsFile()
+	if err != nil {
+		return "", err
+	}
+
+	return readHostsFileToken(path, host)
+}
+
+// ghAuthToken runs `gh auth token` for the host.
+func ghAuthToken(ctx context.Context, host string) (string, error) {
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, ghCommand, "auth", "token", "--hostname", host)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to run gh auth token: %w", err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", ErrNoGHToken
+	}
+
+	return token, nil
+}
+
+// ghHostsFile returns the path of gh's hosts.yml, honoring GH_CONFIG_DIR and XDG_CONFIG_HOME like gh does.
+func ghHostsFile() (string, error) {
+	if dir := os.Getenv("GH_CONFIG_DIR"); dir != "" {
+		return filepath.Join(dir, "hosts.yml"), nil
+	}
+
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "gh", "hosts.yml"), nil
+	}
+
+	if dir := os.Getenv("AppData"); runtime.GOOS == "windows" && dir != "" {
+		return filepath.Join(dir, "GitHub CLI", "hosts.yml"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".config", "gh", "hosts.yml"), nil
+}
+
+// readHostsFileToken reads the oauth_token of the host from a gh hosts.yml file. Tokens gh keeps in the
+// OS keyring are not in the file.
+func readHostsFileToken(path, host string) (string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoGHToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read gh hosts file %s: %w", path, err)
+	}
+
+	var hosts map[string]struct {
+		OAuthToken string `yaml:"oauth_token"`
+	}
+	if err := yaml.Unmarshal(data, &hosts); err != nil {
+		return "", fmt.Errorf("failed to parse gh hosts file %s: %w", path, err)
+	}
+
+	token := hosts[host].OAuthToken
+	if token == "" {
+		return "", ErrNoGHToken
+	}
+
+	return token, nil
+}
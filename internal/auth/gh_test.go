@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGHToken_FallsBackToHostsFile(t *testing.T) {
+	ghCommand = "gh-not-installed"
+	t.Cleanup(func() { ghCommand = "gh" })
+
+	dir := t.TempDir()
+	t.Setenv("GH_CONFIG_DIR", dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hosts.yml"), []byte(`github.com:
+    user: octocat
+    oauth_token: gho_hosts
+    git_protocol: https
+ghe.example.com:
+    oauth_token: gho_enterprise
+`), 0o600))
+
+	token, err := GHToken(context.Background(), DefaultHost)
+	require.NoError(t, err)
+	assert.Equal(t, "gho_hosts", token)
+
+	token, err = GHToken(context.Background(), "ghe.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "gho_enterprise", token)
+}
+
+func TestGHToken_NoCredential(t *testing.T) {
+	ghCommand = "gh-not-installed"
+	t.Cleanup(func() { ghCommand = "gh" })
+
+	tests := []struct {
+		name  string
+		hosts string
+	}{
+		{"No hosts file", ""},
+		{"Token in keyring", "github.com:\n    user: octocat\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Setenv("GH_CONFIG_DIR", dir)
+			if tt.hosts != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "hosts.yml"), []byte(tt.hosts), 0o600))
+			}
+
+			_, err := GHToken(context.Background(), DefaultHost)
+			assert.ErrorIs(t, err, ErrNoGHToken)
+		})
+	}
+}
+
+func TestReadHostsFileToken_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hosts.yml")
+	require.NoError(t, os.WriteFile(path, []byte("github.com: [unclosed"), 0o600))
+
+	_, err := readHostsFileToken(path, DefaultHost)
+	assert.ErrorContains(t, err, "failed to parse gh hosts file")
+}
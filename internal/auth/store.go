@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the name tokens are stored under in the OS keychain.
+const keyringService = "go-repo-manager"
+
+// ErrNoStoredToken is returned when no token was stored by Login.
+var ErrNoStoredToken = errors.New("no stored token")
+
+// Store keeps the token obtained by the login command. It uses the OS keychain and falls back to a file
+// readable only by the user when no keychain is available, e.g. on headless Linux machines.
+type Store struct {
+	// Dir is the directory of the fallback token file.
+	Dir string
+}
+
+// DefaultStore returns the store with its fallback file under the user config directory.
+func DefaultStore() (*Store, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate the user config directory: %w", err)
+	}
+
+	return &Store{Dir: filepath.Join(dir, "go-repo-manager")}, nil
+}
+
+// Save stores the token for the host and returns where it was stored.
+func (s *Store) Save(host, token string) (string, error) {
+	if err := keyring.Set(keyringService, host, token); err == nil {
+		// Remove a token left in the file by an earlier login without keychain.
+		_ = os.Remove(s.tokenFile(host))
+
+		return "OS keychain", nil
+	}
+
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return "", fmt.Errorf("failed to create token directory: %w", err)
+	}
+
+	path := s.tokenFile(host)
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write token file: %w", err)
+	}
+
+	return path, nil
+}
+
+// Load returns the stored token for the host.
+func (s *Store) Load(host string) (string, error) {
+	if token, err := keyring.Get(keyringService, host); err == nil {
+		return token, nil
+	}
+
+	data, err := os.ReadFile(s.tokenFile(host))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoStoredToken
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", ErrNoStoredToken
+	}
+
+	return token, nil
+}
+
+// tokenFile is the fallback file of the host's token.
+func (s *Store) tokenFile(host string) string {
+	return filepath.Join(s.Dir, host+".token")
+}
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestStore_Keychain(t *testing.T) {
+	keyring.MockInit()
+	store := &Store{Dir: t.TempDir()}
+
+	_, err := store.Load(DefaultHost)
+	assert.ErrorIs(t, err, ErrNoStoredToken)
+
+	location, err := store.Save(DefaultHost, "gho_keychain")
+	require.NoError(t, err)
+	assert.Equal(t, "OS keychain", location)
+	assert.NoFileExists(t, filepath.Join(store.Dir, "github.com.token"))
+
+	token, err := store.Load(DefaultHost)
+	require.NoError(t, err)
+	assert.Equal(t, "gho_keychain", token)
+}
+
+func TestStore_FileFallback(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keychain available"))
+	store := &Store{Dir: filepath.Join(t.TempDir(), "go-repo-manager")}
+
+	location, err := store.Save(DefaultHost, "gho_file")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(store.Dir, "github.com.token"), location)
+
+	info, err := os.Stat(location)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	token, err := store.Load(DefaultHost)
+	require.NoError(t, err)
+	assert.Equal(t, "gho_file", token)
+}
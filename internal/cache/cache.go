@@ -0,0 +1,146 @@
+// Package cache stores API responses on disk so repeated runs do not rediscover the same repositories.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultTTL is how long cached entries are used when no TTL is configured.
+const DefaultTTL = time.Hour
+
+// entryExt is the file extension of cache entries; Clear removes only files carrying it.
+const entryExt = ".json"
+
+// Entry is a cached value with the time it was stored.
+type Entry struct {
+	StoredAt time.Time `json:"storedAt"`
+	Data     []byte    `json:"data"`
+}
+
+// Cache is a directory of entries that expire after a TTL. It is safe for concurrent use; concurrent
+// writers of the same key simply leave the last value.
+type Cache struct {
+	dir string
+	ttl time.Duration
+}
+
+// New returns a cache storing its entries in dir. A TTL of zero uses DefaultTTL.
+func New(dir string, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	return &Cache{dir: dir, ttl: ttl}
+}
+
+// DefaultDir returns the default cache directory, go-repo-manager under the user cache directory
+// (e.g. ~/.cache/go-repo-manager on Linux).
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the user cache directory: %w", err)
+	}
+
+	return filepath.Join(dir, "go-repo-manager"), nil
+}
+
+// Dir returns the directory holding the cache entries.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// Get returns the data stored for key, if present and not expired.
+func (c *Cache) Get(key string) ([]byte, bool) {
+	raw, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(raw, &entry); err != nil || time.Since(entry.StoredAt) > c.ttl {
+		return nil, false
+	}
+
+	return entry.Data, true
+}
+
+// Put stores data for key. The entry is written to a temporary file and renamed so readers never see a
+// partial entry.
+func (c *Cache) Put(key string, data []byte) error {
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create cache directory %s: %w", c.dir, err)
+	}
+
+	raw, err := json.Marshal(Entry{StoredAt: time.Now().UTC(), Data: data})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// Clear removes every cache entry and returns how many were removed. A missing directory is not an error.
+func (c *Cache) Clear() (int, error) {
+	files, err := os.ReadDir(c.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to read cache directory %s: %w", c.dir, err)
+	}
+
+	removed := 0
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), entryExt) {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(c.dir, file.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, fmt.Errorf("failed to remove cache entry: %w", err)
+		}
+
+		removed++
+	}
+
+	return removed, nil
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+entryExt)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_PutGet(t *testing.T) {
+	c := New(t.TempDir(), time.Hour)
+
+	_, ok := c.Get("repos")
+	assert.False(t, ok)
+
+	require.NoError(t, c.Put("repos", []byte("data")))
+
+	data, ok := c.Get("repos")
+	require.True(t, ok)
+	assert.Equal(t, []byte("data"), data)
+}
+
+func TestCache_Expired(t *testing.T) {
+	c := New(t.TempDir(), time.Minute)
+
+	raw, err := json.Marshal(Entry{StoredAt: time.Now().Add(-2 * time.Minute), Data: []byte("old")})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(c.path("repos"), raw, 0o600))
+
+	_, ok := c.Get("repos")
+	assert.False(t, ok)
+}
+
+func TestCache_Clear(t *testing.T) {
+	dir := t.TempDir()
+	c := New(dir, 0)
+
+	require.NoError(t, c.Put("a", []byte("1")))
+	require.NoError(t, c.Put("b", []byte("2")))
+	require.NoError(t, os.WriteFile(dir+"/notes.txt", []byte("keep"), 0o600))
+
+	removed, err := c.Clear()
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.FileExists(t, dir+"/notes.txt")
+
+	removed, err = New(dir+"/missing", 0).Clear()
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+}
@@ -0,0 +1,198 @@
+// Package checkpoint records the progress of mutating batch runs so an interrupted run can be resumed
+// without redoing the repositories it already completed.
+package checkpoint
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// StatusFailed marks a repository that must be processed again when the run is resumed. Any other
+	// status counts as completed.
+	StatusFailed = "failed"
+	// StatusDone marks a completed work item that has no more specific status.
+	StatusDone = "done"
+)
+
+// header is the first line of a checkpoint file.
+type header struct {
+	RunID     string    `json:"runId"`
+	Command   string    `json:"command"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+// Outcome is the recorded result of one repository.
+type Outcome struct {
+	Repo   string    `json:"repo"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// Run is the checkpoint of a batch run, stored as JSON lines in <dir>/<run-id>.jsonl: a header followed by
+// one outcome per processed repository, appended as soon as it completes. It is safe for concurrent use.
+type Run struct {
+	ID      string
+	Command string
+
+	mu       sync.Mutex
+	file     *os.File
+	outcomes map[string]Outcome
+}
+
+// DefaultDir returns the default checkpoint directory, go-repo-manager/runs under the user cache directory.
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the user cache directory: %w", err)
+	}
+
+	return filepath.Join(dir, "go-repo-manager", "runs"), nil
+}
+
+// NewRunID returns a new run identifier made of the start time and a random suffix, e.g.
+// 20240501-142233-9f3a1c.
+func NewRunID() string {
+	suffix := make([]byte, 3)
+	rand.Read(suffix)
+
+	return time.Now().UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix)
+}
+
+// Start creates the checkpoint of a new run of command identified by runID, usually from NewRunID.
+func Start(dir, runID, command string) (*Run, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory %s: %w", dir, err)
+	}
+
+	run := &Run{ID: runID, Command: command, outcomes: map[string]Outcome{}}
+
+	file, err := os.OpenFile(runPath(dir, run.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+
+	run.file = file
+	if err := run.append(header{RunID: run.ID, Command: command, StartedAt: time.Now().UTC()}); err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// Resume reopens the checkpoint of run runID so further outcomes are appended to it. The run must have been
+// started by the same command.
+func Resume(dir, runID, command string) (*Run, error) {
+	path := runPath(dir, runID)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no checkpoint found for run %s in %s", runID, dir)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+
+	var h header
+	if err := json.Unmarshal(lines[0], &h); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+
+	if h.Command != command {
+		return nil, fmt.Errorf("run %s was started by %q, not %q", runID, h.Command, command)
+	}
+
+	run := &Run{ID: runID, Command: command, outcomes: map[string]Outcome{}}
+
+	for _, line := range lines[1:] {
+		var outcome Outcome
+		// A line cut short by a crash is ignored; its repository is simply processed again
+		if err := json.Unmarshal(line, &outcome); err == nil {
+			run.outcomes[outcome.Repo] = outcome
+		}
+	}
+
+	run.file, err = os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open checkpoint: %w", err)
+	}
+
+	// Terminate a line cut short by a crash so the next record starts on its own line
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		if _, err := run.file.Write([]byte("\n")); err != nil {
+			run.file.Close()
+
+			return nil, fmt.Errorf("failed to write checkpoint: %w", err)
+		}
+	}
+
+	return run, nil
+}
+
+// Pending returns the repositories of repoNames that the run has not completed yet, in the same order.
+func (r *Run) Pending(repoNames []string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var pending []string
+
+	for _, repoName := range repoNames {
+		if outcome, ok := r.outcomes[repoName]; !ok || outcome.Status == StatusFailed {
+			pending = append(pending, repoName)
+		}
+	}
+
+	return pending
+}
+
+// Record appends the outcome of a repository to the checkpoint.
+func (r *Run) Record(repoName, status string, err error) error {
+	outcome := Outcome{Repo: repoName, Status: status, Time: time.Now().UTC()}
+	if err != nil {
+		outcome.Error = err.Error()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.outcomes[repoName] = outcome
+
+	return r.append(outcome)
+}
+
+// Close closes the checkpoint file.
+func (r *Run) Close() error {
+	return r.file.Close()
+}
+
+// append writes a JSON line to the checkpoint file; the caller holds mu or owns the run exclusively.
+func (r *Run) append(value any) error {
+	line, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint record: %w", err)
+	}
+
+	if _, err := r.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func runPath(dir, runID string) string {
+	return filepath.Join(dir, runID+".jsonl")
+}
@@ -0,0 +1,55 @@
+package checkpoint
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Resume(t *testing.T) {
+	dir := t.TempDir()
+
+	run, err := Start(dir, NewRunID(), "license add")
+	require.NoError(t, err)
+	require.NoError(t, run.Record("api", "created", nil))
+	require.NoError(t, run.Record("web", StatusFailed, errors.New("boom")))
+	require.NoError(t, run.Close())
+
+	// Simulate a crash in the middle of writing a record
+	file, err := os.OpenFile(runPath(dir, run.ID), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	file.WriteString(`{"repo":"cli","sta`)
+	file.Close()
+
+	resumed, err := Resume(dir, run.ID, "license add")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"web", "cli", "docs"}, resumed.Pending([]string{"api", "web", "cli", "docs"}))
+
+	require.NoError(t, resumed.Record("web", "updated", nil))
+	require.NoError(t, resumed.Record("cli", "created", nil))
+	require.NoError(t, resumed.Close())
+
+	again, err := Resume(dir, run.ID, "license add")
+	require.NoError(t, err)
+	defer again.Close()
+
+	assert.Equal(t, []string{"docs"}, again.Pending([]string{"api", "web", "cli", "docs"}))
+}
+
+func TestResume_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := Resume(dir, "missing", "license add")
+	assert.ErrorContains(t, err, "no checkpoint found")
+
+	run, err := Start(dir, NewRunID(), "license add")
+	require.NoError(t, err)
+	run.Close()
+
+	_, err = Resume(dir, run.ID, "dependabot apply")
+	assert.ErrorContains(t, err, `was started by "license add"`)
+}
@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Accepted values of the Actions permission flags.
+var (
+	allowedActionsValues      = []string{"all", "local_only", "selected"}
+	workflowPermissionsValues = []string{"read", "write"}
+	forkPRApprovalValues      = []string{ghbatch.ForkPRApprovalNewToGitHub, ghbatch.ForkPRApprovalFirstTime, ghbatch.ForkPRApprovalAllExternal}
+)
+
+// actionsPermissionsOptions holds the flags of the actions-permissions command.
+type actionsPermissionsOptions struct {
+	allowedActions      string
+	workflowPermissions string
+	canApprovePRs       bool
+	forkPRApproval      string
+	apply               bool
+}
+
+func newActionsPermissionsCmd() *cobra.Command {
+	opts := &actionsPermissionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "actions-permissions",
+		Short: "Audit and enforce GitHub Actions permissions",
+		Long:  "Report the allowed actions policy, default workflow token permissions and fork pull request approval policy of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, flag the settings that drift from the given policy and, with --apply, update them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policy, err := buildActionsPermissionsPolicy(cmd, opts)
+			if err != nil {
+				return err
+			}
+			return runActionsPermissionsCommand(opts, policy)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.allowedActions, "allowed-actions", "", "Required allowed actions policy: "+strings.Join(allowedActionsValues, ", "))
+	cmd.Flags().StringVar(&opts.workflowPermissions, "workflow-permissions", "", "Required default GITHUB_TOKEN permissions: read or write")
+	cmd.Flags().BoolVar(&opts.canApprovePRs, "can-approve-prs", false, "Required setting for whether workflows may approve pull requests (only enforced when set)")
+	cmd.Flags().StringVar(&opts.forkPRApproval, "fork-pr-approval", "", "Required approval policy for fork pull request workflows: "+strings.Join(forkPRApprovalValues, ", "))
+	cmd.Flags().BoolVar(&opts.apply, "apply", false, "Update settings that drift from the policy instead of only reporting them")
+
+	return cmd
+}
+
+// buildActionsPermissionsPolicy validates the policy flags and builds the policy.
+func buildActionsPermissionsPolicy(cmd *cobra.Command, opts *actionsPermissionsOptions) (ghbatch.ActionsPermissionsPolicy, error) {
+	policy := ghbatch.ActionsPermissionsPolicy{
+		AllowedActions:             opts.allowedActions,
+		DefaultWorkflowPermissions: opts.workflowPermissions,
+		ForkPRApprovalPolicy:       opts.forkPRApproval,
+	}
+
+	if policy.AllowedActions != "" && !slices.Contains(allowedActionsValues, policy.AllowedActions) {
+		return policy, fmt.Errorf("--allowed-actions must be one of %s", strings.Join(allowedActionsValues, ", "))
+	}
+
+	if policy.DefaultWorkflowPermissions != "" && !slices.Contains(workflowPermissionsValues, policy.DefaultWorkflowPermissions) {
+		return policy, fmt.Errorf("--workflow-permissions must be read or write")
+	}
+
+	if policy.ForkPRApprovalPolicy != "" && !slices.Contains(forkPRApprovalValues, policy.ForkPRApprovalPolicy) {
+		return policy, fmt.Errorf("--fork-pr-approval must be one of %s", strings.Join(forkPRApprovalValues, ", "))
+	}
+
+	if cmd.Flags().Changed("can-approve-prs") {
+		policy.CanApprovePullRequestReviews = &opts.canApprovePRs
+	}
+
+	if opts.apply && policy == (ghbatch.ActionsPermissionsPolicy{}) {
+		return policy, fmt.Errorf("--apply requires at least one policy flag")
+	}
+
+	return policy, nil
+}
+
+func runActionsPermissionsCommand(opts *actionsPermissionsOptions, policy ghbatch.ActionsPermissionsPolicy) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.EnforceActionsPermissions(ctx, owner, repoNames(repos), policy, opts.apply)
+	if failed := displayActionsPermissions(owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to check or update %d repositories", failed)
+	}
+	return nil
+}
+
+// displayActionsPermissions prints the Actions settings and drift per repository with a summary and returns
+// the number of repositories that could not be checked or updated.
+func displayActionsPermissions(owner, prefix string, results []ghbatch.ActionsPermissionsResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var compliant, drifted, updated, failed int
+
+	fmt.Println("\n📋 Actions Permissions:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tENABLED\tALLOWED\tTOKEN\tAPPROVE PRS\tFORK PR APPROVAL\tSTATUS")
+	for _, result := range results {
+		current := result.Current
+		if result.Err != nil && current == (ghbatch.ActionsPermissions{}) {
+			failed++
+			fmt.Fprintf(w, "%s\t-\t-\t-\t-\t-\t⚠️  ERROR: %v\n", result.RepoName, result.Err)
+			continue
+		}
+
+		var status string
+		switch {
+		case result.Err != nil:
+			failed++
+			status = fmt.Sprintf("⚠️  UPDATE FAILED (%s): %v", strings.Join(result.Drift, ", "), result.Err)
+		case result.Applied:
+			updated++
+			status = "🔄 UPDATED: " + strings.Join(result.Drift, ", ")
+		case len(result.Drift) > 0:
+			drifted++
+			status = "❌ DRIFT: " + strings.Join(result.Drift, ", ")
+		default:
+			compliant++
+			status = "✅ OK"
+		}
+
+		allowed := current.AllowedActions
+		if !current.Enabled {
+			allowed = "disabled"
+		}
+		fork := current.ForkPRApprovalPolicy
+		if fork == "" {
+			fork = "-"
+		}
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%t\t%s\t%s\n", result.RepoName, current.Enabled, allowed,
+			current.DefaultWorkflowPermissions, current.CanApprovePullRequestReviews, fork, status)
+	}
+	w.Flush()
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Matching policy: %d\n", compliant)
+	fmt.Printf("❌ Drifted: %d\n", drifted)
+	if updated > 0 {
+		fmt.Printf("🔄 Updated: %d\n", updated)
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  Failed: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
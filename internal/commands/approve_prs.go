@@ -0,0 +1,101 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// approvePRsOptions holds the flags of the approve-prs command.
+type approvePRsOptions struct {
+	author     string
+	labels     []string
+	base       string
+	titleMatch string
+	body       string
+	max        int
+	dryRun     bool
+}
+
+func newApprovePRsCmd() *cobra.Command {
+	opts := &approvePRsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "approve-prs",
+		Short: "Approve matching pull requests",
+		Long:  "Submit an approving review to all open pull requests matching the given criteria in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Intended for low-risk automated updates that need one approval to satisfy branch protection",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runApprovePRsCommand(opts)
+		},
+	}
+
+	addPullRequestQueryFlags(cmd, &opts.author, &opts.labels, &opts.base, &opts.titleMatch)
+	cmd.Flags().StringVar(&opts.body, "body", "", "Optional review comment")
+	cmd.Flags().IntVar(&opts.max, "max", 50, "Maximum number of successful approvals in this run (0 for no limit)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the pull requests that would be approved without approving them")
+
+	return cmd
+}
+
+func runApprovePRsCommand(opts *approvePRsOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildPullRequestQuery(opts.author, opts.labels, opts.base, opts.titleMatch)
+	if err != nil {
+		return err
+	}
+
+	if opts.max < 0 {
+		return fmt.Errorf("--max cannot be negative")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results, err := githubService.ApprovePullRequests(ctx, owner, repoNames(repos), ghbatch.ApprovePullRequestsOptions{
+		Query:  query,
+		Body:   opts.body,
+		Max:    opts.max,
+		DryRun: opts.dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	title := "Approve Pull Requests"
+	if opts.dryRun {
+		title = "Pull Requests to Approve (dry run)"
+	}
+
+	if failed := displayPullRequestResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d pull requests or repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+func newArchiveCmd() *cobra.Command {
+	return newArchiveStateCmd(true)
+}
+
+func newUnarchiveCmd() *cobra.Command {
+	return newArchiveStateCmd(false)
+}
+
+// newArchiveStateCmd builds the archive or unarchive command, which only differ in the target state.
+func newArchiveStateCmd(archived bool) *cobra.Command {
+	var yes bool
+
+	use, verb := "archive", "Archive"
+	if !archived {
+		use, verb = "unarchive", "Unarchive"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: verb + " repositories",
+		Long:  verb + " a specified repository, repositories with a given prefix, or all repositories in an organization or user account. The matching repositories are listed and confirmation is requested unless --yes is passed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchiveCommand(archived, yes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&yes, "yes", false, "Skip the interactive confirmation")
+
+	return cmd
+}
+
+func runArchiveCommand(archived, yes bool) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Only repositories that are not already in the requested state need changing
+	var pending []*github.Repository
+	for _, repository := range repos {
+		if repository.GetArchived() != archived {
+			pending = append(pending, repository)
+		}
+	}
+
+	action := "Archive"
+	if !archived {
+		action = "Unarchive"
+	}
+
+	if skipped := len(repos) - len(pending); skipped > 0 {
+		log.Info("Skipping repositories already in the requested state", "count", skipped, "archived", archived)
+	}
+
+	if len(pending) == 0 {
+		log.Info("All repositories are already in the requested state", "owner", owner, "prefix", target.repoPrefix)
+		return nil
+	}
+
+	names := repoNames(pending)
+	displayRepoList("Repositories to "+action, owner, names)
+
+	if !yes {
+		confirmed, err := confirmAction(fmt.Sprintf("%s %d repositories?", action, len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.SetArchivedForRepos(ctx, owner, names, archived)
+	displayBatchResults(action, owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to %s %d repositories", strings.ToLower(action), len(failedRepos))
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// assignIssuesOptions holds the flags of the assign-issues command.
+type assignIssuesOptions struct {
+	state      string
+	titleMatch string
+	withLabels []string
+	add        []string
+	remove     []string
+	dryRun     bool
+}
+
+func newAssignIssuesCmd() *cobra.Command {
+	opts := &assignIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign-issues",
+		Short: "Add or remove assignees on matching issues",
+		Long:  "Assign users to or unassign them from the issues matching a query (state, title pattern, existing labels) in a specified repository, repositories with a given prefix, or all repositories in an organization or user account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAssignIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.state, "state", "open", "Issue state to match: open, closed or all")
+	cmd.Flags().StringVar(&opts.titleMatch, "title-match", "", "Regular expression the issue title must match")
+	cmd.Flags().StringSliceVar(&opts.withLabels, "with-labels", nil, "Comma-separated labels the issues must carry")
+	cmd.Flags().StringSliceVar(&opts.add, "add", nil, "Comma-separated logins to assign")
+	cmd.Flags().StringSliceVar(&opts.remove, "remove", nil, "Comma-separated logins to unassign")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the matching issues without changing them")
+
+	return cmd
+}
+
+func runAssignIssuesCommand(opts *assignIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildIssueQuery(opts.state, opts.titleMatch, opts.withLabels)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.add) == 0 && len(opts.remove) == 0 {
+		return fmt.Errorf("at least one of --add or --remove is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.AssignIssues(ctx, owner, repoNames(repos), ghbatch.IssueAssigneeOptions{
+		Query:  query,
+		Add:    opts.add,
+		Remove: opts.remove,
+		DryRun: opts.dryRun,
+	})
+
+	title := "Assign Issues"
+	if opts.dryRun {
+		title = "Matching Issues (dry run)"
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
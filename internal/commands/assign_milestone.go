@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// assignMilestoneOptions holds the flags of the assign-milestone command.
+type assignMilestoneOptions struct {
+	milestone  string
+	state      string
+	titleMatch string
+	withLabels []string
+	dryRun     bool
+}
+
+func newAssignMilestoneCmd() *cobra.Command {
+	opts := &assignMilestoneOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign-milestone",
+		Short: "Set a milestone on matching issues",
+		Long:  "Set a milestone, given by title, on the issues matching a query (state, title pattern, existing labels) in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. The milestone is created in repositories that do not have it yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAssignMilestoneCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.milestone, "milestone", "", "Title of the milestone to assign (required)")
+	cmd.Flags().StringVar(&opts.state, "state", "open", "Issue state to match: open, closed or all")
+	cmd.Flags().StringVar(&opts.titleMatch, "title-match", "", "Regular expression the issue title must match")
+	cmd.Flags().StringSliceVar(&opts.withLabels, "with-labels", nil, "Comma-separated labels the issues must carry")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the matching issues without creating the milestone or changing them")
+
+	cmd.MarkFlagRequired("milestone")
+
+	return cmd
+}
+
+func runAssignMilestoneCommand(opts *assignMilestoneOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(opts.milestone) == "" {
+		return fmt.Errorf("--milestone must not be empty")
+	}
+
+	query, err := buildIssueQuery(opts.state, opts.titleMatch, opts.withLabels)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.AssignMilestone(ctx, owner, repoNames(repos), ghbatch.IssueMilestoneOptions{
+		Query:  query,
+		Title:  opts.milestone,
+		DryRun: opts.dryRun,
+	})
+
+	title := fmt.Sprintf("Assign Milestone %q", opts.milestone)
+	if opts.dryRun {
+		title = fmt.Sprintf("Issues to Assign to Milestone %q (dry run)", opts.milestone)
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit repository settings",
+		Long:  "Audit the settings and policy files of repositories against best practices and report the results",
+	}
+
+	cmd.AddCommand(newAuditBranchProtectionCmd())
+	cmd.AddCommand(newAuditSecurityPolicyCmd())
+	cmd.AddCommand(newAuditHealthCmd())
+
+	return cmd
+}
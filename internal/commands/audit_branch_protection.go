@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+// branchProtectionRules are the columns of the branch protection compliance matrix.
+var branchProtectionRules = []string{"protected", "reviews", "checks", "admins"}
+
+func newAuditBranchProtectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch-protection",
+		Short: "Audit default branch protection",
+		Long:  "Inspect the default branch protection of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and report which lack protection, required reviews, required status checks or admin enforcement",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuditBranchProtectionCommand()
+		},
+	}
+
+	return cmd
+}
+
+func runAuditBranchProtectionCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditBranchProtectionForRepos(ctx, owner, repos)
+
+	rows := make([]complianceRow, 0, len(audits))
+	var failed int
+	for _, audit := range audits {
+		row := complianceRow{repoName: audit.RepoName + "@" + audit.Branch, err: audit.Err}
+		if audit.Err != nil {
+			failed++
+		} else {
+			row.passed = []bool{audit.Protected, audit.RequiredReviews, audit.StatusChecks, audit.AdminEnforcement}
+		}
+		rows = append(rows, row)
+	}
+
+	displayComplianceMatrix("Branch Protection Audit", owner, target.repoPrefix, branchProtectionRules, rows, isUser)
+
+	if failed > 0 {
+		return partialFailure("failed to audit %d repositories", failed)
+	}
+	return nil
+}
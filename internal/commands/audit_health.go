@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// auditHealthOptions holds the flags of the audit health command.
+type auditHealthOptions struct {
+	checks []string
+}
+
+func newAuditHealthCmd() *cobra.Command {
+	opts := &auditHealthOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Score repositories against a health checklist",
+		Long:  "Check a specified repository, repositories with a given prefix, or all repositories in an organization or user account for a README, description, topics, license, contributing guidelines and issue templates, and report a health matrix with a score per repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuditHealthCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.checks, "checks", ghbatch.HealthChecks, "Comma-separated checklist: "+strings.Join(ghbatch.HealthChecks, ", "))
+
+	return cmd
+}
+
+func runAuditHealthCommand(opts *auditHealthOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if len(opts.checks) == 0 {
+		return fmt.Errorf("--checks cannot be empty")
+	}
+
+	for _, check := range opts.checks {
+		if !slices.Contains(ghbatch.HealthChecks, check) {
+			return fmt.Errorf("unknown check %q, must be one of %s", check, strings.Join(ghbatch.HealthChecks, ", "))
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditHealthForRepos(ctx, owner, repos, opts.checks)
+
+	rows := make([]complianceRow, 0, len(audits))
+	var failed int
+	for _, audit := range audits {
+		row := complianceRow{repoName: audit.RepoName, err: audit.Err}
+		if audit.Err != nil {
+			failed++
+		} else {
+			for _, check := range opts.checks {
+				row.passed = append(row.passed, audit.Passed[check])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	displayComplianceMatrix("Repository Health Audit", owner, target.repoPrefix, opts.checks, rows, isUser)
+
+	if failed > 0 {
+		return partialFailure("failed to audit %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// auditSecurityPolicyOptions holds the flags of the audit security-policy command.
+type auditSecurityPolicyOptions struct {
+	policyFile string
+	path       string
+	pr         bool
+	branch     string
+	yes        bool
+}
+
+func newAuditSecurityPolicyCmd() *cobra.Command {
+	opts := &auditSecurityPolicyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "security-policy",
+		Short: "Check repositories for a SECURITY.md policy",
+		Long:  "Check that a specified repository, repositories with a given prefix, or all repositories in an organization or user account have a SECURITY.md file in the repository root, .github or docs directory. With --policy-file, the standard policy is rolled out to the repositories missing one, committing directly or via pull request. [[.Owner]] and [[.Repo]] in the policy are replaced per repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAuditSecurityPolicyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.policyFile, "policy-file", "", "Local SECURITY.md to add to repositories missing a policy")
+	cmd.Flags().StringVar(&opts.path, "path", "SECURITY.md", "Repository path the policy is written to (SECURITY.md, .github/SECURITY.md or docs/SECURITY.md)")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "add-security-policy", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func runAuditSecurityPolicyCommand(opts *auditSecurityPolicyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if !slices.Contains(ghbatch.SecurityPolicyPaths, opts.path) {
+		return fmt.Errorf("--path must be one of %s", strings.Join(ghbatch.SecurityPolicyPaths, ", "))
+	}
+
+	var policy string
+	if opts.policyFile != "" {
+		content, err := os.ReadFile(opts.policyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read policy file: %w", err)
+		}
+		policy = string(content)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditSecurityPolicies(ctx, owner, repoNames(repos))
+	failed := displaySecurityPolicyAudit(owner, target.repoPrefix, audits, isUser)
+
+	var missing []string
+	for _, audit := range audits {
+		if audit.Err == nil && audit.Path == "" {
+			missing = append(missing, audit.RepoName)
+		}
+	}
+
+	if policy != "" && len(missing) > 0 {
+		rollout := ghbatch.FileRollout{
+			Path: opts.path,
+			Render: func(repoName string) (string, error) {
+				return render.File(filepath.Base(opts.policyFile), policy, render.RepoData{Owner: owner, Repo: repoName})
+			},
+			CommitMessage: "Add security policy",
+			// Re-check right before writing in case a policy was added since the audit
+			SkipIfExists:   true,
+			AlternatePaths: ghbatch.SecurityPolicyPaths,
+		}
+
+		if opts.pr {
+			rollout.PullRequest = &ghbatch.PullRequestOptions{
+				Branch: opts.branch,
+				Title:  "Add security policy",
+				Body:   "This pull request adds a SECURITY.md describing how to report security vulnerabilities.",
+			}
+		}
+
+		confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, missing, opts.yes, rollout)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+
+		results := githubService.ApplyFileToRepos(ctx, owner, missing, rollout)
+		failed += displayFileRolloutResults("Security Policy Rollout", owner, target.repoPrefix, opts.path, results, isUser)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to audit or update %d repositories", failed)
+	}
+	return nil
+}
+
+// displaySecurityPolicyAudit prints the security policy location per repository with a summary and returns
+// the number of repositories that could not be audited.
+func displaySecurityPolicyAudit(owner, prefix string, audits []ghbatch.SecurityPolicyAudit, isUser bool) int {
+	sort.Slice(audits, func(i, j int) bool { return audits[i].RepoName < audits[j].RepoName })
+
+	var found, missing, failed int
+
+	fmt.Println("\n📋 Security Policy Audit Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, audit := range audits {
+		switch {
+		case audit.Err != nil:
+			failed++
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, audit.RepoName, audit.Err)
+		case audit.Path == "":
+			missing++
+			fmt.Printf("❌ %s/%s (MISSING)\n", owner, audit.RepoName)
+		default:
+			found++
+			fmt.Printf("✅ %s/%s (%s)\n", owner, audit.RepoName, audit.Path)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(audits))
+	fmt.Printf("✅ With security policy: %d\n", found)
+	fmt.Printf("❌ Missing SECURITY.md: %d\n", missing)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be audited: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
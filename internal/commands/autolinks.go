@@ -0,0 +1,192 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// autolinksOptions holds the flags of the autolinks subcommands.
+type autolinksOptions struct {
+	keyPrefix    string
+	urlTemplate  string
+	alphanumeric bool
+	yes          bool
+}
+
+func newAutolinksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "autolinks",
+		Short: "Manage autolink references",
+		Long:  "Manage autolink references, which turn keys like JIRA-123 in issues, pull requests and commits into links",
+	}
+
+	cmd.AddCommand(newAutolinksAddCmd())
+	cmd.AddCommand(newAutolinksRemoveCmd())
+
+	return cmd
+}
+
+func newAutolinksAddCmd() *cobra.Command {
+	opts := &autolinksOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add an autolink reference to repositories",
+		Long:  "Add an autolink reference to repositories, e.g. --key-prefix JIRA- --url-template 'https://jira.example.com/browse/JIRA-<num>'. An autolink with the same key prefix but another target is replaced",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAutolinksCommand(opts, true)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.keyPrefix, "key-prefix", "", "Prefix of the references, e.g. JIRA- (required)")
+	cmd.Flags().StringVar(&opts.urlTemplate, "url-template", "", "Link target containing <num> for the reference number (required)")
+	cmd.Flags().BoolVar(&opts.alphanumeric, "alphanumeric", false, "Also match letters after the prefix instead of only digits")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("key-prefix")
+	cmd.MarkFlagRequired("url-template")
+
+	return cmd
+}
+
+func newAutolinksRemoveCmd() *cobra.Command {
+	opts := &autolinksOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove an autolink reference from repositories",
+		Long:  "Remove the autolink reference with the given key prefix from repositories",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAutolinksCommand(opts, false)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.keyPrefix, "key-prefix", "", "Prefix of the autolink to remove, e.g. JIRA- (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("key-prefix")
+
+	return cmd
+}
+
+func runAutolinksCommand(opts *autolinksOptions, add bool) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	autolink := ghbatch.Autolink{KeyPrefix: opts.keyPrefix, URLTemplate: opts.urlTemplate, Alphanumeric: opts.alphanumeric}
+	if add {
+		if err := autolink.Validate(); err != nil {
+			return err
+		}
+	} else if opts.keyPrefix == "" {
+		return fmt.Errorf("--key-prefix is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	prompt := fmt.Sprintf("Remove autolink %s from %d repositories?", opts.keyPrefix, len(names))
+	if add {
+		prompt = fmt.Sprintf("Add autolink %s → %s to %d repositories?", opts.keyPrefix, opts.urlTemplate, len(names))
+	}
+
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, names)
+
+		confirmed, err := confirmAction(prompt)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	var results []ghbatch.AutolinkResult
+	if add {
+		results = githubService.AddAutolinkToRepos(ctx, owner, names, autolink)
+	} else {
+		results = githubService.RemoveAutolinkFromRepos(ctx, owner, names, opts.keyPrefix)
+	}
+
+	if failed := displayAutolinkResults(owner, target.repoPrefix, opts.keyPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to update autolinks of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayAutolinkResults prints the per-repository outcome of adding or removing an autolink with a summary
+// and returns the number of failed repositories.
+func displayAutolinkResults(owner, prefix, keyPrefix string, results []ghbatch.AutolinkResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.AutolinkStatusAdded:     "✅",
+		ghbatch.AutolinkStatusUpdated:   "🔄",
+		ghbatch.AutolinkStatusUnchanged: "➖",
+		ghbatch.AutolinkStatusRemoved:   "🗑️ ",
+		ghbatch.AutolinkStatusNotFound:  "➖",
+		ghbatch.AutolinkStatusFailed:    "❌",
+	}
+
+	fmt.Printf("\n📋 Autolink Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		counts[result.Status]++
+
+		line := fmt.Sprintf("  %s %s/%s (%s)", icons[result.Status], owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.Err != nil {
+			line += ": " + result.Err.Error()
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	if counts[ghbatch.AutolinkStatusAdded]+counts[ghbatch.AutolinkStatusUpdated] > 0 {
+		fmt.Printf("✅ Added: %d\n", counts[ghbatch.AutolinkStatusAdded])
+		fmt.Printf("🔄 Updated: %d\n", counts[ghbatch.AutolinkStatusUpdated])
+	}
+	if counts[ghbatch.AutolinkStatusRemoved] > 0 {
+		fmt.Printf("🗑️  Removed: %d\n", counts[ghbatch.AutolinkStatusRemoved])
+	}
+	fmt.Printf("➖ Unchanged: %d\n", counts[ghbatch.AutolinkStatusUnchanged]+counts[ghbatch.AutolinkStatusNotFound])
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.AutolinkStatusFailed])
+	fmt.Printf("📍 Key prefix: %s\n", keyPrefix)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.AutolinkStatusFailed]
+}
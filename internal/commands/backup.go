@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// backupOptions holds the flags of the backup command.
+type backupOptions struct {
+	dir string
+}
+
+// backupManifest is written to the top of a backup directory and lists what it holds.
+type backupManifest struct {
+	Owner        string            `json:"owner"`
+	CreatedAt    time.Time         `json:"created_at"`
+	Repositories []string          `json:"repositories"`
+	Failed       map[string]string `json:"failed,omitempty"`
+}
+
+func newBackupCmd() *cobra.Command {
+	opts := &backupOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Snapshot repositories into a directory of JSON files",
+		Long:  "Write an offline snapshot of a specified repository, repositories with a given prefix, or all repositories in an organization or user account into a directory of JSON files: the repository metadata, issues with their comments, labels, milestones and releases of each repository, and a manifest of the snapshot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBackupCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.dir, "dir", "", "Directory the snapshot is written to (default: backup-<owner>-<date>)")
+
+	return cmd
+}
+
+func runBackupCommand(opts *backupOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	now := time.Now().UTC()
+	dir := opts.dir
+	if dir == "" {
+		dir = fmt.Sprintf("backup-%s-%s", owner, now.Format("20060102"))
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	manifest := backupManifest{Owner: owner, CreatedAt: now, Repositories: []string{}, Failed: map[string]string{}}
+	var success, failed []string
+
+	for result := range githubService.StreamRepositoryBackups(ctx, owner, repoNames(repos)) {
+		err := result.Err
+		if err == nil {
+			err = writeRepositoryBackup(filepath.Join(dir, result.RepoName), result.Value)
+		}
+
+		if err != nil {
+			log.Error("Failed to back up repository", "repo", result.RepoName, "error", err)
+			manifest.Failed[result.RepoName] = err.Error()
+			failed = append(failed, result.RepoName)
+			continue
+		}
+
+		log.Info("Backed up repository", "repo", result.RepoName, "issues", len(result.Value.Issues))
+		success = append(success, result.RepoName)
+	}
+
+	manifest.Repositories = append(manifest.Repositories, success...)
+	sort.Strings(manifest.Repositories)
+
+	if err := writeJSONFile(filepath.Join(dir, "manifest.json"), manifest); err != nil {
+		return err
+	}
+
+	displayBatchResults("Backup", owner, target.repoPrefix, success, failed, isUser)
+	fmt.Printf("💾 Snapshot written to %s\n", dir)
+
+	if len(failed) > 0 {
+		return partialFailure("failed to back up %d repositories", len(failed))
+	}
+	return nil
+}
+
+// writeRepositoryBackup writes the snapshot of a repository as one JSON file per kind of data.
+func writeRepositoryBackup(dir string, backup *ghbatch.RepositoryBackup) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{"repository.json", backup.Repository},
+		{"issues.json", nonNil(backup.Issues)},
+		{"labels.json", nonNil(backup.Labels)},
+		{"milestones.json", nonNil(backup.Milestones)},
+		{"releases.json", nonNil(backup.Releases)},
+	}
+
+	for _, file := range files {
+		if err := writeJSONFile(filepath.Join(dir, file.name), file.data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeJSONFile writes a value as indented JSON to a file.
+func writeJSONFile(path string, v any) error {
+	return writeReport(path, func(w io.Writer) error { return writeJSON(w, v) })
+}
+
+// nonNil returns an empty slice for a nil one, so that it is written as [] rather than null.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	return items
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/cache"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// cacheOptions holds the global flags controlling the on-disk response cache.
+type cacheOptions struct {
+	enabled bool
+	ttl     time.Duration
+	dir     string
+}
+
+// responseCache is set from the persistent root flags and applied by enableResponseCache.
+var responseCache cacheOptions
+
+// addCacheFlags registers the response cache flags.
+func addCacheFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&responseCache.enabled, "cache", false, "Cache repository lists and metadata on disk between runs")
+	flags.DurationVar(&responseCache.ttl, "cache-ttl", cache.DefaultTTL, "How long cached responses are used, e.g. 30m or 24h")
+	flags.StringVar(&responseCache.dir, "cache-dir", "", "Cache directory (default: go-repo-manager under the user cache directory)")
+}
+
+// openResponseCache returns the cache selected by the cache flags.
+func openResponseCache() (*cache.Cache, error) {
+	dir := responseCache.dir
+	if dir == "" {
+		var err error
+		if dir, err = cache.DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+
+	return cache.New(dir, responseCache.ttl), nil
+}
+
+// enableResponseCache turns on the response cache for the GitHub clients of the command when --cache is set.
+func enableResponseCache() error {
+	if !responseCache.enabled {
+		return nil
+	}
+
+	if responseCache.ttl <= 0 {
+		return fmt.Errorf("--cache-ttl must be positive")
+	}
+
+	c, err := openResponseCache()
+	if err != nil {
+		return err
+	}
+
+	clientOptions = append(clientOptions, ghbatch.WithCache(c))
+
+	return nil
+}
+
+func newCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the response cache",
+		Long:  "Manage the on-disk cache of repository lists and metadata enabled with --cache",
+	}
+
+	cmd.AddCommand(newCacheClearCmd())
+
+	return cmd
+}
+
+func newCacheClearCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "clear",
+		Short:       "Remove all cached responses",
+		Long:        "Remove all cached repository lists and metadata from the cache directory",
+		Annotations: map[string]string{ownerIndependentAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := openResponseCache()
+			if err != nil {
+				return err
+			}
+
+			removed, err := c.Clear()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("🧹 Removed %d cached responses from %s\n", removed, c.Dir())
+			return nil
+		},
+	}
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/spec"
+)
+
+// checkOptions holds the flags of the check command.
+type checkOptions struct {
+	policyFile string
+}
+
+func newCheckCmd() *cobra.Command {
+	opts := &checkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check repositories against a compliance policy",
+		Long:  "Evaluate a specified repository, repositories with a given prefix, or all repositories in an organization or user account against a YAML policy of required files, file content patterns, topics and settings, and report a pass/fail compliance matrix. The command exits with code 5 when any repository violates the policy, so it can gate a nightly CI job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCheckCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.policyFile, "policy", "", "YAML policy file (required)")
+
+	cmd.MarkFlagRequired("policy")
+
+	return cmd
+}
+
+func runCheckCommand(opts *checkOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	policy, err := spec.LoadPolicy(opts.policyFile)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.CheckPolicyForRepos(ctx, owner, repos, policy)
+
+	rules := make([]string, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		rules = append(rules, rule.Name)
+	}
+
+	rows := make([]complianceRow, 0, len(results))
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+		rows = append(rows, complianceRow{repoName: result.RepoName, passed: result.Passed, err: result.Err})
+	}
+
+	title := "Policy Compliance"
+	if policy.Name != "" {
+		title += fmt.Sprintf(" (%s)", policy.Name)
+	}
+	compliant := displayComplianceMatrix(title, owner, target.repoPrefix, rules, rows, isUser)
+
+	if violating := len(results) - compliant - failed; violating > 0 {
+		return &exitError{code: ExitNonCompliant, err: fmt.Errorf("%d repositories violate the policy", violating)}
+	}
+	if failed > 0 {
+		return partialFailure("failed to check %d repositories", failed)
+	}
+	return nil
+}
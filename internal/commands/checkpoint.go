@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/checkpoint"
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// checkpointOptions holds the flags of commands whose runs can be resumed.
+type checkpointOptions struct {
+	resume string
+	dir    string
+}
+
+// addCheckpointFlags registers the run checkpoint flags.
+func addCheckpointFlags(flags *pflag.FlagSet, opts *checkpointOptions) {
+	flags.StringVar(&opts.resume, "resume", "", "Resume the interrupted run with this ID, skipping the repositories it already completed")
+	flags.StringVar(&opts.dir, "checkpoint-dir", "", "Directory of run checkpoints (default: go-repo-manager/runs under the user cache directory)")
+}
+
+// activeRun is the checkpointed run of the invocation, started or resumed by the first startRun and shared
+// by all its owners.
+var activeRun *checkpoint.Run
+
+// ownerRun is the part of the checkpointed run belonging to one owner. Its keys are prefixed with the owner
+// so owners with repositories of the same name do not share outcomes.
+type ownerRun struct {
+	run   *checkpoint.Run
+	owner string
+}
+
+// Record records the outcome of the work item key of the owner.
+func (r *ownerRun) Record(key, status string, err error) error {
+	return r.run.Record(r.key(key), status, err)
+}
+
+// pending returns the keys of the owner that the run has not completed yet, in the same order.
+func (r *ownerRun) pending(keys []string) []string {
+	ownerKeys := make([]string, len(keys))
+	for i, key := range keys {
+		ownerKeys[i] = r.key(key)
+	}
+
+	var pending []string
+	for _, key := range r.run.Pending(ownerKeys) {
+		pending = append(pending, strings.TrimPrefix(key, r.owner+"/"))
+	}
+
+	return pending
+}
+
+// done reports whether the run completed the work item key of the owner.
+func (r *ownerRun) done(key string) bool {
+	return len(r.run.Pending([]string{r.key(key)})) == 0
+}
+
+func (r *ownerRun) key(key string) string {
+	return r.owner + "/" + key
+}
+
+// startRun starts the checkpointed run of command for the invocation, or resumes the one selected by
+// --resume, on its first call. It returns the part of the run belonging to owner and the keys of the work
+// items of owner that are still to be processed.
+func startRun(opts checkpointOptions, command, owner string, keys []string) (*ownerRun, []string, error) {
+	if activeRun == nil {
+		run, err := openRun(opts, command)
+		if err != nil {
+			return nil, nil, err
+		}
+		activeRun = run
+	}
+
+	run := &ownerRun{run: activeRun, owner: owner}
+	if opts.resume == "" {
+		return run, keys, nil
+	}
+
+	// Commands that only discover their work items while running skip the completed ones through done
+	if keys == nil {
+		fmt.Printf("🆔 Resuming run %s for %s\n", run.run.ID, owner)
+		return run, nil, nil
+	}
+
+	pending := run.pending(keys)
+	fmt.Printf("🆔 Resuming run %s for %s: %d already completed, %d remaining\n", run.run.ID, owner, len(keys)-len(pending), len(pending))
+	return run, pending, nil
+}
+
+// openRun starts a new checkpointed run of command, or resumes the one selected by --resume.
+func openRun(opts checkpointOptions, command string) (*checkpoint.Run, error) {
+	dir := opts.dir
+	if dir == "" {
+		var err error
+		if dir, err = checkpoint.DefaultDir(); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.resume == "" {
+		run, err := checkpoint.Start(dir, runID, command)
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Printf("🆔 Run ID: %s (resume an interrupted run with --resume %s)\n", run.ID, run.ID)
+		return run, nil
+	}
+
+	run, err := checkpoint.Resume(dir, opts.resume, command)
+	if err != nil {
+		return nil, err
+	}
+
+	// The resumed run keeps its ID so the audit log attributes all its changes to the same run
+	runID = run.ID
+
+	return run, nil
+}
+
+// closeRun closes the checkpointed run of the invocation, if any, once all its owners are done.
+func closeRun() {
+	if activeRun == nil {
+		return
+	}
+
+	if err := activeRun.Close(); err != nil {
+		logger.GetLogger().Error("Failed to close checkpoint", "run", activeRun.ID, "error", err)
+	}
+	activeRun = nil
+}
+
+// recordOutcome records the work item key in the run as done, or as failed when err is set.
+func recordOutcome(run *ownerRun, key string, err error) {
+	status := checkpoint.StatusDone
+	if err != nil {
+		status = checkpoint.StatusFailed
+	}
+
+	if err := run.Record(key, status, err); err != nil {
+		logger.GetLogger().Error("Failed to write checkpoint", "key", key, "error", err)
+	}
+}
+
+// recordRepoResult returns a RepoResultFunc recording each repository in the run under its name.
+func recordRepoResult(run *ownerRun) ghbatch.RepoResultFunc {
+	return func(repoName string, err error) {
+		recordOutcome(run, repoName, err)
+	}
+}
+
+// issueKey is the checkpoint key of an issue or pull request.
+func issueKey(repoName string, number int) string {
+	return fmt.Sprintf("%s#%d", repoName, number)
+}
+
+// recordRolloutResult returns a FileRollout.OnResult callback recording each repository in the run under
+// the key returned by key, or under its name when key is nil.
+func recordRolloutResult(run *ownerRun, key func(repoName string) string) func(ghbatch.FileRolloutResult) {
+	return func(result ghbatch.FileRolloutResult) {
+		name := result.RepoName
+		if key != nil {
+			name = key(name)
+		}
+
+		if err := run.Record(name, result.Status, result.Err); err != nil {
+			logger.GetLogger().Error("Failed to write checkpoint", "repo", result.RepoName, "error", err)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/checkpoint"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func TestCheckpointedRun_MultipleOwners(t *testing.T) {
+	savedTarget, savedOwners, savedRunID := target, targetOwners, runID
+	t.Cleanup(func() { target, targetOwners, runID = savedTarget, savedOwners, savedRunID })
+
+	targetOwners = []ghbatch.Owner{{Login: "org-a"}, {Login: "org-b"}}
+	opts := checkpointOptions{dir: t.TempDir()}
+	pending := map[string][]string{}
+
+	// Both owners have an api repository: it completes for org-a and fails for org-b
+	cmd := &cobra.Command{
+		Use: "license",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, _ := ResolveOwner()
+
+			run, names, err := startRun(opts, "license add", owner, []string{"api", "web"})
+			if err != nil {
+				return err
+			}
+			pending[owner] = names
+
+			if owner == "org-a" {
+				return run.Record("api", "created", nil)
+			}
+			return run.Record("api", checkpoint.StatusFailed, errors.New("boom"))
+		},
+	}
+	runForEachOwner(cmd)
+
+	require.NoError(t, cmd.RunE(cmd, nil))
+	assert.Equal(t, map[string][]string{"org-a": {"api", "web"}, "org-b": {"api", "web"}}, pending)
+	assert.Nil(t, activeRun)
+
+	opts.resume = runID
+	require.NoError(t, cmd.RunE(cmd, nil))
+	assert.Equal(t, map[string][]string{"org-a": {"web"}, "org-b": {"api", "web"}}, pending)
+}
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/gitclone"
+	"go-repo-manager/internal/logger"
+)
+
+// cloneOptions holds the flags of the clone command.
+type cloneOptions struct {
+	dir     string
+	shallow bool
+	bare    bool
+	useSSH  bool
+}
+
+func newCloneCmd() *cobra.Command {
+	opts := &cloneOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "clone",
+		Short: "Clone or update repositories locally",
+		Long:  "Clone a specified repository, repositories with a given prefix, or all repositories in an organization or user account into <dir>/<owner>/<repo>. Repositories that already exist locally are fetch-updated instead",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCloneCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.dir, "dir", ".", "Directory to clone into; repositories are placed under <dir>/<owner>/")
+	cmd.Flags().BoolVar(&opts.shallow, "shallow", false, "Only clone/fetch the latest commit")
+	cmd.Flags().BoolVar(&opts.bare, "bare", false, "Create bare repositories without a working tree")
+	cmd.Flags().BoolVar(&opts.useSSH, "ssh", false, "Clone using SSH URLs instead of HTTPS")
+
+	return cmd
+}
+
+func runCloneCommand(opts *cloneOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	repositories := make([]gitclone.Repository, 0, len(repos))
+	for _, repository := range repos {
+		cloneURL := repository.GetCloneURL()
+		if opts.useSSH {
+			cloneURL = repository.GetSSHURL()
+		}
+		repositories = append(repositories, gitclone.Repository{Name: repository.GetName(), CloneURL: cloneURL})
+	}
+
+	cloner := gitclone.New(gitclone.Options{
+		Dir:         filepath.Join(opts.dir, owner),
+		Shallow:     opts.shallow,
+		Bare:        opts.bare,
+		Token:       token,
+		Concurrency: target.concurrency,
+	}, log)
+
+	results := cloner.SyncAll(ctx, repositories)
+	failed := displayCloneResults(owner, target.repoPrefix, filepath.Join(opts.dir, owner), results, isUser)
+
+	if failed > 0 {
+		return partialFailure("failed to clone or update %d repositories", failed)
+	}
+	return nil
+}
+
+func displayCloneResults(owner, prefix, dir string, results []gitclone.Result, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var cloned, updated, failed int
+
+	fmt.Println("\n📋 Clone Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+			fmt.Printf("  ❌ %s/%s (FAILED: %v)\n", owner, result.RepoName, result.Err)
+		case result.Action == gitclone.ActionCloned:
+			cloned++
+			fmt.Printf("  ✅ %s/%s (CLONED)\n", owner, result.RepoName)
+		default:
+			updated++
+			fmt.Printf("  🔄 %s/%s (UPDATED)\n", owner, result.RepoName)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Cloned: %d\n", cloned)
+	fmt.Printf("🔄 Updated: %d\n", updated)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Printf("📍 Local directory: %s\n", dir)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
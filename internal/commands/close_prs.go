@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// closePRsOptions holds the flags of the close-prs command.
+type closePRsOptions struct {
+	author       string
+	labels       []string
+	base         string
+	titleMatch   string
+	comment      string
+	commentFile  string
+	deleteBranch bool
+	dryRun       bool
+	checkpoint   checkpointOptions
+}
+
+func newClosePRsCmd() *cobra.Command {
+	opts := &closePRsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "close-prs",
+		Short: "Close matching pull requests without merging",
+		Long:  "Close all open pull requests matching the given criteria without merging them in a specified repository, repositories with a given prefix, or all repositories in an organization or user account, optionally leaving a templated comment and deleting their head branches. The comment is a Go template with {{.Owner}}, {{.Repo}}, {{.Number}}, {{.Title}}, {{.URL}} and {{.Author}}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runClosePRsCommand(opts)
+		},
+	}
+
+	addPullRequestQueryFlags(cmd, &opts.author, &opts.labels, &opts.base, &opts.titleMatch)
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment template posted before closing")
+	cmd.Flags().StringVar(&opts.commentFile, "comment-file", "", "Path to a file containing the comment template")
+	cmd.Flags().BoolVar(&opts.deleteBranch, "delete-branch", false, "Delete the head branches of closed pull requests (branches in forks are kept)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the pull requests that would be closed without closing them")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+func runClosePRsCommand(opts *closePRsOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildPullRequestQuery(opts.author, opts.labels, opts.base, opts.titleMatch)
+	if err != nil {
+		return err
+	}
+
+	var comment string
+	if opts.comment != "" || opts.commentFile != "" {
+		if comment, err = readCommentBody(opts.comment, opts.commentFile); err != nil {
+			return err
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	closeOpts := ghbatch.ClosePullRequestsOptions{
+		Query:        query,
+		DeleteBranch: opts.deleteBranch,
+		DryRun:       opts.dryRun,
+	}
+
+	if comment != "" {
+		closeOpts.Comment = func(repoName string, pr *github.PullRequest) (string, error) {
+			return render.Text("comment", comment, render.IssueData{
+				RepoData: render.RepoData{Owner: owner, Repo: repoName},
+				Number:   pr.GetNumber(),
+				Title:    pr.GetTitle(),
+				URL:      pr.GetHTMLURL(),
+				Author:   pr.GetUser().GetLogin(),
+			})
+		}
+	}
+
+	// Each pull request is checkpointed on its own so a resumed run never comments twice
+	if !opts.dryRun {
+		run, _, err := startRun(opts.checkpoint, "close-prs", owner, nil)
+		if err != nil {
+			return err
+		}
+
+		closeOpts.Done = func(repoName string, number int) bool {
+			return run.done(issueKey(repoName, number))
+		}
+		closeOpts.OnResult = func(repoName string, result ghbatch.PullRequestResult) {
+			recordOutcome(run, issueKey(repoName, result.Number), result.Err)
+		}
+	}
+
+	results := githubService.ClosePullRequests(ctx, owner, repoNames(repos), closeOpts)
+
+	title := "Close Pull Requests"
+	if opts.dryRun {
+		title = "Pull Requests to Close (dry run)"
+	}
+
+	if failed := displayPullRequestResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d pull requests or repositories", failed)
+	}
+	return nil
+}
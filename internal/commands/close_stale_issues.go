@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+const defaultStaleComment = "This issue has been automatically closed because it has had no activity for %d days. Feel free to reopen it if it is still relevant."
+
+// closeStaleIssuesOptions holds the flags of the close-stale-issues command.
+type closeStaleIssuesOptions struct {
+	days         int
+	comment      string
+	noComment    bool
+	label        string
+	exemptLabels []string
+	dryRun       bool
+}
+
+func newCloseStaleIssuesCmd() *cobra.Command {
+	opts := &closeStaleIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "close-stale-issues",
+		Short: "Close issues without recent activity",
+		Long:  "Close open issues that have had no activity for a number of days in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Each issue is labeled and commented on before it is closed as not planned",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCloseStaleIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.days, "days", 90, "Number of days without activity after which an issue is stale")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment posted before closing (default: a notice mentioning the inactivity period)")
+	cmd.Flags().BoolVar(&opts.noComment, "no-comment", false, "Close issues without posting a comment")
+	cmd.Flags().StringVar(&opts.label, "label", "stale", "Label applied before closing (empty to skip labeling)")
+	cmd.Flags().StringSliceVar(&opts.exemptLabels, "exempt-labels", nil, "Comma-separated labels that protect issues from being closed")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the stale issues without closing them")
+
+	return cmd
+}
+
+func runCloseStaleIssuesCommand(opts *closeStaleIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.days <= 0 {
+		return fmt.Errorf("--days must be greater than zero")
+	}
+
+	if opts.noComment && opts.comment != "" {
+		return fmt.Errorf("cannot specify both --comment and --no-comment")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	comment := opts.comment
+	if comment == "" && !opts.noComment {
+		comment = fmt.Sprintf(defaultStaleComment, opts.days)
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.CloseStaleIssues(ctx, owner, repoNames(repos), ghbatch.StaleIssueOptions{
+		UpdatedBefore: time.Now().AddDate(0, 0, -opts.days),
+		Comment:       comment,
+		Label:         opts.label,
+		ExemptLabels:  opts.exemptLabels,
+		DryRun:        opts.dryRun,
+	})
+
+	title := "Close Stale Issues"
+	if opts.dryRun {
+		title = "Stale Issues (dry run)"
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"go-repo-manager/internal/logger"
-	"go-repo-manager/internal/repo"
+	"go-repo-manager/pkg/ghbatch"
 )
 
 const (
@@ -22,13 +22,9 @@ const (
 
 func newCodeownersCmd() *cobra.Command {
 	var (
-		repoName       string
-		repoPrefix     string
-		org            string
-		username       string
-		token          string
-		concurrency    int
 		codeownersFile string
+		yes            bool
+		checkpointOpts checkpointOptions
 	)
 
 	cmd := &cobra.Command{
@@ -36,29 +32,27 @@ func newCodeownersCmd() *cobra.Command {
 		Short: "Add or update CODEOWNERS file in repositories",
 		Long:  "Add or update CODEOWNERS file in specified repositories, repositories with a given prefix, or all repositories in an organization or user account",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runCodeownersCommand(repoName, repoPrefix, org, username, token, concurrency, codeownersFile)
+			return runCodeownersCommand(codeownersFile, yes, checkpointOpts)
 		},
 	}
 
-	cmd.Flags().StringVar(&repoName, "repo", "", "Specific repository name")
-	cmd.Flags().StringVar(&repoPrefix, "repo-prefix", "", "Repository name prefix to filter repositories")
-	cmd.Flags().StringVar(&org, "org", "", "GitHub organization name")
-	cmd.Flags().StringVar(&username, "username", "", "GitHub username")
-	cmd.Flags().StringVar(&token, "token", "", "GitHub personal access token (optional, can also be set via GITHUB_TOKEN env var)")
-	cmd.Flags().IntVar(&concurrency, "concurrency", 1, "Maximum number of concurrent workers for processing repositories (default: 1)")
 	cmd.Flags().StringVar(&codeownersFile, "codeowner-file", "", "Path to the CODEOWNERS file to add to repositories (required)")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &checkpointOpts)
 
 	// Mark the codeowner-file flag as required
 	cmd.MarkFlagRequired("codeowner-file")
 
+	cmd.AddCommand(newCodeownersAuditCmd())
+
 	return cmd
 }
 
-func runCodeownersCommand(repoName, repoPrefix, org, username, token string, concurrency int, codeownersFile string) error {
+func runCodeownersCommand(codeownersFile string, yes bool, checkpointOpts checkpointOptions) error {
 	log := logger.GetLogger()
 
 	// Validate input parameters
-	if err := validateCodeownersFlags(org, username, repoName, repoPrefix, codeownersFile); err != nil {
+	if err := validateCodeownersFlags(codeownersFile); err != nil {
 		return err
 	}
 
@@ -68,58 +62,77 @@ func runCodeownersCommand(repoName, repoPrefix, org, username, token string, con
 		return fmt.Errorf("failed to read CODEOWNERS file: %w", err)
 	}
 
-	// Get token from environment if not provided via flag
-	if token == "" {
-		token = os.Getenv("GITHUB_TOKEN")
+	token, err := ResolveToken()
+	if err != nil {
+		return err
 	}
 
-	if token == "" {
-		return fmt.Errorf("GitHub token (--token) is required or must be set in GITHUB_TOKEN environment variable")
+	// Determine if we're working with a user or organization
+	owner, isUser := ResolveOwner()
+
+	// Create GitHub client and service with dependency injection
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	if target.repoName == "" && target.repoPrefix == "" {
+		if isUser {
+			log.Info("No repository or prefix specified, adding CODEOWNERS to all repositories for user")
+		} else {
+			log.Info("No repository or prefix specified, adding CODEOWNERS to all repositories in organization")
+		}
 	}
 
-	// Determine if we're working with a user or organization
-	var owner string
-	var isUser bool
-	if username != "" {
-		owner = username
-		isUser = true
-	} else {
-		owner = org
-		isUser = false
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
 	}
 
-	// Create GitHub client and service with dependency injection
-	githubClient := repo.NewGitHubClient(token)
-	githubService := repo.NewGitHubServiceWithConcurrency(githubClient, concurrency)
-	ctx := context.Background()
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
 
-	if repoName != "" {
-		// Add CODEOWNERS to single repository
-		return handleSingleRepoCodeowners(ctx, githubService, owner, repoName, codeownersContent)
-	} else {
-		if repoName == "" && repoPrefix == "" {
-			if isUser {
-				log.Info("No repository or prefix specified, adding CODEOWNERS to all repositories for user")
-			} else {
-				log.Info("No repository or prefix specified, adding CODEOWNERS to all repositories in organization")
-			}
-		}
-		return handleMultipleReposCodeowners(ctx, githubService, owner, repoPrefix, isUser, codeownersContent)
+	names := repoNames(repos)
+	rollout := ghbatch.FileRollout{
+		Path:   ".github/CODEOWNERS",
+		Render: func(string) (string, error) { return codeownersContent, nil },
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, names, yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
 	}
-}
 
-func validateCodeownersFlags(org, username, repoName, repoPrefix, codeownersFile string) error {
-	// Validate input - must have either org or username, but not both
-	if org == "" && username == "" {
-		return fmt.Errorf("either organization (--org) or username (--username) is required")
+	run, names, err := startRun(checkpointOpts, "codeowners", owner, names)
+	if err != nil {
+		return err
 	}
 
-	if org != "" && username != "" {
-		return fmt.Errorf("cannot specify both --org and --username")
+	if target.repoName != "" {
+		if len(names) == 0 {
+			log.Info("Repository already completed by the resumed run", "owner", owner, "repo", target.repoName)
+			return nil
+		}
+
+		// Add CODEOWNERS to single repository
+		err := handleSingleRepoCodeowners(ctx, githubService, owner, target.repoName, codeownersContent)
+		recordOutcome(run, target.repoName, err)
+		return err
 	}
+	return handleMultipleReposCodeowners(ctx, githubService, owner, target.repoPrefix, names, isUser, codeownersContent,
+		recordRepoResult(run))
+}
 
-	if repoName != "" && repoPrefix != "" {
-		return fmt.Errorf("cannot specify both --repo and --repo-prefix")
+func validateCodeownersFlags(codeownersFile string) error {
+	if err := ValidateTarget(); err != nil {
+		return err
 	}
 
 	if codeownersFile == "" {
@@ -144,7 +157,7 @@ func readCodeownersFile(filePath string) (string, error) {
 	return string(content), nil
 }
 
-func handleSingleRepoCodeowners(ctx context.Context, githubService repo.GitHubClient, owner, repoName, codeownersContent string) error {
+func handleSingleRepoCodeowners(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName, codeownersContent string) error {
 	log := logger.GetLogger()
 
 	commitMessage := "Add/Update CODEOWNERS file"
@@ -158,21 +171,16 @@ func handleSingleRepoCodeowners(ctx context.Context, githubService repo.GitHubCl
 	return nil
 }
 
-func handleMultipleReposCodeowners(ctx context.Context, githubService repo.GitHubClient, owner, prefix string, isUser bool, codeownersContent string) error {
-	log := logger.GetLogger()
+func handleMultipleReposCodeowners(ctx context.Context, githubService ghbatch.GitHubClient, owner, prefix string, names []string,
+	isUser bool, codeownersContent string, onResult ghbatch.RepoResultFunc,
+) error {
+	successRepos, failedRepos := githubService.AddCodeownersToRepos(ctx, owner, names, codeownersContent, onResult)
 
-	successRepos, failedRepos, err := githubService.AddCodeownersToReposWithPrefix(ctx, owner, prefix, isUser, codeownersContent)
-	if err != nil {
-		log.Error("Failed to add CODEOWNERS to repositories with prefix", "owner", owner, "prefix", prefix, "error", err)
-		return err
-	}
+	displayMultipleReposCodeownersResults(owner, prefix, successRepos, failedRepos, isUser)
 
-	if len(successRepos) == 0 && len(failedRepos) == 0 {
-		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", prefix)
-		return nil
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to add CODEOWNERS to %d repositories", len(failedRepos))
 	}
-
-	displayMultipleReposCodeownersResults(owner, prefix, successRepos, failedRepos, isUser)
 	return nil
 }
 
@@ -188,6 +196,7 @@ func displaySingleRepoCodeownersResult(owner, repoName string, success bool) {
 		fmt.Printf("❌ Repository: %s/%s (FAILED)\n", owner, repoName)
 		fmt.Printf("❗ Failed to add/update CODEOWNERS file\n")
 	}
+	displayRunSummary()
 	fmt.Println(strings.Repeat("-", shortSeparatorLength))
 }
 
@@ -246,5 +255,6 @@ func displayMultipleReposCodeownersResults(owner, prefix string, successRepos, f
 	if len(failedRepos) == 0 {
 		fmt.Printf("🎉 All repositories successfully updated!\n")
 	}
+	displayRunSummary()
 	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
 }
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func newCodeownersAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Validate CODEOWNERS files in repositories",
+		Long:  "Check that a specified repository, repositories with a given prefix, or all repositories in an organization or user account have a CODEOWNERS file, and report syntax errors and referenced users or teams that do not exist or lack write access",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCodeownersAuditCommand()
+		},
+	}
+
+	return cmd
+}
+
+func runCodeownersAuditCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditCodeownersForRepos(ctx, owner, repoNames(repos))
+	if failed := displayCodeownersAudit(owner, target.repoPrefix, audits, isUser); failed > 0 {
+		return partialFailure("failed to audit %d repositories", failed)
+	}
+	return nil
+}
+
+// displayCodeownersAudit prints the CODEOWNERS audit per repository with a summary and returns the number
+// of repositories that could not be audited.
+func displayCodeownersAudit(owner, prefix string, audits []ghbatch.CodeownersAudit, isUser bool) int {
+	sort.Slice(audits, func(i, j int) bool { return audits[i].RepoName < audits[j].RepoName })
+
+	var valid, missing, broken, failed int
+
+	fmt.Println("\n📋 CODEOWNERS Audit Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, audit := range audits {
+		switch {
+		case audit.Err != nil:
+			failed++
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, audit.RepoName, audit.Err)
+		case audit.Path == "":
+			missing++
+			fmt.Printf("❌ %s/%s (MISSING)\n", owner, audit.RepoName)
+		case len(audit.Errors) > 0:
+			broken++
+			fmt.Printf("❌ %s/%s (%d ERRORS in %s)\n", owner, audit.RepoName, len(audit.Errors), audit.Path)
+			for _, e := range audit.Errors {
+				fmt.Printf("    line %d:%d %s: %s\n", e.Line, e.Column, e.Kind, strings.TrimSpace(e.Message))
+			}
+		default:
+			valid++
+			fmt.Printf("✅ %s/%s (VALID, %s)\n", owner, audit.RepoName, audit.Path)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(audits))
+	fmt.Printf("✅ Valid: %d\n", valid)
+	fmt.Printf("❌ Missing CODEOWNERS: %d\n", missing)
+	fmt.Printf("❌ With errors: %d\n", broken)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be audited: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
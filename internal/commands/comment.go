@@ -0,0 +1,172 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// commentOptions holds the flags of the comment command.
+type commentOptions struct {
+	body       string
+	bodyFile   string
+	kind       string
+	state      string
+	titleMatch string
+	withLabels []string
+	interval   time.Duration
+	dryRun     bool
+	checkpoint checkpointOptions
+}
+
+func newCommentCmd() *cobra.Command {
+	opts := &commentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Post a comment on matching issues or pull requests",
+		Long:  "Post a templated comment on all issues or pull requests matching a filter in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. The comment is a Go template with {{.Owner}}, {{.Repo}}, {{.Number}}, {{.Title}}, {{.URL}} and {{.Author}}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCommentCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.body, "body", "", "Comment template")
+	cmd.Flags().StringVar(&opts.bodyFile, "body-file", "", "Path to a file containing the comment template")
+	cmd.Flags().StringVar(&opts.kind, "type", "issues", "What to comment on: issues, prs or all")
+	cmd.Flags().StringVar(&opts.state, "state", "open", "State to match: open, closed or all")
+	cmd.Flags().StringVar(&opts.titleMatch, "title-match", "", "Regular expression the title must match")
+	cmd.Flags().StringSliceVar(&opts.withLabels, "with-labels", nil, "Comma-separated labels the issues must carry")
+	cmd.Flags().DurationVar(&opts.interval, "interval", time.Second, "Minimum time between two comments to avoid secondary rate limits (0 to disable)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Render the comments and list the matching issues without posting")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+func runCommentCommand(opts *commentOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildIssueQuery(opts.state, opts.titleMatch, opts.withLabels)
+	if err != nil {
+		return err
+	}
+
+	if err := setIssueKind(&query, opts.kind); err != nil {
+		return err
+	}
+
+	body, err := readCommentBody(opts.body, opts.bodyFile)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Keep the first rendered comment so a dry run can show what would be posted
+	var previewOnce sync.Once
+	var preview string
+
+	commentOpts := ghbatch.IssueCommentOptions{
+		Query: query,
+		Render: func(repoName string, issue *github.Issue) (string, error) {
+			comment, err := render.Text("comment", body, render.IssueData{
+				RepoData: render.RepoData{Owner: owner, Repo: repoName},
+				Number:   issue.GetNumber(),
+				Title:    issue.GetTitle(),
+				URL:      issue.GetHTMLURL(),
+				Author:   issue.GetUser().GetLogin(),
+			})
+			if err == nil {
+				previewOnce.Do(func() { preview = comment })
+			}
+			return comment, err
+		},
+		Interval: opts.interval,
+		DryRun:   opts.dryRun,
+	}
+
+	// Each issue is checkpointed on its own so a resumed run never comments twice
+	if !opts.dryRun {
+		run, _, err := startRun(opts.checkpoint, "comment", owner, nil)
+		if err != nil {
+			return err
+		}
+
+		commentOpts.Done = func(repoName string, number int) bool {
+			return run.done(issueKey(repoName, number))
+		}
+		commentOpts.OnResult = func(repoName string, result ghbatch.IssueResult) {
+			recordOutcome(run, issueKey(repoName, result.Number), result.Err)
+		}
+	}
+
+	results := githubService.CommentOnIssues(ctx, owner, repoNames(repos), commentOpts)
+
+	title := "Comment"
+	if opts.dryRun {
+		title = "Issues to Comment On (dry run)"
+	}
+
+	failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser)
+
+	if opts.dryRun && preview != "" {
+		fmt.Printf("\n💬 Comment preview:\n%s\n", preview)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
+
+// readCommentBody returns the comment template from exactly one of the --body and --body-file flags.
+func readCommentBody(body, bodyFile string) (string, error) {
+	if (body == "") == (bodyFile == "") {
+		return "", fmt.Errorf("exactly one of --body or --body-file is required")
+	}
+
+	if body != "" {
+		return body, nil
+	}
+
+	content, err := os.ReadFile(bodyFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read comment file %s: %w", bodyFile, err)
+	}
+
+	return string(content), nil
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// commitIdentity is set from the persistent commit identity flags and applied by enableCommitIdentity.
+var commitIdentity ghbatch.CommitIdentity
+
+// addCommitIdentityFlags registers the commit author and message flags.
+func addCommitIdentityFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&commitIdentity.Name, "author-name", "", "Author and committer name of file commits, e.g. an automation bot (requires --author-email)")
+	flags.StringVar(&commitIdentity.Email, "author-email", "", "Author and committer email of file commits (requires --author-name)")
+	flags.StringVar(&commitIdentity.Message, "commit-message", "", "Message of file commits, replacing the message chosen by the command")
+}
+
+// enableCommitIdentity applies the commit identity flags to the command's GitHub services.
+func enableCommitIdentity() error {
+	if (commitIdentity.Name == "") != (commitIdentity.Email == "") {
+		return errors.New("--author-name and --author-email must be specified together")
+	}
+
+	if commitIdentity != (ghbatch.CommitIdentity{}) {
+		logger.GetLogger().Debug("Using custom commit identity", "name", commitIdentity.Name, "email", commitIdentity.Email,
+			"message", commitIdentity.Message)
+		serviceOptions = append(serviceOptions, ghbatch.WithCommitIdentity(commitIdentity))
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// completionFlags maps the flags that get dynamic completions to their completion functions.
+var completionFlags = map[string]cobra.CompletionFunc{
+	"org":    completeOrgs,
+	"to-org": completeOrgs,
+	"repo":   completeRepos,
+}
+
+// registerCompletions adds the dynamic flag completions to a command and all its subcommands.
+func registerCompletions(cmd *cobra.Command) {
+	for name, complete := range completionFlags {
+		if cmd.Flags().Lookup(name) != nil || cmd.PersistentFlags().Lookup(name) != nil {
+			cmd.RegisterFlagCompletionFunc(name, complete)
+		}
+	}
+
+	for _, sub := range cmd.Commands() {
+		registerCompletions(sub)
+	}
+}
+
+// completionService returns a GitHub service for completions, with logging off so nothing but the
+// suggestions reaches stdout, and the response cache on so repeated completions do not hit the API.
+func completionService() (*ghbatch.Service, bool) {
+	logger.Disable()
+
+	token, err := ResolveToken()
+	if err != nil {
+		return nil, false
+	}
+
+	var opts []ghbatch.ClientOption
+	if c, err := openResponseCache(); err == nil {
+		opts = append(opts, ghbatch.WithCache(c))
+	}
+
+	return ghbatch.NewGitHubService(ghbatch.NewGitHubClient(token, opts...)), true
+}
+
+// completeOrgs suggests the organizations the token's user is a member of. In a comma-separated list only
+// the last organization is completed.
+func completeOrgs(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	githubService, ok := completionService()
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	orgs, err := githubService.ListOrganizations(context.Background())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	listed, partial := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		listed, partial = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	var suggestions []cobra.Completion
+	for _, org := range filterPrefix(orgs, partial) {
+		suggestions = append(suggestions, listed+org)
+	}
+
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeRepos suggests the repositories of the owners given on the command line.
+func completeRepos(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	owners, err := resolveTargetOwners()
+	if err != nil || len(owners) == 0 {
+		return cobra.AppendActiveHelp(nil, "Set --org, --owners-file or --username first to complete repository names"), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	githubService, ok := completionService()
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	repos, err := githubService.GetRepositoriesForOwners(context.Background(), owners, toComplete)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return repoNames(repos), cobra.ShellCompDirectiveNoFileComp
+}
+
+// filterPrefix returns the values starting with prefix.
+func filterPrefix(values []string, prefix string) []string {
+	var matching []string
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			matching = append(matching, value)
+		}
+	}
+
+	return matching
+}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// createIssueOptions holds the flags of the create-issue command.
+type createIssueOptions struct {
+	title      string
+	body       string
+	bodyFile   string
+	labels     []string
+	assignees  []string
+	dryRun     bool
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newCreateIssueCmd() *cobra.Command {
+	opts := &createIssueOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create-issue",
+		Short: "Open the same issue in many repositories",
+		Long:  "Open an identical issue in a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and print the created issue URLs. Title and body are Go templates with {{.Owner}} and {{.Repo}}",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreateIssueCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.title, "title", "", "Issue title template (required)")
+	cmd.Flags().StringVar(&opts.body, "body", "", "Issue body template")
+	cmd.Flags().StringVar(&opts.bodyFile, "body-file", "", "Path to a file containing the issue body template")
+	cmd.Flags().StringSliceVar(&opts.labels, "labels", nil, "Comma-separated labels to apply")
+	cmd.Flags().StringSliceVar(&opts.assignees, "assignees", nil, "Comma-separated logins to assign")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Render the issue and list the repositories without creating anything")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func runCreateIssueCommand(opts *createIssueOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(opts.title) == "" {
+		return fmt.Errorf("issue title (--title) is required")
+	}
+
+	body := opts.body
+	if opts.body != "" || opts.bodyFile != "" {
+		var err error
+		if body, err = readCommentBody(opts.body, opts.bodyFile); err != nil {
+			return err
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Render every issue up front so template errors surface before anything is created
+	names := repoNames(repos)
+	issues := make(map[string]ghbatch.NewIssue, len(names))
+	for _, name := range names {
+		issue, err := renderNewIssue(opts, owner, name, body)
+		if err != nil {
+			return err
+		}
+		issues[name] = issue
+	}
+
+	displayRepoList("Repositories to Open the Issue In", owner, names)
+	fmt.Printf("\n📝 %s\n\n%s\n", issues[names[0]].Title, issues[names[0]].Body)
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Create the issue in %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	run, names, err := startRun(opts.checkpoint, "create-issue", owner, names)
+	if err != nil {
+		return err
+	}
+
+	results := githubService.CreateIssues(ctx, owner, names, func(repoName string) (ghbatch.NewIssue, error) {
+		return issues[repoName], nil
+	}, recordRepoResult(run))
+
+	if failed := displayIssueResults("Create Issue", owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to create the issue in %d repositories", failed)
+	}
+	return nil
+}
+
+// renderNewIssue renders the title and body templates for a repository.
+func renderNewIssue(opts *createIssueOptions, owner, repoName, body string) (ghbatch.NewIssue, error) {
+	data := render.RepoData{Owner: owner, Repo: repoName}
+
+	title, err := render.Text("title", opts.title, data)
+	if err != nil {
+		return ghbatch.NewIssue{}, err
+	}
+
+	renderedBody, err := render.Text("body", body, data)
+	if err != nil {
+		return ghbatch.NewIssue{}, err
+	}
+
+	return ghbatch.NewIssue{
+		Title:     title,
+		Body:      renderedBody,
+		Labels:    opts.labels,
+		Assignees: opts.assignees,
+	}, nil
+}
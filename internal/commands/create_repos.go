@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/spec"
+)
+
+// createReposOptions holds the flags of the create-repos command.
+type createReposOptions struct {
+	specFile string
+	template string
+	yes      bool
+}
+
+func newCreateReposCmd() *cobra.Command {
+	opts := &createReposOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create-repos",
+		Short: "Create repositories from a template repository",
+		Long:  "Create repositories described in a CSV or YAML spec file (name, description, visibility, team, team_permission, topics) from a template repository, applying topics and team permissions in the same pass",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreateReposCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.specFile, "spec", "", "Path to the CSV or YAML file describing the repositories to create (required)")
+	cmd.Flags().StringVar(&opts.template, "template", "", "Template repository as owner/name, or name within the target owner (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("spec")
+	cmd.MarkFlagRequired("template")
+
+	return cmd
+}
+
+// parseRepoReference splits an owner/name reference, defaulting the owner when only a name is given.
+func parseRepoReference(reference, defaultOwner string) (string, string, error) {
+	parts := strings.Split(reference, "/")
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return defaultOwner, parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("invalid repository reference %q: expected owner/name or name", reference)
+	}
+}
+
+func runCreateReposCommand(opts *createReposOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if target.repoName != "" || target.repoPrefix != "" {
+		return fmt.Errorf("create-repos takes the repositories from --spec, not --repo or --repo-prefix")
+	}
+
+	owner, _ := ResolveOwner()
+
+	templateOwner, templateRepo, err := parseRepoReference(opts.template, owner)
+	if err != nil {
+		return err
+	}
+
+	requests, err := spec.LoadRepoCreateRequests(opts.specFile)
+	if err != nil {
+		return err
+	}
+
+	if len(requests) == 0 {
+		log.Info("Spec file does not describe any repositories", "file", opts.specFile)
+		return nil
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	names := make([]string, 0, len(requests))
+	for _, request := range requests {
+		names = append(names, request.Name)
+	}
+	displayRepoList(fmt.Sprintf("Repositories to create from %s/%s", templateOwner, templateRepo), owner, names)
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Create %d repositories?", len(requests)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.CreateReposFromTemplate(ctx, owner, templateOwner, templateRepo, requests)
+	displayBatchResults("Repository Creation", owner, "", successRepos, failedRepos, target.username != "")
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to create %d repositories", len(failedRepos))
+	}
+	return nil
+}
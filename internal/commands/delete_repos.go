@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+// deleteReposOptions holds the flags of the delete-repos command.
+type deleteReposOptions struct {
+	yes    bool
+	force  bool
+	dryRun bool
+}
+
+func newDeleteReposCmd() *cobra.Command {
+	opts := &deleteReposOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete-repos",
+		Short: "Permanently delete repositories",
+		Long:  "Permanently delete a specified repository, repositories with a given prefix, or all repositories in an organization or user account. The full list is shown and the owner name must be typed to confirm, unless both --force and --yes are passed. Every deletion is recorded in the audit log",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeleteReposCommand(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation (requires --force)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Allow skipping the interactive confirmation together with --yes")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only list the repositories that would be deleted")
+
+	return cmd
+}
+
+func runDeleteReposCommand(opts *deleteReposOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.yes != opts.force {
+		return fmt.Errorf("--yes and --force must be used together to skip the confirmation")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	displayRepoList("Repositories to DELETE", owner, names)
+
+	if opts.dryRun {
+		fmt.Printf("🔍 Dry run: %d repositories would be deleted. No changes were made.\n", len(names))
+		return nil
+	}
+
+	if !opts.force {
+		fmt.Printf("⚠️  This will PERMANENTLY delete %d repositories. This cannot be undone.\n", len(names))
+		answer, err := promptInput(fmt.Sprintf("Type the owner name '%s' to confirm: ", owner))
+		if err != nil {
+			return err
+		}
+		if answer != owner {
+			log.Info("Confirmation did not match owner name, aborting")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.DeleteRepos(ctx, owner, names, nil)
+
+	displayBatchResults("Repository Deletion", owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to delete %d repositories", len(failedRepos))
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// dependabotApplyOptions holds the flags of the dependabot apply command.
+type dependabotApplyOptions struct {
+	configFile string
+	auto       bool
+	interval   string
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newDependabotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dependabot",
+		Short: "Manage Dependabot configuration",
+		Long:  "Manage .github/dependabot.yml across repositories",
+	}
+
+	cmd.AddCommand(newDependabotApplyCmd())
+
+	return cmd
+}
+
+func newDependabotApplyCmd() *cobra.Command {
+	opts := &dependabotApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Write .github/dependabot.yml to repositories",
+		Long:  "Write .github/dependabot.yml to repositories, either from a local file (--config-file) or generated per repository (--auto) from the go.mod, Dockerfile and package.json files found in its root",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDependabotApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.configFile, "config-file", "", "Path to a dependabot.yml to write to every repository")
+	cmd.Flags().BoolVar(&opts.auto, "auto", false, "Generate the configuration per repository from the detected ecosystems")
+	cmd.Flags().StringVar(&opts.interval, "interval", "weekly", "Update schedule interval for generated configurations (daily, weekly, monthly)")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "dependabot-config", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+func validateDependabotApplyFlags(opts *dependabotApplyOptions) error {
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if (opts.configFile == "") == !opts.auto {
+		return fmt.Errorf("exactly one of --config-file or --auto is required")
+	}
+
+	switch opts.interval {
+	case "daily", "weekly", "monthly":
+	default:
+		return fmt.Errorf("invalid --interval %q: must be daily, weekly or monthly", opts.interval)
+	}
+
+	return nil
+}
+
+func runDependabotApplyCommand(opts *dependabotApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := validateDependabotApplyFlags(opts); err != nil {
+		return err
+	}
+
+	var staticConfig string
+	if opts.configFile != "" {
+		content, err := os.ReadFile(opts.configFile)
+		if err != nil {
+			return fmt.Errorf("failed to read dependabot config: %w", err)
+		}
+		staticConfig = string(content)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	render := func(string) (string, error) { return staticConfig, nil }
+	if opts.auto {
+		render = func(repoName string) (string, error) {
+			ecosystems, err := githubService.DetectDependabotEcosystems(ctx, owner, repoName)
+			if err != nil {
+				return "", err
+			}
+			if len(ecosystems) == 0 {
+				log.Info("No supported ecosystems detected, skipping repository", "repo", repoName)
+				return "", ghbatch.ErrSkipFile
+			}
+			return ghbatch.RenderDependabotConfig(ecosystems, opts.interval), nil
+		}
+	}
+
+	rollout := ghbatch.FileRollout{
+		Path:          ghbatch.DependabotConfigPath,
+		Render:        render,
+		CommitMessage: "Add/Update Dependabot configuration",
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update Dependabot configuration",
+			Body:   "This pull request configures Dependabot version updates.",
+		}
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(repos), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "dependabot apply", owner, repoNames(repos))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("Dependabot Rollout", owner, target.repoPrefix, ghbatch.DependabotConfigPath, results, isUser); failed > 0 {
+		return partialFailure("failed to apply dependabot configuration to %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/gomod"
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// depsReportOptions holds the flags of the deps report command.
+type depsReportOptions struct {
+	module          string
+	below           string
+	includeIndirect bool
+	top             int
+}
+
+// moduleUsage collects the repositories requiring a module, grouped by version.
+type moduleUsage struct {
+	path     string
+	versions map[string][]string
+	repos    int
+}
+
+func newDepsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deps",
+		Short: "Report on repository dependencies",
+		Long:  "Report on the dependencies declared by repositories",
+	}
+
+	cmd.AddCommand(newDepsReportCmd())
+
+	return cmd
+}
+
+func newDepsReportCmd() *cobra.Command {
+	opts := &depsReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Inventory Go module dependencies across repositories",
+		Long:  "Fetch and parse the go.mod file of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and report the modules and versions they require. With --module, list the repositories requiring that module, highlighting versions below --below",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDepsReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.module, "module", "", "Only report repositories requiring this module path")
+	cmd.Flags().StringVar(&opts.below, "below", "", "Highlight requirements on --module below this version, e.g. v1.4.0")
+	cmd.Flags().BoolVar(&opts.includeIndirect, "include-indirect", false, "Include indirect requirements")
+	cmd.Flags().IntVar(&opts.top, "top", 50, "Number of most used modules to list in the inventory (0 for all)")
+
+	return cmd
+}
+
+func runDepsReportCommand(opts *depsReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.below != "" {
+		if opts.module == "" {
+			return fmt.Errorf("--below requires --module")
+		}
+		if !gomod.ValidVersion(opts.below) {
+			return fmt.Errorf("invalid --below version %q, expected a semantic version such as v1.4.0", opts.below)
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetGoModReports(ctx, owner, repoNames(repos))
+	sort.Slice(reports, func(i, j int) bool { return reports[i].RepoName < reports[j].RepoName })
+
+	var failed int
+	if opts.module != "" {
+		failed = displayModuleDependents(owner, target.repoPrefix, reports, opts.module, opts.below, isUser)
+	} else {
+		failed = displayModuleInventory(owner, target.repoPrefix, reports, opts.includeIndirect, opts.top, isUser)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to read go.mod of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayModuleDependents lists the repositories requiring a module and returns the number of repositories
+// whose go.mod could not be read.
+func displayModuleDependents(owner, prefix string, reports []ghbatch.GoModReport, module, below string, isUser bool) int {
+	var goRepos, dependents, outdated, failed int
+
+	fmt.Printf("\n📋 Repositories requiring %s:\n", module)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tVERSION\tNOTE")
+	for _, report := range reports {
+		if report.Err != nil {
+			failed++
+			continue
+		}
+		if report.Module == nil {
+			continue
+		}
+		goRepos++
+
+		requirement, found := report.Module.Find(module)
+		if !found {
+			continue
+		}
+		dependents++
+
+		icon := "✅"
+		var notes []string
+		if below != "" && gomod.VersionBelow(requirement.Version, below) {
+			icon = "⚠️"
+			outdated++
+			notes = append(notes, "below "+below)
+		}
+		if requirement.Indirect {
+			notes = append(notes, "indirect")
+		}
+		if requirement.Replace != "" && requirement.Replace != module {
+			notes = append(notes, "replaced by "+requirement.Replace)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", icon, report.RepoName, requirement.Version, strings.Join(notes, ", "))
+	}
+	w.Flush()
+
+	displayGoModErrors(owner, reports)
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("🐹 With go.mod: %d\n", goRepos)
+	fmt.Printf("📦 Requiring %s: %d\n", module, dependents)
+	if below != "" {
+		fmt.Printf("⚠️  Below %s: %d\n", below, outdated)
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be read: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// displayModuleInventory lists the modules required across repositories, most used first, and returns the
+// number of repositories whose go.mod could not be read.
+func displayModuleInventory(owner, prefix string, reports []ghbatch.GoModReport, includeIndirect bool, top int, isUser bool) int {
+	var goRepos, failed int
+	usages := make(map[string]*moduleUsage)
+	goVersions := make(map[string]int)
+
+	for _, report := range reports {
+		if report.Err != nil {
+			failed++
+			continue
+		}
+		if report.Module == nil {
+			continue
+		}
+		goRepos++
+		goVersions[report.Module.GoVersion]++
+
+		for _, requirement := range report.Module.Requires {
+			if requirement.Indirect && !includeIndirect {
+				continue
+			}
+
+			usage, ok := usages[requirement.Path]
+			if !ok {
+				usage = &moduleUsage{path: requirement.Path, versions: make(map[string][]string)}
+				usages[requirement.Path] = usage
+			}
+			usage.versions[requirement.Version] = append(usage.versions[requirement.Version], report.RepoName)
+			usage.repos++
+		}
+	}
+
+	sorted := make([]*moduleUsage, 0, len(usages))
+	for _, usage := range usages {
+		sorted = append(sorted, usage)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].repos != sorted[j].repos {
+			return sorted[i].repos > sorted[j].repos
+		}
+		return sorted[i].path < sorted[j].path
+	})
+	if top > 0 && len(sorted) > top {
+		sorted = sorted[:top]
+	}
+
+	fmt.Println("\n📋 Go Module Inventory:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tREPOS\tVERSIONS")
+	for _, usage := range sorted {
+		fmt.Fprintf(w, "%s\t%d\t%s\n", usage.path, usage.repos, formatModuleVersions(usage.versions))
+	}
+	w.Flush()
+
+	displayGoModErrors(owner, reports)
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("🐹 With go.mod: %d\n", goRepos)
+	fmt.Printf("📦 Distinct modules: %d\n", len(usages))
+	if len(goVersions) > 0 {
+		fmt.Printf("🔖 Go versions: %s\n", formatCounts(goVersions))
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be read: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// formatModuleVersions formats the versions of a module with their repository counts, newest first.
+func formatModuleVersions(versions map[string][]string) string {
+	sorted := make([]string, 0, len(versions))
+	for version := range versions {
+		sorted = append(sorted, version)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return gomod.VersionBelow(sorted[j], sorted[i]) })
+
+	parts := make([]string, len(sorted))
+	for i, version := range sorted {
+		parts[i] = fmt.Sprintf("%s (%d)", version, len(versions[version]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// formatCounts formats counts keyed by name, most frequent first.
+func formatCounts(counts map[string]int) string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		name := key
+		if name == "" {
+			name = "unset"
+		}
+		parts[i] = fmt.Sprintf("%s (%d)", name, counts[key])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// displayGoModErrors prints the repositories whose go.mod could not be fetched or parsed.
+func displayGoModErrors(owner string, reports []ghbatch.GoModReport) {
+	for _, report := range reports {
+		if report.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, report.RepoName, report.Err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Accepted values of the --archived flag.
+const (
+	archivedInclude = "include"
+	archivedExclude = "exclude"
+	archivedOnly    = "only"
+)
+
+// discoveryOptions holds the global flags selecting how target repositories are discovered.
+type discoveryOptions struct {
+	mode     string
+	topics   []string
+	language string
+	archived string
+}
+
+// discovery is set from the persistent root flags and used by resolveTargetRepos.
+var discovery = discoveryOptions{mode: ghbatch.DiscoveryList, archived: archivedInclude}
+
+// addDiscoveryFlags registers the repository discovery flags.
+func addDiscoveryFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&discovery.mode, "discovery", ghbatch.DiscoveryList, "Repository discovery backend: list (page through all repositories) or search (Search API, faster for large organizations)")
+	flags.StringSliceVar(&discovery.topics, "topic", nil, "Only target repositories carrying all of these comma-separated topics")
+	flags.StringVar(&discovery.language, "language", "", "Only target repositories whose primary language is this, e.g. Go")
+	flags.StringVar(&discovery.archived, "archived", archivedInclude, "Archived repositories: include, exclude or only")
+}
+
+// buildDiscoveryQuery validates the discovery flags and builds the query for the given prefix.
+func buildDiscoveryQuery(prefix string) (ghbatch.DiscoveryQuery, error) {
+	query := ghbatch.DiscoveryQuery{
+		Mode:     discovery.mode,
+		Prefix:   prefix,
+		Topics:   discovery.topics,
+		Language: discovery.language,
+	}
+
+	if query.Mode != ghbatch.DiscoveryList && query.Mode != ghbatch.DiscoverySearch {
+		return query, fmt.Errorf("--discovery must be list or search")
+	}
+
+	switch discovery.archived {
+	case archivedInclude:
+	case archivedExclude:
+		query.Archived = new(bool)
+	case archivedOnly:
+		archived := true
+		query.Archived = &archived
+	default:
+		return query, fmt.Errorf("--archived must be include, exclude or only")
+	}
+
+	return query, nil
+}
+
+// targetsAllRepos reports whether no flag narrows the target repositories down from every repository of the owner.
+func targetsAllRepos(repoName, repoPrefix string) bool {
+	return repoName == "" && repoPrefix == "" && len(discovery.topics) == 0 && discovery.language == ""
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func newDiscussionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussions",
+		Short: "Report on GitHub Discussions",
+		Long:  "Report on the GitHub Discussions of repositories",
+	}
+
+	cmd.AddCommand(newDiscussionsReportCmd())
+
+	return cmd
+}
+
+func newDiscussionsReportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "report",
+		Short: "Count open, answered and unanswered discussions",
+		Long:  "Count the total, open, closed, answered and unanswered discussions of a specified repository, repositories with a given prefix, or all repositories in an organization or user account with GraphQL, and aggregate them across repositories. Answers only exist in question and answer categories; unanswered counts the open discussions without a marked answer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiscussionsReportCommand()
+		},
+	}
+}
+
+func runDiscussionsReportCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	allStats, err := githubService.GetDiscussionStats(ctx, owner, repoNames(repos))
+	if err != nil {
+		log.Error("Failed to get discussion stats", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if failed := displayDiscussionStats(owner, target.repoPrefix, allStats, isUser); failed > 0 {
+		return partialFailure("failed to count the discussions of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayDiscussionStats prints the discussion counts of each repository, busiest first, followed by the
+// totals, and returns the number of repositories that could not be counted.
+func displayDiscussionStats(owner, prefix string, allStats []*ghbatch.DiscussionStats, isUser bool) int {
+	sort.Slice(allStats, func(i, j int) bool {
+		if allStats[i].Total != allStats[j].Total {
+			return allStats[i].Total > allStats[j].Total
+		}
+		return allStats[i].RepoName < allStats[j].RepoName
+	})
+
+	var total ghbatch.DiscussionStats
+	var enabled, disabled, failed int
+
+	fmt.Println("\n📋 Repository Analysis:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	for _, stats := range allStats {
+		switch {
+		case stats.Err != nil:
+			failed++
+			fmt.Printf("⚠️  %s/%s: %v\n\n", owner, stats.RepoName, stats.Err)
+			continue
+		case !stats.Enabled:
+			disabled++
+			fmt.Printf("🚫 Repository: %s/%s (DISCUSSIONS DISABLED)\n\n", owner, stats.RepoName)
+			continue
+		}
+
+		enabled++
+		total.Total += stats.Total
+		total.Open += stats.Open
+		total.Answered += stats.Answered
+		total.Unanswered += stats.Unanswered
+
+		fmt.Printf("💬 Repository: %s/%s\n", owner, stats.RepoName)
+		fmt.Printf("  📊 Total Discussions: %d\n", stats.Total)
+		if stats.Total > 0 {
+			fmt.Printf("  🔓 Open: %d\n", stats.Open)
+			fmt.Printf("  ✔️  Closed: %d\n", stats.Closed())
+			fmt.Printf("  ✅ Answered: %d\n", stats.Answered)
+			fmt.Printf("  ❓ Unanswered: %d\n", stats.Unanswered)
+		}
+		fmt.Println()
+	}
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(allStats))
+	fmt.Printf("💬 Repositories with Discussions: %d\n", enabled)
+	fmt.Printf("🚫 Repositories without Discussions: %d\n", disabled)
+	if failed > 0 {
+		fmt.Printf("⚠️  Failed: %d\n", failed)
+	}
+	if total.Total > 0 {
+		fmt.Println(strings.Repeat("-", longSeparatorLength))
+		fmt.Printf("📊 Total Discussions across all repos: %d\n", total.Total)
+		fmt.Printf("🔓 Total Open: %d\n", total.Open)
+		fmt.Printf("✔️  Total Closed: %d\n", total.Closed())
+		fmt.Printf("✅ Total Answered: %d\n", total.Answered)
+		fmt.Printf("❓ Total Unanswered: %d\n", total.Unanswered)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
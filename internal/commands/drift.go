@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/textdiff"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// driftOptions holds the flags of the drift command.
+type driftOptions struct {
+	source string
+	paths  []string
+	diff   bool
+}
+
+func newDriftCmd() *cobra.Command {
+	opts := &driftOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detect drift of managed files from a source-of-truth repository",
+		Long:  "Compare managed files, e.g. workflows, CODEOWNERS and linter configs, in each repository with their copies in a source-of-truth repository and report the repositories that have diverged. Paths naming a directory of the source repository cover every file below it. The command exits with code 5 when any repository has diverged",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDriftCommand(opts)
+		},
+	}
+
+	addManagedFileFlags(cmd, &opts.source, &opts.paths)
+	cmd.Flags().BoolVar(&opts.diff, "diff", false, "Print the unified diff of every diverged file")
+
+	return cmd
+}
+
+// addManagedFileFlags registers the source repository and managed path flags shared by drift and sync.
+func addManagedFileFlags(cmd *cobra.Command, source *string, paths *[]string) {
+	cmd.Flags().StringVar(source, "source", "", "Source-of-truth repository as owner/name, or name within the target owner (required)")
+	cmd.Flags().StringSliceVar(paths, "path", nil, "Managed file or directory path, comma-separated or repeated (required)")
+
+	cmd.MarkFlagRequired("source")
+	cmd.MarkFlagRequired("path")
+}
+
+func runDriftCommand(opts *driftOptions) error {
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	sourceOwner, sourceRepo, err := parseRepoReference(opts.source, owner)
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	files, err := githubService.GetManagedFiles(ctx, sourceOwner, sourceRepo, opts.paths)
+	if err != nil {
+		return err
+	}
+
+	names, err := resolveDownstreamRepos(ctx, githubService, owner, isUser, sourceOwner, sourceRepo)
+	if err != nil {
+		return err
+	}
+
+	drifts := githubService.DetectDrift(ctx, owner, names, files)
+	diverged, failed := displayDrift(owner, target.repoPrefix, sourceOwner+"/"+sourceRepo, files, drifts, opts.diff, isUser)
+
+	if diverged > 0 {
+		return &exitError{code: ExitNonCompliant, err: fmt.Errorf("%d repositories diverge from %s/%s", diverged, sourceOwner, sourceRepo)}
+	}
+	if failed > 0 {
+		return partialFailure("failed to check %d repositories for drift", failed)
+	}
+	return nil
+}
+
+// resolveDownstreamRepos returns the names of the target repositories, leaving out the source repository.
+func resolveDownstreamRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner string, isUser bool,
+	sourceOwner, sourceRepo string,
+) ([]string, error) {
+	log := logger.GetLogger()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range repoNames(repos) {
+		if owner != sourceOwner || name != sourceRepo {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return nil, errNoReposMatched
+	}
+
+	return names, nil
+}
+
+// displayDrift prints the drift of each repository from the managed files with a summary and returns the
+// number of diverged and failed repositories. With diff set, the unified diff turning each diverged file
+// into the source version is printed below its repository.
+func displayDrift(owner, prefix, source string, files []ghbatch.ManagedFile, drifts []ghbatch.RepoDrift, diff, isUser bool) (int, int) {
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].RepoName < drifts[j].RepoName })
+
+	sourceContent := make(map[string]string, len(files))
+	for _, file := range files {
+		sourceContent[file.Path] = file.Content
+	}
+
+	var inSync, diverged, failed int
+	pathDrift := map[string]int{}
+
+	fmt.Printf("\n📋 Drift from %s (%d managed files):\n", source, len(files))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, drift := range drifts {
+		if drift.Err != nil {
+			failed++
+			fmt.Printf("  ⚠️  %s/%s (ERROR): %v\n", owner, drift.RepoName, drift.Err)
+			continue
+		}
+
+		if !drift.Diverged() {
+			inSync++
+			fmt.Printf("  ✅ %s/%s (IN SYNC)\n", owner, drift.RepoName)
+			continue
+		}
+
+		diverged++
+		var details []string
+		for _, file := range drift.Files {
+			if file.Status != ghbatch.DriftStatusInSync {
+				pathDrift[file.Path]++
+				details = append(details, fmt.Sprintf("%s (%s)", file.Path, file.Status))
+			}
+		}
+		fmt.Printf("  ❌ %s/%s (DIVERGED): %s\n", owner, drift.RepoName, strings.Join(details, ", "))
+
+		if !diff {
+			continue
+		}
+		for _, file := range drift.Files {
+			oldName := "a/" + file.Path
+			switch file.Status {
+			case ghbatch.DriftStatusInSync:
+				continue
+			case ghbatch.DriftStatusMissing:
+				oldName = "/dev/null"
+			}
+			fmt.Print(textdiff.Unified(oldName, "b/"+file.Path, file.Content, sourceContent[file.Path], diffContextLines))
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(drifts))
+	fmt.Printf("✅ In sync: %d\n", inSync)
+	fmt.Printf("❌ Diverged: %d\n", diverged)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be checked: %d\n", failed)
+	}
+	for _, file := range files {
+		fmt.Printf("   %s diverged in: %d\n", file.Path, pathDrift[file.Path])
+	}
+	fmt.Printf("📍 Source: %s\n", source)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return diverged, failed
+}
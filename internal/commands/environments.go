@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/spec"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// environmentsApplyOptions holds the flags of the environments apply command.
+type environmentsApplyOptions struct {
+	file string
+	yes  bool
+}
+
+func newEnvironmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "environments",
+		Short: "Manage deployment environments",
+		Long:  "Manage deployment environments, such as staging and production, and their protection rules across repositories",
+	}
+
+	cmd.AddCommand(newEnvironmentsApplyCmd())
+
+	return cmd
+}
+
+func newEnvironmentsApplyCmd() *cobra.Command {
+	opts := &environmentsApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update deployment environments in repositories from a spec file",
+		Long:  "Create the deployment environments of a YAML spec file in repositories, or update existing environments with the same name, applying their protection rules: required reviewers, wait timer and the branches allowed to deploy. Branch patterns not listed in the spec are removed from the environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnvironmentsApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", "Path to the YAML file listing the environments (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runEnvironmentsApplyCommand(opts *environmentsApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	environments, err := spec.LoadEnvironments(opts.file)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	if err := githubService.ResolveEnvironmentReviewers(ctx, owner, environments); err != nil {
+		return err
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	environmentNames := make([]string, 0, len(environments))
+	for _, environment := range environments {
+		environmentNames = append(environmentNames, environment.Name)
+	}
+
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, names)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Apply environments %s to %d repositories?", strings.Join(environmentNames, ", "), len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.ApplyEnvironments(ctx, owner, names, environments)
+	if failed := displayEnvironmentResults(owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to apply environments to %d repositories", failed)
+	}
+	return nil
+}
+
+// displayEnvironmentResults prints the environments created or updated per repository with a summary and
+// returns the number of failed repositories.
+func displayEnvironmentResults(owner, prefix string, results []ghbatch.EnvironmentResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	var failed int
+
+	fmt.Printf("\n📋 Environment Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		var details []string
+		for _, change := range result.Changes {
+			counts[change.Status]++
+			details = append(details, fmt.Sprintf("%s (%s)", change.Name, change.Status))
+		}
+
+		if result.Err != nil {
+			failed++
+			details = append(details, result.Err.Error())
+			fmt.Printf("  ❌ %s/%s (FAILED): %s\n", owner, result.RepoName, strings.Join(details, ", "))
+			continue
+		}
+		fmt.Printf("  ✅ %s/%s: %s\n", owner, result.RepoName, strings.Join(details, ", "))
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Successful: %d\n", len(results)-failed)
+	fmt.Printf("   Environments created: %d\n", counts[ghbatch.EnvironmentStatusCreated])
+	fmt.Printf("   Environments updated: %d\n", counts[ghbatch.EnvironmentStatusUpdated])
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
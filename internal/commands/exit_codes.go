@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/logger"
+)
+
+// Exit codes of the CLI, so that automation can react to the outcome of a batch run.
+const (
+	// ExitOK means every repository was processed successfully.
+	ExitOK = 0
+	// ExitUsage means the command was invoked incorrectly or failed before processing any repository.
+	ExitUsage = 1
+	// ExitPartialFailure means the batch ran but some repositories or items failed, with --fail-on-partial.
+	ExitPartialFailure = 2
+	// ExitAuth means the token is missing or was rejected, or a rate limit was exhausted.
+	ExitAuth = 3
+	// ExitNoRepos means no repository matched the target flags.
+	ExitNoRepos = 4
+	// ExitNonCompliant means check found repositories violating the policy or drift found repositories
+	// diverging from the source of truth.
+	ExitNonCompliant = 5
+)
+
+// failOnPartial is set from the persistent --fail-on-partial flag.
+var failOnPartial bool
+
+// addExitCodeFlags registers the exit code flags.
+func addExitCodeFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&failOnPartial, "fail-on-partial", false, "Exit with code 2 when some repositories fail; without it the failures are reported but the exit code is 0")
+}
+
+// exitError is an error carrying the exit code of the command.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitError) Unwrap() error {
+	return e.err
+}
+
+// errNoReposMatched is returned by commands whose target flags matched no repository.
+var errNoReposMatched = &exitError{code: ExitNoRepos, err: errors.New("no repositories matched the specified criteria")}
+
+// errMissingToken is returned when no token is configured.
+var errMissingToken = errors.New("GitHub token (--token) is required, or must be set in GITHUB_TOKEN environment variable, via login or via gh auth login")
+
+// partialFailure returns the error of a batch in which some repositories or items failed. Without
+// --fail-on-partial the failure is only logged and nil is returned.
+func partialFailure(format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	if !failOnPartial {
+		logger.GetLogger().Warn("Batch completed with failures", "error", err)
+		return nil
+	}
+
+	return &exitError{code: ExitPartialFailure, err: err}
+}
+
+// exitCode maps the error returned by a command to the exit code of the process.
+func exitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	var exitErr *exitError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+
+	var (
+		errResp      *github.ErrorResponse
+		rateLimitErr *github.RateLimitError
+		abuseErr     *github.AbuseRateLimitError
+	)
+	if errors.Is(err, errMissingToken) || errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return ExitAuth
+	}
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusUnauthorized {
+		return ExitAuth
+	}
+
+	return ExitUsage
+}
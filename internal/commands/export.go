@@ -0,0 +1,86 @@
+package commands
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Report output formats. The table is printed for people, CSV and JSON feed dashboards, and NDJSON, one
+// JSON object per line, feeds log and data pipelines.
+const (
+	reportFormatTable  = "table"
+	reportFormatCSV    = "csv"
+	reportFormatJSON   = "json"
+	reportFormatNDJSON = "ndjson"
+)
+
+// validateReportFormat checks the --format flag of a report command and that --output is only used with
+// an export format.
+func validateReportFormat(format, output string) error {
+	switch format {
+	case reportFormatTable:
+		if output != "" {
+			return fmt.Errorf("--output requires --format %s or %s", reportFormatCSV, reportFormatJSON)
+		}
+	case reportFormatCSV, reportFormatJSON:
+	default:
+		return fmt.Errorf("invalid --format %q: must be %s, %s or %s", format, reportFormatTable, reportFormatCSV, reportFormatJSON)
+	}
+
+	return nil
+}
+
+// writeReport writes an exported report to the output file, or to stdout when output is empty.
+func writeReport(output string, write func(w io.Writer) error) error {
+	if output == "" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", output, err)
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+
+	return f.Close()
+}
+
+// writeCSV writes a header and rows as CSV.
+func writeCSV(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// writeJSON writes a value as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(v)
+}
+
+// writeNDJSON writes each item as a compact JSON object on its own line.
+func writeNDJSON[T any](w io.Writer, items []T) error {
+	encoder := json.NewEncoder(w)
+	for _, item := range items {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// exportIssuesOptions holds the flags of the export issues command.
+type exportIssuesOptions struct {
+	state  string
+	format string
+	output string
+}
+
+// exportedIssueHeader is the header of the CSV issue export.
+var exportedIssueHeader = []string{"repository", "number", "title", "state", "author", "labels", "assignees", "created_at", "closed_at", "url"}
+
+func newExportIssuesCmd() *cobra.Command {
+	opts := &exportIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "issues",
+		Short: "Export issues as CSV or NDJSON",
+		Long:  "Export the issues of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, with their title, state, author, labels, assignees and creation and closing dates, as CSV or NDJSON for BI tools. Pull requests are left out. Each repository is written as soon as its issues are listed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.state, "state", "all", "Issues to export: open, closed or all")
+	cmd.Flags().StringVar(&opts.format, "format", reportFormatCSV, "Export format: csv or ndjson (one issue per line)")
+	cmd.Flags().StringVar(&opts.output, "output", "", "File the export is written to (default: stdout)")
+
+	return cmd
+}
+
+func runExportIssuesCommand(opts *exportIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if !slices.Contains(ghbatch.IssueStates, opts.state) {
+		return fmt.Errorf("invalid --state %q: must be one of %s", opts.state, strings.Join(ghbatch.IssueStates, ", "))
+	}
+
+	if opts.format != reportFormatCSV && opts.format != reportFormatNDJSON {
+		return fmt.Errorf("invalid --format %q: must be %s or %s", opts.format, reportFormatCSV, reportFormatNDJSON)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	var exported, failed int
+	results := githubService.StreamIssueExports(ctx, owner, repoNames(repos), opts.state)
+
+	err = writeReport(opts.output, func(w io.Writer) error {
+		write := issueExportWriter(w, opts.format)
+		if err := write.header(); err != nil {
+			return err
+		}
+
+		for result := range results {
+			if result.Err != nil {
+				failed++
+				continue
+			}
+
+			for _, issue := range result.Value {
+				if err := write.issue(issue); err != nil {
+					// Keep draining so the workers can finish
+					for range results {
+					}
+					return err
+				}
+			}
+			exported += len(result.Value)
+		}
+
+		return write.flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Exported issues", "owner", owner, "repos", len(repos)-failed, "issues", exported, "failed", failed,
+		"format", opts.format, "file", opts.output)
+
+	if failed > 0 {
+		return partialFailure("failed to export the issues of %d repositories", failed)
+	}
+	return nil
+}
+
+// issueWriter writes exported issues one at a time in an export format: header is called first and flush last.
+type issueWriter struct {
+	header func() error
+	issue  func(ghbatch.ExportedIssue) error
+	flush  func() error
+}
+
+// issueExportWriter returns the writer of the CSV or NDJSON issue export.
+func issueExportWriter(w io.Writer, format string) issueWriter {
+	if format == reportFormatNDJSON {
+		encoder := json.NewEncoder(w)
+		return issueWriter{
+			header: func() error { return nil },
+			issue:  func(issue ghbatch.ExportedIssue) error { return encoder.Encode(issue) },
+			flush:  func() error { return nil },
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	return issueWriter{
+		header: func() error { return cw.Write(exportedIssueHeader) },
+		issue: func(issue ghbatch.ExportedIssue) error {
+			closedAt := ""
+			if issue.ClosedAt != nil {
+				closedAt = issue.ClosedAt.Format(time.RFC3339)
+			}
+
+			return cw.Write([]string{
+				issue.RepoName, strconv.Itoa(issue.Number), issue.Title, issue.State, issue.Author,
+				strings.Join(issue.Labels, ","), strings.Join(issue.Assignees, ","),
+				issue.CreatedAt.Format(time.RFC3339), closedAt, issue.URL,
+			})
+		},
+		flush: func() error {
+			cw.Flush()
+			return cw.Error()
+		},
+	}
+}
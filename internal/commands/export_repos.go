@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+// exportReposOptions holds the flags of the export repos command.
+type exportReposOptions struct {
+	format string
+	output string
+}
+
+func newExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export repository data",
+		Long:  "Export repository data as machine-readable files for other systems",
+	}
+
+	cmd.AddCommand(newExportReposCmd())
+	cmd.AddCommand(newExportIssuesCmd())
+
+	return cmd
+}
+
+func newExportReposCmd() *cobra.Command {
+	opts := &exportReposOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "repos",
+		Short: "Export the full repository objects",
+		Long:  "Export the full repository objects of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, with their settings, topics, default branch, visibility and timestamps, as JSON or NDJSON. Repositories are sorted by name so that exports can be diffed over time",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExportReposCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.format, "format", reportFormatJSON, "Export format: json (a single array) or ndjson (one repository per line)")
+	cmd.Flags().StringVar(&opts.output, "output", "", "File the export is written to (default: stdout)")
+
+	return cmd
+}
+
+// validateExportFormat checks the --format flag of an export command.
+func validateExportFormat(format string) error {
+	if format != reportFormatJSON && format != reportFormatNDJSON {
+		return fmt.Errorf("invalid --format %q: must be %s or %s", format, reportFormatJSON, reportFormatNDJSON)
+	}
+
+	return nil
+}
+
+func runExportReposCommand(opts *exportReposOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if err := validateExportFormat(opts.format); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Listing leaves out settings such as the merge options, so every repository is fetched in full
+	results := githubService.GetRepositories(ctx, owner, repoNames(repos))
+
+	exported := make([]*github.Repository, 0, len(results))
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+		exported = append(exported, result.Value)
+	}
+
+	if opts.format == reportFormatNDJSON {
+		err = writeReport(opts.output, func(w io.Writer) error { return writeNDJSON(w, exported) })
+	} else {
+		err = writeReport(opts.output, func(w io.Writer) error { return writeJSON(w, exported) })
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("Exported repositories", "owner", owner, "repos", len(exported), "failed", failed, "format", opts.format,
+		"file", opts.output)
+
+	if failed > 0 {
+		return partialFailure("failed to export %d repositories", failed)
+	}
+	return nil
+}
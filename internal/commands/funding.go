@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// fundingApplyOptions holds the flags of the funding apply command.
+type fundingApplyOptions struct {
+	configFile string
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newFundingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding",
+		Short: "Manage sponsor button configuration",
+		Long:  "Manage .github/FUNDING.yml across public repositories",
+	}
+
+	cmd.AddCommand(newFundingApplyCmd())
+
+	return cmd
+}
+
+func newFundingApplyCmd() *cobra.Command {
+	opts := &fundingApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Add or update .github/FUNDING.yml in public repositories",
+		Long:  "Add or update .github/FUNDING.yml in the public repositories matching the target flags; private and internal repositories are skipped since GitHub only shows the sponsor button on public ones. The sponsor platform keys are validated before anything is pushed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFundingApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.configFile, "config-file", "", "Path to the FUNDING.yml to add to repositories (required)")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-funding", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("config-file")
+
+	return cmd
+}
+
+// publicRepos returns the repositories that are neither private nor internal.
+func publicRepos(repos []*github.Repository) []*github.Repository {
+	var public []*github.Repository
+	for _, repository := range repos {
+		if !repository.GetPrivate() {
+			public = append(public, repository)
+		}
+	}
+
+	return public
+}
+
+func runFundingApplyCommand(opts *fundingApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.configFile == "" {
+		return fmt.Errorf("--config-file is required")
+	}
+
+	content, err := os.ReadFile(opts.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to read FUNDING.yml: %w", err)
+	}
+	if err := ghbatch.ValidateFundingConfig(string(content)); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	public := publicRepos(repos)
+	if skipped := len(repos) - len(public); skipped > 0 {
+		log.Info("Skipping private and internal repositories", "count", skipped)
+	}
+
+	if len(public) == 0 {
+		log.Info("No public repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	rollout := ghbatch.FileRollout{
+		Path:          ghbatch.FundingConfigPath,
+		Render:        func(string) (string, error) { return string(content), nil },
+		CommitMessage: "Add/Update FUNDING.yml",
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update FUNDING.yml",
+			Body:   "This pull request configures the sponsor button of the repository.",
+		}
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(public), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "funding apply", owner, repoNames(public))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("FUNDING.yml Rollout", owner, target.repoPrefix, ghbatch.FundingConfigPath, results, isUser); failed > 0 {
+		return partialFailure("failed to apply FUNDING.yml to %d repositories", failed)
+	}
+	return nil
+}
@@ -6,108 +6,211 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"go-repo-manager/internal/logger"
-	"go-repo-manager/internal/repo"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Issue counting backends.
+const (
+	issueBackendREST    = "rest"
+	issueBackendGraphQL = "graphql"
 )
 
 func newGetIssueCountCmd() *cobra.Command {
 	var (
-		repoName    string
-		repoPrefix  string
-		org         string
-		username    string
-		token       string
-		concurrency int
+		labels      []string
+		since       string
+		until       string
+		assignee    string
+		milestone   string
+		backend     string
+		stream      bool
+		interactive bool
+		byLabel     bool
 	)
 
 	cmd := &cobra.Command{
 		Use:   "get-issue-count",
 		Short: "Get issue count from repositories",
-		Long:  "Get the count of issues from specified repositories, repositories with a given prefix, or all repositories in an organization or user account",
+		Long:  "Get the count of issues from specified repositories, repositories with a given prefix, or all repositories in an organization or user account. The counts can be narrowed by label, creation date range, assignee and milestone",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			log := logger.GetLogger()
 
-			// Validate input - must have either org or username, but not both
-			if org == "" && username == "" {
-				return fmt.Errorf("either organization (--org) or username (--username) is required")
+			if err := ValidateTarget(); err != nil {
+				return err
 			}
 
-			if org != "" && username != "" {
-				return fmt.Errorf("cannot specify both --org and --username")
+			if stream && backend == issueBackendGraphQL {
+				return fmt.Errorf("--stream is only supported with the rest backend")
 			}
 
-			if repoName != "" && repoPrefix != "" {
-				return fmt.Errorf("cannot specify both --repo and --repo-prefix")
+			if interactive && (stream || target.repoName != "") {
+				return fmt.Errorf("--tui cannot be combined with --stream or --repo")
 			}
 
-			// Get token from environment if not provided via flag
-			if token == "" {
-				token = os.Getenv("GITHUB_TOKEN")
+			if byLabel && backend == issueBackendGraphQL {
+				return fmt.Errorf("--by-label is only supported with the rest backend")
 			}
 
-			if token == "" {
-				return fmt.Errorf("GitHub token (--token) is required or must be set in GITHUB_TOKEN environment variable")
+			if byLabel && interactive {
+				return fmt.Errorf("--by-label cannot be combined with --tui")
 			}
 
-			// Determine if we're working with a user or organization
-			var owner string
-			var isUser bool
-			if username != "" {
-				owner = username
-				isUser = true
-			} else {
-				owner = org
-				isUser = false
+			if backend != issueBackendREST && backend != issueBackendGraphQL {
+				return fmt.Errorf("invalid --backend %q: must be %s or %s", backend, issueBackendREST, issueBackendGraphQL)
+			}
+
+			filter, err := buildIssueStatsFilter(labels, since, until, assignee, milestone)
+			if err != nil {
+				return err
+			}
+
+			token, err := ResolveToken()
+			if err != nil {
+				return err
 			}
 
+			// Determine if we're working with a user or organization
+			owner, isUser := ResolveOwner()
+
 			// Create GitHub client and service with dependency injection
-			githubClient := repo.NewGitHubClient(token)
-			githubService := repo.NewGitHubServiceWithConcurrency(githubClient, concurrency)
-			ctx := context.Background()
+			githubClient := newGitHubClient(token)
+			githubService := newGitHubService(githubClient)
+			ctx, cancel := commandContext()
+			defer cancel()
 
-			if repoName != "" {
+			if backend == issueBackendGraphQL {
+				return handleGraphQLRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser, filter, interactive)
+			}
+
+			if target.repoName != "" {
 				// Get issue count for single repository
-				return handleSingleRepo(ctx, githubService, owner, repoName)
+				return handleSingleRepo(ctx, githubService, owner, target.repoName, filter, byLabel)
 			} else {
-				if repoName == "" && repoPrefix == "" {
+				if target.repoName == "" && target.repoPrefix == "" {
 					if isUser {
 						log.Info("No repository or prefix specified, fetching all repositories for user")
 					} else {
 						log.Info("No repository or prefix specified, fetching all repositories in organization")
 					}
 				}
-				return handleMultipleRepos(ctx, githubService, owner, repoPrefix, isUser)
+				if stream {
+					return handleStreamedRepos(ctx, githubService, owner, target.repoPrefix, isUser, filter, byLabel)
+				}
+				return handleMultipleRepos(ctx, githubService, owner, target.repoPrefix, isUser, filter, interactive, byLabel)
 			}
 		},
 	}
 
-	cmd.Flags().StringVar(&repoName, "repo", "", "Specific repository name")
-	cmd.Flags().StringVar(&repoPrefix, "repo-prefix", "", "Repository name prefix to filter repositories")
-	cmd.Flags().StringVar(&org, "org", "", "GitHub organization name")
-	cmd.Flags().StringVar(&username, "username", "", "GitHub username")
-	cmd.Flags().StringVar(&token, "token", "", "GitHub personal access token (optional, can also be set via GITHUB_TOKEN env var)")
-	cmd.Flags().IntVar(&concurrency, "concurrency", 1, "Maximum number of concurrent workers for processing repositories (default: 1)")
+	cmd.Flags().StringSliceVar(&labels, "label", nil, "Only count issues carrying all of these comma-separated labels")
+	cmd.Flags().StringVar(&since, "since", "", "Only count issues created on or after this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&until, "until", "", "Only count issues created on or before this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "Only count issues assigned to this login (\"none\" for unassigned, \"*\" for any)")
+	cmd.Flags().StringVar(&milestone, "milestone", "", "Only count issues in this milestone, by number or title (\"none\" or \"*\" also accepted)")
+	cmd.Flags().BoolVar(&stream, "stream", false, "Print each repository's counts as soon as they are ready instead of after the whole batch")
+	cmd.Flags().BoolVar(&interactive, "tui", false, "Browse the results interactively: sort, filter and drill into the open issues of a repository")
+	cmd.Flags().BoolVar(&byLabel, "by-label", false, "Break the open and closed counts down by label, per repository and across all repositories")
+	cmd.Flags().StringVar(&backend, "backend", issueBackendREST, "API used to count issues: rest (lists every issue) or graphql (one aggregate query per 50 repositories)")
 
 	return cmd
 }
 
-func handleSingleRepo(ctx context.Context, githubService repo.GitHubClient, owner, repoName string) error {
-	stats, err := githubService.GetIssueStatsForRepo(ctx, owner, repoName)
+// buildIssueStatsFilter validates the issue filter flags and builds the filter.
+func buildIssueStatsFilter(labels []string, since, until, assignee, milestone string) (ghbatch.IssueStatsFilter, error) {
+	filter := ghbatch.IssueStatsFilter{Labels: labels, Assignee: assignee, Milestone: milestone}
+
+	if since != "" {
+		t, _, err := parseDateFlag(since)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --since: %w", err)
+		}
+		filter.Since = t
+	}
+
+	if until != "" {
+		t, dateOnly, err := parseDateFlag(until)
+		if err != nil {
+			return filter, fmt.Errorf("invalid --until: %w", err)
+		}
+		// A plain date includes the whole day
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		filter.Until = t
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return filter, fmt.Errorf("--since must be before --until")
+	}
+
+	return filter, nil
+}
+
+// parseDateFlag parses a YYYY-MM-DD date or an RFC 3339 timestamp and reports whether it was a plain date.
+func parseDateFlag(value string) (time.Time, bool, error) {
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, true, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("%q is neither a YYYY-MM-DD date nor an RFC 3339 timestamp", value)
+	}
+
+	return t, false, nil
+}
+
+// displayIssueStatsFilter prints the active issue filters, if any.
+func displayIssueStatsFilter(filter ghbatch.IssueStatsFilter) {
+	var parts []string
+	if len(filter.Labels) > 0 {
+		parts = append(parts, "labels="+strings.Join(filter.Labels, ","))
+	}
+	if !filter.Since.IsZero() {
+		parts = append(parts, "created since "+filter.Since.Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		parts = append(parts, "created before "+filter.Until.Format(time.RFC3339))
+	}
+	if filter.Assignee != "" {
+		parts = append(parts, "assignee="+filter.Assignee)
+	}
+	if filter.Milestone != "" {
+		parts = append(parts, "milestone="+filter.Milestone)
+	}
+
+	if len(parts) > 0 {
+		fmt.Printf("\n🔎 Filters: %s\n", strings.Join(parts, ", "))
+	}
+}
+
+func handleSingleRepo(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName string, filter ghbatch.IssueStatsFilter,
+	byLabel bool,
+) error {
+	stats, err := githubService.GetIssueStatsForRepo(ctx, owner, repoName, filter)
 	if err != nil {
 		logger.GetLogger().Error("Failed to get issue stats for repository", "owner", owner, "repo", repoName, "error", err)
 		return err
 	}
 
+	displayIssueStatsFilter(filter)
 	displaySingleRepoStats(owner, stats)
+	if byLabel {
+		displayLabelCounts("Issues by Label", stats.Labels)
+	}
 	return nil
 }
 
-func handleMultipleRepos(ctx context.Context, githubService repo.GitHubClient, owner, prefix string, isUser bool) error {
+func handleMultipleRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner, prefix string, isUser bool,
+	filter ghbatch.IssueStatsFilter, interactive, byLabel bool,
+) error {
 	log := logger.GetLogger()
-	allStats, err := githubService.GetIssueStatsForReposWithPrefix(ctx, owner, prefix, isUser)
+	allStats, err := githubService.GetIssueStatsForReposWithPrefix(ctx, owner, prefix, isUser, filter)
 	if err != nil {
 		log.Error("Failed to get issue stats for repositories with prefix", "owner", owner, "prefix", prefix, "error", err)
 		return err
@@ -115,14 +218,123 @@ func handleMultipleRepos(ctx context.Context, githubService repo.GitHubClient, o
 
 	if len(allStats) == 0 {
 		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", prefix)
+		return errNoReposMatched
+	}
+
+	if interactive {
+		return browseIssueStats(ctx, githubService, owner, allStats, filter)
+	}
+
+	displayIssueStatsFilter(filter)
+	displayMultipleReposStats(owner, prefix, allStats, isUser)
+	if byLabel {
+		displayRepoLabelCounts(owner, allStats)
+	}
+	return nil
+}
+
+// handleGraphQLRepos counts issues with the GraphQL backend for a single repository or all repositories
+// matching the prefix.
+func handleGraphQLRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName, prefix string, isUser bool,
+	filter ghbatch.IssueStatsFilter, interactive bool,
+) error {
+	log := logger.GetLogger()
+
+	names := []string{repoName}
+	if repoName == "" {
+		repos, err := githubService.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+		if err != nil {
+			log.Error("Failed to get repositories with prefix", "owner", owner, "prefix", prefix, "error", err)
+			return err
+		}
+		names = repoNames(repos)
+	}
+
+	if len(names) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", prefix)
+		return errNoReposMatched
+	}
+
+	allStats, err := githubService.GetIssueStatsGraphQL(ctx, owner, names, filter)
+	if err != nil {
+		log.Error("Failed to get issue stats with GraphQL", "owner", owner, "error", err)
+		return err
+	}
+
+	if repoName != "" {
+		if len(allStats) == 0 {
+			return fmt.Errorf("failed to get issue stats for %s/%s", owner, repoName)
+		}
+
+		displayIssueStatsFilter(filter)
+		displaySingleRepoStats(owner, allStats[0])
 		return nil
 	}
 
+	if len(allStats) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", prefix)
+		return errNoReposMatched
+	}
+
+	if interactive {
+		return browseIssueStats(ctx, githubService, owner, allStats, filter)
+	}
+
+	displayIssueStatsFilter(filter)
 	displayMultipleReposStats(owner, prefix, allStats, isUser)
 	return nil
 }
 
-func displaySingleRepoStats(owner string, stats *repo.IssueStats) {
+// handleStreamedRepos counts issues for all repositories matching the prefix, printing each repository as
+// soon as its counts are ready and the summary once the batch completes.
+func handleStreamedRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner, prefix string, isUser bool,
+	filter ghbatch.IssueStatsFilter, byLabel bool,
+) error {
+	log := logger.GetLogger()
+	results, total, err := githubService.StreamIssueStats(ctx, owner, prefix, isUser, filter)
+	if err != nil {
+		log.Error("Failed to get issue stats for repositories with prefix", "owner", owner, "prefix", prefix, "error", err)
+		return err
+	}
+
+	if total == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", prefix)
+		return errNoReposMatched
+	}
+
+	displayIssueStatsFilter(filter)
+	fmt.Println("\n📋 Repository Analysis:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	var allStats []*ghbatch.IssueStats
+	completed, failed := 0, 0
+	for result := range results {
+		completed++
+		if result.Err != nil {
+			log.Error("Error fetching repository stats", "repo", result.RepoName, "error", result.Err)
+			failed++
+			continue
+		}
+
+		fmt.Printf("[%d/%d] ", completed, total)
+		displayRepoIssueStats(owner, result.Value)
+		allStats = append(allStats, result.Value)
+	}
+
+	if len(allStats) > 0 {
+		displayIssueStatsSummary(owner, prefix, allStats, isUser)
+		if byLabel {
+			displayRepoLabelCounts(owner, allStats)
+		}
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to get issue stats for %d repositories", failed)
+	}
+	return nil
+}
+
+func displaySingleRepoStats(owner string, stats *ghbatch.IssueStats) {
 	fmt.Println("\n📋 Repository Analysis:")
 	fmt.Println(strings.Repeat("-", shortSeparatorLength))
 
@@ -145,13 +357,7 @@ func displaySingleRepoStats(owner string, stats *repo.IssueStats) {
 	fmt.Println(strings.Repeat("-", shortSeparatorLength))
 }
 
-func displayMultipleReposStats(owner, prefix string, allStats []*repo.IssueStats, isUser bool) {
-	var totalIssuesAcrossRepos int
-	var totalOpenIssues int
-	var totalClosedIssues int
-	var reposWithIssues int
-	var reposWithoutIssues int
-
+func displayMultipleReposStats(owner, prefix string, allStats []*ghbatch.IssueStats, isUser bool) {
 	// Sort repositories: repos without issues first, then repos with issues (sorted by total issues desc)
 	sort.Slice(allStats, func(i, j int) bool {
 		// If one has issues and the other doesn't, prioritize the one without issues
@@ -167,25 +373,46 @@ func displayMultipleReposStats(owner, prefix string, allStats []*repo.IssueStats
 	fmt.Println(strings.Repeat("-", longSeparatorLength))
 
 	for _, stats := range allStats {
-		// Determine status indicator based on whether repo has issues
-		statusIcon := "❌" // Cross for repos with issues
-		statusText := "HAS ISSUES"
+		displayRepoIssueStats(owner, stats)
+	}
+
+	displayIssueStatsSummary(owner, prefix, allStats, isUser)
+}
+
+// displayRepoIssueStats prints the issue counts of one repository in the multi-repository report.
+func displayRepoIssueStats(owner string, stats *ghbatch.IssueStats) {
+	// Determine status indicator based on whether repo has issues
+	statusIcon := "❌" // Cross for repos with issues
+	statusText := "HAS ISSUES"
+	if stats.TotalIssues == 0 {
+		statusIcon = "✅" // Checkmark for repos without issues
+		statusText = "CLEAN"
+	}
+
+	fmt.Printf("%s Repository: %s/%s (%s)\n", statusIcon, owner, stats.RepoName, statusText)
+	fmt.Printf("  📊 Total Issues: %d\n", stats.TotalIssues)
+	if stats.TotalIssues > 0 {
+		fmt.Printf("  🔓 Open Issues: %d\n", stats.OpenIssues)
+		fmt.Printf("  ✔️  Closed Issues: %d\n", stats.ClosedIssues)
+	}
+	fmt.Println()
+}
+
+// displayIssueStatsSummary prints the totals of the multi-repository report.
+func displayIssueStatsSummary(owner, prefix string, allStats []*ghbatch.IssueStats, isUser bool) {
+	var totalIssuesAcrossRepos int
+	var totalOpenIssues int
+	var totalClosedIssues int
+	var reposWithIssues int
+	var reposWithoutIssues int
+
+	for _, stats := range allStats {
 		if stats.TotalIssues == 0 {
-			statusIcon = "✅" // Checkmark for repos without issues
-			statusText = "CLEAN"
 			reposWithoutIssues++
 		} else {
 			reposWithIssues++
 		}
 
-		fmt.Printf("%s Repository: %s/%s (%s)\n", statusIcon, owner, stats.RepoName, statusText)
-		fmt.Printf("  📊 Total Issues: %d\n", stats.TotalIssues)
-		if stats.TotalIssues > 0 {
-			fmt.Printf("  🔓 Open Issues: %d\n", stats.OpenIssues)
-			fmt.Printf("  ✔️  Closed Issues: %d\n", stats.ClosedIssues)
-		}
-		fmt.Println()
-
 		totalIssuesAcrossRepos += stats.TotalIssues
 		totalOpenIssues += stats.OpenIssues
 		totalClosedIssues += stats.ClosedIssues
@@ -222,3 +449,60 @@ func displayMultipleReposStats(owner, prefix string, allStats []*repo.IssueStats
 	}
 	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
 }
+
+// displayRepoLabelCounts prints the per-label issue counts of each repository followed by the counts
+// across all of them.
+func displayRepoLabelCounts(owner string, allStats []*ghbatch.IssueStats) {
+	sort.Slice(allStats, func(i, j int) bool { return allStats[i].RepoName < allStats[j].RepoName })
+
+	fmt.Println("\n🏷️  Issues by Label per Repository:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tLABEL\tOPEN\tCLOSED\tTOTAL")
+	for _, stats := range allStats {
+		for _, label := range sortedLabels(stats.Labels) {
+			counts := stats.Labels[label]
+			fmt.Fprintf(w, "%s/%s\t%s\t%d\t%d\t%d\n", owner, stats.RepoName, label, counts.Open, counts.Closed, counts.Total())
+		}
+	}
+	w.Flush()
+
+	displayLabelCounts("Issues by Label across all Repositories", ghbatch.AggregateLabelCounts(allStats))
+}
+
+// displayLabelCounts prints per-label issue counts as a table, the most used labels first.
+func displayLabelCounts(title string, labels map[string]ghbatch.LabelCounts) {
+	fmt.Printf("\n🏷️  %s:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	if len(labels) == 0 {
+		fmt.Println("No labeled issues")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LABEL\tOPEN\tCLOSED\tTOTAL")
+	for _, label := range sortedLabels(labels) {
+		counts := labels[label]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", label, counts.Open, counts.Closed, counts.Total())
+	}
+	w.Flush()
+}
+
+// sortedLabels returns the label names ordered by descending issue count, then by name.
+func sortedLabels(labels map[string]ghbatch.LabelCounts) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if labels[names[i]].Total() != labels[names[j]].Total() {
+			return labels[names[i]].Total() > labels[names[j]].Total()
+		}
+		return names[i] < names[j]
+	})
+
+	return names
+}
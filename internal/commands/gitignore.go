@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// gitignoreApplyOptions holds the flags of the gitignore apply command.
+type gitignoreApplyOptions struct {
+	templateFile string
+	merge        bool
+	pr           bool
+	branch       string
+	yes          bool
+	checkpoint   checkpointOptions
+}
+
+func newGitignoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gitignore",
+		Short: "Manage .gitignore files",
+		Long:  "Manage .gitignore files across repositories",
+	}
+
+	cmd.AddCommand(newGitignoreApplyCmd())
+
+	return cmd
+}
+
+func newGitignoreApplyCmd() *cobra.Command {
+	opts := &gitignoreApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Add a .gitignore matching the primary language of repositories",
+		Long:  "Commit the community .gitignore template matching the primary language of each repository, or the file given with --template-file, to repositories without a .gitignore. With --merge, repositories that already have one get the sections of the template they are missing appended",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runGitignoreApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.templateFile, "template-file", "", "Path to a .gitignore to use for every repository instead of the community template of its language")
+	cmd.Flags().BoolVar(&opts.merge, "merge", false, "Append missing template sections to existing .gitignore files instead of skipping them")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-gitignore", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+// fetchGitignoreTemplates fetches the community template of every primary language among repos, keyed by
+// repository name. Repositories without a language or a matching template are left out.
+func fetchGitignoreTemplates(ctx context.Context, githubService ghbatch.GitHubClient, repos []*github.Repository) (map[string]string, error) {
+	available, err := githubService.ListGitignoreTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := map[string]string{}
+	templates := map[string]string{}
+	for _, repository := range repos {
+		name := ghbatch.GitignoreTemplateName(repository.GetLanguage(), available)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := sources[name]; !ok {
+			if sources[name], err = githubService.GetGitignoreTemplate(ctx, name); err != nil {
+				return nil, err
+			}
+		}
+
+		templates[repository.GetName()] = sources[name]
+	}
+
+	return templates, nil
+}
+
+func runGitignoreApplyCommand(opts *gitignoreApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	var customTemplate string
+	if opts.templateFile != "" {
+		content, err := os.ReadFile(opts.templateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read .gitignore template: %w", err)
+		}
+		customTemplate = string(content)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	templates := map[string]string{}
+	if customTemplate == "" {
+		if templates, err = fetchGitignoreTemplates(ctx, githubService, repos); err != nil {
+			return err
+		}
+	}
+
+	rollout := ghbatch.FileRollout{
+		Path: ghbatch.GitignorePath,
+		Render: func(repoName string) (string, error) {
+			template, ok := customTemplate, customTemplate != ""
+			if !ok {
+				if template, ok = templates[repoName]; !ok {
+					log.Info("No .gitignore template for the primary language, skipping repository", "repo", repoName)
+					return "", ghbatch.ErrSkipFile
+				}
+			}
+
+			if !opts.merge {
+				return template, nil
+			}
+
+			existing, _, found, err := githubService.GetFileContent(ctx, owner, repoName, ghbatch.GitignorePath)
+			if err != nil || !found {
+				return template, err
+			}
+			return ghbatch.MergeGitignore(existing, template), nil
+		},
+		CommitMessage: "Add/Update .gitignore",
+		SkipIfExists:  !opts.merge,
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update .gitignore",
+			Body:   "This pull request adds the .gitignore patterns for the languages used in the repository.",
+		}
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(repos), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "gitignore apply", owner, repoNames(repos))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults(".gitignore Rollout", owner, target.repoPrefix, ghbatch.GitignorePath, results, isUser); failed > 0 {
+		return partialFailure("failed to apply .gitignore to %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// resolveTargetRepos returns the single named repository, or all repositories matching the prefix and the
+// discovery flags.
+func resolveTargetRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName, repoPrefix string,
+	isUser bool,
+) ([]*github.Repository, error) {
+	if repoName != "" {
+		repository, err := githubService.GetRepository(ctx, owner, repoName)
+		if err != nil {
+			return nil, err
+		}
+		return []*github.Repository{repository}, nil
+	}
+
+	query, err := buildDiscoveryQuery(repoPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return githubService.DiscoverRepositories(ctx, owner, isUser, query)
+}
+
+// repoNames extracts the sorted names of the given repositories.
+func repoNames(repos []*github.Repository) []string {
+	names := make([]string, 0, len(repos))
+	for _, repository := range repos {
+		names = append(names, repository.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// confirmAction prints the prompt and reads a yes/no answer from stdin. Anything but "y" or "yes" is a no.
+func confirmAction(prompt string) (bool, error) {
+	answer, err := promptInput(prompt + " [y/N]: ")
+	if err != nil {
+		return false, err
+	}
+
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes", nil
+}
+
+// stdin buffers the standard input shared by every prompt of a run, so that answers piped for the prompts
+// of later owners are not lost in the buffer of the first one.
+var stdin = bufio.NewReader(os.Stdin)
+
+// promptInput prints the prompt and reads a single trimmed line from stdin.
+func promptInput(prompt string) (string, error) {
+	fmt.Print(prompt)
+
+	answer, err := stdin.ReadString('\n')
+	if err != nil && answer == "" {
+		return "", fmt.Errorf("failed to read confirmation: %w", err)
+	}
+
+	return strings.TrimSpace(answer), nil
+}
+
+// displayRepoList prints the repositories a mutating command is about to operate on.
+func displayRepoList(title, owner string, names []string) {
+	fmt.Printf("\n📋 %s (%d repositories):\n", title, len(names))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, name := range names {
+		fmt.Printf("  • %s/%s\n", owner, name)
+	}
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
+
+// displayBatchResults prints the successful and failed repositories of a batch operation with a summary.
+func displayBatchResults(action, owner, prefix string, successRepos, failedRepos []string, isUser bool) {
+	// Sort the repositories for consistent output
+	sort.Strings(successRepos)
+	sort.Strings(failedRepos)
+
+	fmt.Printf("\n📋 %s Results:\n", action)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	if len(successRepos) > 0 {
+		fmt.Printf("✅ SUCCESSFUL (%d repositories):\n", len(successRepos))
+		for _, repoName := range successRepos {
+			fmt.Printf("  ✅ %s/%s\n", owner, repoName)
+		}
+		fmt.Println()
+	}
+
+	if len(failedRepos) > 0 {
+		fmt.Printf("❌ FAILED (%d repositories):\n", len(failedRepos))
+		for _, repoName := range failedRepos {
+			fmt.Printf("  ❌ %s/%s\n", owner, repoName)
+		}
+		fmt.Println()
+	}
+
+	displaySummaryHeader(owner, prefix, isUser)
+	total := len(successRepos) + len(failedRepos)
+	fmt.Printf("📁 Total Repositories: %d\n", total)
+	fmt.Printf("✅ Successful: %d\n", len(successRepos))
+	fmt.Printf("❌ Failed: %d\n", len(failedRepos))
+
+	if total > 0 {
+		successPercentage := float64(len(successRepos)) / float64(total) * 100
+		fmt.Printf("📈 Success Rate: %.1f%%\n", successPercentage)
+	}
+
+	if len(failedRepos) == 0 && total > 0 {
+		fmt.Printf("🎉 All repositories processed successfully!\n")
+	}
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+}
+
+// displaySummaryHeader prints the opening lines of a summary block for the given owner and prefix.
+func displaySummaryHeader(owner, prefix string, isUser bool) {
+	ownerType := "organization"
+	if isUser {
+		ownerType = "user"
+	}
+
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+	if prefix == "" {
+		fmt.Printf("📊 SUMMARY for all repositories for %s '%s':\n", ownerType, owner)
+	} else {
+		fmt.Printf("📊 SUMMARY for repositories with prefix '%s' for %s '%s':\n", prefix, ownerType, owner)
+	}
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
+
+// displayRunSummary notes in a summary block when requests were throttled by secondary rate limits and
+// where the changes of the run were recorded.
+func displayRunSummary() {
+	if events := ghbatch.ThrottleEvents(); events > 0 {
+		fmt.Printf("⏳ Throttled by secondary rate limits: %d times (writes were serialized)\n", events)
+	}
+
+	displayAuditSummary()
+	displayTimeoutSummary()
+}
+
+// displayFileRolloutResults prints the per-repository outcome of a file rollout with a summary
+// and returns the number of failed repositories.
+func displayFileRolloutResults(title, owner, prefix, filePath string, results []ghbatch.FileRolloutResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.FileStatusCreated:   "✅",
+		ghbatch.FileStatusUpdated:   "🔄",
+		ghbatch.FileStatusUnchanged: "➖",
+		ghbatch.FileStatusSkipped:   "⏭️ ",
+		ghbatch.FileStatusFailed:    "❌",
+	}
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		counts[result.Status]++
+
+		line := fmt.Sprintf("  %s %s/%s (%s)", icons[result.Status], owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.PullRequestURL != "" {
+			line += " → " + result.PullRequestURL
+		}
+		if result.Err != nil {
+			line += ": " + result.Err.Error()
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Created: %d\n", counts[ghbatch.FileStatusCreated])
+	fmt.Printf("🔄 Updated: %d\n", counts[ghbatch.FileStatusUpdated])
+	fmt.Printf("➖ Unchanged: %d\n", counts[ghbatch.FileStatusUnchanged])
+	if counts[ghbatch.FileStatusSkipped] > 0 {
+		fmt.Printf("⏭️  Skipped (already present): %d\n", counts[ghbatch.FileStatusSkipped])
+	}
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.FileStatusFailed])
+	fmt.Printf("📍 File: %s\n", filePath)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.FileStatusFailed]
+}
+
+// displayIssueResults prints the per-issue outcome of an issue operation grouped by repository with a summary
+// and returns the number of failed issues and repositories.
+func displayIssueResults(title, owner, prefix string, results []ghbatch.RepoIssueResults, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var total, failed int
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("❌ %s/%s: %v\n", owner, result.RepoName, result.Err)
+			continue
+		}
+		if len(result.Issues) == 0 {
+			continue
+		}
+
+		fmt.Printf("📁 %s/%s (%d issues):\n", owner, result.RepoName, len(result.Issues))
+		for _, issue := range result.Issues {
+			total++
+			if issue.Err != nil {
+				failed++
+				fmt.Printf("  ❌ #%d %s: %v\n", issue.Number, issue.Title, issue.Err)
+				continue
+			}
+			line := fmt.Sprintf("  ✅ #%d %s", issue.Number, issue.Title)
+			if issue.URL != "" {
+				line += " → " + issue.URL
+			}
+			fmt.Println(line)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("📝 Total Issues: %d\n", total)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// displayPullRequestResults prints the per-pull-request outcome of a pull request operation grouped by
+// repository with a summary and returns the number of failed pull requests and repositories.
+func displayPullRequestResults(title, owner, prefix string, results []ghbatch.RepoPullRequestResults, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.PullRequestStatusMatched:  "🔍",
+		ghbatch.PullRequestStatusMerged:   "✅",
+		ghbatch.PullRequestStatusApproved: "👍",
+		ghbatch.PullRequestStatusClosed:   "🚫",
+		ghbatch.PullRequestStatusSkipped:  "⏭️ ",
+		ghbatch.PullRequestStatusFailed:   "❌",
+	}
+	var total, failedRepos int
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failedRepos++
+			fmt.Printf("❌ %s/%s: %v\n", owner, result.RepoName, result.Err)
+			continue
+		}
+		if len(result.PullRequests) == 0 {
+			continue
+		}
+
+		fmt.Printf("📁 %s/%s (%d pull requests):\n", owner, result.RepoName, len(result.PullRequests))
+		for _, pr := range result.PullRequests {
+			total++
+			counts[pr.Status]++
+
+			icon, ok := icons[pr.Status]
+			if !ok {
+				icon = "✅"
+			}
+
+			line := fmt.Sprintf("  %s #%d %s (%s", icon, pr.Number, pr.Title, strings.ToUpper(pr.Status))
+			if pr.Reason != "" {
+				line += ": " + pr.Reason
+			}
+			line += ")"
+			if pr.Err != nil {
+				line += ": " + pr.Err.Error()
+			}
+			fmt.Println(line)
+		}
+	}
+	fmt.Println()
+
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🔀 Total Pull Requests: %d\n", total)
+	for _, status := range statuses {
+		fmt.Printf("   %s: %d\n", strings.ToUpper(status[:1])+status[1:], counts[status])
+	}
+	if failedRepos > 0 {
+		fmt.Printf("❌ Failed Repositories: %d\n", failedRepos)
+	}
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.PullRequestStatusFailed] + failedRepos
+}
+
+// complianceRow is a repository's line in a compliance matrix: one pass/fail value per rule, or an error
+// when the repository could not be audited.
+type complianceRow struct {
+	repoName string
+	passed   []bool
+	err      error
+}
+
+// displayComplianceMatrix prints a pass/fail matrix of repositories against rules with a summary and returns
+// the number of compliant repositories.
+func displayComplianceMatrix(title, owner, prefix string, rules []string, rows []complianceRow, isUser bool) int {
+	sort.Slice(rows, func(i, j int) bool { return rows[i].repoName < rows[j].repoName })
+
+	var compliant, failed, passedTotal int
+	violations := make([]int, len(rules))
+
+	fmt.Printf("\n📋 %s:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "REPOSITORY\t%s\tRESULT\n", strings.ToUpper(strings.Join(rules, "\t")))
+	for _, row := range rows {
+		if row.err != nil {
+			failed++
+			fmt.Fprintf(w, "%s\t%s\tERROR: %v\n", row.repoName, strings.Repeat("-\t", len(rules)-1)+"-", row.err)
+			continue
+		}
+
+		cells := make([]string, len(rules))
+		score := 0
+		for i, passed := range row.passed {
+			cells[i] = "PASS"
+			if !passed {
+				cells[i] = "FAIL"
+				violations[i]++
+				continue
+			}
+			score++
+		}
+		passedTotal += score
+
+		result := fmt.Sprintf("❌ NON-COMPLIANT (%d/%d)", score, len(rules))
+		if score == len(rules) {
+			result = "✅ COMPLIANT"
+			compliant++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", row.repoName, strings.Join(cells, "\t"), result)
+	}
+	w.Flush()
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(rows))
+	fmt.Printf("✅ Compliant: %d\n", compliant)
+	fmt.Printf("❌ Non-compliant: %d\n", len(rows)-compliant-failed)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be audited: %d\n", failed)
+	}
+	if audited := len(rows) - failed; audited > 0 {
+		fmt.Printf("📈 Average Score: %.1f%%\n", float64(passedTotal)/float64(audited*len(rules))*100)
+	}
+	for i, rule := range rules {
+		fmt.Printf("   %s violations: %d\n", rule, violations[i])
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return compliant
+}
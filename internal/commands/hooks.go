@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/hooks"
+)
+
+var (
+	// preHook and postHook are set from the persistent --pre-hook and --post-hook flags.
+	preHook  string
+	postHook string
+)
+
+// addHookFlags registers the per-repository hook flags.
+func addHookFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&preHook, "pre-hook", "", "Shell command run before each repository is processed; the repository fails when it fails")
+	flags.StringVar(&postHook, "post-hook", "", "Shell command run after each repository is processed, with the result in GRM_RESULT")
+}
+
+// validateHooks rejects hooks on servers, which process repositories outside of batch runs.
+func validateHooks() error {
+	if runIsServer && (preHook != "" || postHook != "") {
+		return fmt.Errorf("--pre-hook and --post-hook cannot be used with servers")
+	}
+
+	return nil
+}
+
+// withHooks returns a context running the hooks around the repositories of the selected owner.
+func withHooks(ctx context.Context) context.Context {
+	owner, _ := ResolveOwner()
+
+	return hooks.WithHooks(ctx, hooks.Hooks{Pre: preHook, Post: postHook, Owner: owner})
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/spec"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// importIssuesOptions holds the flags of the import issues command.
+type importIssuesOptions struct {
+	file       string
+	interval   time.Duration
+	dryRun     bool
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import data into repositories",
+		Long:  "Import data from other systems into the repositories of an organization or user account",
+	}
+
+	cmd.AddCommand(newImportIssuesCmd())
+
+	return cmd
+}
+
+func newImportIssuesCmd() *cobra.Command {
+	opts := &importIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "issues",
+		Short: "Create issues from a CSV file",
+		Long:  "Create the issues listed in a CSV file with the columns repo, title, body, labels and assignees in the repositories of an organization or user account. Labels and assignees are separated by ';'. Issues are created one after another in file order, paced to avoid secondary rate limits, and the URL of every created issue is reported",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", "Path to the CSV file listing the issues to create (required)")
+	cmd.Flags().DurationVar(&opts.interval, "interval", time.Second, "Minimum time between two created issues to avoid secondary rate limits (0 to disable)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Validate the file and list the issues without creating them")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runImportIssuesCommand(opts *importIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if target.repoName != "" || target.repoPrefix != "" {
+		return fmt.Errorf("import issues takes the repositories from --file, not --repo or --repo-prefix")
+	}
+
+	if opts.interval < 0 {
+		return fmt.Errorf("--interval must not be negative")
+	}
+
+	owner, isUser := ResolveOwner()
+
+	imports, err := spec.LoadIssueImports(opts.file)
+	if err != nil {
+		return err
+	}
+
+	if len(imports) == 0 {
+		log.Info("Import file does not list any issues", "file", opts.file)
+		return nil
+	}
+
+	displayIssueImports(owner, imports)
+
+	if opts.dryRun {
+		return nil
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Create %d issues?", len(imports)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	// Rows are checkpointed by line so a resumed run does not create the same issue twice
+	keys := make([]string, len(imports))
+	for i, issue := range imports {
+		keys[i] = importKey(issue)
+	}
+
+	run, pendingKeys, err := startRun(opts.checkpoint, "import issues", owner, keys)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(pendingKeys))
+	for _, key := range pendingKeys {
+		pending[key] = true
+	}
+
+	var remaining []ghbatch.IssueImport
+	for _, issue := range imports {
+		if pending[importKey(issue)] {
+			remaining = append(remaining, issue)
+		}
+	}
+
+	results := githubService.ImportIssues(ctx, owner, remaining, opts.interval, func(result ghbatch.IssueImportResult) {
+		recordOutcome(run, importKey(result.IssueImport), result.Err)
+	})
+	failed := displayIssueImportResults(owner, results, isUser)
+
+	if failed > 0 {
+		return partialFailure("failed to create %d issues", failed)
+	}
+	return nil
+}
+
+// importKey is the checkpoint key of an issue read from the import file.
+func importKey(issue ghbatch.IssueImport) string {
+	return fmt.Sprintf("line %d", issue.Line)
+}
+
+// displayIssueImports lists the issues read from the import file before they are created.
+func displayIssueImports(owner string, imports []ghbatch.IssueImport) {
+	fmt.Printf("\n📋 Issues to import (%d issues):\n", len(imports))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, issue := range imports {
+		line := fmt.Sprintf("  • line %d %s/%s: %s", issue.Line, owner, issue.RepoName, issue.Issue.Title)
+		if len(issue.Issue.Labels) > 0 {
+			line += fmt.Sprintf(" [%s]", strings.Join(issue.Issue.Labels, ", "))
+		}
+		fmt.Println(line)
+	}
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
+
+// displayIssueImportResults prints the created issues with their URLs and the rows that failed, in file
+// order, with a summary and returns the number of failed rows.
+func displayIssueImportResults(owner string, results []ghbatch.IssueImportResult, isUser bool) int {
+	var failed int
+
+	fmt.Printf("\n📋 Issue Import Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("❌ line %d %s/%s %q: %v\n", result.Line, owner, result.RepoName, result.Issue.Title, result.Err)
+			continue
+		}
+		fmt.Printf("✅ line %d %s/%s#%d %s → %s\n", result.Line, owner, result.RepoName, result.Number, result.Issue.Title, result.URL)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, "", isUser)
+	fmt.Printf("📝 Total Issues: %d\n", len(results))
+	fmt.Printf("✅ Created: %d\n", len(results)-failed)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
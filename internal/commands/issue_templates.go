@@ -0,0 +1,195 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// issueTemplatesApplyOptions holds the flags of the issue-templates apply command.
+type issueTemplatesApplyOptions struct {
+	dir        string
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newIssueTemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue-templates",
+		Short: "Manage issue templates",
+		Long:  "Manage the issue forms and templates of repositories",
+	}
+
+	cmd.AddCommand(newIssueTemplatesApplyCmd())
+
+	return cmd
+}
+
+func newIssueTemplatesApplyCmd() *cobra.Command {
+	opts := &issueTemplatesApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Push issue forms and templates to repositories",
+		Long:  "Push a directory of issue forms (*.yml), Markdown templates (*.md) and the template chooser config.yml to .github/ISSUE_TEMPLATE/ in repositories, with a single commit per repository or via pull request. Issue forms are validated before anything is pushed. Files are templates: [[.Owner]] and [[.Repo]] are replaced per repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIssueTemplatesApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.dir, "dir", "", "Directory of issue forms, templates and config.yml to push (required)")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-issue-templates", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("dir")
+
+	return cmd
+}
+
+// loadIssueTemplates reads and validates the issue forms, templates and config.yml of dir.
+func loadIssueTemplates(dir string) ([]localFile, error) {
+	var paths []string
+	for _, pattern := range []string{"*.yml", "*.yaml", "*.md"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issue template directory %s: %w", dir, err)
+		}
+		paths = append(paths, matches...)
+	}
+	sort.Strings(paths)
+
+	templates := make([]localFile, 0, len(paths))
+	for _, filePath := range paths {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read issue template: %w", err)
+		}
+
+		name := filepath.Base(filePath)
+		if err := ghbatch.ValidateIssueTemplate(name, string(content)); err != nil {
+			return nil, err
+		}
+		templates = append(templates, localFile{name: name, content: string(content)})
+	}
+
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no issue templates (*.yml, *.yaml or *.md) found in %s", dir)
+	}
+
+	return templates, nil
+}
+
+// fileSetRollouts returns one FileRollout per file of a set, used to preview the set file by file.
+func fileSetRollouts(paths []string, render func(repoName string) (map[string]string, error)) []ghbatch.FileRollout {
+	rollouts := make([]ghbatch.FileRollout, 0, len(paths))
+	for _, filePath := range paths {
+		rollouts = append(rollouts, ghbatch.FileRollout{
+			Path: filePath,
+			Render: func(repoName string) (string, error) {
+				files, err := render(repoName)
+				return files[filePath], err
+			},
+		})
+	}
+
+	return rollouts
+}
+
+func runIssueTemplatesApplyCommand(opts *issueTemplatesApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	templates, err := loadIssueTemplates(opts.dir)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	paths := make([]string, 0, len(templates))
+	for _, template := range templates {
+		paths = append(paths, path.Join(ghbatch.IssueTemplateDir, template.name))
+	}
+
+	rollout := ghbatch.FileSetRollout{
+		Render: func(repoName string) (map[string]string, error) {
+			files := make(map[string]string, len(templates))
+			for i, template := range templates {
+				content, err := render.File(template.name, template.content, render.RepoData{Owner: owner, Repo: repoName})
+				if err != nil {
+					return nil, err
+				}
+				files[paths[i]] = content
+			}
+			return files, nil
+		},
+		CommitMessage: "Add/Update issue templates",
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update issue templates",
+			Body:   fmt.Sprintf("This pull request adds or updates the issue templates in %s.", ghbatch.IssueTemplateDir),
+		}
+	}
+
+	names := repoNames(repos)
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, names, opts.yes,
+		fileSetRollouts(paths, rollout.Render)...)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "issue-templates apply", owner, names)
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileSetToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("Issue Templates Rollout", owner, target.repoPrefix, ghbatch.IssueTemplateDir, results, isUser); failed > 0 {
+		return partialFailure("failed to apply issue templates to %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/tui"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// maxBreakdownIssues is the number of oldest open issues listed in the issue breakdown of a repository.
+const maxBreakdownIssues = 20
+
+// browseIssueStats opens the interactive browser over the issue statistics of multiple repositories.
+func browseIssueStats(ctx context.Context, githubService ghbatch.GitHubClient, owner string, allStats []*ghbatch.IssueStats,
+	filter ghbatch.IssueStatsFilter,
+) error {
+	report := tui.Report{
+		Title:   fmt.Sprintf("Issues of %d repositories of %s", len(allStats), owner),
+		Columns: []string{"Repository", "Total", "Open", "Closed", "Open %"},
+		Detail: func(ctx context.Context, row tui.Row) (string, error) {
+			issues, err := githubService.ListIssues(ctx, owner, row.Name, ghbatch.IssueQuery{State: "open", Labels: filter.Labels})
+			if err != nil {
+				return "", err
+			}
+			return formatIssueBreakdown(issues), nil
+		},
+		Actions: []tui.Action{{
+			Key:         "o",
+			Description: "Open issues in browser",
+			Run: func(ctx context.Context, row tui.Row) (string, error) {
+				url := fmt.Sprintf("https://github.com/%s/%s/issues", owner, row.Name)
+				if err := openBrowser(url); err != nil {
+					return "", err
+				}
+				return "Opened " + url, nil
+			},
+		}},
+	}
+
+	for _, stats := range allStats {
+		openPercentage := 0.0
+		if stats.TotalIssues > 0 {
+			openPercentage = float64(stats.OpenIssues) / float64(stats.TotalIssues) * 100
+		}
+
+		report.Rows = append(report.Rows, tui.Row{
+			Name: stats.RepoName,
+			Cells: []string{
+				stats.RepoName,
+				strconv.Itoa(stats.TotalIssues),
+				strconv.Itoa(stats.OpenIssues),
+				strconv.Itoa(stats.ClosedIssues),
+				fmt.Sprintf("%.1f%%", openPercentage),
+			},
+		})
+	}
+
+	return tui.Run(ctx, report)
+}
+
+// formatIssueBreakdown summarizes open issues by label and assignee and lists the oldest of them.
+func formatIssueBreakdown(issues []*github.Issue) string {
+	byLabel := map[string]int{}
+	byAssignee := map[string]int{}
+
+	for _, issue := range issues {
+		if len(issue.Labels) == 0 {
+			byLabel["(no label)"]++
+		}
+		for _, label := range issue.Labels {
+			byLabel[label.GetName()]++
+		}
+
+		if len(issue.Assignees) == 0 {
+			byAssignee["(unassigned)"]++
+		}
+		for _, assignee := range issue.Assignees {
+			byAssignee[assignee.GetLogin()]++
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Open issues: %d\n", len(issues))
+	writeCounts(&b, "By label", byLabel)
+	writeCounts(&b, "By assignee", byAssignee)
+
+	oldest := append([]*github.Issue(nil), issues...)
+	sort.Slice(oldest, func(i, j int) bool { return oldest[i].GetCreatedAt().Before(oldest[j].GetCreatedAt().Time) })
+
+	if len(oldest) > 0 {
+		fmt.Fprintf(&b, "\nOldest open issues:\n")
+	}
+	for _, issue := range oldest[:min(len(oldest), maxBreakdownIssues)] {
+		fmt.Fprintf(&b, "  #%-6d %s  %s\n", issue.GetNumber(), issue.GetCreatedAt().Format("2006-01-02"), issue.GetTitle())
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// writeCounts writes a titled list of counts, highest first.
+func writeCounts(b *strings.Builder, title string, counts map[string]int) {
+	if len(counts) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	fmt.Fprintf(b, "\n%s:\n", title)
+	for _, key := range keys {
+		fmt.Fprintf(b, "  %-30s %d\n", key, counts[key])
+	}
+}
+
+// openBrowser opens the URL in the default web browser.
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+
+	return cmd.Process.Release()
+}
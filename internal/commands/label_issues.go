@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// labelIssuesOptions holds the flags of the label-issues command.
+type labelIssuesOptions struct {
+	state      string
+	titleMatch string
+	withLabels []string
+	add        []string
+	remove     []string
+	dryRun     bool
+}
+
+func newLabelIssuesCmd() *cobra.Command {
+	opts := &labelIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "label-issues",
+		Short: "Add or remove labels on matching issues",
+		Long:  "Apply or remove labels on the issues matching a query (state, title pattern, existing labels) in a specified repository, repositories with a given prefix, or all repositories in an organization or user account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLabelIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.state, "state", "open", "Issue state to match: open, closed or all")
+	cmd.Flags().StringVar(&opts.titleMatch, "title-match", "", "Regular expression the issue title must match, e.g. '(?i)security'")
+	cmd.Flags().StringSliceVar(&opts.withLabels, "with-labels", nil, "Comma-separated labels the issues must already carry")
+	cmd.Flags().StringSliceVar(&opts.add, "add", nil, "Comma-separated labels to apply")
+	cmd.Flags().StringSliceVar(&opts.remove, "remove", nil, "Comma-separated labels to remove")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the matching issues without changing them")
+
+	return cmd
+}
+
+func runLabelIssuesCommand(opts *labelIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildIssueQuery(opts.state, opts.titleMatch, opts.withLabels)
+	if err != nil {
+		return err
+	}
+
+	if len(opts.add) == 0 && len(opts.remove) == 0 {
+		return fmt.Errorf("at least one of --add or --remove is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.LabelIssues(ctx, owner, repoNames(repos), ghbatch.IssueLabelOptions{
+		Query:  query,
+		Add:    opts.add,
+		Remove: opts.remove,
+		DryRun: opts.dryRun,
+	})
+
+	title := "Label Issues"
+	if opts.dryRun {
+		title = "Matching Issues (dry run)"
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
+
+// buildIssueQuery validates the issue filter flags shared by the issue commands and builds the query.
+func buildIssueQuery(state, titleMatch string, labels []string) (ghbatch.IssueQuery, error) {
+	query := ghbatch.IssueQuery{State: state, Labels: labels}
+
+	switch state {
+	case "open", "closed", "all":
+	default:
+		return query, fmt.Errorf("invalid --state %q: must be open, closed or all", state)
+	}
+
+	if titleMatch != "" {
+		pattern, err := regexp.Compile(titleMatch)
+		if err != nil {
+			return query, fmt.Errorf("invalid --title-match pattern: %w", err)
+		}
+		query.TitlePattern = pattern
+	}
+
+	return query, nil
+}
+
+// setIssueKind narrows the query to issues, pull requests or both according to the --type flag.
+func setIssueKind(query *ghbatch.IssueQuery, kind string) error {
+	switch kind {
+	case "issues":
+	case "prs":
+		query.OnlyPullRequests = true
+	case "all":
+		query.IncludePullRequests = true
+	default:
+		return fmt.Errorf("invalid --type %q: must be issues, prs or all", kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// licenseAddOptions holds the flags of the license add command.
+type licenseAddOptions struct {
+	spdx       string
+	holder     string
+	year       int
+	overwrite  bool
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newLicenseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "license",
+		Short: "Manage and audit repository licenses",
+		Long:  "Manage and audit LICENSE files across repositories",
+	}
+
+	cmd.AddCommand(newLicenseAddCmd())
+	cmd.AddCommand(newLicenseAuditCmd())
+
+	return cmd
+}
+
+func newLicenseAddCmd() *cobra.Command {
+	opts := &licenseAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a LICENSE file to repositories",
+		Long:  "Render the official text of an SPDX license with the year and copyright holder and commit it as LICENSE (or open pull requests) to repositories that do not have a license file yet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLicenseAddCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.spdx, "spdx", "", "SPDX identifier of the license, e.g. Apache-2.0 or MIT (required)")
+	cmd.Flags().StringVar(&opts.holder, "holder", "", "Copyright holder, e.g. \"Acme Inc\" (required)")
+	cmd.Flags().IntVar(&opts.year, "year", time.Now().Year(), "Copyright year")
+	cmd.Flags().BoolVar(&opts.overwrite, "overwrite", false, "Also replace existing license files")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "add-license", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("spdx")
+	cmd.MarkFlagRequired("holder")
+
+	return cmd
+}
+
+func runLicenseAddCommand(opts *licenseAddOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.spdx == "" || opts.holder == "" {
+		return fmt.Errorf("both --spdx and --holder are required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	template, err := githubService.GetLicenseTemplate(ctx, opts.spdx)
+	if err != nil {
+		return err
+	}
+	licenseText := ghbatch.RenderLicense(template, opts.year, opts.holder)
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	commitMessage := fmt.Sprintf("Add %s LICENSE", opts.spdx)
+	rollout := ghbatch.FileRollout{
+		Path:           "LICENSE",
+		Render:         func(string) (string, error) { return licenseText, nil },
+		CommitMessage:  commitMessage,
+		SkipIfExists:   !opts.overwrite,
+		AlternatePaths: ghbatch.LicenseFilePaths[1:],
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  commitMessage,
+			Body:   fmt.Sprintf("This pull request adds the %s license with copyright %d %s.", opts.spdx, opts.year, opts.holder),
+		}
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(repos), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "license add", owner, repoNames(repos))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("LICENSE Rollout", owner, target.repoPrefix, "LICENSE", results, isUser); failed > 0 {
+		return partialFailure("failed to add LICENSE to %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// licenseAuditOptions holds the flags of the license audit command.
+type licenseAuditOptions struct {
+	allowed []string
+}
+
+func newLicenseAuditCmd() *cobra.Command {
+	opts := &licenseAuditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Report license compliance of repositories",
+		Long:  "Detect the license of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and report repositories without a license, with a license outside the allow-list, or whose license file GitHub cannot match to its declared SPDX identifier",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLicenseAuditCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.allowed, "allow", nil, "Comma-separated SPDX identifiers of approved licenses (default: any recognized license)")
+
+	return cmd
+}
+
+func runLicenseAuditCommand(opts *licenseAuditOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditLicensesForRepos(ctx, owner, repoNames(repos))
+	if failed := displayLicenseAudit(owner, target.repoPrefix, audits, opts.allowed, isUser); failed > 0 {
+		return partialFailure("failed to audit %d repositories", failed)
+	}
+	return nil
+}
+
+// displayLicenseAudit prints the license status per repository with a summary and returns the number of
+// repositories that could not be audited.
+func displayLicenseAudit(owner, prefix string, audits []ghbatch.LicenseAudit, allowed []string, isUser bool) int {
+	sort.Slice(audits, func(i, j int) bool { return audits[i].RepoName < audits[j].RepoName })
+
+	counts := make(map[string]int)
+	licenses := make(map[string]int)
+
+	fmt.Println("\n📋 License Audit Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, audit := range audits {
+		status := audit.Status(allowed)
+		counts[status]++
+
+		switch status {
+		case ghbatch.LicenseStatusFailed:
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, audit.RepoName, audit.Err)
+		case ghbatch.LicenseStatusMissing:
+			fmt.Printf("❌ %s/%s (NO LICENSE)\n", owner, audit.RepoName)
+		case ghbatch.LicenseStatusMismatch:
+			if audit.DeclaredSPDXID != "" {
+				fmt.Printf("❌ %s/%s (MISMATCH: %s declares %s, detected %s)\n", owner, audit.RepoName, audit.Path, audit.DeclaredSPDXID, audit.SPDXID)
+			} else {
+				fmt.Printf("❌ %s/%s (UNRECOGNIZED license in %s)\n", owner, audit.RepoName, audit.Path)
+			}
+		case ghbatch.LicenseStatusNotApproved:
+			licenses[audit.SPDXID]++
+			fmt.Printf("❌ %s/%s (NOT APPROVED: %s)\n", owner, audit.RepoName, audit.SPDXID)
+		default:
+			licenses[audit.SPDXID]++
+			fmt.Printf("✅ %s/%s (%s)\n", owner, audit.RepoName, audit.SPDXID)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(audits))
+	fmt.Printf("✅ Approved: %d\n", counts[ghbatch.LicenseStatusApproved])
+	if len(allowed) > 0 {
+		fmt.Printf("❌ Not approved: %d\n", counts[ghbatch.LicenseStatusNotApproved])
+	}
+	fmt.Printf("❌ No license: %d\n", counts[ghbatch.LicenseStatusMissing])
+	fmt.Printf("❌ Unrecognized or mismatched: %d\n", counts[ghbatch.LicenseStatusMismatch])
+	if counts[ghbatch.LicenseStatusFailed] > 0 {
+		fmt.Printf("⚠️  Could not be audited: %d\n", counts[ghbatch.LicenseStatusFailed])
+	}
+
+	if len(licenses) > 0 {
+		ids := make([]string, 0, len(licenses))
+		for id := range licenses {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			if licenses[ids[i]] != licenses[ids[j]] {
+				return licenses[ids[i]] > licenses[ids[j]]
+			}
+			return ids[i] < ids[j]
+		})
+
+		fmt.Println(strings.Repeat("-", longSeparatorLength))
+		for _, id := range ids {
+			fmt.Printf("📜 %s: %d\n", id, licenses[id])
+		}
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.LicenseStatusFailed]
+}
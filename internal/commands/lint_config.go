@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Lint configuration files pushed by lint-config apply.
+const (
+	golangciConfigPath  = ".golangci.yml"
+	preCommitConfigPath = ".pre-commit-config.yaml"
+)
+
+// lintConfigApplyOptions holds the flags of the lint-config apply command.
+type lintConfigApplyOptions struct {
+	golangciFile  string
+	preCommitFile string
+	pr            bool
+	branch        string
+	yes           bool
+	checkpoint    checkpointOptions
+}
+
+func newLintConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint-config",
+		Short: "Manage linter configuration of Go repositories",
+		Long:  "Manage the golangci-lint and pre-commit configuration of Go repositories",
+	}
+
+	cmd.AddCommand(newLintConfigApplyCmd())
+
+	return cmd
+}
+
+func newLintConfigApplyCmd() *cobra.Command {
+	opts := &lintConfigApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Push .golangci.yml and .pre-commit-config.yaml to Go repositories",
+		Long:  "Push the standard .golangci.yml and/or .pre-commit-config.yaml to every repository with a go.mod at its root, in a single commit per repository or via pull request. Repositories without a go.mod are skipped. Files are templates: [[.Owner]], [[.Repo]] and [[.Module]], the module path of the repository, are replaced per repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLintConfigApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.golangciFile, "golangci-file", "", "Path to the .golangci.yml to push")
+	cmd.Flags().StringVar(&opts.preCommitFile, "pre-commit-file", "", "Path to the .pre-commit-config.yaml to push")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-lint-config", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+// loadLintConfigs reads the configuration files given by the flags, keyed by their path in repositories.
+// Each file is rendered once so that template errors fail the command before any repository is touched.
+func loadLintConfigs(opts *lintConfigApplyOptions) (map[string]string, error) {
+	configs := map[string]string{}
+	for repoPath, localPath := range map[string]string{golangciConfigPath: opts.golangciFile, preCommitConfigPath: opts.preCommitFile} {
+		if localPath == "" {
+			continue
+		}
+
+		content, err := os.ReadFile(localPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read lint configuration: %w", err)
+		}
+		if _, err := render.File(repoPath, string(content), render.ModuleData{}); err != nil {
+			return nil, err
+		}
+		configs[repoPath] = string(content)
+	}
+
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("at least one of --golangci-file or --pre-commit-file is required")
+	}
+
+	return configs, nil
+}
+
+func runLintConfigApplyCommand(opts *lintConfigApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	configs, err := loadLintConfigs(opts)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	paths := make([]string, 0, len(configs))
+	for repoPath := range configs {
+		paths = append(paths, repoPath)
+	}
+	sort.Strings(paths)
+
+	rollout := ghbatch.FileSetRollout{
+		Render: func(repoName string) (map[string]string, error) {
+			module, err := githubService.GetGoModule(ctx, owner, repoName)
+			if err != nil {
+				return nil, err
+			}
+			if module == nil {
+				log.Info("No go.mod found, skipping repository", "repo", repoName)
+				return nil, ghbatch.ErrSkipFile
+			}
+
+			data := render.ModuleData{RepoData: render.RepoData{Owner: owner, Repo: repoName}, Module: module.Path}
+			files := make(map[string]string, len(configs))
+			for repoPath, content := range configs {
+				if files[repoPath], err = render.File(repoPath, content, data); err != nil {
+					return nil, err
+				}
+			}
+			return files, nil
+		},
+		CommitMessage: "Add/Update lint configuration",
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update lint configuration",
+			Body:   "This pull request adds or updates the standard linter configuration.",
+		}
+	}
+
+	names := repoNames(repos)
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, names, opts.yes,
+		fileSetRollouts(paths, rollout.Render)...)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "lint-config apply", owner, names)
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileSetToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("Lint Configuration Rollout", owner, target.repoPrefix, strings.Join(paths, ", "), results, isUser); failed > 0 {
+		return partialFailure("failed to apply lint configuration to %d repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// lockIssuesOptions holds the flags of the lock-issues command.
+type lockIssuesOptions struct {
+	days         int
+	reason       string
+	exemptLabels []string
+	dryRun       bool
+}
+
+func newLockIssuesCmd() *cobra.Command {
+	opts := &lockIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "lock-issues",
+		Short: "Lock the conversations of old closed issues",
+		Long:  "Lock the conversations of issues closed more than a number of days ago in a specified repository, repositories with a given prefix, or all repositories in an organization or user account, so that they no longer collect comments. Issues that are already locked are skipped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLockIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.days, "days", 30, "Number of days since closing after which an issue is locked")
+	cmd.Flags().StringVar(&opts.reason, "reason", "resolved", "Lock reason: off-topic, too heated, resolved or spam (empty for none)")
+	cmd.Flags().StringSliceVar(&opts.exemptLabels, "exempt-labels", nil, "Comma-separated labels that protect issues from being locked")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the issues to lock without locking them")
+
+	return cmd
+}
+
+func runLockIssuesCommand(opts *lockIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.days <= 0 {
+		return fmt.Errorf("--days must be greater than zero")
+	}
+
+	if opts.reason != "" && !slices.Contains(ghbatch.IssueLockReasons, opts.reason) {
+		return fmt.Errorf("invalid --reason %q: must be one of %s", opts.reason, strings.Join(ghbatch.IssueLockReasons, ", "))
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.LockIssues(ctx, owner, repoNames(repos), ghbatch.IssueLockOptions{
+		ClosedBefore: time.Now().AddDate(0, 0, -opts.days),
+		Reason:       opts.reason,
+		ExemptLabels: opts.exemptLabels,
+		DryRun:       opts.dryRun,
+	})
+
+	title := "Lock Issues"
+	if opts.dryRun {
+		title = "Issues to Lock (dry run)"
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/logger"
+)
+
+// logOptions holds the global flags controlling log output.
+type logOptions struct {
+	level   string
+	format  string
+	file    string
+	quiet   bool
+	verbose bool
+}
+
+// logging is set from the persistent root flags and applied by setupLogging.
+var logging logOptions
+
+// addLogFlags registers the log output flags.
+func addLogFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&logging.level, "log-level", "info", "Minimum level of log messages: debug, info, warn or error")
+	flags.StringVar(&logging.format, "log-format", logger.FormatText, "Log format: text (colored on the terminal) or json (one object per line, e.g. for CI)")
+	flags.StringVar(&logging.file, "log-file", "", "File logs are appended to instead of being written to stdout")
+	flags.BoolVarP(&logging.quiet, "quiet", "q", false, "Only log warnings and errors, leaving just the final report")
+	flags.BoolVarP(&logging.verbose, "verbose", "v", false, "Log at debug level, including every GitHub API request")
+}
+
+// setupLogging configures the logger from the log flags.
+func setupLogging(cmd *cobra.Command) error {
+	level, err := logger.ParseLevel(logging.level)
+	if err != nil {
+		return err
+	}
+
+	if logging.quiet && logging.verbose {
+		return fmt.Errorf("cannot specify both --quiet and --verbose")
+	}
+
+	if (logging.quiet || logging.verbose) && cmd.Flags().Changed("log-level") {
+		return fmt.Errorf("--log-level cannot be combined with --quiet or --verbose")
+	}
+
+	switch {
+	case logging.quiet:
+		level = slog.LevelWarn
+	case logging.verbose:
+		level = slog.LevelDebug
+	}
+
+	format, err := logger.ParseFormat(logging.format)
+	if err != nil {
+		return err
+	}
+
+	opts := logger.Options{Level: level, Format: format}
+	if logging.file != "" {
+		// The file stays open until the process exits so that every log line of the run reaches it.
+		file, err := os.OpenFile(logging.file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+
+		opts.Output = file
+	}
+
+	logger.Setup(opts)
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/auth"
+	"go-repo-manager/internal/logger"
+)
+
+// clientIDEnv is the environment variable holding the client ID of the OAuth app used by login.
+const clientIDEnv = "GO_REPO_MANAGER_CLIENT_ID"
+
+type loginOptions struct {
+	clientID string
+	scopes   []string
+}
+
+func newLoginCmd() *cobra.Command {
+	opts := &loginOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Authenticate with GitHub in the browser",
+		Long: `Authenticate with the OAuth device flow: open the verification page, enter the one-time code and
+authorize the OAuth app. The token is stored in the OS keychain, or in a file readable only by you when no
+keychain is available, and is used by every other command when neither --token nor GITHUB_TOKEN is set.
+
+The OAuth app must have the device flow enabled. Its client ID is given with --client-id or the
+GO_REPO_MANAGER_CLIENT_ID environment variable.`,
+		Annotations: map[string]string{ownerIndependentAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runLogin(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.clientID, "client-id", os.Getenv(clientIDEnv), "Client ID of the OAuth app (default: GO_REPO_MANAGER_CLIENT_ID env var)")
+	cmd.Flags().StringSliceVar(&opts.scopes, "scopes", auth.DefaultScopes, "Comma-separated OAuth scopes to request")
+
+	return cmd
+}
+
+func runLogin(opts *loginOptions) error {
+	log := logger.GetLogger()
+
+	if opts.clientID == "" {
+		return fmt.Errorf("OAuth app client ID (--client-id) is required or must be set in %s environment variable", clientIDEnv)
+	}
+
+	store, err := auth.DefaultStore()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	flow := &auth.DeviceFlow{ClientID: opts.clientID, Scopes: opts.scopes}
+	code, err := flow.RequestCode(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("🔑 Enter the one-time code %s at %s\n", code.UserCode, code.VerificationURI)
+	if err := openBrowser(code.VerificationURI); err != nil {
+		log.Debug("Could not open the verification page", "error", err)
+	}
+
+	ctx, cancel = context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+	defer cancel()
+
+	fmt.Println("⏳ Waiting for authorization...")
+	token, err := flow.PollToken(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	location, err := store.Save(auth.DefaultHost, token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Logged in to %s; token stored in %s\n", auth.DefaultHost, location)
+	return nil
+}
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// mergePRsOptions holds the flags of the merge-prs command.
+type mergePRsOptions struct {
+	author           string
+	labels           []string
+	base             string
+	titleMatch       string
+	method           string
+	requireChecks    bool
+	requireMergeable bool
+	dryRun           bool
+	yes              bool
+}
+
+func newMergePRsCmd() *cobra.Command {
+	opts := &mergePRsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "merge-prs",
+		Short: "Merge matching pull requests",
+		Long:  "Merge all open pull requests matching the given criteria (author, labels, base branch, title) in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. By default only cleanly mergeable pull requests with successful checks are merged",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMergePRsCommand(opts)
+		},
+	}
+
+	addPullRequestQueryFlags(cmd, &opts.author, &opts.labels, &opts.base, &opts.titleMatch)
+	cmd.Flags().StringVar(&opts.method, "method", ghbatch.MergeMethodMerge, "Merge method: merge, squash or rebase")
+	cmd.Flags().BoolVar(&opts.requireChecks, "require-checks", true, "Only merge pull requests whose statuses and check runs all succeeded")
+	cmd.Flags().BoolVar(&opts.requireMergeable, "require-mergeable", true, "Only merge pull requests GitHub reports as cleanly mergeable")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Evaluate the pull requests without merging them")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func runMergePRsCommand(opts *mergePRsOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	query, err := buildPullRequestQuery(opts.author, opts.labels, opts.base, opts.titleMatch)
+	if err != nil {
+		return err
+	}
+
+	switch opts.method {
+	case ghbatch.MergeMethodMerge, ghbatch.MergeMethodSquash, ghbatch.MergeMethodRebase:
+	default:
+		return fmt.Errorf("invalid --method %q: must be merge, squash or rebase", opts.method)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes && !opts.dryRun {
+		displayRepoList("Repositories to merge pull requests in", owner, names)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Merge matching pull requests in %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.MergePullRequests(ctx, owner, names, ghbatch.MergePullRequestsOptions{
+		Query:            query,
+		Method:           opts.method,
+		RequireChecks:    opts.requireChecks,
+		RequireMergeable: opts.requireMergeable,
+		DryRun:           opts.dryRun,
+	})
+
+	title := "Merge Pull Requests"
+	if opts.dryRun {
+		title = "Pull Requests to Merge (dry run)"
+	}
+
+	if failed := displayPullRequestResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d pull requests or repositories", failed)
+	}
+	return nil
+}
+
+// addPullRequestQueryFlags registers the pull request filter flags shared by the pull request commands.
+func addPullRequestQueryFlags(cmd *cobra.Command, author *string, labels *[]string, base, titleMatch *string) {
+	cmd.Flags().StringVar(author, "author", "", "Only match pull requests opened by this login, e.g. dependabot[bot]")
+	cmd.Flags().StringSliceVar(labels, "label", nil, "Only match pull requests carrying all of these comma-separated labels")
+	cmd.Flags().StringVar(base, "base", "", "Only match pull requests targeting this branch")
+	cmd.Flags().StringVar(titleMatch, "title-match", "", "Regular expression the pull request title must match")
+}
+
+// buildPullRequestQuery validates the pull request filter flags and builds the query.
+func buildPullRequestQuery(author string, labels []string, base, titleMatch string) (ghbatch.PullRequestQuery, error) {
+	query := ghbatch.PullRequestQuery{Author: author, Labels: labels, Base: base}
+
+	if titleMatch != "" {
+		pattern, err := regexp.Compile(titleMatch)
+		if err != nil {
+			return query, fmt.Errorf("invalid --title-match pattern: %w", err)
+		}
+		query.TitlePattern = pattern
+	}
+
+	return query, nil
+}
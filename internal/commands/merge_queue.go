@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// mergeQueueEnableOptions holds the flags of the merge-queue enable command.
+type mergeQueueEnableOptions struct {
+	name               string
+	mergeMethod        string
+	groupingStrategy   string
+	maxEntriesToBuild  int
+	maxEntriesToMerge  int
+	minEntriesToMerge  int
+	minEntriesWait     int
+	checkTimeout       int
+	allowMissingChecks bool
+	dryRun             bool
+	diff               bool
+	yes                bool
+}
+
+func newMergeQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-queue",
+		Short: "Manage merge queues",
+		Long:  "Manage the merge queues that test and merge pull requests into the default branch of repositories",
+	}
+
+	cmd.AddCommand(newMergeQueueEnableCmd())
+
+	return cmd
+}
+
+func newMergeQueueEnableCmd() *cobra.Command {
+	opts := &mergeQueueEnableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Turn on the merge queue for the default branch",
+		Long:  "Turn on the merge queue for the default branch of repositories through a branch ruleset. A merge queue only tests pull requests against the checks the branch requires, so repositories without required status checks are reported and skipped unless --allow-missing-checks is set",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMergeQueueEnableCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.name, "name", "merge-queue", "Name of the ruleset holding the merge queue")
+	cmd.Flags().StringVar(&opts.mergeMethod, "merge-method", "MERGE", "Merge method: MERGE, SQUASH or REBASE")
+	cmd.Flags().StringVar(&opts.groupingStrategy, "grouping-strategy", "ALLGREEN", "ALLGREEN requires every pull request of a group to pass, HEADGREEN only the last one")
+	cmd.Flags().IntVar(&opts.maxEntriesToBuild, "max-entries-to-build", 5, "Maximum number of pull requests built at once")
+	cmd.Flags().IntVar(&opts.maxEntriesToMerge, "max-entries-to-merge", 5, "Maximum number of pull requests merged together")
+	cmd.Flags().IntVar(&opts.minEntriesToMerge, "min-entries-to-merge", 1, "Minimum number of pull requests merged together")
+	cmd.Flags().IntVar(&opts.minEntriesWait, "min-entries-wait", 5, "Minutes to wait for the minimum number of pull requests")
+	cmd.Flags().IntVar(&opts.checkTimeout, "check-timeout", 60, "Minutes a required check may take before it is considered failed")
+	cmd.Flags().BoolVar(&opts.allowMissingChecks, "allow-missing-checks", false, "Also turn on the merge queue in repositories without required status checks")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only report the repositories where the merge queue would be turned on or updated")
+	cmd.Flags().BoolVar(&opts.diff, "diff", false, "Print the difference between the existing and the desired ruleset")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the comparison preview and interactive confirmation")
+
+	return cmd
+}
+
+// mergeQueueSettings validates the flags of the merge-queue enable command and builds the queue settings.
+func mergeQueueSettings(opts *mergeQueueEnableOptions) (ghbatch.MergeQueueSettings, error) {
+	settings := ghbatch.MergeQueueSettings{
+		MergeMethod:                  strings.ToUpper(opts.mergeMethod),
+		GroupingStrategy:             strings.ToUpper(opts.groupingStrategy),
+		MaxEntriesToBuild:            opts.maxEntriesToBuild,
+		MaxEntriesToMerge:            opts.maxEntriesToMerge,
+		MinEntriesToMerge:            opts.minEntriesToMerge,
+		MinEntriesToMergeWaitMinutes: opts.minEntriesWait,
+		CheckResponseTimeoutMinutes:  opts.checkTimeout,
+	}
+
+	if !slices.Contains(ghbatch.MergeQueueMergeMethods, settings.MergeMethod) {
+		return settings, fmt.Errorf("invalid --merge-method %q: must be one of %s", opts.mergeMethod, strings.Join(ghbatch.MergeQueueMergeMethods, ", "))
+	}
+	if !slices.Contains(ghbatch.MergeQueueGroupingStrategies, settings.GroupingStrategy) {
+		return settings, fmt.Errorf("invalid --grouping-strategy %q: must be one of %s", opts.groupingStrategy, strings.Join(ghbatch.MergeQueueGroupingStrategies, ", "))
+	}
+
+	limits := []struct {
+		flag     string
+		value    int
+		min, max int
+	}{
+		{"--max-entries-to-build", settings.MaxEntriesToBuild, 0, 100},
+		{"--max-entries-to-merge", settings.MaxEntriesToMerge, 0, 100},
+		{"--min-entries-to-merge", settings.MinEntriesToMerge, 0, 100},
+		{"--min-entries-wait", settings.MinEntriesToMergeWaitMinutes, 0, 360},
+		{"--check-timeout", settings.CheckResponseTimeoutMinutes, 1, 360},
+	}
+	for _, limit := range limits {
+		if limit.value < limit.min || limit.value > limit.max {
+			return settings, fmt.Errorf("%s must be between %d and %d", limit.flag, limit.min, limit.max)
+		}
+	}
+
+	if settings.MinEntriesToMerge > settings.MaxEntriesToMerge {
+		return settings, fmt.Errorf("--min-entries-to-merge must not exceed --max-entries-to-merge")
+	}
+
+	return settings, nil
+}
+
+func runMergeQueueEnableCommand(opts *mergeQueueEnableOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.name == "" {
+		return fmt.Errorf("--name must not be empty")
+	}
+
+	settings, err := mergeQueueSettings(opts)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	prerequisites := githubService.CheckMergeQueuePrerequisites(ctx, owner, repos)
+	ready, failed := displayMergeQueuePrerequisites(owner, prerequisites, opts.allowMissingChecks)
+
+	if len(ready) > 0 {
+		ruleset := ghbatch.MergeQueueRuleset(opts.name, settings)
+		if err := applyRulesetsToRepos(ctx, githubService, owner, ready, []ghbatch.Ruleset{ruleset}, opts.dryRun, opts.diff, opts.yes, isUser); err != nil {
+			return err
+		}
+	} else {
+		log.Info("No repositories meet the merge queue prerequisites", "owner", owner, "prefix", target.repoPrefix)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to check the merge queue prerequisites of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayMergeQueuePrerequisites lists the repositories whose default branch has no required status checks
+// and those that could not be checked. It returns the names of the repositories to turn the merge queue on
+// in, which include the ones without required checks when allowMissingChecks is set, and the number of
+// repositories that could not be checked.
+func displayMergeQueuePrerequisites(owner string, prerequisites []ghbatch.MergeQueuePrerequisites, allowMissingChecks bool) ([]string, int) {
+	sort.Slice(prerequisites, func(i, j int) bool { return prerequisites[i].RepoName < prerequisites[j].RepoName })
+
+	var ready, missing []string
+	var failed []ghbatch.MergeQueuePrerequisites
+	for _, prerequisite := range prerequisites {
+		switch {
+		case prerequisite.Err != nil:
+			failed = append(failed, prerequisite)
+		case prerequisite.RequiredChecks:
+			ready = append(ready, prerequisite.RepoName)
+		default:
+			missing = append(missing, fmt.Sprintf("%s/%s (%s)", owner, prerequisite.RepoName, prerequisite.Branch))
+			if allowMissingChecks {
+				ready = append(ready, prerequisite.RepoName)
+			}
+		}
+	}
+
+	if len(missing) == 0 && len(failed) == 0 {
+		return ready, 0
+	}
+
+	fmt.Printf("\n📋 Merge Queue Prerequisites:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	if len(missing) > 0 {
+		action := "skipped"
+		if allowMissingChecks {
+			action = "enabled anyway"
+		}
+		fmt.Printf("⚠️  NO REQUIRED STATUS CHECKS (%d repositories, %s):\n", len(missing), action)
+		for _, name := range missing {
+			fmt.Printf("  ⚠️  %s\n", name)
+		}
+		fmt.Println()
+	}
+	if len(failed) > 0 {
+		fmt.Printf("❌ FAILED (%d repositories):\n", len(failed))
+		for _, prerequisite := range failed {
+			fmt.Printf("  ❌ %s/%s: %v\n", owner, prerequisite.RepoName, prerequisite.Err)
+		}
+		fmt.Println()
+	}
+
+	return ready, len(failed)
+}
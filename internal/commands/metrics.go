@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/metrics"
+)
+
+// serverAnnotation marks the commands running a long-lived server. They expose their metrics on /metrics
+// instead of recording the run and pushing the metrics when it ends.
+const serverAnnotation = "server"
+
+var (
+	// metricsPushGateway and metricsJob are set from the persistent metrics flags.
+	metricsPushGateway string
+	metricsJob         string
+	// runOperation is the command being run, e.g. "releases prune", set by setupRun.
+	runOperation string
+	// runIsServer reports whether the command being run is a server.
+	runIsServer bool
+)
+
+// addMetricsFlags registers the push gateway flags.
+func addMetricsFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&metricsPushGateway, "metrics-push-gateway", "", "URL of a Prometheus push gateway the metrics of the run are pushed to when it ends")
+	flags.StringVar(&metricsJob, "metrics-job", "go-repo-manager", "Job name the metrics are pushed under")
+}
+
+// setupMetrics records which command runs, attributing the repositories it processes to it.
+func setupMetrics(cmd *cobra.Command) {
+	runOperation = strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	runIsServer = cmd.Annotations[serverAnnotation] == "true"
+}
+
+// finishMetrics records the duration of a CLI run that started at start and pushes the metrics to the push
+// gateway when one is set. Failing to push is logged and does not fail the run.
+func finishMetrics(start time.Time, err error) {
+	if runOperation == "" || runIsServer {
+		return
+	}
+
+	metrics.ObserveOperation(runOperation, start, err)
+
+	if metricsPushGateway == "" {
+		return
+	}
+
+	if err := metrics.Default.Push(context.Background(), metricsPushGateway, metricsJob); err != nil {
+		logger.GetLogger().Warn("Failed to push metrics", "gateway", metricsPushGateway, "error", err)
+	}
+}
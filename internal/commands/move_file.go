@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// moveFileOptions holds the flags of the move-file command.
+type moveFileOptions struct {
+	from string
+	to   string
+	yes  bool
+}
+
+func newMoveFileCmd() *cobra.Command {
+	opts := &moveFileOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "move-file",
+		Short: "Move or rename a file across repositories",
+		Long:  "Move a file to a new path on the default branch of repositories, e.g. --from CODEOWNERS --to .github/CODEOWNERS. Each repository gets a single commit made through the Git trees API, so the content and file mode are preserved. Repositories without the file or where the new path is taken are reported and left untouched",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMoveFileCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.from, "from", "", "Current path of the file within each repository (required)")
+	cmd.Flags().StringVar(&opts.to, "to", "", "New path of the file within each repository (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func runMoveFileCommand(opts *moveFileOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	from, to := cleanRepoPath(opts.from), cleanRepoPath(opts.to)
+	if from == "" || to == "" {
+		return fmt.Errorf("both --from and --to must be file paths within the repository")
+	}
+	if from == to {
+		return fmt.Errorf("--from and --to are the same path %s", from)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes {
+		displayRepoList(fmt.Sprintf("Repositories to move %s to %s in", from, to), owner, names)
+		if targetsAllRepos(target.repoName, target.repoPrefix) {
+			fmt.Printf("⚠️  No --repo, --repo-prefix, --topic or --language given: every repository of %s is targeted\n", owner)
+		}
+
+		confirmed, err := confirmAction(fmt.Sprintf("Move %s to %s in %d repositories?", from, to, len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.MoveFileInRepos(ctx, owner, names, ghbatch.FileMove{
+		From:          from,
+		To:            to,
+		CommitMessage: fmt.Sprintf("Move %s to %s", from, to),
+	})
+	if failed := displayFileMoveResults(owner, target.repoPrefix, from, to, results, isUser); failed > 0 {
+		return partialFailure("failed to move %s in %d repositories", from, failed)
+	}
+	return nil
+}
+
+// cleanRepoPath normalizes a file path within a repository. It returns an empty string for the repository
+// root and for paths ending in a slash, which name directories.
+func cleanRepoPath(filePath string) string {
+	filePath = strings.TrimSpace(filePath)
+	if filePath == "" || strings.HasSuffix(filePath, "/") {
+		return ""
+	}
+
+	return strings.TrimPrefix(path.Clean("/"+filePath), "/")
+}
+
+// displayFileMoveResults prints the per-repository outcome of a file move with a summary and returns the
+// number of failed repositories.
+func displayFileMoveResults(owner, prefix, from, to string, results []ghbatch.FileMoveResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.MoveStatusMoved:             "✅",
+		ghbatch.MoveStatusSourceMissing:     "➖",
+		ghbatch.MoveStatusDestinationExists: "⏭️ ",
+		ghbatch.MoveStatusFailed:            "❌",
+	}
+
+	fmt.Printf("\n📋 File Move Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		counts[result.Status]++
+
+		line := fmt.Sprintf("  %s %s/%s (%s)", icons[result.Status], owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.CommitURL != "" {
+			line += " → " + result.CommitURL
+		}
+		if result.Err != nil {
+			line += ": " + result.Err.Error()
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Moved: %d\n", counts[ghbatch.MoveStatusMoved])
+	fmt.Printf("➖ Source not found: %d\n", counts[ghbatch.MoveStatusSourceMissing])
+	if counts[ghbatch.MoveStatusDestinationExists] > 0 {
+		fmt.Printf("⏭️  Skipped (destination exists): %d\n", counts[ghbatch.MoveStatusDestinationExists])
+	}
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.MoveStatusFailed])
+	fmt.Printf("📍 File: %s → %s\n", from, to)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.MoveStatusFailed]
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/audit"
+	"go-repo-manager/internal/checkpoint"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// auditLogOptions holds the global flags controlling the audit log of mutations.
+type auditLogOptions struct {
+	path     string
+	endpoint string
+}
+
+// auditLog is set from the persistent root flags and applied by enableAuditLog.
+var auditLog auditLogOptions
+
+// runID identifies the current invocation in the audit log and run checkpoints. Resuming a checkpointed
+// run adopts the ID of that run.
+var runID = checkpoint.NewRunID()
+
+// mutationLog receives the mutations of the current invocation once enableAuditLog has run.
+var mutationLog *runAuditWriter
+
+// addAuditLogFlags registers the audit log flags.
+func addAuditLogFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&auditLog.path, "audit-log", "", "JSON lines file every change is recorded in (default: go-repo-manager/audit.jsonl under the user cache directory)")
+	flags.StringVar(&auditLog.endpoint, "audit-endpoint", "", "URL every change record is also POSTed to as JSON, e.g. a central change management service")
+}
+
+// auditLogPath returns the audit log selected by the flags.
+func auditLogPath() (string, error) {
+	if auditLog.path != "" {
+		return auditLog.path, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate the user cache directory: %w", err)
+	}
+
+	return filepath.Join(dir, "go-repo-manager", "audit.jsonl"), nil
+}
+
+// runAuditWriter stamps mutation records with the run ID and appends them to the local audit log and,
+// if configured, posts them to the central endpoint. The local log is only opened on the first write so
+// read-only commands leave no trace.
+type runAuditWriter struct {
+	mu       sync.Mutex
+	path     string
+	log      *audit.Log
+	endpoint *audit.Endpoint
+	written  int
+}
+
+// Write implements audit.Writer.
+func (w *runAuditWriter) Write(record audit.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	record.RunID = runID
+
+	if w.log == nil {
+		if err := os.MkdirAll(filepath.Dir(w.path), 0o700); err != nil {
+			return fmt.Errorf("failed to create audit log directory: %w", err)
+		}
+
+		log, err := audit.Open(w.path)
+		if err != nil {
+			return err
+		}
+
+		w.log = log
+	}
+
+	if err := w.log.Write(record); err != nil {
+		return err
+	}
+
+	w.written++
+
+	if w.endpoint != nil {
+		return w.endpoint.Write(record)
+	}
+
+	return nil
+}
+
+// enableAuditLog records the mutations of the command's GitHub services in the audit log.
+func enableAuditLog() error {
+	path, err := auditLogPath()
+	if err != nil {
+		return err
+	}
+
+	mutationLog = &runAuditWriter{path: path}
+	if auditLog.endpoint != "" {
+		mutationLog.endpoint = audit.NewEndpoint(auditLog.endpoint)
+	}
+
+	serviceOptions = append(serviceOptions, ghbatch.WithAuditLog(mutationLog))
+
+	return nil
+}
+
+// displayAuditSummary notes in a summary block how many changes of this run were recorded in the audit log.
+func displayAuditSummary() {
+	if mutationLog == nil {
+		return
+	}
+
+	mutationLog.mu.Lock()
+	written := mutationLog.written
+	mutationLog.mu.Unlock()
+
+	if written > 0 {
+		fmt.Printf("📝 Run %s: %d changes recorded in %s\n", runID, written, mutationLog.path)
+	}
+}
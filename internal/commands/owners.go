@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// ownerIndependentAnnotation marks commands that do not operate on the target owners, such as login. They
+// run once even when several owners are selected.
+const ownerIndependentAnnotation = "ownerIndependent"
+
+// userOwnerPrefix marks user accounts in an owners file; other lines are organizations.
+const userOwnerPrefix = "user:"
+
+// targetOwners are the owners selected by --org, --owners-file and --username, resolved by setupRun.
+var targetOwners []ghbatch.Owner
+
+// resolveTargetOwners collects the owners selected by the flags in order, dropping duplicates.
+func resolveTargetOwners() ([]ghbatch.Owner, error) {
+	if target.username != "" && (len(target.orgs) > 0 || target.ownersFile != "") {
+		return nil, fmt.Errorf("cannot specify both --org or --owners-file and --username; list users as %s<name> in the owners file", userOwnerPrefix)
+	}
+
+	var owners []ghbatch.Owner
+	for _, org := range target.orgs {
+		owners = append(owners, ghbatch.Owner{Login: strings.TrimSpace(org)})
+	}
+
+	if target.ownersFile != "" {
+		fileOwners, err := readOwnersFile(target.ownersFile)
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, fileOwners...)
+	}
+
+	if target.username != "" {
+		owners = append(owners, ghbatch.Owner{Login: target.username, IsUser: true})
+	}
+
+	seen := map[ghbatch.Owner]bool{}
+	unique := owners[:0]
+	for _, owner := range owners {
+		if owner.Login == "" || seen[owner] {
+			continue
+		}
+		seen[owner] = true
+		unique = append(unique, owner)
+	}
+
+	return unique, nil
+}
+
+// readOwnersFile reads an owners file: one organization per line, user accounts as user:<name>. Blank
+// lines and lines starting with # are ignored.
+func readOwnersFile(path string) ([]ghbatch.Owner, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open owners file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var owners []ghbatch.Owner
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if login, ok := strings.CutPrefix(line, userOwnerPrefix); ok {
+			owners = append(owners, ghbatch.Owner{Login: strings.TrimSpace(login), IsUser: true})
+			continue
+		}
+
+		owners = append(owners, ghbatch.Owner{Login: line})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read owners file %s: %w", path, err)
+	}
+
+	return owners, nil
+}
+
+// selectOwner points the owner flags read by ResolveOwner at the owner.
+func selectOwner(owner ghbatch.Owner) {
+	target.org, target.username = owner.Login, ""
+	if owner.IsUser {
+		target.org, target.username = "", owner.Login
+	}
+}
+
+// runForEachOwner wraps the RunE of every command operating on the target owners so that, when several
+// owners are selected, the command runs once per owner with its results grouped under the owner.
+func runForEachOwner(cmd *cobra.Command) {
+	for _, sub := range cmd.Commands() {
+		runForEachOwner(sub)
+	}
+
+	run := cmd.RunE
+	if run == nil || cmd.Annotations[ownerIndependentAnnotation] == "true" {
+		return
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		// The owners of an invocation share one checkpointed run
+		defer closeRun()
+
+		if len(targetOwners) <= 1 {
+			return run(cmd, args)
+		}
+
+		return runOwners(targetOwners, func(owner ghbatch.Owner) error {
+			selectOwner(owner)
+			return run(cmd, args)
+		})
+	}
+}
+
+// runOwners runs fn for every owner, printing a header before each one and a summary at the end. Owners
+// without matching repositories only fail the run when no owner had any.
+func runOwners(owners []ghbatch.Owner, fn func(owner ghbatch.Owner) error) error {
+	var (
+		failedOwners []string
+		firstErr     error
+		noRepos      int
+	)
+
+	for i, owner := range owners {
+		ownerType := "organization"
+		if owner.IsUser {
+			ownerType = "user"
+		}
+
+		fmt.Printf("\n🏢 Owner %d/%d: %s '%s'\n", i+1, len(owners), ownerType, owner.Login)
+		fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+		err := fn(owner)
+		switch {
+		case err == nil:
+		case errors.Is(err, errNoReposMatched):
+			noRepos++
+		default:
+			failedOwners = append(failedOwners, owner.Login)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	fmt.Println("\n" + strings.Repeat("=", longSeparatorLength+1))
+	fmt.Printf("📊 OWNERS SUMMARY: %d owners\n", len(owners))
+	fmt.Printf("✅ Succeeded: %d\n", len(owners)-len(failedOwners)-noRepos)
+	if noRepos > 0 {
+		fmt.Printf("➖ No matching repositories: %d\n", noRepos)
+	}
+	if len(failedOwners) > 0 {
+		fmt.Printf("❌ Failed: %d (%s)\n", len(failedOwners), strings.Join(failedOwners, ", "))
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	if firstErr != nil {
+		return fmt.Errorf("%d of %d owners failed, first error: %w", len(failedOwners), len(owners), firstErr)
+	}
+
+	if noRepos == len(owners) {
+		return errNoReposMatched
+	}
+
+	return nil
+}
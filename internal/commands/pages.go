@@ -0,0 +1,344 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// pagesEnableOptions holds the flags of the pages enable command.
+type pagesEnableOptions struct {
+	branch  string
+	path    string
+	actions bool
+	yes     bool
+}
+
+// pagesDisableOptions holds the flags of the pages disable command.
+type pagesDisableOptions struct {
+	yes bool
+}
+
+func newPagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pages",
+		Short: "Manage GitHub Pages sites",
+		Long:  "Enable, disable and report the GitHub Pages sites of repositories",
+	}
+
+	cmd.AddCommand(newPagesEnableCmd())
+	cmd.AddCommand(newPagesDisableCmd())
+	cmd.AddCommand(newPagesReportCmd())
+
+	return cmd
+}
+
+func newPagesEnableCmd() *cobra.Command {
+	opts := &pagesEnableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Enable GitHub Pages and set its source",
+		Long:  "Enable GitHub Pages in repositories, publishing a branch directory (--branch, --path) or the artifact of a GitHub Actions workflow (--actions). Sites published from another source are switched to the given one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPagesEnableCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.branch, "branch", "", "Branch to publish from (default: the default branch of each repository)")
+	cmd.Flags().StringVar(&opts.path, "path", "/", "Directory to publish from: / or /docs")
+	cmd.Flags().BoolVar(&opts.actions, "actions", false, "Publish with a GitHub Actions workflow instead of from a branch")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func newPagesDisableCmd() *cobra.Command {
+	opts := &pagesDisableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Unpublish GitHub Pages sites",
+		Long:  "Unpublish the GitHub Pages site of repositories. Repositories without a site are reported as not enabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPagesDisableCommand(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func newPagesReportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "report",
+		Short: "Report the GitHub Pages sites of repositories",
+		Long:  "List the GitHub Pages URL and publishing source of every repository with a site",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPagesReportCommand()
+		},
+	}
+}
+
+// pagesConfig builds the Pages source from the flags of the pages enable command.
+func pagesConfig(opts *pagesEnableOptions) (ghbatch.PagesConfig, error) {
+	if opts.actions {
+		if opts.branch != "" {
+			return ghbatch.PagesConfig{}, fmt.Errorf("--branch cannot be combined with --actions")
+		}
+		return ghbatch.PagesConfig{BuildType: ghbatch.PagesBuildTypeWorkflow}, nil
+	}
+
+	if opts.path != "/" && opts.path != "/docs" {
+		return ghbatch.PagesConfig{}, fmt.Errorf("invalid --path %q: must be / or /docs", opts.path)
+	}
+
+	return ghbatch.PagesConfig{BuildType: ghbatch.PagesBuildTypeLegacy, Branch: opts.branch, Path: opts.path}, nil
+}
+
+// pagesSource describes a Pages source for display.
+func pagesSource(buildType, branch, path string) string {
+	if buildType == ghbatch.PagesBuildTypeWorkflow {
+		return "GitHub Actions"
+	}
+	if branch == "" {
+		return "default branch " + path
+	}
+
+	return branch + " " + path
+}
+
+func runPagesEnableCommand(opts *pagesEnableOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	config, err := pagesConfig(opts)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	source := pagesSource(config.BuildType, config.Branch, config.Path)
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, repoNames(repos))
+
+		confirmed, err := confirmAction(fmt.Sprintf("Publish GitHub Pages from %s in %d repositories?", source, len(repos)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.ConfigurePages(ctx, owner, repos, config)
+	if failed := displayPagesResults(owner, target.repoPrefix, source, results, isUser); failed > 0 {
+		return partialFailure("failed to configure Pages in %d repositories", failed)
+	}
+	return nil
+}
+
+func runPagesDisableCommand(opts *pagesDisableOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, names)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Unpublish the GitHub Pages sites of %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.DisablePagesForRepos(ctx, owner, names)
+	if failed := displayPagesResults(owner, target.repoPrefix, "", results, isUser); failed > 0 {
+		return partialFailure("failed to disable Pages in %d repositories", failed)
+	}
+	return nil
+}
+
+func runPagesReportCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetPagesReports(ctx, owner, repoNames(repos))
+	if failed := displayPagesReport(owner, target.repoPrefix, reports, isUser); failed > 0 {
+		return partialFailure("failed to get the Pages site of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayPagesResults prints the per-repository outcome of enabling or disabling Pages with a summary and
+// returns the number of failed repositories. source is empty when disabling.
+func displayPagesResults(owner, prefix, source string, results []ghbatch.PagesResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.PagesStatusEnabled:    "✅",
+		ghbatch.PagesStatusUpdated:    "🔄",
+		ghbatch.PagesStatusUnchanged:  "➖",
+		ghbatch.PagesStatusDisabled:   "🗑️ ",
+		ghbatch.PagesStatusNotEnabled: "➖",
+		ghbatch.PagesStatusFailed:     "❌",
+	}
+
+	fmt.Printf("\n📋 Pages Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		counts[result.Status]++
+
+		line := fmt.Sprintf("  %s %s/%s (%s)", icons[result.Status], owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.URL != "" {
+			line += " " + result.URL
+		}
+		if result.Err != nil {
+			line += ": " + result.Err.Error()
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	if source != "" {
+		fmt.Printf("✅ Enabled: %d\n", counts[ghbatch.PagesStatusEnabled])
+		fmt.Printf("🔄 Updated: %d\n", counts[ghbatch.PagesStatusUpdated])
+		fmt.Printf("➖ Unchanged: %d\n", counts[ghbatch.PagesStatusUnchanged])
+		fmt.Printf("📍 Source: %s\n", source)
+	} else {
+		fmt.Printf("🗑️  Disabled: %d\n", counts[ghbatch.PagesStatusDisabled])
+		fmt.Printf("➖ Not enabled: %d\n", counts[ghbatch.PagesStatusNotEnabled])
+	}
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.PagesStatusFailed])
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.PagesStatusFailed]
+}
+
+// displayPagesReport prints the Pages sites of the repositories as a table with a summary and returns the
+// number of repositories that could not be checked.
+func displayPagesReport(owner, prefix string, reports []ghbatch.PagesReport, isUser bool) int {
+	sort.Slice(reports, func(i, j int) bool { return reports[i].RepoName < reports[j].RepoName })
+
+	var enabled, failed int
+
+	fmt.Printf("\n📋 Pages Sites:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tSOURCE\tURL")
+	for _, report := range reports {
+		switch {
+		case report.Err != nil:
+			failed++
+			fmt.Fprintf(w, "%s\t❌ failed\t%v\n", report.RepoName, report.Err)
+		case report.Enabled:
+			enabled++
+			fmt.Fprintf(w, "%s\t%s\t%s\n", report.RepoName, pagesSource(report.BuildType, report.Branch, report.Path), report.URL)
+		}
+	}
+	w.Flush()
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("🌐 With Pages: %d\n", enabled)
+	fmt.Printf("➖ Without Pages: %d\n", len(reports)-enabled-failed)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
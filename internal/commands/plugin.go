@@ -0,0 +1,227 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/plugin"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// pluginRunOptions holds the flags of the plugin run command.
+type pluginRunOptions struct {
+	dryRun bool
+	yes    bool
+}
+
+func newPluginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "plugin",
+		Aliases: []string{"plugins"},
+		Short:   "Run custom operations implemented by plugins",
+		Long:    "Run custom per-repository operations implemented by plugins: executables named " + plugin.ExecutablePrefix + "<name> on PATH that receive each repository as JSON on stdin and reach the GitHub API through a proxy of the run",
+	}
+
+	cmd.AddCommand(newPluginRunCmd())
+	cmd.AddCommand(newPluginListCmd())
+
+	return cmd
+}
+
+func newPluginRunCmd() *cobra.Command {
+	opts := &pluginRunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run <plugin> [-- plugin arguments...]",
+		Short: "Run a plugin on repositories",
+		Long:  "Run a plugin, given by name or by path, once per repository in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Arguments after -- are passed to the plugin",
+		Args:  cobra.MinimumNArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveDefault
+			}
+
+			var names []string
+			for _, p := range plugin.List() {
+				names = append(names, p.Name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPluginRunCommand(args[0], args[1:], opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Ask the plugin to report what it would change without changing anything")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func newPluginListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:         "list",
+		Short:       "List the plugins on PATH",
+		Long:        "List the plugins found on PATH with the path of their executable",
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{ownerIndependentAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPluginListCommand()
+		},
+	}
+}
+
+func runPluginRunCommand(name string, pluginArgs []string, opts *pluginRunOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	p, err := plugin.Lookup(name)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes && !opts.dryRun {
+		displayRepoList("Repositories to run "+p.Name+" on", owner, names)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Run plugin %s on %d repositories?", p.Name, len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	proxy, err := plugin.StartAPIProxy(githubClient)
+	if err != nil {
+		return err
+	}
+	defer proxy.Close()
+
+	byName := make(map[string]*github.Repository, len(repos))
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+	}
+
+	log.Info("Running plugin", "plugin", p.Name, "path", p.Path, "repositories", len(names))
+
+	results := githubService.RunRepoOperation(ctx, owner, names, func(ctx context.Context, repoName string) (ghbatch.RepoOperationResult, error) {
+		resp, err := p.Run(ctx, plugin.Request{
+			Owner:        owner,
+			Repository:   byName[repoName],
+			Repositories: names,
+			APIURL:       proxy.URL,
+			APIToken:     proxy.Token,
+			DryRun:       opts.dryRun,
+		}, pluginArgs)
+
+		return ghbatch.RepoOperationResult{Changed: resp.Changed, Message: resp.Message}, err
+	})
+
+	title := "Plugin " + p.Name
+	if opts.dryRun {
+		title += " (dry run)"
+	}
+
+	if failed := displayRepoOperationResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("plugin %s failed in %d repositories", p.Name, failed)
+	}
+	return nil
+}
+
+// displayRepoOperationResults prints the outcome of a custom operation per repository with a summary and
+// returns the number of failed repositories.
+func displayRepoOperationResults(title, owner, prefix string, results []ghbatch.RepoResult[ghbatch.RepoOperationResult],
+	isUser bool,
+) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var changed, failed int
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+			fmt.Printf("❌ %s/%s: %v\n", owner, result.RepoName, result.Err)
+		case result.Value.Changed:
+			changed++
+			fmt.Printf("✅ %s/%s: %s\n", owner, result.RepoName, orDefault(result.Value.Message, "changed"))
+		default:
+			fmt.Printf("➖ %s/%s: %s\n", owner, result.RepoName, orDefault(result.Value.Message, "unchanged"))
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Changed: %d\n", changed)
+	fmt.Printf("➖ Unchanged: %d\n", len(results)-changed-failed)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// orDefault returns value, or fallback when value is empty.
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+func runPluginListCommand() error {
+	plugins := plugin.List()
+	if len(plugins) == 0 {
+		fmt.Printf("No plugins found: plugins are executables named %s<name> on PATH\n", plugin.ExecutablePrefix)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPATH")
+	for _, p := range plugins {
+		fmt.Fprintf(w, "%s\t%s\n", p.Name, p.Path)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,208 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// prAgeReportOptions holds the flags of the pr-age report command.
+type prAgeReportOptions struct {
+	author        string
+	labels        []string
+	base          string
+	titleMatch    string
+	olderThan     int
+	top           int
+	excludeDrafts bool
+}
+
+// agedPullRequest is an open pull request with the repository it belongs to.
+type agedPullRequest struct {
+	repoName string
+	ghbatch.OpenPullRequest
+}
+
+func newPRAgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr-age",
+		Short: "Report on the age of open pull requests",
+		Long:  "Report on the age of open pull requests across repositories",
+	}
+
+	cmd.AddCommand(newPRAgeReportCmd())
+
+	return cmd
+}
+
+func newPRAgeReportCmd() *cobra.Command {
+	opts := &prAgeReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "List the oldest open pull requests",
+		Long:  "List the oldest open pull requests of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, with their age, author and review status, flagging pull requests older than a threshold",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPRAgeReportCommand(opts)
+		},
+	}
+
+	addPullRequestQueryFlags(cmd, &opts.author, &opts.labels, &opts.base, &opts.titleMatch)
+	cmd.Flags().IntVar(&opts.olderThan, "older-than", 30, "Flag pull requests open for at least this many days")
+	cmd.Flags().IntVar(&opts.top, "top", 50, "Number of oldest pull requests to list (0 for all)")
+	cmd.Flags().BoolVar(&opts.excludeDrafts, "exclude-drafts", false, "Leave draft pull requests out of the report")
+
+	return cmd
+}
+
+func runPRAgeReportCommand(opts *prAgeReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.olderThan <= 0 {
+		return fmt.Errorf("--older-than must be greater than zero")
+	}
+
+	if opts.top < 0 {
+		return fmt.Errorf("--top cannot be negative")
+	}
+
+	query, err := buildPullRequestQuery(opts.author, opts.labels, opts.base, opts.titleMatch)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.ListOpenPullRequests(ctx, owner, repoNames(repos), query)
+	if failed := displayPullRequestAges(owner, opts, results, time.Now(), isUser); failed > 0 {
+		return partialFailure("failed to list pull requests for %d repositories", failed)
+	}
+	return nil
+}
+
+// displayPullRequestAges prints the oldest open pull requests with a summary and returns the number of
+// repositories whose pull requests could not be listed.
+func displayPullRequestAges(owner string, opts *prAgeReportOptions, results []ghbatch.RepoOpenPullRequests, now time.Time,
+	isUser bool,
+) int {
+	var prs []agedPullRequest
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+		for _, pr := range result.PullRequests {
+			if opts.excludeDrafts && pr.Draft {
+				continue
+			}
+			prs = append(prs, agedPullRequest{repoName: result.RepoName, OpenPullRequest: pr})
+		}
+	}
+
+	sort.Slice(prs, func(i, j int) bool {
+		if !prs[i].CreatedAt.Equal(prs[j].CreatedAt) {
+			return prs[i].CreatedAt.Before(prs[j].CreatedAt)
+		}
+		if prs[i].repoName != prs[j].repoName {
+			return prs[i].repoName < prs[j].repoName
+		}
+		return prs[i].Number < prs[j].Number
+	})
+
+	threshold := time.Duration(opts.olderThan) * 24 * time.Hour
+	var old int
+	reviewStates := make(map[string]int)
+	for _, pr := range prs {
+		if now.Sub(pr.CreatedAt) >= threshold {
+			old++
+		}
+		if pr.Err == nil {
+			reviewStates[pr.ReviewState]++
+		}
+	}
+
+	listed := prs
+	if opts.top > 0 && len(listed) > opts.top {
+		listed = listed[:opts.top]
+	}
+
+	fmt.Println("\n📋 Oldest Open Pull Requests:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tPULL REQUEST\tAGE\tAUTHOR\tREVIEW\tTITLE")
+	for _, pr := range listed {
+		age := now.Sub(pr.CreatedAt)
+		icon := "✅"
+		if age >= threshold {
+			icon = "⏰"
+		}
+
+		review := pr.ReviewState
+		if pr.Err != nil {
+			review = "unknown"
+		}
+		if pr.Draft {
+			review += " (draft)"
+		}
+
+		fmt.Fprintf(w, "%s\t%s#%d\t%dd\t%s\t%s\t%s\n", icon, pr.repoName, pr.Number, int(age.Hours()/24), pr.Author, review,
+			pr.Title)
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, target.repoPrefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🔀 Open Pull Requests: %d\n", len(prs))
+	fmt.Printf("⏰ Open %d+ days: %d\n", opts.olderThan, old)
+	if len(reviewStates) > 0 {
+		fmt.Printf("👀 Review status: %s\n", formatCounts(reviewStates))
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be listed: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
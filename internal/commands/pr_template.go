@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// prTemplateApplyOptions holds the flags of the pr-template apply command.
+type prTemplateApplyOptions struct {
+	templateFile  string
+	onlyIfMissing bool
+	pr            bool
+	branch        string
+	yes           bool
+	checkpoint    checkpointOptions
+}
+
+func newPRTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr-template",
+		Short: "Manage pull request templates",
+		Long:  "Manage the pull request template of repositories",
+	}
+
+	cmd.AddCommand(newPRTemplateApplyCmd())
+
+	return cmd
+}
+
+func newPRTemplateApplyCmd() *cobra.Command {
+	opts := &prTemplateApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Add or update .github/pull_request_template.md in repositories",
+		Long:  "Add or update .github/pull_request_template.md in repositories, committing to the default branch or via pull request. The file is a template: [[.Owner]] and [[.Repo]] are replaced per repository",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPRTemplateApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.templateFile, "template-file", "", "Path to the pull request template to add to repositories (required)")
+	cmd.Flags().BoolVar(&opts.onlyIfMissing, "only-if-missing", false, "Skip repositories that already have a pull request template in any location GitHub recognizes")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-pr-template", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("template-file")
+
+	return cmd
+}
+
+func runPRTemplateApplyCommand(opts *prTemplateApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.templateFile == "" {
+		return fmt.Errorf("--template-file is required")
+	}
+
+	content, err := os.ReadFile(opts.templateFile)
+	if err != nil {
+		return fmt.Errorf("failed to read pull request template: %w", err)
+	}
+	templatePath := ghbatch.PullRequestTemplatePaths[0]
+
+	// Render once up front so that template errors fail the command before any repository is touched.
+	if _, err := render.File(templatePath, string(content), render.RepoData{}); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	rollout := ghbatch.FileRollout{
+		Path: templatePath,
+		Render: func(repoName string) (string, error) {
+			return render.File(templatePath, string(content), render.RepoData{Owner: owner, Repo: repoName})
+		},
+		CommitMessage:  "Add/Update pull request template",
+		SkipIfExists:   opts.onlyIfMissing,
+		AlternatePaths: ghbatch.PullRequestTemplatePaths[1:],
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  "Add/Update pull request template",
+			Body:   fmt.Sprintf("This pull request adds or updates %s.", templatePath),
+		}
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(repos), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "pr-template apply", owner, repoNames(repos))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("Pull Request Template Rollout", owner, target.repoPrefix, templatePath, results, isUser); failed > 0 {
+		return partialFailure("failed to apply the pull request template to %d repositories", failed)
+	}
+	return nil
+}
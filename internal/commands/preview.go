@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go-repo-manager/internal/textdiff"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// diffContextLines is the number of unchanged lines shown around each change of a preview diff.
+const diffContextLines = 3
+
+// confirmFileRollout shows the repositories the rollouts are about to write to and the change each rollout
+// makes to the first of them, then asks for confirmation. It returns true without prompting when yes is set.
+func confirmFileRollout(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName, repoPrefix string,
+	names []string, yes bool, rollouts ...ghbatch.FileRollout,
+) (bool, error) {
+	if yes || len(names) == 0 {
+		return true, nil
+	}
+
+	paths := make([]string, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		paths = append(paths, rollout.Path)
+	}
+
+	displayRepoList("Repositories to write "+strings.Join(paths, ", ")+" to", owner, names)
+	if targetsAllRepos(repoName, repoPrefix) {
+		fmt.Printf("⚠️  No --repo, --repo-prefix, --topic or --language given: every repository of %s is targeted\n", owner)
+	}
+
+	for _, rollout := range rollouts {
+		if err := previewFileChange(ctx, githubService, owner, names[0], rollout); err != nil {
+			return false, err
+		}
+	}
+
+	return confirmAction(fmt.Sprintf("Write %s to %d repositories?", strings.Join(paths, ", "), len(names)))
+}
+
+// previewFileChange prints the diff between the current file of a sample repository and the content
+// the rollout would write to it.
+func previewFileChange(ctx context.Context, githubService ghbatch.GitHubClient, owner, repoName string,
+	rollout ghbatch.FileRollout,
+) error {
+	fmt.Printf("\n🔍 Preview of %s in %s/%s:\n", rollout.Path, owner, repoName)
+
+	current, _, found, err := githubService.GetFileContent(ctx, owner, repoName, rollout.Path)
+	if err != nil {
+		return err
+	}
+
+	if rollout.SkipIfExists {
+		for _, filePath := range append([]string{rollout.Path}, rollout.AlternatePaths...) {
+			_, _, exists, err := githubService.GetFileContent(ctx, owner, repoName, filePath)
+			if err != nil {
+				return err
+			}
+			if exists {
+				fmt.Printf("  ⏭️  %s already exists and the repository would be skipped\n", filePath)
+				return nil
+			}
+		}
+	}
+
+	content, err := rollout.Content(repoName, current, found)
+	if errors.Is(err, ghbatch.ErrSkipFile) {
+		fmt.Println("  ⏭️  Repository would be skipped")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to render %s for %s/%s: %w", rollout.Path, owner, repoName, err)
+	}
+
+	oldName := "a/" + rollout.Path
+	if !found {
+		oldName = "/dev/null"
+	}
+
+	diff := textdiff.Unified(oldName, "b/"+rollout.Path, current, content, diffContextLines)
+	if diff == "" {
+		fmt.Println("  ➖ File is already up to date")
+		return nil
+	}
+
+	fmt.Print(diff)
+	return nil
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// projectAddItemsOptions holds the flags of the project add-items command.
+type projectAddItemsOptions struct {
+	number     int
+	kind       string
+	state      string
+	titleMatch string
+	withLabels []string
+	field      string
+	value      string
+	dryRun     bool
+}
+
+func newProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Manage organization projects",
+		Long:  "Manage the items of organization Projects (v2) boards across repositories",
+	}
+
+	cmd.AddCommand(newProjectAddItemsCmd())
+
+	return cmd
+}
+
+func newProjectAddItemsCmd() *cobra.Command {
+	opts := &projectAddItemsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add-items",
+		Short: "Add matching issues or pull requests to a project",
+		Long:  "Add the issues or pull requests matching a filter in a specified repository, repositories with a given prefix, or all repositories in an organization to a Projects (v2) board of the organization, optionally setting a text, number, date or single select field on every added item. Items already in the project are kept and only get the field value",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProjectAddItemsCommand(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.number, "project", 0, "Number of the organization project (required)")
+	cmd.Flags().StringVar(&opts.kind, "type", "issues", "What to add: issues, prs or all")
+	cmd.Flags().StringVar(&opts.state, "state", "open", "State to match: open, closed or all")
+	cmd.Flags().StringVar(&opts.titleMatch, "title-match", "", "Regular expression the title must match")
+	cmd.Flags().StringSliceVar(&opts.withLabels, "with-labels", nil, "Comma-separated labels the issues must carry")
+	cmd.Flags().StringVar(&opts.field, "field", "", "Name of a project field to set on the added items")
+	cmd.Flags().StringVar(&opts.value, "value", "", "Value of --field: text, number, YYYY-MM-DD date or single select option name")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the matching issues without adding them")
+
+	cmd.MarkFlagRequired("project")
+
+	return cmd
+}
+
+func runProjectAddItemsCommand(opts *projectAddItemsOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if target.username != "" {
+		return fmt.Errorf("project add-items works with organization projects and requires --org")
+	}
+
+	if opts.number <= 0 {
+		return fmt.Errorf("--project must be a project number greater than zero")
+	}
+
+	if (opts.field == "") != (opts.value == "") {
+		return fmt.Errorf("--field and --value must be specified together")
+	}
+
+	query, err := buildIssueQuery(opts.state, opts.titleMatch, opts.withLabels)
+	if err != nil {
+		return err
+	}
+
+	if err := setIssueKind(&query, opts.kind); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	project, err := githubService.GetOrgProject(ctx, owner, opts.number)
+	if err != nil {
+		log.Error("Failed to get project", "owner", owner, "project", opts.number, "error", err)
+		return err
+	}
+
+	var fieldValue *ghbatch.ProjectFieldValue
+	if opts.field != "" {
+		if fieldValue, err = project.FieldValue(opts.field, opts.value); err != nil {
+			return err
+		}
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.AddProjectItems(ctx, owner, repoNames(repos), ghbatch.ProjectItemOptions{
+		ProjectID:  project.ID,
+		Query:      query,
+		FieldValue: fieldValue,
+		DryRun:     opts.dryRun,
+	})
+
+	title := fmt.Sprintf("Add to Project %q", project.Title)
+	if opts.dryRun {
+		title = fmt.Sprintf("Issues to Add to Project %q (dry run)", project.Title)
+	}
+
+	if failed := displayIssueResults(title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to process %d issues or repositories", failed)
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// errPropertiesNeedOrg is returned when custom properties are used with a user account.
+var errPropertiesNeedOrg = errors.New("custom properties are only available for organizations")
+
+// propertiesSetOptions holds the flags of the properties set command.
+type propertiesSetOptions struct {
+	properties []string
+	yes        bool
+}
+
+func newPropertiesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "properties",
+		Short: "Manage organization custom properties of repositories",
+		Long:  "Assign and report the custom property values, such as service tier or owning team, that an organization defines for its repositories",
+	}
+
+	cmd.AddCommand(newPropertiesSetCmd())
+	cmd.AddCommand(newPropertiesReportCmd())
+
+	return cmd
+}
+
+func newPropertiesSetCmd() *cobra.Command {
+	opts := &propertiesSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Assign custom property values to repositories",
+		Long:  "Assign custom property values to repositories in bulk, e.g. --property service-tier=1 --property owner-team=payments. The values are validated against the properties defined by the organization, repositories that already have them are left alone and an empty value (--property owner-team=) unsets the property",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPropertiesSetCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.properties, "property", nil, "Property value as name=value; repeat for several properties (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("property")
+
+	return cmd
+}
+
+func newPropertiesReportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "report",
+		Short: "Report the custom property values of repositories",
+		Long:  "Print the custom property values of repositories as a matrix and count the repositories missing required properties",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPropertiesReportCommand()
+		},
+	}
+}
+
+// parsePropertyValues parses name=value pairs into a map, rejecting duplicate names.
+func parsePropertyValues(pairs []string) (map[string]string, error) {
+	values := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --property %q: expected name=value", pair)
+		}
+		if _, dup := values[name]; dup {
+			return nil, fmt.Errorf("--property %s is given more than once", name)
+		}
+		values[name] = strings.TrimSpace(value)
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("at least one --property is required")
+	}
+
+	return values, nil
+}
+
+func runPropertiesSetCommand(opts *propertiesSetOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	values, err := parsePropertyValues(opts.properties)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+	if isUser {
+		return errPropertiesNeedOrg
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	schema, err := githubService.GetCustomPropertySchema(ctx, owner)
+	if err != nil {
+		return err
+	}
+	if err := ghbatch.ValidateCustomPropertyValues(schema, values); err != nil {
+		return err
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	current, err := githubService.ListCustomPropertyValues(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	// Repositories that already have every value are left untouched
+	var pendingNames, unchangedNames []string
+	for _, name := range repoNames(repos) {
+		if len(ghbatch.CustomPropertyChanges(current[name], values)) == 0 {
+			unchangedNames = append(unchangedNames, name)
+		} else {
+			pendingNames = append(pendingNames, name)
+		}
+	}
+
+	if len(unchangedNames) > 0 {
+		log.Info("Skipping repositories that already have the property values", "count", len(unchangedNames))
+	}
+
+	if len(pendingNames) == 0 {
+		log.Info("No repositories need a custom property change", "owner", owner, "prefix", target.repoPrefix)
+		return nil
+	}
+
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, pendingNames)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Set %s on %d repositories?", strings.Join(opts.properties, ", "), len(pendingNames)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.SetCustomPropertyValues(ctx, owner, pendingNames, values)
+	displayBatchResults("Custom Properties", owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to set custom properties of %d repositories", len(failedRepos))
+	}
+	return nil
+}
+
+func runPropertiesReportCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+	if isUser {
+		return errPropertiesNeedOrg
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	schema, err := githubService.GetCustomPropertySchema(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	if len(schema) == 0 {
+		log.Info("The organization defines no custom properties", "owner", owner)
+		return nil
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	values, err := githubService.ListCustomPropertyValues(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	displayCustomProperties(owner, target.repoPrefix, schema, repoNames(repos), values, isUser)
+	return nil
+}
+
+// displayCustomProperties prints the custom property values of the repositories as a matrix with a summary
+// of the repositories missing required properties.
+func displayCustomProperties(owner, prefix string, schema []*github.CustomProperty, names []string,
+	values map[string]map[string]string, isUser bool,
+) {
+	sort.Strings(names)
+
+	missing := map[string]int{}
+	var incomplete int
+
+	fmt.Printf("\n📋 Custom Properties (%d defined):\n", len(schema))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{"REPOSITORY"}
+	for _, property := range schema {
+		header = append(header, strings.ToUpper(property.GetPropertyName()))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for _, name := range names {
+		row := []string{name}
+		complete := true
+		for _, property := range schema {
+			value, ok := values[name][property.GetPropertyName()]
+			switch {
+			case ok:
+				row = append(row, value)
+			case property.GetRequired():
+				missing[property.GetPropertyName()]++
+				complete = false
+				row = append(row, "❌ missing")
+			default:
+				row = append(row, "-")
+			}
+		}
+		if !complete {
+			incomplete++
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	w.Flush()
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(names))
+	fmt.Printf("✅ All required properties set: %d\n", len(names)-incomplete)
+	fmt.Printf("❌ Missing required properties: %d\n", incomplete)
+	for _, property := range schema {
+		if count := missing[property.GetPropertyName()]; count > 0 {
+			fmt.Printf("   %s missing in: %d\n", property.GetPropertyName(), count)
+		}
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+}
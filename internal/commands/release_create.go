@@ -0,0 +1,225 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// releasesCreateOptions holds the flags of the releases create command.
+type releasesCreateOptions struct {
+	tag           string
+	name          string
+	notes         string
+	notesFile     string
+	generateNotes bool
+	draft         bool
+	prerelease    bool
+	dryRun        bool
+	yes           bool
+}
+
+func newReleasesCreateCmd() *cobra.Command {
+	opts := &releasesCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Tag and release the default branch head of repositories",
+		Long:  "Create a tag and a release at the current head of the default branch of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, for coordinated multi-repository version cuts. The tag is a Go template with {{.Owner}} and {{.Repo}}; name and notes may also use {{.Tag}}. Repositories that already have the tag are reported as failed and left untouched",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReleasesCreateCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.tag, "tag", "", "Tag template, e.g. v2.0.0 (required)")
+	cmd.Flags().StringVar(&opts.name, "name", "{{.Tag}}", "Release name template")
+	cmd.Flags().StringVar(&opts.notes, "notes", "", "Release notes template")
+	cmd.Flags().StringVar(&opts.notesFile, "notes-file", "", "Path to a file containing the release notes template")
+	cmd.Flags().BoolVar(&opts.generateNotes, "generate-notes", false, "Have GitHub generate the notes from the changes since the previous release, below --notes")
+	cmd.Flags().BoolVar(&opts.draft, "draft", false, "Create draft releases")
+	cmd.Flags().BoolVar(&opts.prerelease, "prerelease", false, "Mark the releases as pre-releases")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Render the release and list the repositories without creating anything")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("tag")
+
+	return cmd
+}
+
+func runReleasesCreateCommand(opts *releasesCreateOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(opts.tag) == "" {
+		return fmt.Errorf("release tag (--tag) is required")
+	}
+
+	if opts.notes != "" && opts.notesFile != "" {
+		return fmt.Errorf("cannot specify both --notes and --notes-file")
+	}
+
+	notes := opts.notes
+	if opts.notesFile != "" {
+		content, err := os.ReadFile(opts.notesFile)
+		if err != nil {
+			return fmt.Errorf("failed to read notes file %s: %w", opts.notesFile, err)
+		}
+		notes = string(content)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Render every release up front so template errors surface before anything is created
+	names := repoNames(repos)
+	releases := make(map[string]ghbatch.NewRelease, len(names))
+	for _, name := range names {
+		release, err := renderNewRelease(opts, owner, name, notes)
+		if err != nil {
+			return err
+		}
+		releases[name] = release
+	}
+
+	displayRepoList("Repositories to Release", owner, names)
+	preview := releases[names[0]]
+	fmt.Printf("\n🏷️  %s (%s)\n", preview.Name, preview.Tag)
+	if preview.Body != "" {
+		fmt.Printf("\n%s\n", preview.Body)
+	}
+	if preview.GenerateNotes {
+		fmt.Println("\n(notes generated by GitHub)")
+	}
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Tag and release %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.CreateReleases(ctx, owner, names, func(repoName string) (ghbatch.NewRelease, error) {
+		return releases[repoName], nil
+	})
+
+	if failed := displayReleaseResults(owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to release %d repositories", failed)
+	}
+	return nil
+}
+
+// renderNewRelease renders the tag, name and notes templates for a repository.
+func renderNewRelease(opts *releasesCreateOptions, owner, repoName, notes string) (ghbatch.NewRelease, error) {
+	data := render.ReleaseData{RepoData: render.RepoData{Owner: owner, Repo: repoName}}
+
+	tag, err := render.Text("tag", opts.tag, data.RepoData)
+	if err != nil {
+		return ghbatch.NewRelease{}, err
+	}
+	if tag = strings.TrimSpace(tag); tag == "" || strings.ContainsAny(tag, " \t\n") {
+		return ghbatch.NewRelease{}, fmt.Errorf("invalid tag %q for %s/%s", tag, owner, repoName)
+	}
+	data.Tag = tag
+
+	name, err := render.Text("name", opts.name, data)
+	if err != nil {
+		return ghbatch.NewRelease{}, err
+	}
+
+	body, err := render.Text("notes", notes, data)
+	if err != nil {
+		return ghbatch.NewRelease{}, err
+	}
+
+	return ghbatch.NewRelease{
+		Tag:           tag,
+		Name:          name,
+		Body:          body,
+		GenerateNotes: opts.generateNotes,
+		Draft:         opts.draft,
+		Prerelease:    opts.prerelease,
+	}, nil
+}
+
+// displayReleaseResults prints the created releases with the commit they point to and the repositories
+// that failed, with a summary, and returns the number of failed repositories.
+func displayReleaseResults(owner, prefix string, results []ghbatch.ReleaseResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var failed int
+
+	fmt.Println("\n📋 Release Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tTAG\tCOMMIT\tURL")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Fprintf(w, "❌\t%s\t%s\t-\t-\n", result.RepoName, result.Tag)
+			continue
+		}
+		fmt.Fprintf(w, "✅\t%s\t%s\t%s\t%s\n", result.RepoName, result.Tag, shortSHA(result.Commit), result.URL)
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Released: %d\n", len(results)-failed)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// shortSHA abbreviates a commit SHA for display.
+func shortSHA(sha string) string {
+	return sha[:min(len(sha), 7)]
+}
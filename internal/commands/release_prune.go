@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// releasesPruneOptions holds the flags of the releases prune command.
+type releasesPruneOptions struct {
+	drafts     bool
+	keep       int
+	days       int
+	deleteTags bool
+	dryRun     bool
+}
+
+func newReleasesPruneCmd() *cobra.Command {
+	opts := &releasesPruneOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete draft and old releases",
+		Long:  "Delete draft releases and published releases beyond the newest --keep or older than --older-than-days, optionally with their tags, in a specified repository, repositories with a given prefix, or all repositories in an organization or user account. When both --keep and --older-than-days are given, a release is only deleted when it matches both",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReleasesPruneCommand(opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.drafts, "drafts", false, "Delete every draft release")
+	cmd.Flags().IntVar(&opts.keep, "keep", 0, "Keep this many of the newest published releases and delete the older ones")
+	cmd.Flags().IntVar(&opts.days, "older-than-days", 0, "Delete published releases published more than this many days ago")
+	cmd.Flags().BoolVar(&opts.deleteTags, "delete-tags", false, "Also delete the tags of the deleted published releases")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the releases that would be deleted without deleting them")
+
+	return cmd
+}
+
+func runReleasesPruneCommand(opts *releasesPruneOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.keep < 0 || opts.days < 0 {
+		return fmt.Errorf("--keep and --older-than-days must not be negative")
+	}
+
+	if !opts.drafts && opts.keep == 0 && opts.days == 0 {
+		return fmt.Errorf("at least one of --drafts, --keep or --older-than-days is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	pruneOpts := ghbatch.ReleasePruneOptions{
+		Drafts:     opts.drafts,
+		Keep:       opts.keep,
+		DeleteTags: opts.deleteTags,
+		DryRun:     opts.dryRun,
+	}
+	if opts.days > 0 {
+		pruneOpts.OlderThan = time.Now().AddDate(0, 0, -opts.days)
+	}
+
+	results := githubService.PruneReleases(ctx, owner, repoNames(repos), pruneOpts)
+
+	title := "Release Prune"
+	if opts.dryRun {
+		title = "Releases to Delete (dry run)"
+	}
+
+	if failed := displayReleasePruneResults(title, owner, target.repoPrefix, results, opts.deleteTags, isUser); failed > 0 {
+		return partialFailure("failed to process %d releases or repositories", failed)
+	}
+	return nil
+}
+
+// displayReleasePruneResults prints the deleted releases grouped by repository with a summary and returns
+// the number of failed releases and repositories.
+func displayReleasePruneResults(title, owner, prefix string, results []ghbatch.RepoReleasePruneResult, deleteTags, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var total, failed int
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("❌ %s/%s: %v\n", owner, result.RepoName, result.Err)
+			continue
+		}
+		if len(result.Releases) == 0 {
+			continue
+		}
+
+		fmt.Printf("📁 %s/%s (%d releases):\n", owner, result.RepoName, len(result.Releases))
+		for _, release := range result.Releases {
+			total++
+
+			line := release.Tag
+			switch {
+			case release.Draft:
+				line += " (draft)"
+			case deleteTags:
+				line += fmt.Sprintf(" (published %s, with tag)", release.PublishedAt.Format(time.DateOnly))
+			default:
+				line += fmt.Sprintf(" (published %s)", release.PublishedAt.Format(time.DateOnly))
+			}
+
+			if release.Err != nil {
+				failed++
+				fmt.Printf("  ❌ %s: %v\n", line, release.Err)
+				continue
+			}
+			fmt.Printf("  🗑️  %s\n", line)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🗑️  Total Releases: %d\n", total)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// releasesUploadOptions holds the flags of the releases upload command.
+type releasesUploadOptions struct {
+	file    string
+	name    string
+	release string
+	replace bool
+	dryRun  bool
+	yes     bool
+}
+
+func newReleasesUploadCmd() *cobra.Command {
+	opts := &releasesUploadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upload",
+		Short: "Upload a per-repository file to a release",
+		Long:  "Upload a locally generated file, such as an SBOM, to the latest or a named release of a specified repository, repositories with a given prefix, or all repositories in an organization or user account. The file path, asset name and release tag are Go templates with {{.Owner}} and {{.Repo}}. Every uploaded asset is downloaded again to verify its SHA-256 checksum",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReleasesUploadCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", "Path template of the local file to upload, e.g. sbom/{{.Repo}}.spdx.json (required)")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Asset name template (default: the base name of the file)")
+	cmd.Flags().StringVar(&opts.release, "release", "", "Tag template of the release to upload to (default: the latest published release)")
+	cmd.Flags().BoolVar(&opts.replace, "replace", false, "Replace an existing asset with the same name instead of failing")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Resolve the files and list the uploads without uploading")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runReleasesUploadCommand(opts *releasesUploadOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(opts.file) == "" {
+		return fmt.Errorf("file (--file) is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Resolve every file up front so a missing artifact surfaces before anything is uploaded
+	names := repoNames(repos)
+	uploads := make(map[string]ghbatch.ReleaseAssetUpload, len(names))
+	for _, name := range names {
+		upload, err := renderReleaseAssetUpload(opts, owner, name)
+		if err != nil {
+			return err
+		}
+		uploads[name] = upload
+	}
+
+	displayReleaseAssetUploads(owner, names, uploads)
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Upload assets to releases of %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.UploadReleaseAssets(ctx, owner, names, func(repoName string) (ghbatch.ReleaseAssetUpload, error) {
+		return uploads[repoName], nil
+	})
+
+	if failed := displayReleaseAssetResults(owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to upload assets to %d repositories", failed)
+	}
+	return nil
+}
+
+// renderReleaseAssetUpload renders the file, name and release templates for a repository and checks that
+// the file exists.
+func renderReleaseAssetUpload(opts *releasesUploadOptions, owner, repoName string) (ghbatch.ReleaseAssetUpload, error) {
+	data := render.RepoData{Owner: owner, Repo: repoName}
+	upload := ghbatch.ReleaseAssetUpload{Replace: opts.replace}
+
+	var err error
+	if upload.Path, err = render.Text("file", opts.file, data); err != nil {
+		return upload, err
+	}
+	if upload.Name, err = render.Text("name", opts.name, data); err != nil {
+		return upload, err
+	}
+	if upload.Release, err = render.Text("release", opts.release, data); err != nil {
+		return upload, err
+	}
+
+	info, err := os.Stat(upload.Path)
+	if err != nil {
+		return upload, fmt.Errorf("file for %s/%s: %w", owner, repoName, err)
+	}
+	if info.IsDir() {
+		return upload, fmt.Errorf("file for %s/%s: %s is a directory", owner, repoName, upload.Path)
+	}
+
+	if upload.Name == "" {
+		upload.Name = filepath.Base(upload.Path)
+	}
+
+	return upload, nil
+}
+
+// displayReleaseAssetUploads lists the file each repository gets before anything is uploaded.
+func displayReleaseAssetUploads(owner string, names []string, uploads map[string]ghbatch.ReleaseAssetUpload) {
+	fmt.Printf("\n📋 Release Assets to Upload (%d repositories):\n", len(names))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tRELEASE\tASSET\tFILE")
+	for _, name := range names {
+		upload := uploads[name]
+		release := upload.Release
+		if release == "" {
+			release = "(latest)"
+		}
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\t%s\n", owner, name, release, upload.Name, upload.Path)
+	}
+	w.Flush()
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
+
+// displayReleaseAssetResults prints the uploaded assets with their checksums and the repositories that
+// failed, with a summary, and returns the number of failed repositories.
+func displayReleaseAssetResults(owner, prefix string, results []ghbatch.ReleaseAssetResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var failed int
+
+	fmt.Println("\n📋 Release Asset Upload Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tRELEASE\tASSET\tSIZE\tSHA-256")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			release := result.Release
+			if release == "" {
+				release = "-"
+			}
+			fmt.Fprintf(w, "❌\t%s\t%s\t%s\t-\t-\n", result.RepoName, release, result.Name)
+			continue
+		}
+		fmt.Fprintf(w, "✅\t%s\t%s\t%s\t%d\t%s\n", result.RepoName, result.Release, result.Name, result.Size, result.SHA256)
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Uploaded and verified: %d\n", len(results)-failed)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// releasesReportOptions holds the flags of the releases report command.
+type releasesReportOptions struct {
+	staleDays int
+}
+
+func newReleasesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "releases",
+		Aliases: []string{"release"},
+		Short:   "Manage and report on releases",
+		Long:    "Manage and report on releases across repositories",
+	}
+
+	cmd.AddCommand(newReleasesReportCmd())
+	cmd.AddCommand(newReleasesCreateCmd())
+	cmd.AddCommand(newReleasesUploadCmd())
+	cmd.AddCommand(newReleasesPruneCmd())
+
+	return cmd
+}
+
+func newReleasesReportCmd() *cobra.Command {
+	opts := &releasesReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the release cadence of repositories",
+		Long:  "List the latest release tag, date and days since the last release of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, highlighting repositories that have not released in a given number of days",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReleasesReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.staleDays, "stale-days", 90, "Highlight repositories without a release in this many days")
+
+	return cmd
+}
+
+func runReleasesReportCommand(opts *releasesReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.staleDays <= 0 {
+		return fmt.Errorf("--stale-days must be greater than zero")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetReleaseReports(ctx, owner, repoNames(repos))
+	if failed := displayReleaseReports(owner, target.repoPrefix, reports, opts.staleDays, time.Now(), isUser); failed > 0 {
+		return partialFailure("failed to get releases for %d repositories", failed)
+	}
+	return nil
+}
+
+// displayReleaseReports prints the latest release per repository, least recently released first, and
+// returns the number of repositories whose releases could not be read.
+func displayReleaseReports(owner, prefix string, reports []ghbatch.ReleaseReport, staleDays int, now time.Time, isUser bool) int {
+	// Never released first, then oldest release first
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].HasRelease() != reports[j].HasRelease() {
+			return !reports[i].HasRelease()
+		}
+		if !reports[i].PublishedAt.Equal(reports[j].PublishedAt) {
+			return reports[i].PublishedAt.Before(reports[j].PublishedAt)
+		}
+		return reports[i].RepoName < reports[j].RepoName
+	})
+
+	var never, stale, failed int
+
+	fmt.Println("\n📋 Release Cadence:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tLATEST\tPUBLISHED\tDAYS AGO")
+	for _, report := range reports {
+		switch {
+		case report.Err != nil:
+			failed++
+			fmt.Fprintf(w, "⚠️\t%s\t-\t-\t-\n", report.RepoName)
+		case !report.HasRelease():
+			never++
+			fmt.Fprintf(w, "❌\t%s\t(never released)\t-\t-\n", report.RepoName)
+		default:
+			days := int(now.Sub(report.PublishedAt).Hours() / 24)
+			icon := "✅"
+			if days >= staleDays {
+				icon = "⏰"
+				stale++
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n", icon, report.RepoName, report.Tag, report.PublishedAt.Format(time.DateOnly), days)
+		}
+	}
+	w.Flush()
+
+	for _, report := range reports {
+		if report.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, report.RepoName, report.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("✅ Released within %d days: %d\n", staleDays, len(reports)-never-stale-failed)
+	fmt.Printf("⏰ No release in %d+ days: %d\n", staleDays, stale)
+	fmt.Printf("❌ Never released: %d\n", never)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be read: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
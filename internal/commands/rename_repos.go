@@ -0,0 +1,164 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// renameReposOptions holds the flags of the rename-repos command.
+type renameReposOptions struct {
+	match      string
+	replace    string
+	dryRun     bool
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newRenameReposCmd() *cobra.Command {
+	opts := &renameReposOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rename-repos",
+		Short: "Rename repositories using a regular expression",
+		Long:  "Rename repositories whose names match a regular expression, e.g. --match '^svc-(.*)$' --replace 'service-$1'. The old -> new names are previewed and any collision aborts the run before a single repository is renamed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRenameReposCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.match, "match", "", "Regular expression repository names must match (required)")
+	cmd.Flags().StringVar(&opts.replace, "replace", "", "Replacement for the matched name, supporting $1-style capture group references (required)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only preview the renames")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("match")
+	cmd.MarkFlagRequired("replace")
+
+	return cmd
+}
+
+func runRenameReposCommand(opts *renameReposOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if target.repoName != "" {
+		return fmt.Errorf("rename-repos selects repositories with --match, not --repo")
+	}
+
+	pattern, err := regexp.Compile(opts.match)
+	if err != nil {
+		return fmt.Errorf("invalid --match expression: %w", err)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	// Every repository of the owner is needed to detect collisions, not only the prefix matches
+	allRepos, err := githubService.GetRepositoriesWithPrefix(ctx, owner, "", isUser)
+	if err != nil {
+		log.Error("Failed to list repositories", "owner", owner, "error", err)
+		return err
+	}
+
+	var candidates []string
+	for _, repository := range allRepos {
+		if strings.HasPrefix(repository.GetName(), target.repoPrefix) && pattern.MatchString(repository.GetName()) {
+			candidates = append(candidates, repository.GetName())
+		}
+	}
+
+	if len(candidates) == 0 {
+		log.Info("No repositories match the rename pattern", "owner", owner, "prefix", target.repoPrefix, "match", opts.match)
+		return errNoReposMatched
+	}
+
+	plan, collisions := ghbatch.PlanRenames(candidates, repoNames(allRepos), pattern, opts.replace)
+	if len(plan) == 0 {
+		log.Info("All matching repositories already have their target name", "owner", owner, "match", opts.match)
+		return nil
+	}
+
+	displayRenamePlan(owner, plan)
+
+	if len(collisions) > 0 {
+		fmt.Printf("❌ %d collisions detected, no repositories were renamed:\n", len(collisions))
+		for _, collision := range collisions {
+			fmt.Printf("  ❌ %s\n", collision)
+		}
+		return fmt.Errorf("rename plan has %d collisions", len(collisions))
+	}
+
+	if opts.dryRun {
+		fmt.Printf("🔍 Dry run: %d repositories would be renamed. No changes were made.\n", len(plan))
+		return nil
+	}
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Rename %d repositories?", len(plan)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	oldNames := make([]string, len(plan))
+	for i, rename := range plan {
+		oldNames[i] = rename.OldName
+	}
+
+	run, pendingNames, err := startRun(opts.checkpoint, "rename-repos", owner, oldNames)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(pendingNames))
+	for _, name := range pendingNames {
+		pending[name] = true
+	}
+
+	var renames []ghbatch.RepoRename
+	for _, rename := range plan {
+		if pending[rename.OldName] {
+			renames = append(renames, rename)
+		}
+	}
+
+	successRepos, failedRepos := githubService.RenameRepos(ctx, owner, renames, recordRepoResult(run))
+	displayBatchResults("Repository Rename", owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to rename %d repositories", len(failedRepos))
+	}
+	return nil
+}
+
+func displayRenamePlan(owner string, plan []ghbatch.RepoRename) {
+	fmt.Printf("\n📋 Rename Plan (%d repositories):\n", len(plan))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, rename := range plan {
+		fmt.Printf("  %s/%s → %s\n", owner, rename.OldName, rename.NewName)
+	}
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// replaceOptions holds the flags of the replace command.
+type replaceOptions struct {
+	file       string
+	find       string
+	replace    string
+	regexp     bool
+	dryRun     bool
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newReplaceCmd() *cobra.Command {
+	opts := &replaceOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "replace",
+		Short: "Find and replace text in a file across repositories",
+		Long:  "Replace text in a file of each repository, e.g. a badge URL or an image tag in README.md, using a literal string or a regular expression. Only repositories where the file changes get a commit (or a pull request); --dry-run shows the diff of every repository without changing anything",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReplaceCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", "Path of the file within each repository, e.g. README.md (required)")
+	cmd.Flags().StringVar(&opts.find, "find", "", "Text to find (required)")
+	cmd.Flags().StringVar(&opts.replace, "replace", "", "Replacement text; with --regexp it may reference capture groups as $1 or ${name}")
+	cmd.Flags().BoolVar(&opts.regexp, "regexp", false, "Treat --find as a regular expression instead of literal text")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the diff for every repository without committing")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "find-and-replace", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("file")
+	cmd.MarkFlagRequired("find")
+
+	return cmd
+}
+
+func runReplaceCommand(opts *replaceOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	filePath := cleanRepoPath(opts.file)
+	if filePath == "" {
+		return fmt.Errorf("--file must be a file path within the repository")
+	}
+
+	edit, err := ghbatch.Substitution{Find: opts.find, Replace: opts.replace, Regexp: opts.regexp}.Edit()
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	commitMessage := fmt.Sprintf("Update %s", filePath)
+	rollout := ghbatch.FileRollout{
+		Path:          filePath,
+		Edit:          edit,
+		CommitMessage: commitMessage,
+	}
+
+	if opts.pr {
+		rollout.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: opts.branch,
+			Title:  commitMessage,
+			Body:   fmt.Sprintf("This pull request replaces `%s` with `%s` in %s.", opts.find, opts.replace, filePath),
+		}
+	}
+
+	if opts.dryRun {
+		for _, name := range repoNames(repos) {
+			if err := previewFileChange(ctx, githubService, owner, name, rollout); err != nil {
+				return err
+			}
+		}
+
+		fmt.Printf("\n🔍 Dry run: %d repositories checked. No changes were made.\n", len(repos))
+		return nil
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, repoNames(repos), opts.yes, rollout)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	run, names, err := startRun(opts.checkpoint, "replace", owner, repoNames(repos))
+	if err != nil {
+		return err
+	}
+	rollout.OnResult = recordRolloutResult(run, nil)
+
+	results := githubService.ApplyFileToRepos(ctx, owner, names, rollout)
+	if failed := displayFileRolloutResults("Find and Replace", owner, target.repoPrefix, filePath, results, isUser); failed > 0 {
+		return partialFailure("failed to update %s in %d repositories", filePath, failed)
+	}
+	return nil
+}
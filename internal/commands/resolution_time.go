@@ -0,0 +1,253 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// resolutionTimeOverall is the repository column of the org-wide row of exported resolution times.
+const resolutionTimeOverall = "(all)"
+
+// resolutionTimeReportOptions holds the flags of the resolution-time report command.
+type resolutionTimeReportOptions struct {
+	since  string
+	until  string
+	format string
+	output string
+}
+
+// resolutionTimeRow is a row of exported resolution times: a repository, or all repositories together.
+type resolutionTimeRow struct {
+	Repository   string  `json:"repository"`
+	IssuesClosed int     `json:"issues_closed"`
+	MedianHours  float64 `json:"median_hours"`
+	P90Hours     float64 `json:"p90_hours"`
+	Error        string  `json:"error,omitempty"`
+}
+
+// resolutionTimeExport is the JSON export of the resolution-time report.
+type resolutionTimeExport struct {
+	Owner        string              `json:"owner"`
+	Since        string              `json:"since"`
+	Until        string              `json:"until,omitempty"`
+	Repositories []resolutionTimeRow `json:"repositories"`
+	Overall      resolutionTimeRow   `json:"overall"`
+}
+
+func newResolutionTimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolution-time",
+		Short: "Report on how long issues stay open",
+		Long:  "Report on the time from opening to closing issues across repositories",
+	}
+
+	cmd.AddCommand(newResolutionTimeReportCmd())
+
+	return cmd
+}
+
+func newResolutionTimeReportCmd() *cobra.Command {
+	opts := &resolutionTimeReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the resolution time of issues",
+		Long:  "Compute the median and 90th percentile time from opening to closing of the issues closed in a date range, per repository and across all repositories. The report can be exported as CSV or JSON for dashboards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runResolutionTimeReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only include issues closed on or after this date (YYYY-MM-DD or RFC 3339, default: 30 days ago)")
+	cmd.Flags().StringVar(&opts.until, "until", "", "Only include issues closed on or before this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&opts.format, "format", reportFormatTable, "Report format: table, csv or json")
+	cmd.Flags().StringVar(&opts.output, "output", "", "File the csv or json report is written to (default: stdout)")
+
+	return cmd
+}
+
+func runResolutionTimeReportCommand(opts *resolutionTimeReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if err := validateReportFormat(opts.format, opts.output); err != nil {
+		return err
+	}
+
+	filter, err := buildReportDateRange(opts.since, opts.until, time.Now())
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.GetIssueResolutionTimes(ctx, owner, repoNames(repos), filter)
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+	rows, overall := resolutionTimeRows(results)
+
+	switch opts.format {
+	case reportFormatCSV:
+		err = writeReport(opts.output, func(w io.Writer) error { return writeResolutionTimesCSV(w, rows, overall) })
+	case reportFormatJSON:
+		export := resolutionTimeExport{
+			Owner:        owner,
+			Since:        filter.Since.Format(time.RFC3339),
+			Repositories: rows,
+			Overall:      overall,
+		}
+		if !filter.Until.IsZero() {
+			export.Until = filter.Until.Format(time.RFC3339)
+		}
+		err = writeReport(opts.output, func(w io.Writer) error { return writeJSON(w, export) })
+	default:
+		displayResolutionTimes(owner, filter, results, isUser)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.output != "" {
+		log.Info("Wrote resolution time report", "file", opts.output, "format", opts.format)
+	}
+
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return partialFailure("failed to get the issue resolution times of %d repositories", failed)
+	}
+	return nil
+}
+
+// resolutionTimeRows summarizes the resolution times of each repository and of all of them together.
+func resolutionTimeRows(results []ghbatch.RepoIssueResolutions) ([]resolutionTimeRow, resolutionTimeRow) {
+	var all []time.Duration
+	rows := make([]resolutionTimeRow, 0, len(results))
+
+	for _, result := range results {
+		row := resolutionTimeRow{Repository: result.RepoName}
+		if result.Err != nil {
+			row.Error = result.Err.Error()
+		} else {
+			durations := result.ResolutionTimes()
+			all = append(all, durations...)
+			row.setStats(ghbatch.SummarizeDurations(durations))
+		}
+		rows = append(rows, row)
+	}
+
+	overall := resolutionTimeRow{Repository: resolutionTimeOverall}
+	overall.setStats(ghbatch.SummarizeDurations(all))
+
+	return rows, overall
+}
+
+// setStats fills the counts and percentiles of the row, in hours rounded to two decimals.
+func (r *resolutionTimeRow) setStats(stats ghbatch.DurationStats) {
+	r.IssuesClosed = stats.Count
+	r.MedianHours = roundHours(stats.Median)
+	r.P90Hours = roundHours(stats.P90)
+}
+
+// roundHours converts a duration to hours rounded to two decimals.
+func roundHours(d time.Duration) float64 {
+	return float64(d.Round(36*time.Second)) / float64(time.Hour)
+}
+
+// writeResolutionTimesCSV writes one row per repository followed by the org-wide row.
+func writeResolutionTimesCSV(w io.Writer, rows []resolutionTimeRow, overall resolutionTimeRow) error {
+	records := make([][]string, 0, len(rows)+1)
+	for _, row := range append(rows, overall) {
+		records = append(records, []string{
+			row.Repository,
+			strconv.Itoa(row.IssuesClosed),
+			strconv.FormatFloat(row.MedianHours, 'f', 2, 64),
+			strconv.FormatFloat(row.P90Hours, 'f', 2, 64),
+			row.Error,
+		})
+	}
+
+	return writeCSV(w, []string{"repository", "issues_closed", "median_hours", "p90_hours", "error"}, records)
+}
+
+// displayResolutionTimes prints the resolution time of each repository with an org-wide summary.
+func displayResolutionTimes(owner string, filter ghbatch.IssueStatsFilter, results []ghbatch.RepoIssueResolutions, isUser bool) {
+	var all []time.Duration
+	var failed int
+
+	fmt.Printf("\n📋 Issue Resolution Time (%s):\n", formatDateRange("closed", filter))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REPOSITORY\tCLOSED\tMEDIAN\tP90")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+
+		durations := result.ResolutionTimes()
+		stats := ghbatch.SummarizeDurations(durations)
+		all = append(all, durations...)
+
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", result.RepoName, stats.Count, formatElapsed(stats.Median, stats.Count),
+			formatElapsed(stats.P90, stats.Count))
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+	fmt.Println()
+
+	overall := ghbatch.SummarizeDurations(all)
+
+	displaySummaryHeader(owner, target.repoPrefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✔️  Issues Closed: %d\n", overall.Count)
+	fmt.Printf("⏱️  Median: %s, P90: %s\n", formatElapsed(overall.Median, overall.Count), formatElapsed(overall.P90, overall.Count))
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be checked: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+}
@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// defaultReportDays is the number of days covered by the issue metric reports when --since is not set.
+const defaultReportDays = 30
+
+// responseTimeReportOptions holds the flags of the response-time report command.
+type responseTimeReportOptions struct {
+	since string
+	until string
+	slo   time.Duration
+}
+
+func newResponseTimeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "response-time",
+		Short: "Report on how fast issues get a first response",
+		Long:  "Report on the time issues wait for a first response across repositories",
+	}
+
+	cmd.AddCommand(newResponseTimeReportCmd())
+
+	return cmd
+}
+
+func newResponseTimeReportCmd() *cobra.Command {
+	opts := &responseTimeReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the time to first response of issues",
+		Long:  "Compute the median and 90th percentile time between the creation of an issue and the first comment by someone other than its author, for the issues created in a date range. Comments by bots are not responses. Repositories whose 90th percentile exceeds the SLO are flagged",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runResponseTimeReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only include issues created on or after this date (YYYY-MM-DD or RFC 3339, default: 30 days ago)")
+	cmd.Flags().StringVar(&opts.until, "until", "", "Only include issues created on or before this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().DurationVar(&opts.slo, "slo", 48*time.Hour, "Flag repositories whose 90th percentile time to first response exceeds this, e.g. 24h")
+
+	return cmd
+}
+
+// buildReportDateRange validates the --since and --until flags of the issue metric reports, defaulting the
+// start of the range to 30 days ago.
+func buildReportDateRange(since, until string, now time.Time) (ghbatch.IssueStatsFilter, error) {
+	filter, err := buildIssueStatsFilter(nil, since, until, "", "")
+	if err != nil {
+		return filter, err
+	}
+
+	if filter.Since.IsZero() {
+		filter.Since = now.AddDate(0, 0, -defaultReportDays).Truncate(24 * time.Hour)
+		if !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+			return filter, fmt.Errorf("--since is required when --until is more than %d days ago", defaultReportDays)
+		}
+	}
+
+	return filter, nil
+}
+
+func runResponseTimeReportCommand(opts *responseTimeReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.slo <= 0 {
+		return fmt.Errorf("--slo must be greater than zero")
+	}
+
+	filter, err := buildReportDateRange(opts.since, opts.until, time.Now())
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	results := githubService.GetIssueResponseTimes(ctx, owner, repoNames(repos), filter)
+	if failed := displayResponseTimes(owner, filter, opts.slo, results, isUser); failed > 0 {
+		return partialFailure("failed to get the issue response times of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayResponseTimes prints the time to first response of each repository with a summary and returns the
+// number of repositories that could not be checked.
+func displayResponseTimes(owner string, filter ghbatch.IssueStatsFilter, slo time.Duration, results []ghbatch.RepoIssueResponses,
+	isUser bool,
+) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var all []time.Duration
+	var issues, unanswered, breaching, failed int
+
+	fmt.Printf("\n📋 Time to First Response (%s):\n", formatDateRange("created", filter))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tISSUES\tRESPONDED\tUNANSWERED\tMEDIAN\tP90")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+
+		durations := result.ResponseTimes()
+		stats := ghbatch.SummarizeDurations(durations)
+		all = append(all, durations...)
+		issues += len(result.Issues)
+		unanswered += len(result.Issues) - stats.Count
+
+		icon := "✅"
+		switch {
+		case stats.Count == 0:
+			icon = "➖"
+		case stats.P90 > slo:
+			icon = "⏰"
+			breaching++
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\n", icon, result.RepoName, len(result.Issues), stats.Count,
+			len(result.Issues)-stats.Count, formatElapsed(stats.Median, stats.Count), formatElapsed(stats.P90, stats.Count))
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+	fmt.Println()
+
+	overall := ghbatch.SummarizeDurations(all)
+
+	displaySummaryHeader(owner, target.repoPrefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🐛 Issues Created: %d\n", issues)
+	fmt.Printf("💬 Responded: %d\n", overall.Count)
+	fmt.Printf("🔇 Unanswered: %d\n", unanswered)
+	fmt.Printf("⏱️  Median: %s, P90: %s\n", formatElapsed(overall.Median, overall.Count), formatElapsed(overall.P90, overall.Count))
+	fmt.Printf("⏰ Repositories exceeding the %s SLO: %d\n", formatElapsed(slo, 1), breaching)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be checked: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// formatDateRange describes the date range of an issue filter, e.g. "created since 2024-04-01".
+func formatDateRange(event string, filter ghbatch.IssueStatsFilter) string {
+	if filter.Until.IsZero() {
+		return fmt.Sprintf("%s since %s", event, filter.Since.Format(time.DateOnly))
+	}
+
+	return fmt.Sprintf("%s %s to %s", event, filter.Since.Format(time.DateOnly), filter.Until.Add(-time.Nanosecond).Format(time.DateOnly))
+}
+
+// formatElapsed formats a duration in days, hours and minutes, e.g. 2d 4h, or "-" when there is nothing to
+// measure (count is zero).
+func formatElapsed(d time.Duration, count int) string {
+	if count == 0 {
+		return "-"
+	}
+
+	d = d.Round(time.Minute)
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	minutes := int(d % time.Hour / time.Minute)
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
@@ -0,0 +1,220 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// noTeam groups the pull requests whose author is in no team of the organization.
+const noTeam = "(no team)"
+
+// reviewLatencyReportOptions holds the flags of the review-latency report command.
+type reviewLatencyReportOptions struct {
+	since       string
+	until       string
+	includeBots bool
+}
+
+func newReviewLatencyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "review-latency",
+		Short: "Report on pull request review turnaround",
+		Long:  "Report on how fast pull requests get reviewed and merged across repositories",
+	}
+
+	cmd.AddCommand(newReviewLatencyReportCmd())
+
+	return cmd
+}
+
+func newReviewLatencyReportCmd() *cobra.Command {
+	opts := &reviewLatencyReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report the time to first review and to merge of pull requests",
+		Long:  "Compute the median and 90th percentile time from a pull request being ready for review to its first review and to its merge, for the pull requests opened in a date range, per repository and, for organizations, per team of the author. Pull requests opened as drafts are measured from when they were first marked ready for review, and those still in draft are left out. Reviews by the author or by bots are not counted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReviewLatencyReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only include pull requests opened on or after this date (YYYY-MM-DD or RFC 3339, default: 30 days ago)")
+	cmd.Flags().StringVar(&opts.until, "until", "", "Only include pull requests opened on or before this date (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().BoolVar(&opts.includeBots, "include-bots", false, "Include pull requests opened by bots such as Dependabot")
+
+	return cmd
+}
+
+func runReviewLatencyReportCommand(opts *reviewLatencyReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	filter, err := buildReportDateRange(opts.since, opts.until, time.Now())
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Teams only exist in organizations
+	var memberships map[string][]string
+	if !isUser {
+		memberships, err = githubService.GetTeamMemberships(ctx, owner)
+		if err != nil {
+			log.Warn("Failed to list teams, skipping the per-team breakdown", "org", owner, "error", err)
+		}
+	}
+
+	results := githubService.GetPullRequestLatencies(ctx, owner, repoNames(repos), filter)
+	if !opts.includeBots {
+		results = withoutBotPullRequests(results)
+	}
+
+	if failed := displayReviewLatencies(owner, filter, results, memberships, isUser); failed > 0 {
+		return partialFailure("failed to get the pull request review latencies of %d repositories", failed)
+	}
+	return nil
+}
+
+// withoutBotPullRequests drops the pull requests opened by bots.
+func withoutBotPullRequests(results []ghbatch.RepoPullRequestLatencies) []ghbatch.RepoPullRequestLatencies {
+	for i := range results {
+		var prs []ghbatch.PullRequestLatency
+		for _, pr := range results[i].PullRequests {
+			if !pr.Bot {
+				prs = append(prs, pr)
+			}
+		}
+		results[i].PullRequests = prs
+	}
+
+	return results
+}
+
+// displayReviewLatencies prints the review latencies of each repository and, when memberships are known, of
+// each team with a summary, and returns the number of repositories that could not be checked.
+func displayReviewLatencies(owner string, filter ghbatch.IssueStatsFilter, results []ghbatch.RepoPullRequestLatencies,
+	memberships map[string][]string, isUser bool,
+) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	var all []ghbatch.PullRequestLatency
+	var failed int
+	byTeam := make(map[string][]ghbatch.PullRequestLatency)
+
+	fmt.Printf("\n📋 Review Latency by Repository (%s):\n", formatDateRange("opened", filter))
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	writeReviewLatencyHeader(w, "REPOSITORY")
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			continue
+		}
+
+		all = append(all, result.PullRequests...)
+		for _, pr := range result.PullRequests {
+			teams := memberships[pr.Author]
+			if len(teams) == 0 {
+				teams = []string{noTeam}
+			}
+			for _, team := range teams {
+				byTeam[team] = append(byTeam[team], pr)
+			}
+		}
+
+		writeReviewLatencyRow(w, result.RepoName, ghbatch.SummarizeReviewLatencies(result.PullRequests))
+	}
+	w.Flush()
+
+	for _, result := range results {
+		if result.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, result.RepoName, result.Err)
+		}
+	}
+
+	if memberships != nil {
+		teams := make([]string, 0, len(byTeam))
+		for team := range byTeam {
+			teams = append(teams, team)
+		}
+		sort.Strings(teams)
+
+		fmt.Printf("\n👥 Review Latency by Team of the Author:\n")
+		fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		writeReviewLatencyHeader(w, "TEAM")
+		for _, team := range teams {
+			writeReviewLatencyRow(w, team, ghbatch.SummarizeReviewLatencies(byTeam[team]))
+		}
+		w.Flush()
+	}
+	fmt.Println()
+
+	overall := ghbatch.SummarizeReviewLatencies(all)
+
+	displaySummaryHeader(owner, target.repoPrefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🔀 Pull Requests: %d\n", overall.PullRequests)
+	fmt.Printf("👀 Reviewed: %d (median %s, P90 %s to first review)\n", overall.FirstReview.Count,
+		formatElapsed(overall.FirstReview.Median, overall.FirstReview.Count), formatElapsed(overall.FirstReview.P90, overall.FirstReview.Count))
+	fmt.Printf("✅ Merged: %d (median %s, P90 %s to merge)\n", overall.Merge.Count,
+		formatElapsed(overall.Merge.Median, overall.Merge.Count), formatElapsed(overall.Merge.P90, overall.Merge.Count))
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be checked: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// writeReviewLatencyHeader writes the header of a review latency table grouped by the given column.
+func writeReviewLatencyHeader(w io.Writer, group string) {
+	fmt.Fprintf(w, "%s\tPRS\tREVIEWED\tREVIEW MEDIAN\tREVIEW P90\tMERGED\tMERGE MEDIAN\tMERGE P90\n", group)
+}
+
+// writeReviewLatencyRow writes the review latencies of a repository or team.
+func writeReviewLatencyRow(w io.Writer, name string, stats ghbatch.ReviewLatencyStats) {
+	fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%d\t%s\t%s\n", name, stats.PullRequests,
+		stats.FirstReview.Count, formatElapsed(stats.FirstReview.Median, stats.FirstReview.Count),
+		formatElapsed(stats.FirstReview.P90, stats.FirstReview.Count),
+		stats.Merge.Count, formatElapsed(stats.Merge.Median, stats.Merge.Count),
+		formatElapsed(stats.Merge.P90, stats.Merge.Count))
+}
@@ -0,0 +1,215 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/audit"
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// rollbackOptions holds the flags of the rollback command.
+type rollbackOptions struct {
+	run    string
+	force  bool
+	pr     bool
+	branch string
+	dryRun bool
+	yes    bool
+}
+
+func newRollbackCmd() *cobra.Command {
+	opts := &rollbackOptions{}
+
+	cmd := &cobra.Command{
+		Use:         "rollback",
+		Short:       "Revert the file changes of a batch run",
+		Long:        "Revert the files committed or deleted by a batch run, as recorded in the audit log: files the run created are deleted and changed files get their previous content back. Files changed again since the run are reported as conflicts and left alone unless --force is passed. Changes the run only proposed in pull requests are not touched; close those pull requests instead",
+		Annotations: map[string]string{ownerIndependentAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRollbackCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.run, "run", "", "ID of the run to revert, as printed in its summary and recorded in the audit log (required)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Also restore files that were changed again after the run")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request per repository instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "", "Branch used for pull requests (default: rollback-<run>)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only list the files that would be restored")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("run")
+
+	return cmd
+}
+
+func runRollbackCommand(opts *rollbackOptions) error {
+	log := logger.GetLogger()
+
+	if opts.run == "" {
+		return fmt.Errorf("run ID (--run) is required")
+	}
+
+	path, err := auditLogPath()
+	if err != nil {
+		return err
+	}
+
+	records, err := audit.Read(path)
+	if err != nil {
+		return err
+	}
+
+	plan := ghbatch.PlanRollback(records, opts.run)
+	if plan.Proposed > 0 {
+		fmt.Printf("ℹ️  %d changes of run %s were proposed in pull requests and are not reverted; close those pull requests instead\n", plan.Proposed, opts.run)
+	}
+
+	if len(plan.Restores) == 0 {
+		log.Info("No file changes recorded for the run", "run", opts.run, "auditLog", path)
+		return nil
+	}
+
+	owners := make([]string, 0, len(plan.Restores))
+	files := 0
+	for owner, restores := range plan.Restores {
+		owners = append(owners, owner)
+		for _, restore := range restores {
+			files += len(restore.Files)
+		}
+	}
+	sort.Strings(owners)
+
+	displayRollbackPlan(opts.run, owners, plan)
+
+	if opts.dryRun {
+		fmt.Printf("🔍 Dry run: %d files would be restored. No changes were made.\n", files)
+		return nil
+	}
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Restore %d files?", files))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	restoreOpts := ghbatch.RestoreOptions{
+		CommitMessage: fmt.Sprintf("Roll back changes of run %s", opts.run),
+		Force:         opts.force,
+	}
+
+	if opts.pr {
+		branch := opts.branch
+		if branch == "" {
+			branch = "rollback-" + opts.run
+		}
+		restoreOpts.PullRequest = &ghbatch.PullRequestOptions{
+			Branch: branch,
+			Title:  restoreOpts.CommitMessage,
+			Body:   fmt.Sprintf("This pull request reverts the files changed by the go-repo-manager run %s.", opts.run),
+		}
+	}
+
+	var failed int
+	for _, owner := range owners {
+		results := githubService.RestoreFiles(ctx, owner, plan.Restores[owner], restoreOpts)
+		failed += displayRestoreResults(owner, results)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to restore %d files", failed)
+	}
+	return nil
+}
+
+func displayRollbackPlan(runID string, owners []string, plan ghbatch.RollbackPlan) {
+	fmt.Printf("\n📋 Rollback Plan for run %s:\n", runID)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, owner := range owners {
+		for _, restore := range plan.Restores[owner] {
+			for _, file := range restore.Files {
+				action := "restore"
+				if file.OldSHA == "" {
+					action = "delete"
+				}
+				fmt.Printf("  %s/%s: %s (%s)\n", owner, restore.RepoName, file.Path, action)
+			}
+		}
+	}
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+}
+
+// displayRestoreResults prints the per-file outcome of a rollback with a summary and returns the number of
+// files and repositories that failed.
+func displayRestoreResults(owner string, results []ghbatch.RepoRestoreResult) int {
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.RestoreStatusRestored:  "✅",
+		ghbatch.RestoreStatusDeleted:   "🗑️ ",
+		ghbatch.RestoreStatusUnchanged: "➖",
+		ghbatch.RestoreStatusConflict:  "⚠️ ",
+		ghbatch.RestoreStatusFailed:    "❌",
+	}
+
+	failedRepos := 0
+
+	fmt.Println("\n📋 Rollback Results:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		header := fmt.Sprintf("%s/%s", owner, result.RepoName)
+		if result.PullRequestURL != "" {
+			header += " → " + result.PullRequestURL
+		}
+		if result.Err != nil {
+			failedRepos++
+			header = "❌ " + header + ": " + result.Err.Error()
+		}
+		fmt.Println(header)
+
+		for _, file := range result.Files {
+			counts[file.Status]++
+
+			line := fmt.Sprintf("  %s %s (%s)", icons[file.Status], file.Path, strings.ToUpper(file.Status))
+			if file.Err != nil {
+				line += ": " + file.Err.Error()
+			}
+			fmt.Println(line)
+		}
+	}
+	fmt.Println()
+
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+	fmt.Printf("📊 SUMMARY for %s:\n", owner)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Restored: %d\n", counts[ghbatch.RestoreStatusRestored])
+	fmt.Printf("🗑️  Deleted: %d\n", counts[ghbatch.RestoreStatusDeleted])
+	fmt.Printf("➖ Unchanged: %d\n", counts[ghbatch.RestoreStatusUnchanged])
+	if counts[ghbatch.RestoreStatusConflict] > 0 {
+		fmt.Printf("⚠️  Conflicts (changed since the run, use --force to restore anyway): %d\n", counts[ghbatch.RestoreStatusConflict])
+	}
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.RestoreStatusFailed]+failedRepos)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.RestoreStatusFailed] + failedRepos
+}
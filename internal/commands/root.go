@@ -2,24 +2,155 @@ package commands
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 )
 
 var rootCmd = &cobra.Command{
-	Use:   "go-repo-manager",
-	Short: "A CLI tool to manage Go repositories",
-	Long:  `A command-line interface for managing multiple Go repositories efficiently.`,
+	Use:               "go-repo-manager",
+	Short:             "A CLI tool to manage Go repositories",
+	Long:              `A command-line interface for managing multiple Go repositories efficiently.`,
+	PersistentPreRunE: setupRun,
 }
 
-func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+// setupRun applies the global flags before any command runs.
+func setupRun(cmd *cobra.Command, args []string) error {
+	if err := setupLogging(cmd); err != nil {
+		return err
+	}
+
+	if err := setupTarget(); err != nil {
+		return err
+	}
+
+	setupMetrics(cmd)
+
+	if err := setupTracing(); err != nil {
+		return err
+	}
+
+	if err := validateHooks(); err != nil {
+		return err
+	}
+
+	if err := validateTimeout(); err != nil {
+		return err
+	}
+
+	if err := enableResponseCache(); err != nil {
+		return err
+	}
+
+	if err := enableAuditLog(); err != nil {
+		return err
+	}
+
+	if err := enableCommitSigning(); err != nil {
+		return err
 	}
+
+	if err := enableCommitIdentity(); err != nil {
+		return err
+	}
+
+	// Flags and arguments are valid, so errors from here on are failures of the run rather than usage errors.
+	cmd.SilenceUsage = true
+
+	return nil
+}
+
+// Execute runs the command selected by the arguments and exits with the exit code of its outcome.
+func Execute() {
+	start := time.Now()
+	err := rootCmd.Execute()
+	finishMetrics(start, err)
+	finishTracing(err)
+
+	os.Exit(exitCode(err))
 }
 
 func init() {
+	addTargetFlags(rootCmd.PersistentFlags())
+	addLogFlags(rootCmd.PersistentFlags())
+	addDiscoveryFlags(rootCmd.PersistentFlags())
+	addCacheFlags(rootCmd.PersistentFlags())
+	addAuditLogFlags(rootCmd.PersistentFlags())
+	addTimeoutFlags(rootCmd.PersistentFlags())
+	addExitCodeFlags(rootCmd.PersistentFlags())
+	addSigningFlags(rootCmd.PersistentFlags())
+	addCommitIdentityFlags(rootCmd.PersistentFlags())
+	addMetricsFlags(rootCmd.PersistentFlags())
+	addTracingFlags(rootCmd.PersistentFlags())
+	addHookFlags(rootCmd.PersistentFlags())
+
 	// Initialize subcommands here
 	rootCmd.AddCommand(newGetIssueCountCmd())
+	rootCmd.AddCommand(newDiscussionsCmd())
+	rootCmd.AddCommand(newSearchIssuesCmd())
+	rootCmd.AddCommand(newExportCmd())
+	rootCmd.AddCommand(newBackupCmd())
+	rootCmd.AddCommand(newImportCmd())
 	rootCmd.AddCommand(newCodeownersCmd())
+	rootCmd.AddCommand(newArchiveCmd())
+	rootCmd.AddCommand(newUnarchiveCmd())
+	rootCmd.AddCommand(newDeleteReposCmd())
+	rootCmd.AddCommand(newVisibilityCmd())
+	rootCmd.AddCommand(newTransferCmd())
+	rootCmd.AddCommand(newRenameReposCmd())
+	rootCmd.AddCommand(newMoveFileCmd())
+	rootCmd.AddCommand(newReplaceCmd())
+	rootCmd.AddCommand(newCreateReposCmd())
+	rootCmd.AddCommand(newCloneCmd())
+	rootCmd.AddCommand(newLicenseCmd())
+	rootCmd.AddCommand(newDependabotCmd())
+	rootCmd.AddCommand(newWorkflowsCmd())
+	rootCmd.AddCommand(newIssueTemplatesCmd())
+	rootCmd.AddCommand(newPRTemplateCmd())
+	rootCmd.AddCommand(newFundingCmd())
+	rootCmd.AddCommand(newGitignoreCmd())
+	rootCmd.AddCommand(newLintConfigCmd())
+	rootCmd.AddCommand(newCloseStaleIssuesCmd())
+	rootCmd.AddCommand(newLockIssuesCmd())
+	rootCmd.AddCommand(newLabelIssuesCmd())
+	rootCmd.AddCommand(newAssignIssuesCmd())
+	rootCmd.AddCommand(newAssignMilestoneCmd())
+	rootCmd.AddCommand(newProjectCmd())
+	rootCmd.AddCommand(newCommentCmd())
+	rootCmd.AddCommand(newCreateIssueCmd())
+	rootCmd.AddCommand(newMergePRsCmd())
+	rootCmd.AddCommand(newApprovePRsCmd())
+	rootCmd.AddCommand(newClosePRsCmd())
+	rootCmd.AddCommand(newAuditCmd())
+	rootCmd.AddCommand(newCheckCmd())
+	rootCmd.AddCommand(newDriftCmd())
+	rootCmd.AddCommand(newSyncCmd())
+	rootCmd.AddCommand(newSecurityCmd())
+	rootCmd.AddCommand(newTrafficCmd())
+	rootCmd.AddCommand(newReleasesCmd())
+	rootCmd.AddCommand(newDepsCmd())
+	rootCmd.AddCommand(newSizeCmd())
+	rootCmd.AddCommand(newPRAgeCmd())
+	rootCmd.AddCommand(newResponseTimeCmd())
+	rootCmd.AddCommand(newResolutionTimeCmd())
+	rootCmd.AddCommand(newReviewLatencyCmd())
+	rootCmd.AddCommand(newActionsPermissionsCmd())
+	rootCmd.AddCommand(newRulesetsCmd())
+	rootCmd.AddCommand(newTagProtectionCmd())
+	rootCmd.AddCommand(newMergeQueueCmd())
+	rootCmd.AddCommand(newAutolinksCmd())
+	rootCmd.AddCommand(newPropertiesCmd())
+	rootCmd.AddCommand(newEnvironmentsCmd())
+	rootCmd.AddCommand(newSecretsCmd())
+	rootCmd.AddCommand(newRunnersCmd())
+	rootCmd.AddCommand(newPagesCmd())
+	rootCmd.AddCommand(newCacheCmd())
+	rootCmd.AddCommand(newRollbackCmd())
+	rootCmd.AddCommand(newPluginCmd())
+	rootCmd.AddCommand(newServeCmd())
+	rootCmd.AddCommand(newLoginCmd())
+	rootCmd.AddCommand(newWhoamiCmd())
+
+	runForEachOwner(rootCmd)
+	registerCompletions(rootCmd)
 }
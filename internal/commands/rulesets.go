@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/spec"
+	"go-repo-manager/internal/textdiff"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// rulesetsApplyOptions holds the flags of the rulesets apply command.
+type rulesetsApplyOptions struct {
+	file   string
+	dryRun bool
+	diff   bool
+	yes    bool
+}
+
+func newRulesetsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rulesets",
+		Short: "Manage repository rulesets",
+		Long:  "Manage repository rulesets, the successor of branch protection rules, across repositories",
+	}
+
+	cmd.AddCommand(newRulesetsApplyCmd())
+
+	return cmd
+}
+
+func newRulesetsApplyCmd() *cobra.Command {
+	opts := &rulesetsApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update rulesets in repositories from a definition file",
+		Long:  "Create the rulesets of a JSON or YAML definition file in repositories that lack them and update those whose definition differs, matching rulesets by name. Branch and tag rulesets are supported. The changes are compared with the existing rulesets and listed for confirmation before anything is written",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRulesetsApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.file, "file", "", "Path to a JSON or YAML file with a ruleset or a list of rulesets (required)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only report the rulesets that would be created or updated")
+	cmd.Flags().BoolVar(&opts.diff, "diff", false, "Print the difference between the existing and the desired definition of changed rulesets")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the comparison preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runRulesetsApplyCommand(opts *rulesetsApplyOptions) error {
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	rulesets, err := spec.LoadRulesets(opts.file)
+	if err != nil {
+		return err
+	}
+
+	return applyRulesets(rulesets, opts.dryRun, opts.diff, opts.yes)
+}
+
+// applyRulesets compares the rulesets with those of the target repositories and, after confirmation unless
+// yes is set, creates or updates them where they differ. With dryRun only the comparison is reported.
+func applyRulesets(rulesets []ghbatch.Ruleset, dryRun, diff, yes bool) error {
+	log := logger.GetLogger()
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	return applyRulesetsToRepos(ctx, githubService, owner, repoNames(repos), rulesets, dryRun, diff, yes, isUser)
+}
+
+// applyRulesetsToRepos compares the rulesets with those of the named repositories and, after confirmation
+// unless yes is set, creates or updates them where they differ. With dryRun only the comparison is reported.
+func applyRulesetsToRepos(ctx context.Context, githubService ghbatch.GitHubClient, owner string, names []string,
+	rulesets []ghbatch.Ruleset, dryRun, diff, yes, isUser bool,
+) error {
+	log := logger.GetLogger()
+
+	if dryRun || !yes {
+		results := githubService.ApplyRulesets(ctx, owner, names, rulesets, true)
+		failed := displayRulesetResults("Ruleset Comparison", owner, target.repoPrefix, results, diff, true, isUser)
+		if dryRun {
+			if failed > 0 {
+				return partialFailure("failed to compare rulesets of %d repositories", failed)
+			}
+			return nil
+		}
+
+		names = nil
+		for _, result := range results {
+			if result.Err == nil && result.Changed() {
+				names = append(names, result.RepoName)
+			}
+		}
+
+		if len(names) == 0 {
+			log.Info("All rulesets are up to date", "owner", owner, "prefix", target.repoPrefix)
+			return nil
+		}
+
+		confirmed, err := confirmAction(fmt.Sprintf("Create or update rulesets in %d repositories?", len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.ApplyRulesets(ctx, owner, names, rulesets, false)
+	if failed := displayRulesetResults("Ruleset Rollout", owner, target.repoPrefix, results, false, false, isUser); failed > 0 {
+		return partialFailure("failed to apply rulesets to %d repositories", failed)
+	}
+	return nil
+}
+
+// displayRulesetResults prints the ruleset changes per repository with a summary and returns the number of
+// failed repositories. With dryRun the changes are reported as pending; with diff the definition changes
+// are printed below each repository.
+func displayRulesetResults(title, owner, prefix string, results []ghbatch.RulesetResult, diff, dryRun, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	labels := map[string]string{
+		ghbatch.RulesetStatusCreated:   "created",
+		ghbatch.RulesetStatusUpdated:   "updated",
+		ghbatch.RulesetStatusUnchanged: "unchanged",
+	}
+	if dryRun {
+		labels[ghbatch.RulesetStatusCreated] = "would create"
+		labels[ghbatch.RulesetStatusUpdated] = "would update"
+	}
+
+	counts := map[string]int{}
+	var changed, failed int
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("  ❌ %s/%s (FAILED): %v\n", owner, result.RepoName, result.Err)
+			continue
+		}
+
+		var details []string
+		for _, change := range result.Changes {
+			counts[change.Status]++
+			details = append(details, fmt.Sprintf("%s (%s)", change.Name, labels[change.Status]))
+		}
+
+		icon := "➖"
+		if result.Changed() {
+			changed++
+			icon = "🔄"
+		}
+		fmt.Printf("  %s %s/%s: %s\n", icon, owner, result.RepoName, strings.Join(details, ", "))
+
+		if !diff {
+			continue
+		}
+		for _, change := range result.Changes {
+			oldName := "a/" + change.Name
+			switch change.Status {
+			case ghbatch.RulesetStatusUnchanged:
+				continue
+			case ghbatch.RulesetStatusCreated:
+				oldName = "/dev/null"
+			}
+			fmt.Print(textdiff.Unified(oldName, "b/"+change.Name, change.Current, change.Desired, diffContextLines))
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("🔄 Repositories with changes: %d\n", changed)
+	fmt.Printf("   Rulesets %s: %d\n", labels[ghbatch.RulesetStatusCreated], counts[ghbatch.RulesetStatusCreated])
+	fmt.Printf("   Rulesets %s: %d\n", labels[ghbatch.RulesetStatusUpdated], counts[ghbatch.RulesetStatusUpdated])
+	fmt.Printf("   Rulesets unchanged: %d\n", counts[ghbatch.RulesetStatusUnchanged])
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
@@ -0,0 +1,169 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// errRunnersNeedOrg is returned when runner groups are used with a user account.
+var errRunnersNeedOrg = errors.New("runner groups are only available for organizations")
+
+// runnersAssignOptions holds the flags of the runners assign command.
+type runnersAssignOptions struct {
+	group string
+	yes   bool
+}
+
+func newRunnersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runners",
+		Short: "Manage access to self-hosted runners",
+		Long:  "Manage which repositories can use the self-hosted runner groups of an organization",
+	}
+
+	cmd.AddCommand(newRunnersAssignCmd())
+
+	return cmd
+}
+
+func newRunnersAssignCmd() *cobra.Command {
+	opts := &runnersAssignOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "assign",
+		Short: "Give repositories access to a runner group",
+		Long:  "Give repositories access to an organization runner group limited to selected repositories. Repositories that already have access are reported and left alone",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRunnersAssignCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.group, "group", "", "Name of the runner group (required)")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("group")
+
+	return cmd
+}
+
+func runRunnersAssignCommand(opts *runnersAssignOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+	if isUser {
+		return errRunnersNeedOrg
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	group, err := githubService.GetRunnerGroup(ctx, owner, opts.group)
+	if err != nil {
+		return err
+	}
+
+	// Only groups limited to selected repositories have a repository list to add to
+	if group.GetVisibility() != ghbatch.RunnerGroupVisibilitySelected {
+		return fmt.Errorf("runner group %s is available to %s repositories; limit it to selected repositories to assign repositories", opts.group, group.GetVisibility())
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	current, err := githubService.ListRunnerGroupRepos(ctx, owner, group.GetID())
+	if err != nil {
+		return err
+	}
+
+	var pending []*github.Repository
+	var alreadyAssigned []string
+	for _, repository := range repos {
+		if current[repository.GetName()] {
+			alreadyAssigned = append(alreadyAssigned, repository.GetName())
+		} else {
+			pending = append(pending, repository)
+		}
+	}
+
+	if len(pending) == 0 {
+		displayRunnerGroupResults(owner, target.repoPrefix, opts.group, nil, alreadyAssigned, nil, isUser)
+		return nil
+	}
+
+	if !opts.yes {
+		displayRepoList("Repositories to assign", owner, repoNames(pending))
+
+		confirmed, err := confirmAction(fmt.Sprintf("Give %d repositories access to runner group %s?", len(pending), opts.group))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.AssignReposToRunnerGroup(ctx, owner, group.GetID(), pending)
+	displayRunnerGroupResults(owner, target.repoPrefix, opts.group, successRepos, alreadyAssigned, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to assign %d repositories to runner group %s", len(failedRepos), opts.group)
+	}
+	return nil
+}
+
+// displayRunnerGroupResults prints the repositories assigned to a runner group, those that already had access
+// and those that failed, with a summary.
+func displayRunnerGroupResults(owner, prefix, group string, assigned, alreadyAssigned, failed []string, isUser bool) {
+	sort.Strings(assigned)
+	sort.Strings(alreadyAssigned)
+	sort.Strings(failed)
+
+	fmt.Printf("\n📋 Runner Group Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, name := range assigned {
+		fmt.Printf("  ✅ %s/%s (ASSIGNED)\n", owner, name)
+	}
+	for _, name := range alreadyAssigned {
+		fmt.Printf("  ➖ %s/%s (ALREADY ASSIGNED)\n", owner, name)
+	}
+	for _, name := range failed {
+		fmt.Printf("  ❌ %s/%s (FAILED)\n", owner, name)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(assigned)+len(alreadyAssigned)+len(failed))
+	fmt.Printf("✅ Assigned: %d\n", len(assigned))
+	fmt.Printf("➖ Already assigned: %d\n", len(alreadyAssigned))
+	fmt.Printf("❌ Failed: %d\n", len(failed))
+	fmt.Printf("🏃 Runner group: %s\n", group)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// searchIssuesOptions holds the flags of the search-issues command.
+type searchIssuesOptions struct {
+	query  string
+	format string
+	output string
+}
+
+func newSearchIssuesCmd() *cobra.Command {
+	opts := &searchIssuesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "search-issues",
+		Short: "Search issues across repositories",
+		Long:  "Run a GitHub issue search query, e.g. \"label:security state:open\", over a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and list the matching issues with their repository, number, title and URL",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearchIssuesCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.query, "query", "", "GitHub issue search query, without repo:, org: or user: qualifiers (required)")
+	cmd.Flags().StringVar(&opts.format, "format", reportFormatTable, "Output format: table, csv or json")
+	cmd.Flags().StringVar(&opts.output, "output", "", "File the csv or json results are written to (default: stdout)")
+
+	cmd.MarkFlagRequired("query")
+
+	return cmd
+}
+
+func runSearchIssuesCommand(opts *searchIssuesOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	opts.query = strings.TrimSpace(opts.query)
+	if opts.query == "" {
+		return fmt.Errorf("--query must not be empty")
+	}
+	for _, field := range strings.Fields(opts.query) {
+		qualifier, _, _ := strings.Cut(strings.TrimPrefix(field, "-"), ":")
+		if qualifier == "repo" || qualifier == "org" || qualifier == "user" {
+			return fmt.Errorf("--query must not contain %s: qualifiers, the repositories are selected with --org, --username, --repo and --repo-prefix", qualifier)
+		}
+	}
+
+	if err := validateReportFormat(opts.format, opts.output); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	search, err := githubService.SearchIssues(ctx, owner, isUser, repoNames(repos), opts.query)
+	if err != nil {
+		log.Error("Failed to search issues", "owner", owner, "query", opts.query, "error", err)
+		return err
+	}
+
+	sort.Slice(search.Issues, func(i, j int) bool {
+		if search.Issues[i].RepoName != search.Issues[j].RepoName {
+			return search.Issues[i].RepoName < search.Issues[j].RepoName
+		}
+		return search.Issues[i].Number < search.Issues[j].Number
+	})
+
+	if search.Truncated {
+		log.Warn("The search matched more issues than GitHub returns, narrow the query to see them all",
+			"query", search.Query, "matches", search.Total)
+	}
+
+	switch opts.format {
+	case reportFormatCSV:
+		err = writeReport(opts.output, func(w io.Writer) error { return writeIssueSearchCSV(w, search.Issues) })
+	case reportFormatJSON:
+		err = writeReport(opts.output, func(w io.Writer) error { return writeJSON(w, nonNil(search.Issues)) })
+	default:
+		displayIssueSearch(owner, search, len(repos), isUser)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.output != "" {
+		log.Info("Wrote issue search results", "file", opts.output, "format", opts.format, "issues", len(search.Issues))
+	}
+	return nil
+}
+
+// writeIssueSearchCSV writes one row per matching issue.
+func writeIssueSearchCSV(w io.Writer, issues []ghbatch.IssueSearchResult) error {
+	records := make([][]string, 0, len(issues))
+	for _, issue := range issues {
+		records = append(records, []string{
+			issue.RepoName, strconv.Itoa(issue.Number), issue.Title, issue.State, issue.URL, strings.Join(issue.Labels, ","),
+		})
+	}
+
+	return writeCSV(w, []string{"repository", "number", "title", "state", "url", "labels"}, records)
+}
+
+// displayIssueSearch prints the matching issues with a summary.
+func displayIssueSearch(owner string, search ghbatch.IssueSearch, repoCount int, isUser bool) {
+	fmt.Printf("\n🔍 Issues matching %q:\n", search.Query)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tSTATE\tTITLE\tURL")
+	repos := make(map[string]bool)
+	for _, issue := range search.Issues {
+		repos[issue.RepoName] = true
+		fmt.Fprintf(w, "%s#%d\t%s\t%s\t%s\n", issue.RepoName, issue.Number, issue.State, issue.Title, issue.URL)
+	}
+	w.Flush()
+	fmt.Println()
+
+	displaySummaryHeader(owner, target.repoPrefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", repoCount)
+	fmt.Printf("🐛 Matching Issues: %d\n", len(search.Issues))
+	fmt.Printf("📂 Repositories with matches: %d\n", len(repos))
+	if search.Truncated {
+		fmt.Printf("⚠️  Truncated: GitHub returns at most 1000 of the %d matches\n", search.Total)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+}
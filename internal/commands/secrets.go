@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// secretsSetOptions holds the flags of the secrets set command.
+type secretsSetOptions struct {
+	name        string
+	valueEnv    string
+	valueFile   string
+	environment string
+	yes         bool
+}
+
+func newSecretsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Manage GitHub Actions secrets",
+		Long:  "Manage GitHub Actions secrets of repositories and their deployment environments",
+	}
+
+	cmd.AddCommand(newSecretsSetCmd())
+
+	return cmd
+}
+
+func newSecretsSetCmd() *cobra.Command {
+	opts := &secretsSetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Create or rotate a secret in repositories",
+		Long:  "Create an Actions secret in repositories, or rotate it where it already exists. With --environment the secret is scoped to that deployment environment, which is created in repositories that lack it. The value is read from an environment variable or a file so that it never appears in the shell history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSecretsSetCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name of the secret (required)")
+	cmd.Flags().StringVar(&opts.valueEnv, "value-env", "", "Environment variable holding the secret value")
+	cmd.Flags().StringVar(&opts.valueFile, "value-file", "", "File holding the secret value; a trailing newline is dropped")
+	cmd.Flags().StringVar(&opts.environment, "environment", "", "Deployment environment to scope the secret to, e.g. production")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+// readSecretValue reads the secret value from the environment variable or the file given by the flags.
+func readSecretValue(opts *secretsSetOptions) (string, error) {
+	if (opts.valueEnv == "") == (opts.valueFile == "") {
+		return "", fmt.Errorf("exactly one of --value-env or --value-file is required")
+	}
+
+	var value string
+	if opts.valueEnv != "" {
+		var ok bool
+		if value, ok = os.LookupEnv(opts.valueEnv); !ok {
+			return "", fmt.Errorf("environment variable %s is not set", opts.valueEnv)
+		}
+	} else {
+		content, err := os.ReadFile(opts.valueFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret value file %s: %w", opts.valueFile, err)
+		}
+		value = strings.TrimSuffix(strings.TrimSuffix(string(content), "\n"), "\r")
+	}
+
+	if value == "" {
+		return "", fmt.Errorf("secret value is empty")
+	}
+
+	return value, nil
+}
+
+func runSecretsSetCommand(opts *secretsSetOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if err := ghbatch.ValidateSecretName(opts.name); err != nil {
+		return err
+	}
+
+	value, err := readSecretValue(opts)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, names)
+
+		prompt := fmt.Sprintf("Set secret %s in %d repositories?", opts.name, len(names))
+		if opts.environment != "" {
+			prompt = fmt.Sprintf("Set secret %s in environment %s of %d repositories?", opts.name, opts.environment, len(names))
+		}
+
+		confirmed, err := confirmAction(prompt)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := githubService.SetSecretInRepos(ctx, owner, names, opts.environment, opts.name, value)
+	if failed := displaySecretResults(owner, target.repoPrefix, opts.name, opts.environment, results, isUser); failed > 0 {
+		return partialFailure("failed to set secret in %d repositories", failed)
+	}
+	return nil
+}
+
+// displaySecretResults prints the per-repository outcome of setting a secret with a summary and returns the
+// number of failed repositories.
+func displaySecretResults(owner, prefix, name, environment string, results []ghbatch.SecretResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	var environmentsCreated, failed int
+
+	fmt.Printf("\n📋 Secret Results:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			fmt.Printf("  ❌ %s/%s (FAILED): %v\n", owner, result.RepoName, result.Err)
+			continue
+		}
+
+		counts[result.Status]++
+		line := fmt.Sprintf("  ✅ %s/%s (%s)", owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.EnvironmentCreated {
+			environmentsCreated++
+			line += ", environment created"
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Created: %d\n", counts[ghbatch.SecretStatusCreated])
+	fmt.Printf("🔄 Rotated: %d\n", counts[ghbatch.SecretStatusUpdated])
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Printf("🔑 Secret: %s\n", name)
+	if environment != "" {
+		fmt.Printf("🌐 Environment: %s (created in %d repositories)\n", environment, environmentsCreated)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newSecurityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security",
+		Short: "Report on and manage repository security",
+		Long:  "Report on security alerts and settings across repositories and turn on security features in bulk",
+	}
+
+	cmd.AddCommand(newCodeScanningAlertsCmd())
+	cmd.AddCommand(newEnableDependabotUpdatesCmd())
+	cmd.AddCommand(newEnableSecretScanningCmd())
+	cmd.AddCommand(newEnablePVRCmd())
+
+	return cmd
+}
@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// severityOrder ranks code scanning severities from most to least severe for display.
+var severityOrder = []string{"critical", "high", "medium", "low", "error", "warning", "note"}
+
+// codeScanningAlertsOptions holds the flags of the security code-scanning-alerts command.
+type codeScanningAlertsOptions struct {
+	severities []string
+	tools      []string
+	top        int
+}
+
+func newCodeScanningAlertsCmd() *cobra.Command {
+	opts := &codeScanningAlertsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "code-scanning-alerts",
+		Short: "Aggregate open code scanning alerts",
+		Long:  "Collect the open code scanning alerts of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and aggregate them per repository, rule and severity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCodeScanningAlertsCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.severities, "severity", nil, "Comma-separated severities to include: "+strings.Join(severityOrder, ", "))
+	cmd.Flags().StringSliceVar(&opts.tools, "tool", nil, "Comma-separated analysis tools to include, e.g. CodeQL")
+	cmd.Flags().IntVar(&opts.top, "top", 20, "Number of most frequent rules to list in the org-wide summary (0 for all)")
+
+	return cmd
+}
+
+func runCodeScanningAlertsCommand(opts *codeScanningAlertsOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	for _, severity := range opts.severities {
+		if !slices.Contains(severityOrder, severity) {
+			return fmt.Errorf("invalid --severity %q: must be one of %s", severity, strings.Join(severityOrder, ", "))
+		}
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetCodeScanningReports(ctx, owner, repoNames(repos), ghbatch.CodeScanningFilter{
+		Severities: opts.severities,
+		Tools:      opts.tools,
+	})
+
+	if failed := displayCodeScanningReports(owner, target.repoPrefix, reports, opts.top, isUser); failed > 0 {
+		return partialFailure("failed to collect alerts for %d repositories", failed)
+	}
+	return nil
+}
+
+// displayCodeScanningReports prints the alerts per repository and an org-wide aggregation by rule and
+// severity, and returns the number of repositories whose alerts could not be collected.
+func displayCodeScanningReports(owner, prefix string, reports []ghbatch.CodeScanningReport, top int, isUser bool) int {
+	// Repositories with the most alerts first
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Total != reports[j].Total {
+			return reports[i].Total > reports[j].Total
+		}
+		return reports[i].RepoName < reports[j].RepoName
+	})
+
+	var total, notEnabled, failed int
+	bySeverity := map[string]int{}
+	byRule := map[string]*ghbatch.CodeScanningRuleCount{}
+	reposByRule := map[string]int{}
+
+	fmt.Println("\n📋 Code Scanning Alerts:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, report := range reports {
+		switch {
+		case report.Err != nil:
+			failed++
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, report.RepoName, report.Err)
+			continue
+		case !report.Enabled:
+			notEnabled++
+			fmt.Printf("➖ %s/%s (code scanning not enabled)\n", owner, report.RepoName)
+			continue
+		case report.Total == 0:
+			fmt.Printf("✅ %s/%s (no open alerts)\n", owner, report.RepoName)
+			continue
+		}
+
+		repoSeverities := map[string]int{}
+		for _, rule := range report.Rules {
+			repoSeverities[rule.Severity] += rule.Count
+			bySeverity[rule.Severity] += rule.Count
+			reposByRule[rule.RuleID]++
+
+			if aggregated, ok := byRule[rule.RuleID]; ok {
+				aggregated.Count += rule.Count
+			} else {
+				copied := rule
+				byRule[rule.RuleID] = &copied
+			}
+		}
+		total += report.Total
+
+		fmt.Printf("❌ %s/%s: %d alerts (%s)\n", owner, report.RepoName, report.Total, formatSeverityCounts(repoSeverities))
+	}
+	fmt.Println()
+
+	if len(byRule) > 0 {
+		rules := make([]*ghbatch.CodeScanningRuleCount, 0, len(byRule))
+		for _, rule := range byRule {
+			rules = append(rules, rule)
+		}
+		sort.Slice(rules, func(i, j int) bool {
+			if rules[i].Count != rules[j].Count {
+				return rules[i].Count > rules[j].Count
+			}
+			return rules[i].RuleID < rules[j].RuleID
+		})
+		if top > 0 && len(rules) > top {
+			rules = rules[:top]
+		}
+
+		fmt.Println("🔎 Most frequent rules:")
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "RULE\tSEVERITY\tTOOL\tALERTS\tREPOS")
+		for _, rule := range rules {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\n", rule.RuleID, rule.Severity, rule.Tool, rule.Count, reposByRule[rule.RuleID])
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("➖ Code scanning not enabled: %d\n", notEnabled)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be collected: %d\n", failed)
+	}
+	fmt.Printf("🚨 Total Open Alerts: %d\n", total)
+	if total > 0 {
+		fmt.Printf("📊 By severity: %s\n", formatSeverityCounts(bySeverity))
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// formatSeverityCounts formats alert counts from most to least severe, e.g. "critical: 1, high: 3".
+func formatSeverityCounts(counts map[string]int) string {
+	var parts []string
+	for _, severity := range severityOrder {
+		if counts[severity] > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", severity, counts[severity]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
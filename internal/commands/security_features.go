@@ -0,0 +1,294 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// securityFeature is a security feature the security enable-* commands turn on.
+type securityFeature struct {
+	// title names the feature in the results, e.g. "Dependabot Security Updates".
+	title string
+	// description names the feature in the confirmation prompt and errors.
+	description string
+	// publicOnly limits the feature to public repositories.
+	publicOnly bool
+	enable     func(ctx context.Context, githubService ghbatch.GitHubClient, owner string, names []string) []ghbatch.SecurityFeatureResult
+}
+
+// enableSecurityFeatureOptions holds the flags shared by the security enable-* commands.
+type enableSecurityFeatureOptions struct {
+	yes bool
+}
+
+// enablePVROptions holds the flags of the security enable-pvr command.
+type enablePVROptions struct {
+	enableSecurityFeatureOptions
+	audit bool
+}
+
+// enableSecretScanningOptions holds the flags of the security enable-secret-scanning command.
+type enableSecretScanningOptions struct {
+	enableSecurityFeatureOptions
+	pushProtection bool
+}
+
+func newEnableDependabotUpdatesCmd() *cobra.Command {
+	opts := &enableSecurityFeatureOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable-dependabot-updates",
+		Short: "Turn on Dependabot security updates",
+		Long:  "Turn on vulnerability alerts and Dependabot security updates, which open pull requests fixing vulnerable dependencies, for a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Repositories that already have both are reported as already enabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEnableSecurityFeatureCommand(opts, securityFeature{
+				title:       "Dependabot Security Updates",
+				description: "Dependabot security updates",
+				enable: func(ctx context.Context, githubService ghbatch.GitHubClient, owner string, names []string) []ghbatch.SecurityFeatureResult {
+					return githubService.EnableDependabotSecurityUpdates(ctx, owner, names)
+				},
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func newEnableSecretScanningCmd() *cobra.Command {
+	opts := &enableSecretScanningOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable-secret-scanning",
+		Short: "Turn on secret scanning",
+		Long:  "Turn on secret scanning, and with --push-protection also push protection, which blocks pushes containing secrets, for a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Private repositories require GitHub Advanced Security. Repositories that already have the settings are reported as already enabled",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			description := "secret scanning"
+			if opts.pushProtection {
+				description = "secret scanning and push protection"
+			}
+
+			return runEnableSecurityFeatureCommand(&opts.enableSecurityFeatureOptions, securityFeature{
+				title:       "Secret Scanning",
+				description: description,
+				enable: func(ctx context.Context, githubService ghbatch.GitHubClient, owner string, names []string) []ghbatch.SecurityFeatureResult {
+					return githubService.EnableSecretScanning(ctx, owner, names, opts.pushProtection)
+				},
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.pushProtection, "push-protection", false, "Also turn on push protection")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func newEnablePVRCmd() *cobra.Command {
+	opts := &enablePVROptions{}
+
+	cmd := &cobra.Command{
+		Use:   "enable-pvr",
+		Short: "Turn on private vulnerability reporting",
+		Long:  "Turn on private vulnerability reporting, which lets anyone report a vulnerability privately to the maintainers, for a specified public repository, public repositories with a given prefix, or all public repositories in an organization or user account. Private and internal repositories are skipped. With --audit the repositories where it is off are only listed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.audit {
+				return runPVRAuditCommand()
+			}
+
+			return runEnableSecurityFeatureCommand(&opts.enableSecurityFeatureOptions, securityFeature{
+				title:       "Private Vulnerability Reporting",
+				description: "private vulnerability reporting",
+				publicOnly:  true,
+				enable: func(ctx context.Context, githubService ghbatch.GitHubClient, owner string, names []string) []ghbatch.SecurityFeatureResult {
+					return githubService.EnablePrivateVulnerabilityReporting(ctx, owner, names)
+				},
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.audit, "audit", false, "Only list the repositories where private vulnerability reporting is off")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+
+	return cmd
+}
+
+func runEnableSecurityFeatureCommand(opts *enableSecurityFeatureOptions, feature securityFeature) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if feature.publicOnly {
+		public := publicRepos(repos)
+		if skipped := len(repos) - len(public); skipped > 0 {
+			log.Info("Skipping private and internal repositories", "count", skipped)
+		}
+		repos = public
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	if !opts.yes {
+		displayRepoList("Repositories to update", owner, names)
+
+		confirmed, err := confirmAction(fmt.Sprintf("Turn on %s in %d repositories?", feature.description, len(names)))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	results := feature.enable(ctx, githubService, owner, names)
+	if failed := displaySecurityFeatureResults(feature.title, owner, target.repoPrefix, results, isUser); failed > 0 {
+		return partialFailure("failed to turn on %s in %d repositories", feature.description, failed)
+	}
+	return nil
+}
+
+func runPVRAuditCommand() error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	public := publicRepos(repos)
+	if skipped := len(repos) - len(public); skipped > 0 {
+		log.Info("Skipping private and internal repositories", "count", skipped)
+	}
+
+	if len(public) == 0 {
+		log.Info("No public repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	audits := githubService.AuditPrivateVulnerabilityReporting(ctx, owner, repoNames(public))
+	if failed := displayPVRAudit(owner, target.repoPrefix, audits, isUser); failed > 0 {
+		return partialFailure("failed to check private vulnerability reporting of %d repositories", failed)
+	}
+	return nil
+}
+
+// displayPVRAudit lists the repositories where private vulnerability reporting is off with a summary and
+// returns the number of repositories that could not be checked.
+func displayPVRAudit(owner, prefix string, audits []ghbatch.SecurityFeatureAudit, isUser bool) int {
+	sort.Slice(audits, func(i, j int) bool { return audits[i].RepoName < audits[j].RepoName })
+
+	var enabled, disabled, failed int
+
+	fmt.Printf("\n📋 Private Vulnerability Reporting Audit:\n")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, audit := range audits {
+		switch {
+		case audit.Err != nil:
+			failed++
+			fmt.Printf("  ❌ %s/%s (FAILED): %v\n", owner, audit.RepoName, audit.Err)
+		case audit.Enabled:
+			enabled++
+		default:
+			disabled++
+			fmt.Printf("  ⚠️  %s/%s (OFF)\n", owner, audit.RepoName)
+		}
+	}
+	if disabled == 0 && failed == 0 {
+		fmt.Println("  ✅ Private vulnerability reporting is on in every repository")
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Public Repositories: %d\n", len(audits))
+	fmt.Printf("✅ On: %d\n", enabled)
+	fmt.Printf("⚠️  Off: %d\n", disabled)
+	fmt.Printf("❌ Failed: %d\n", failed)
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// displaySecurityFeatureResults prints the per-repository outcome of enabling a security feature with a
+// summary and returns the number of failed repositories.
+func displaySecurityFeatureResults(title, owner, prefix string, results []ghbatch.SecurityFeatureResult, isUser bool) int {
+	sort.Slice(results, func(i, j int) bool { return results[i].RepoName < results[j].RepoName })
+
+	counts := map[string]int{}
+	icons := map[string]string{
+		ghbatch.SecurityFeatureStatusEnabled:        "✅",
+		ghbatch.SecurityFeatureStatusAlreadyEnabled: "➖",
+		ghbatch.SecurityFeatureStatusFailed:         "❌",
+	}
+
+	fmt.Printf("\n📋 %s Results:\n", title)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+	for _, result := range results {
+		counts[result.Status]++
+
+		line := fmt.Sprintf("  %s %s/%s (%s)", icons[result.Status], owner, result.RepoName, strings.ToUpper(result.Status))
+		if result.Err != nil {
+			line += ": " + result.Err.Error()
+		}
+		fmt.Println(line)
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("✅ Enabled: %d\n", counts[ghbatch.SecurityFeatureStatusEnabled])
+	fmt.Printf("➖ Already enabled: %d\n", counts[ghbatch.SecurityFeatureStatusAlreadyEnabled])
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.SecurityFeatureStatusFailed])
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.SecurityFeatureStatusFailed]
+}
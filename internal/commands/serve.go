@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/metrics"
+)
+
+// serverShutdownTimeout bounds how long a server waits for open connections when it is stopped.
+const serverShutdownTimeout = 10 * time.Second
+
+func newServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run a long-lived server",
+		Long:  "Run a long-lived server reacting to events or requests instead of a one-off batch operation",
+	}
+
+	cmd.AddCommand(newServeWebhookCmd())
+	cmd.AddCommand(newServeAPICmd())
+
+	return cmd
+}
+
+// serverContext returns the context of a server command, done on SIGINT or SIGTERM or when --timeout
+// expires. After the first signal a second one terminates the process right away.
+func serverContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := commandContext()
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// addMetricsAddrFlag registers the flag selecting the address the metrics of a server are served on.
+func addMetricsAddrFlag(cmd *cobra.Command, metricsAddr *string) {
+	cmd.Flags().StringVar(metricsAddr, "metrics-addr", "", "Address serving the Prometheus metrics on GET /metrics, separate from --listen (default: metrics are not served)")
+}
+
+// runServer serves handler on address, and the Prometheus metrics on GET /metrics at metricsAddress unless it is
+// empty, until ctx is done, then stops accepting requests and calls wait so that work started by the handler
+// can finish. The metrics have a listener of their own because they are not authenticated.
+func runServer(ctx context.Context, name, address, metricsAddress string, handler http.Handler, wait func()) error {
+	log := logger.GetLogger()
+
+	servers := map[string]*http.Server{name: {Addr: address, Handler: handler, ReadHeaderTimeout: 10 * time.Second}}
+	if metricsAddress != "" {
+		mux := http.NewServeMux()
+		mux.Handle("GET /metrics", metrics.Default.Handler())
+		servers[name+" metrics"] = &http.Server{Addr: metricsAddress, Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+		log.Info("Metrics server listening", "address", metricsAddress)
+	}
+
+	serveErr := make(chan error, len(servers))
+	for serverName, server := range servers {
+		go func() {
+			serveErr <- fmt.Errorf("%s server failed: %w", serverName, server.ListenAndServe())
+		}()
+	}
+
+	// A server failing stops the others too
+	var failed error
+	select {
+	case failed = <-serveErr:
+	case <-ctx.Done():
+		log.Info("Stopping server", "server", name)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	var shutdownErr error
+	for _, server := range servers {
+		if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			shutdownErr = err
+		}
+	}
+	wait()
+
+	if failed != nil {
+		return failed
+	}
+	if shutdownErr != nil {
+		return fmt.Errorf("failed to stop %s server: %w", name, shutdownErr)
+	}
+	return nil
+}
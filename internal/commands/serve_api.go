@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/api"
+	"go-repo-manager/internal/logger"
+)
+
+// serveAPIOptions holds the flags of the serve api command.
+type serveAPIOptions struct {
+	listen      string
+	apiToken    string
+	maxJobs     int
+	metricsAddr string
+}
+
+func newServeAPICmd() *cobra.Command {
+	opts := &serveAPIOptions{}
+
+	cmd := &cobra.Command{
+		Use:         "api",
+		Short:       "Expose batch operations over a REST API",
+		Long:        "Run an HTTP server exposing repository listing, issue statistics and file rollouts over a REST API authenticated with a bearer token. Each request starts a background job whose status and result are polled by its ID. Jobs are limited to the owners selected by --org, --owners-file or --username, when given. The server runs until it is interrupted and then waits for the accepted jobs to finish",
+		Annotations: map[string]string{ownerIndependentAnnotation: "true", serverAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServeAPICommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listen, "listen", ":8080", "Address the server listens on")
+	cmd.Flags().StringVar(&opts.apiToken, "api-token", "", "Bearer token clients must send (can also be set via GRM_API_TOKEN env var)")
+	cmd.Flags().IntVar(&opts.maxJobs, "max-jobs", 1, "Maximum number of jobs running at once; further jobs are queued")
+	addMetricsAddrFlag(cmd, &opts.metricsAddr)
+
+	return cmd
+}
+
+func runServeAPICommand(opts *serveAPIOptions) error {
+	log := logger.GetLogger()
+
+	if target.repoName != "" || target.repoPrefix != "" {
+		return fmt.Errorf("--repo and --repo-prefix cannot be used with serve api, requests select their repositories")
+	}
+
+	if opts.apiToken == "" {
+		opts.apiToken = os.Getenv("GRM_API_TOKEN")
+	}
+	if opts.apiToken == "" {
+		return fmt.Errorf("an API token is required, set --api-token or the GRM_API_TOKEN env var")
+	}
+
+	if opts.maxJobs < 1 {
+		return fmt.Errorf("--max-jobs must be at least 1")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+
+	ctx, cancel := serverContext()
+	defer cancel()
+
+	// Jobs keep running when the server is stopped so that no batch is left half done
+	server := api.NewServer(context.WithoutCancel(ctx), githubService, api.Config{
+		Token:   opts.apiToken,
+		Owners:  targetOwners,
+		MaxJobs: opts.maxJobs,
+	})
+
+	log.Info("API server listening", "address", opts.listen, "owners", len(targetOwners), "max_jobs", opts.maxJobs)
+
+	return runServer(ctx, "api", opts.listen, opts.metricsAddr, server.Handler(), server.Wait)
+}
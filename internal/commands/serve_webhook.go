@@ -0,0 +1,210 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/metrics"
+	"go-repo-manager/internal/spec"
+	"go-repo-manager/internal/webhook"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// newRepoPolicyOperation is the operation the new repositories are counted under in the metrics.
+const newRepoPolicyOperation = "new-repo-policy"
+
+// serveWebhookOptions holds the flags of the serve webhook command.
+type serveWebhookOptions struct {
+	listen         string
+	path           string
+	secret         string
+	codeownersFile string
+	labelsFile     string
+	rulesetsFile   string
+	metricsAddr    string
+}
+
+func newServeWebhookCmd() *cobra.Command {
+	opts := &serveWebhookOptions{}
+
+	cmd := &cobra.Command{
+		Use:         "webhook",
+		Short:       "Apply policies to new repositories as they are created",
+		Long:        "Run an HTTP server receiving GitHub repository webhook events and apply the configured CODEOWNERS file, labels and rulesets to every repository as soon as it is created. Deliveries must be signed with the webhook secret. Only repositories of the owners selected by --org, --owners-file or --username, when given, and matching --repo-prefix are handled. The server runs until it is interrupted",
+		Annotations: map[string]string{ownerIndependentAnnotation: "true", serverAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runServeWebhookCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listen, "listen", ":8080", "Address the server listens on")
+	cmd.Flags().StringVar(&opts.path, "path", "/webhook", "URL path receiving the webhook deliveries")
+	cmd.Flags().StringVar(&opts.secret, "secret", "", "Webhook secret deliveries are signed with (can also be set via GITHUB_WEBHOOK_SECRET env var)")
+	cmd.Flags().StringVar(&opts.codeownersFile, "codeowner-file", "", "Path to the CODEOWNERS file to add to new repositories")
+	cmd.Flags().StringVar(&opts.labelsFile, "labels-file", "", "Path to a YAML file with the labels new repositories must have")
+	cmd.Flags().StringVar(&opts.rulesetsFile, "rulesets-file", "", "Path to a JSON or YAML file with the rulesets protecting new repositories")
+	addMetricsAddrFlag(cmd, &opts.metricsAddr)
+
+	return cmd
+}
+
+// loadNewRepoPolicy reads the policy files given to the serve webhook command.
+func loadNewRepoPolicy(opts *serveWebhookOptions) (ghbatch.NewRepoPolicy, error) {
+	var policy ghbatch.NewRepoPolicy
+	var err error
+
+	if opts.codeownersFile != "" {
+		if policy.Codeowners, err = readCodeownersFile(opts.codeownersFile); err != nil {
+			return policy, fmt.Errorf("failed to read CODEOWNERS file: %w", err)
+		}
+	}
+
+	if opts.labelsFile != "" {
+		if policy.Labels, err = spec.LoadLabels(opts.labelsFile); err != nil {
+			return policy, err
+		}
+	}
+
+	if opts.rulesetsFile != "" {
+		if policy.Rulesets, err = spec.LoadRulesets(opts.rulesetsFile); err != nil {
+			return policy, err
+		}
+	}
+
+	if policy.Empty() {
+		return policy, fmt.Errorf("at least one of --codeowner-file, --labels-file or --rulesets-file is required")
+	}
+
+	return policy, nil
+}
+
+func runServeWebhookCommand(opts *serveWebhookOptions) error {
+	log := logger.GetLogger()
+
+	if target.repoName != "" {
+		return fmt.Errorf("--repo cannot be used with serve webhook, use --repo-prefix to select the new repositories to handle")
+	}
+
+	if opts.secret == "" {
+		opts.secret = os.Getenv("GITHUB_WEBHOOK_SECRET")
+	}
+	if opts.secret == "" {
+		return fmt.Errorf("a webhook secret is required, set --secret or the GITHUB_WEBHOOK_SECRET env var")
+	}
+
+	if !strings.HasPrefix(opts.path, "/") {
+		return fmt.Errorf("--path must start with /")
+	}
+
+	policy, err := loadNewRepoPolicy(opts)
+	if err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+
+	ctx, cancel := serverContext()
+	defer cancel()
+
+	// Policies are applied in the background so deliveries are answered within GitHub's timeout; at most
+	// --concurrency repositories are handled at once and stopping the server waits for those in progress.
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, max(target.concurrency, 1))
+	applyCtx := context.WithoutCancel(ctx)
+
+	handler := webhook.NewHandler(opts.secret, func(event webhook.RepositoryCreated) {
+		if !acceptsNewRepo(event) {
+			log.Info("Ignored repository outside the target", "owner", event.Owner, "repo", event.RepoName)
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			applyNewRepoPolicy(applyCtx, githubService, event, policy)
+		}()
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(opts.path, handler)
+
+	log.Info("Webhook server listening", "address", opts.listen, "path", opts.path,
+		"codeowners", policy.Codeowners != "", "labels", len(policy.Labels), "rulesets", len(policy.Rulesets))
+
+	return runServer(ctx, "webhook", opts.listen, opts.metricsAddr, mux, wg.Wait)
+}
+
+// acceptsNewRepo reports whether a new repository belongs to one of the target owners, when any are
+// selected, and matches --repo-prefix.
+func acceptsNewRepo(event webhook.RepositoryCreated) bool {
+	if !strings.HasPrefix(event.RepoName, target.repoPrefix) {
+		return false
+	}
+
+	return len(targetOwners) == 0 || slices.ContainsFunc(targetOwners, func(owner ghbatch.Owner) bool {
+		return strings.EqualFold(owner.Login, event.Owner)
+	})
+}
+
+// applyNewRepoPolicy applies the policy to a new repository and logs the outcome.
+func applyNewRepoPolicy(ctx context.Context, githubService ghbatch.GitHubClient, event webhook.RepositoryCreated,
+	policy ghbatch.NewRepoPolicy,
+) {
+	log := logger.GetLogger()
+
+	ctx, span := otel.Tracer("go-repo-manager/internal/commands").Start(ctx, newRepoPolicyOperation, trace.WithAttributes(
+		attribute.String("github.delivery", event.DeliveryID),
+		attribute.String("github.owner", event.Owner),
+		attribute.String("github.repository", event.RepoName),
+	))
+	defer span.End()
+
+	start := time.Now()
+	result := githubService.ApplyNewRepoPolicy(ctx, event.Owner, event.RepoName, policy)
+	metrics.ReposProcessed.Inc(newRepoPolicyOperation, metrics.Result(result.Err))
+	metrics.ObserveOperation(newRepoPolicyOperation, start, result.Err)
+
+	if result.Err != nil {
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	if result.Err != nil {
+		log.Error("Failed to apply policies to new repository", "delivery", event.DeliveryID, "owner", event.Owner,
+			"repo", event.RepoName, "error", result.Err)
+		return
+	}
+
+	var rulesets []string
+	for _, change := range result.Rulesets {
+		if change.Status != ghbatch.RulesetStatusUnchanged {
+			rulesets = append(rulesets, change.Name)
+		}
+	}
+
+	log.Info("Applied policies to new repository", "delivery", event.DeliveryID, "owner", event.Owner,
+		"repo", event.RepoName, "codeowners", result.Codeowners, "labels", result.Labels, "rulesets", rulesets)
+}
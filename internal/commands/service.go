@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// clientOptions and serviceOptions are added to by the global flags in setupRun and configure every GitHub
+// client and service the command creates.
+var (
+	clientOptions  []ghbatch.ClientOption
+	serviceOptions []ghbatch.Option
+)
+
+// newGitHubClient returns a GitHub client authenticating with token, configured by the global flags.
+func newGitHubClient(token string) *github.Client {
+	return ghbatch.NewGitHubClient(token, clientOptions...)
+}
+
+// newGitHubService returns a service processing up to --concurrency repositories at once with client,
+// configured by the global flags.
+func newGitHubService(client *github.Client) *ghbatch.Service {
+	return ghbatch.New(client, append([]ghbatch.Option{ghbatch.WithConcurrency(target.concurrency)}, serviceOptions...)...)
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/signing"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// signingKeyPassphraseEnv is the environment variable holding the passphrase of an encrypted signing key.
+const signingKeyPassphraseEnv = "GO_REPO_MANAGER_SIGNING_KEY_PASSPHRASE"
+
+// signingKey is set from the persistent --signing-key flag and applied by enableCommitSigning.
+var signingKey string
+
+// addSigningFlags registers the commit signing flags.
+func addSigningFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&signingKey, "signing-key", "", "Armored OpenPGP or OpenSSH private key file to sign file commits with, for branches requiring signed commits")
+}
+
+// enableCommitSigning signs the file commits of the command's GitHub services when --signing-key is set.
+func enableCommitSigning() error {
+	if signingKey == "" {
+		return nil
+	}
+
+	signer, err := signing.LoadFile(signingKey, []byte(os.Getenv(signingKeyPassphraseEnv)))
+	if err != nil {
+		return err
+	}
+
+	logger.GetLogger().Debug("Signing file commits", "key", signingKey, "format", signer.Format)
+	serviceOptions = append(serviceOptions, ghbatch.WithCommitSigner(signer))
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// sizeReportOptions holds the flags of the size report command.
+type sizeReportOptions struct {
+	top int
+}
+
+func newSizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "size",
+		Short: "Report on repository sizes",
+		Long:  "Report on the disk size and references of repositories",
+	}
+
+	cmd.AddCommand(newSizeReportCmd())
+
+	return cmd
+}
+
+func newSizeReportCmd() *cobra.Command {
+	opts := &sizeReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "List repositories by disk size",
+		Long:  "List the disk size, branch count, tag count and Git LFS usage of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, largest first, to identify bloated repositories before a migration",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSizeReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.top, "top", 0, "Only list the largest N repositories (0 for all)")
+
+	return cmd
+}
+
+func runSizeReportCommand(opts *sizeReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.top < 0 {
+		return fmt.Errorf("--top cannot be negative")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetSizeReports(ctx, owner, repos)
+	if failed := displaySizeReports(owner, target.repoPrefix, reports, opts.top, isUser); failed > 0 {
+		return partialFailure("failed to inspect %d repositories", failed)
+	}
+	return nil
+}
+
+// displaySizeReports prints the repositories largest first with fleet totals and returns the number of
+// repositories whose references could not be counted.
+func displaySizeReports(owner, prefix string, reports []ghbatch.SizeReport, top int, isUser bool) int {
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].SizeKB != reports[j].SizeKB {
+			return reports[i].SizeKB > reports[j].SizeKB
+		}
+		return reports[i].RepoName < reports[j].RepoName
+	})
+
+	var totalKB, lfs, failed int
+	for _, report := range reports {
+		totalKB += report.SizeKB
+		if report.LFS {
+			lfs++
+		}
+		if report.Err != nil {
+			failed++
+		}
+	}
+
+	listed := reports
+	if top > 0 && len(listed) > top {
+		listed = listed[:top]
+	}
+
+	fmt.Println("\n📋 Repository Sizes:")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "REPOSITORY\tSIZE\tBRANCHES\tTAGS\tLFS\t")
+	for _, report := range listed {
+		if report.Err != nil {
+			fmt.Fprintf(w, "%s\t%s\t-\t-\t-\t\n", report.RepoName, formatSizeKB(report.SizeKB))
+			continue
+		}
+		lfsText := "no"
+		if report.LFS {
+			lfsText = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t\n", report.RepoName, formatSizeKB(report.SizeKB), report.Branches, report.Tags, lfsText)
+	}
+	w.Flush()
+
+	for _, report := range reports {
+		if report.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, report.RepoName, report.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("💾 Total Size: %s\n", formatSizeKB(totalKB))
+	if len(reports) > 0 {
+		fmt.Printf("🐘 Largest: %s (%s)\n", reports[0].RepoName, formatSizeKB(reports[0].SizeKB))
+	}
+	fmt.Printf("📦 Using Git LFS: %d\n", lfs)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be inspected: %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
+
+// formatSizeKB formats a size in kilobytes with a binary unit, e.g. "1.5 GB".
+func formatSizeKB(kb int) string {
+	units := []string{"KB", "MB", "GB", "TB"}
+	size := float64(kb)
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+
+	if unit == 0 {
+		return fmt.Sprintf("%d KB", kb)
+	}
+	return fmt.Sprintf("%.1f %s", size, units[unit])
+}
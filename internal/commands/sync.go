@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// syncOptions holds the flags of the sync command.
+type syncOptions struct {
+	source     string
+	paths      []string
+	pr         bool
+	branch     string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newSyncCmd() *cobra.Command {
+	opts := &syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Copy managed files from a source-of-truth repository",
+		Long:  "Copy managed files, e.g. workflows, CODEOWNERS and linter configs, from a source-of-truth repository into each repository, committing directly or via pull request. Only files whose content differs are written, and the outcome is reported per file and repository. Paths naming a directory of the source repository cover every file below it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSyncCommand(opts)
+		},
+	}
+
+	addManagedFileFlags(cmd, &opts.source, &opts.paths)
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "sync-managed-files", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+func runSyncCommand(opts *syncOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	sourceOwner, sourceRepo, err := parseRepoReference(opts.source, owner)
+	if err != nil {
+		return err
+	}
+	source := sourceOwner + "/" + sourceRepo
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	files, err := githubService.GetManagedFiles(ctx, sourceOwner, sourceRepo, opts.paths)
+	if err != nil {
+		return err
+	}
+
+	names, err := resolveDownstreamRepos(ctx, githubService, owner, isUser, sourceOwner, sourceRepo)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(files))
+	rollouts := make([]ghbatch.FileRollout, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+		rollouts = append(rollouts, ghbatch.FileRollout{
+			Path:          file.Path,
+			Render:        func(string) (string, error) { return file.Content, nil },
+			CommitMessage: fmt.Sprintf("Sync %s from %s", file.Path, source),
+		})
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, names, opts.yes, rollouts...)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	// Each managed file of each repository is checkpointed on its own
+	var keys []string
+	for _, path := range paths {
+		for _, name := range names {
+			keys = append(keys, fileRepoKey(path, name))
+		}
+	}
+
+	run, pendingKeys, err := startRun(opts.checkpoint, "sync", owner, keys)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(pendingKeys))
+	for _, key := range pendingKeys {
+		pending[key] = true
+	}
+
+	results := make(map[string][]ghbatch.FileRolloutResult, len(rollouts))
+
+	// Files are synced one after another so that in PR mode they all land on the same branch and pull request
+	for _, rollout := range rollouts {
+		var pathRepos []string
+		for _, name := range names {
+			if pending[fileRepoKey(rollout.Path, name)] {
+				pathRepos = append(pathRepos, name)
+			}
+		}
+		if len(pathRepos) == 0 {
+			continue
+		}
+
+		rollout.OnResult = recordRolloutResult(run, func(repoName string) string {
+			return fileRepoKey(rollout.Path, repoName)
+		})
+
+		if opts.pr {
+			rollout.PullRequest = &ghbatch.PullRequestOptions{
+				Branch: opts.branch,
+				Title:  "Sync managed files from " + source,
+				Body:   fmt.Sprintf("This pull request syncs the following files with %s: %s.", source, strings.Join(paths, ", ")),
+			}
+		}
+
+		results[rollout.Path] = githubService.ApplyFileToRepos(ctx, owner, pathRepos, rollout)
+	}
+
+	if failed := displaySyncResults(owner, target.repoPrefix, source, paths, results, isUser); failed > 0 {
+		return partialFailure("failed to sync %d files", failed)
+	}
+	return nil
+}
+
+// displaySyncResults prints a matrix of the outcome of each managed file in each repository with a summary
+// and returns the number of files that failed to sync.
+func displaySyncResults(owner, prefix, source string, paths []string, results map[string][]ghbatch.FileRolloutResult,
+	isUser bool,
+) int {
+	byRepo := map[string]map[string]ghbatch.FileRolloutResult{}
+	for path, pathResults := range results {
+		for _, result := range pathResults {
+			if byRepo[result.RepoName] == nil {
+				byRepo[result.RepoName] = map[string]ghbatch.FileRolloutResult{}
+			}
+			byRepo[result.RepoName][path] = result
+		}
+	}
+
+	names := make([]string, 0, len(byRepo))
+	for name := range byRepo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	counts := map[string]int{}
+	var pullRequests, failures []string
+
+	fmt.Printf("\n📋 Sync from %s Results:\n", source)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "REPOSITORY\t%s\n", strings.Join(paths, "\t"))
+	for _, name := range names {
+		cells := make([]string, len(paths))
+		pullRequest := ""
+		for i, path := range paths {
+			result, ok := byRepo[name][path]
+			if !ok {
+				// Completed by the resumed run
+				cells[i] = "-"
+				continue
+			}
+
+			counts[result.Status]++
+			cells[i] = strings.ToUpper(result.Status)
+			if result.Err != nil {
+				failures = append(failures, fmt.Sprintf("%s/%s:%s: %v", owner, name, path, result.Err))
+			}
+			if result.PullRequestURL != "" {
+				pullRequest = result.PullRequestURL
+			}
+		}
+		if pullRequest != "" {
+			pullRequests = append(pullRequests, pullRequest)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", name, strings.Join(cells, "\t"))
+	}
+	w.Flush()
+
+	if len(pullRequests) > 0 {
+		fmt.Println("\n🔀 Pull requests:")
+		for _, url := range pullRequests {
+			fmt.Printf("  %s\n", url)
+		}
+	}
+	if len(failures) > 0 {
+		fmt.Println("\n❌ Errors:")
+		for _, message := range failures {
+			fmt.Printf("  %s\n", message)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(names))
+	fmt.Printf("📄 Managed Files: %d\n", len(paths))
+	fmt.Printf("✅ Created: %d\n", counts[ghbatch.FileStatusCreated])
+	fmt.Printf("🔄 Updated: %d\n", counts[ghbatch.FileStatusUpdated])
+	fmt.Printf("➖ Unchanged: %d\n", counts[ghbatch.FileStatusUnchanged])
+	fmt.Printf("❌ Failed: %d\n", counts[ghbatch.FileStatusFailed])
+	fmt.Printf("📍 Source: %s\n", source)
+	displayRunSummary()
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return counts[ghbatch.FileStatusFailed]
+}
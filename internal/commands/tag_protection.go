@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// tagProtectionApplyOptions holds the flags of the tag-protection apply command.
+type tagProtectionApplyOptions struct {
+	patterns []string
+	name     string
+	dryRun   bool
+	yes      bool
+}
+
+func newTagProtectionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag-protection",
+		Short: "Protect release tags",
+		Long:  "Keep release tags from being deleted or moved across repositories",
+	}
+
+	cmd.AddCommand(newTagProtectionApplyCmd())
+
+	return cmd
+}
+
+func newTagProtectionApplyCmd() *cobra.Command {
+	opts := &tagProtectionApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Protect tags matching patterns in repositories",
+		Long:  "Keep tags matching the patterns, e.g. v*, from being deleted or moved. GitHub retired tag protection rules in favor of rulesets, so the protection is a tag ruleset restricting deletions and updates. Repositories that already have the ruleset are reported as unchanged, and those whose ruleset covers other patterns are updated",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTagProtectionApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.patterns, "pattern", nil, "Comma-separated tag patterns to protect, e.g. v* (required)")
+	cmd.Flags().StringVar(&opts.name, "name", "tag-protection", "Name of the ruleset holding the protection")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Only report the repositories where the protection would be created or updated")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the comparison preview and interactive confirmation")
+
+	cmd.MarkFlagRequired("pattern")
+
+	return cmd
+}
+
+func runTagProtectionApplyCommand(opts *tagProtectionApplyOptions) error {
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if len(opts.patterns) == 0 {
+		return fmt.Errorf("at least one --pattern is required")
+	}
+	for _, pattern := range opts.patterns {
+		if pattern == "" {
+			return fmt.Errorf("--pattern must not be empty")
+		}
+	}
+	if opts.name == "" {
+		return fmt.Errorf("--name must not be empty")
+	}
+
+	ruleset := ghbatch.TagProtectionRuleset(opts.name, opts.patterns)
+	return applyRulesets([]ghbatch.Ruleset{ruleset}, opts.dryRun, false, opts.yes)
+}
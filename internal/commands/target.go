@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/auth"
+	"go-repo-manager/internal/logger"
+)
+
+// targetOptions holds the global flags selecting the owners and repositories a command operates on and how.
+type targetOptions struct {
+	repoName   string
+	repoPrefix string
+	orgs       []string
+	ownersFile string
+	// org is the organization being processed, selected from the owner flags by setupRun or, with several
+	// owners, by runForEachOwner.
+	org         string
+	username    string
+	token       string
+	concurrency int
+}
+
+// target is set from the persistent root flags shared by every command.
+var target targetOptions
+
+// addTargetFlags registers the owner, repository, token and concurrency flags.
+func addTargetFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&target.repoName, "repo", "", "Specific repository name")
+	flags.StringVar(&target.repoPrefix, "repo-prefix", "", "Repository name prefix to filter repositories")
+	flags.StringSliceVar(&target.orgs, "org", nil, "GitHub organization name; comma-separated or repeated to process several organizations")
+	flags.StringVar(&target.ownersFile, "owners-file", "", "File listing the organizations to process, one per line, and user accounts as user:<name>")
+	flags.StringVar(&target.username, "username", "", "GitHub username")
+	flags.StringVar(&target.token, "token", "", "GitHub personal access token (optional, can also be set via GITHUB_TOKEN env var, login or the gh CLI)")
+	flags.IntVar(&target.concurrency, "concurrency", 1, "Maximum number of concurrent workers for processing repositories (default: 1)")
+}
+
+// ValidateTarget checks that an owner is selected by --org, --owners-file or --username and that --repo and
+// --repo-prefix are not combined.
+func ValidateTarget() error {
+	if target.org == "" && target.username == "" {
+		return fmt.Errorf("either organization (--org), owners file (--owners-file) or username (--username) is required")
+	}
+
+	if target.repoName != "" && target.repoPrefix != "" {
+		return fmt.Errorf("cannot specify both --repo and --repo-prefix")
+	}
+
+	return nil
+}
+
+// setupTarget resolves the owners selected by the flags and, when there is exactly one, selects it.
+func setupTarget() error {
+	owners, err := resolveTargetOwners()
+	if err != nil {
+		return err
+	}
+
+	targetOwners = owners
+	if len(owners) == 1 {
+		selectOwner(owners[0])
+	}
+
+	return nil
+}
+
+// ResolveOwner returns the owner being processed and whether it is a user account.
+func ResolveOwner() (string, bool) {
+	if target.username != "" {
+		return target.username, true
+	}
+
+	return target.org, false
+}
+
+// ResolveToken returns the token from --token, falling back to the GITHUB_TOKEN environment variable, the
+// token stored by the login command and then to the credential of the gh CLI.
+func ResolveToken() (string, error) {
+	token, source, err := resolveTokenSource()
+	if err != nil {
+		return "", err
+	}
+
+	logger.GetLogger().Debug("Resolved GitHub token", "source", source)
+
+	return token, nil
+}
+
+// resolveTokenSource resolves the token like ResolveToken and also returns where it came from.
+func resolveTokenSource() (string, string, error) {
+	if target.token != "" {
+		return target.token, "--token flag", nil
+	}
+
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		return token, "GITHUB_TOKEN environment variable", nil
+	}
+
+	if store, err := auth.DefaultStore(); err == nil {
+		if token, err := store.Load(auth.DefaultHost); err == nil {
+			return token, "login", nil
+		}
+	}
+
+	token, err := auth.GHToken(context.Background(), auth.DefaultHost)
+	if err != nil {
+		logger.GetLogger().Debug("No gh CLI credential available", "error", err)
+		return "", "", errMissingToken
+	}
+
+	return token, "gh CLI", nil
+}
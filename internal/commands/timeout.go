@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"go-repo-manager/internal/metrics"
+)
+
+var (
+	// commandTimeout is set from the persistent --timeout flag; zero means no deadline.
+	commandTimeout time.Duration
+	// runCtx is the context returned by the last commandContext call, used to report a fired deadline.
+	runCtx context.Context
+	// runDeadline is the deadline set by the first commandContext call, shared by the runs of every owner.
+	runDeadline time.Time
+)
+
+// addTimeoutFlags registers the command deadline flag.
+func addTimeoutFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&commandTimeout, "timeout", 0, "Stop the command after this long, e.g. 10m, and report the results collected so far (default: no limit)")
+}
+
+// validateTimeout rejects negative --timeout values.
+func validateTimeout() error {
+	if commandTimeout < 0 {
+		return fmt.Errorf("--timeout must not be negative")
+	}
+
+	return nil
+}
+
+// commandContext returns the context a command runs under, with the hooks of the selected owner and the
+// --timeout deadline applied when set.
+// Repositories that are not finished when the deadline fires fail with a context error and are reported
+// with the rest of the results.
+func commandContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := withHooks(metrics.WithOperation(runTraceCtx, runOperation)), context.CancelFunc(func() {})
+	if commandTimeout > 0 {
+		if runDeadline.IsZero() {
+			runDeadline = time.Now().Add(commandTimeout)
+		}
+		ctx, cancel = context.WithDeadline(ctx, runDeadline)
+	}
+
+	runCtx = ctx
+
+	return ctx, cancel
+}
+
+// displayTimeoutSummary notes in a summary block when the run was cut short by --timeout.
+func displayTimeoutSummary() {
+	if runCtx == nil || !errors.Is(runCtx.Err(), context.DeadlineExceeded) {
+		return
+	}
+
+	fmt.Printf("⏱️  Timed out after %s: repositories not finished in time are reported as failed\n", commandTimeout)
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/tracing"
+)
+
+// tracingShutdownTimeout bounds how long flushing the pending spans may delay the exit.
+const tracingShutdownTimeout = 10 * time.Second
+
+var (
+	// otlpEndpoint is set from the persistent --otlp-endpoint flag.
+	otlpEndpoint string
+	// runTraceCtx carries the span of the CLI run, which commandContext parents the batch operations to.
+	runTraceCtx = context.Background()
+	// runSpan is the span of the CLI run, nil for servers and when tracing is not configured.
+	runSpan trace.Span
+	// shutdownTracing flushes the pending spans, nil when tracing is not configured.
+	shutdownTracing func(ctx context.Context) error
+)
+
+// addTracingFlags registers the trace export flags.
+func addTracingFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&otlpEndpoint, "otlp-endpoint", "", "OTLP/HTTP endpoint traces are exported to, e.g. http://localhost:4318 (default: OTEL_EXPORTER_OTLP_ENDPOINT, tracing is off when neither is set)")
+}
+
+// setupTracing starts exporting traces when an OTLP endpoint is configured and, for CLI runs, starts the
+// span of the run. Servers trace each job or delivery on its own instead.
+func setupTracing() error {
+	if !tracing.Configured(otlpEndpoint) {
+		return nil
+	}
+
+	shutdown, err := tracing.Setup(context.Background(), otlpEndpoint)
+	if err != nil {
+		return err
+	}
+	shutdownTracing = shutdown
+
+	if !runIsServer {
+		runTraceCtx, runSpan = otel.Tracer("go-repo-manager/internal/commands").Start(context.Background(), runOperation)
+	}
+
+	return nil
+}
+
+// finishTracing ends the span of the run, marking it failed with err, and flushes the pending spans.
+// Failing to export is logged and does not fail the run.
+func finishTracing(err error) {
+	if runSpan != nil {
+		if err != nil {
+			runSpan.RecordError(err)
+			runSpan.SetStatus(codes.Error, err.Error())
+		}
+		runSpan.End()
+	}
+
+	if shutdownTracing == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+
+	if err := shutdownTracing(ctx); err != nil {
+		logger.GetLogger().Warn("Failed to export traces", "error", err)
+	}
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// trafficReportOptions holds the flags of the traffic report command.
+type trafficReportOptions struct {
+	sortBy string
+}
+
+func newTrafficCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "traffic",
+		Short: "Report repository traffic",
+		Long:  "Report the views and clones traffic of repositories",
+	}
+
+	cmd.AddCommand(newTrafficReportCmd())
+
+	return cmd
+}
+
+func newTrafficReportCmd() *cobra.Command {
+	opts := &trafficReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report 14-day views and clones per repository",
+		Long:  "Collect the views and clones traffic of a specified repository, repositories with a given prefix, or all repositories in an organization or user account, and aggregate the 14-day totals. Traffic data requires push access",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTrafficReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.sortBy, "sort", "views", "Sort repositories by views, visitors, clones or cloners")
+
+	return cmd
+}
+
+func runTrafficReportCommand(opts *trafficReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	metric, ok := trafficMetrics[opts.sortBy]
+	if !ok {
+		return fmt.Errorf("invalid --sort %q: must be views, visitors, clones or cloners", opts.sortBy)
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	reports := githubService.GetTrafficReports(ctx, owner, repoNames(repos))
+	if failed := displayTrafficReports(owner, target.repoPrefix, reports, metric, isUser); failed > 0 {
+		return partialFailure("failed to get traffic for %d repositories", failed)
+	}
+	return nil
+}
+
+// trafficMetrics maps the --sort values to the metric they sort by.
+var trafficMetrics = map[string]func(ghbatch.TrafficReport) int{
+	"views":    func(r ghbatch.TrafficReport) int { return r.Views },
+	"visitors": func(r ghbatch.TrafficReport) int { return r.UniqueVisitors },
+	"clones":   func(r ghbatch.TrafficReport) int { return r.Clones },
+	"cloners":  func(r ghbatch.TrafficReport) int { return r.UniqueCloners },
+}
+
+// displayTrafficReports prints the traffic table sorted by the given metric with totals and returns the
+// number of repositories whose traffic could not be read.
+func displayTrafficReports(owner, prefix string, reports []ghbatch.TrafficReport, metric func(ghbatch.TrafficReport) int,
+	isUser bool,
+) int {
+	sort.Slice(reports, func(i, j int) bool {
+		if metric(reports[i]) != metric(reports[j]) {
+			return metric(reports[i]) > metric(reports[j])
+		}
+		return reports[i].RepoName < reports[j].RepoName
+	})
+
+	var total ghbatch.TrafficReport
+	var unused, failed int
+
+	fmt.Println("\n📋 Traffic (last 14 days):")
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "REPOSITORY\tVIEWS\tVISITORS\tCLONES\tCLONERS\t")
+	for _, report := range reports {
+		if report.Err != nil {
+			failed++
+			continue
+		}
+		if report.Views == 0 && report.Clones == 0 {
+			unused++
+		}
+
+		total.Views += report.Views
+		total.UniqueVisitors += report.UniqueVisitors
+		total.Clones += report.Clones
+		total.UniqueCloners += report.UniqueCloners
+
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t\n", report.RepoName, report.Views, report.UniqueVisitors, report.Clones, report.UniqueCloners)
+	}
+	w.Flush()
+
+	for _, report := range reports {
+		if report.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, report.RepoName, report.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(reports))
+	fmt.Printf("👀 Total Views: %d (unique visitors per repository summed: %d)\n", total.Views, total.UniqueVisitors)
+	fmt.Printf("📥 Total Clones: %d (unique cloners per repository summed: %d)\n", total.Clones, total.UniqueCloners)
+	fmt.Printf("💤 Repositories without views or clones: %d\n", unused)
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be read (push access required): %d\n", failed)
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
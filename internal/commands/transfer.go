@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+// transferOptions holds the flags of the transfer command.
+type transferOptions struct {
+	toOrg      string
+	teams      []string
+	yes        bool
+	checkpoint checkpointOptions
+}
+
+func newTransferCmd() *cobra.Command {
+	opts := &transferOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Transfer repositories to another organization",
+		Long:  "Transfer a specified repository, repositories with a given prefix, or all repositories in an organization or user account to another organization, optionally granting teams of the target organization access",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTransferCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.toOrg, "to-org", "", "Organization to transfer the repositories to (required)")
+	cmd.Flags().StringSliceVar(&opts.teams, "teams", nil, "Comma-separated team slugs in the target organization to grant access")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	cmd.MarkFlagRequired("to-org")
+
+	return cmd
+}
+
+func runTransferCommand(opts *transferOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.toOrg == "" {
+		return fmt.Errorf("target organization (--to-org) is required")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+	if owner == opts.toOrg {
+		return fmt.Errorf("target organization must differ from the current owner")
+	}
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	// Resolve teams up front so a typo fails before any repository is moved
+	var teamIDs []int64
+	if len(opts.teams) > 0 {
+		teamIDs, err = githubService.GetTeamIDsBySlug(ctx, opts.toOrg, opts.teams)
+		if err != nil {
+			return err
+		}
+	}
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	displayRepoList(fmt.Sprintf("Repositories to transfer to '%s'", opts.toOrg), owner, names)
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Transfer %d repositories to %s?", len(names), opts.toOrg))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	run, names, err := startRun(opts.checkpoint, "transfer", owner, names)
+	if err != nil {
+		return err
+	}
+
+	successRepos, failedRepos := githubService.TransferRepos(ctx, owner, names, opts.toOrg, teamIDs, recordRepoResult(run))
+	displayBatchResults("Repository Transfer", owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(successRepos) > 0 {
+		fmt.Printf("📍 Transferred repositories are now at: %s/<repo> (GitHub may take a moment to finish)\n", opts.toOrg)
+	}
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to transfer %d repositories", len(failedRepos))
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+)
+
+// Supported repository visibility values.
+const (
+	visibilityPublic   = "public"
+	visibilityPrivate  = "private"
+	visibilityInternal = "internal"
+)
+
+// visibilityOptions holds the flags of the visibility set command.
+type visibilityOptions struct {
+	to          string
+	allowPublic bool
+	yes         bool
+}
+
+func newVisibilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "visibility",
+		Short: "Manage repository visibility",
+		Long:  "Manage the visibility (public, private, internal) of repositories",
+	}
+
+	cmd.AddCommand(newVisibilitySetCmd())
+
+	return cmd
+}
+
+func newVisibilitySetCmd() *cobra.Command {
+	opts := &visibilityOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Change repository visibility",
+		Long:  "Change the visibility of a specified repository, repositories with a given prefix, or all repositories in an organization or user account. Making repositories public requires --allow-public",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVisibilitySetCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.to, "to", "", "Target visibility: private, internal or public (required)")
+	cmd.Flags().BoolVar(&opts.allowPublic, "allow-public", false, "Allow making repositories public")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the interactive confirmation")
+
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func validateVisibilityFlags(opts *visibilityOptions) error {
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	switch opts.to {
+	case visibilityPrivate:
+	case visibilityInternal:
+		if target.username != "" {
+			return fmt.Errorf("internal visibility is only available for organization repositories")
+		}
+	case visibilityPublic:
+		if !opts.allowPublic {
+			return fmt.Errorf("refusing to make repositories public without --allow-public")
+		}
+	default:
+		return fmt.Errorf("invalid visibility %q: must be one of private, internal or public", opts.to)
+	}
+
+	return nil
+}
+
+func runVisibilitySetCommand(opts *visibilityOptions) error {
+	log := logger.GetLogger()
+
+	if err := validateVisibilityFlags(opts); err != nil {
+		return err
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	// Repositories that already have the target visibility are left untouched
+	var pendingNames, unchangedNames []string
+	for _, repository := range repos {
+		if repository.GetVisibility() == opts.to {
+			unchangedNames = append(unchangedNames, repository.GetName())
+		} else {
+			pendingNames = append(pendingNames, repository.GetName())
+		}
+	}
+
+	if len(unchangedNames) > 0 {
+		log.Info("Skipping repositories that already have the target visibility", "count", len(unchangedNames), "visibility", opts.to)
+	}
+
+	if len(pendingNames) == 0 {
+		log.Info("No repositories need a visibility change", "owner", owner, "prefix", target.repoPrefix)
+		return nil
+	}
+
+	displayRepoList(fmt.Sprintf("Repositories to make %s", opts.to), owner, pendingNames)
+
+	if !opts.yes {
+		confirmed, err := confirmAction(fmt.Sprintf("Change visibility of %d repositories to %s?", len(pendingNames), opts.to))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Aborted by user")
+			return nil
+		}
+	}
+
+	successRepos, failedRepos := githubService.SetVisibilityForRepos(ctx, owner, pendingNames, opts.to)
+	displayBatchResults("Visibility Change", owner, target.repoPrefix, successRepos, failedRepos, isUser)
+
+	if len(failedRepos) > 0 {
+		return partialFailure("failed to change visibility of %d repositories", len(failedRepos))
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func newWhoamiCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the authenticated user, token scopes and rate limits",
+		Long: `Validate the configured token and show where it came from, the user it belongs to, its scopes, the
+organizations it can see and the remaining core, search and GraphQL rate limits. Run it before a large
+batch, or when a command finds fewer repositories than expected.`,
+		Annotations: map[string]string{ownerIndependentAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWhoami()
+		},
+	}
+}
+
+func runWhoami() error {
+	log := logger.GetLogger()
+
+	token, source, err := resolveTokenSource()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	githubService := ghbatch.NewGitHubService(newGitHubClient(token))
+
+	info, err := githubService.GetTokenInfo(ctx)
+	if err != nil {
+		log.Error("Token was rejected", "source", source, "error", err)
+		return err
+	}
+
+	orgs, err := githubService.ListOrganizations(ctx)
+	if err != nil {
+		log.Warn("Failed to list organizations", "error", err)
+	}
+
+	displayTokenInfo(source, info, orgs, err == nil)
+	return nil
+}
+
+func displayTokenInfo(source string, info *ghbatch.TokenInfo, orgs []string, orgsListed bool) {
+	fmt.Println("\n🔑 Authentication:")
+	fmt.Println(strings.Repeat("-", shortSeparatorLength))
+	fmt.Printf("👤 Logged in as: %s\n", info.Login)
+	fmt.Printf("📍 Token source: %s\n", source)
+
+	switch {
+	case !info.ScopesReported:
+		fmt.Println("🔐 Scopes: not reported (fine-grained token or GitHub App)")
+	case len(info.Scopes) == 0:
+		fmt.Println("🔐 Scopes: none (only public data is accessible)")
+	default:
+		fmt.Printf("🔐 Scopes: %s\n", strings.Join(info.Scopes, ", "))
+	}
+
+	switch {
+	case !orgsListed:
+		fmt.Println("🏢 Organizations: could not be listed")
+	case len(orgs) == 0:
+		fmt.Println("🏢 Organizations: none (check the read:org scope or the organization's OAuth app policy)")
+	default:
+		fmt.Printf("🏢 Organizations (%d): %s\n", len(orgs), strings.Join(orgs, ", "))
+	}
+
+	fmt.Println("\n⏱️  Rate Limits:")
+	fmt.Println(strings.Repeat("-", shortSeparatorLength))
+	displayRateStatus("Core", info.Core)
+	displayRateStatus("Search", info.Search)
+	displayRateStatus("GraphQL", info.GraphQL)
+}
+
+func displayRateStatus(name string, status ghbatch.RateStatus) {
+	icon := "✅"
+	if status.Limit > 0 && status.Remaining*10 < status.Limit {
+		icon = "⚠️ "
+	}
+
+	fmt.Printf("%s %-8s %d/%d remaining, resets in %s\n", icon, name+":", status.Remaining, status.Limit,
+		max(time.Until(status.Reset), 0).Round(time.Second))
+}
@@ -0,0 +1,229 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/render"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// workflowsDir is the directory GitHub Actions workflows live in.
+const workflowsDir = ".github/workflows"
+
+// workflowsApplyOptions holds the flags of the workflows apply command.
+type workflowsApplyOptions struct {
+	workflowFiles []string
+	workflowDir   string
+	pr            bool
+	branch        string
+	yes           bool
+	checkpoint    checkpointOptions
+}
+
+// localFile is a file read from disk that will be written to repositories.
+type localFile struct {
+	name    string
+	content string
+}
+
+func newWorkflowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "workflows",
+		Short: "Manage and report on GitHub Actions workflows",
+		Long:  "Manage GitHub Actions workflow files and report on their runs across repositories",
+	}
+
+	cmd.AddCommand(newWorkflowsApplyCmd())
+	cmd.AddCommand(newWorkflowsReportCmd())
+
+	return cmd
+}
+
+func newWorkflowsApplyCmd() *cobra.Command {
+	opts := &workflowsApplyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Push workflow files to repositories",
+		Long:  "Push one or more workflow YAML files to .github/workflows/ in repositories, committing directly or via pull request. Files are templates: [[.Owner]] and [[.Repo]] are replaced per repository, while ${{ }} expressions are left untouched",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowsApplyCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.workflowFiles, "workflow-file", nil, "Workflow file to push (repeatable)")
+	cmd.Flags().StringVar(&opts.workflowDir, "workflow-dir", "", "Directory whose .yml/.yaml files are all pushed")
+	cmd.Flags().BoolVar(&opts.pr, "pr", false, "Open a pull request instead of committing to the default branch")
+	cmd.Flags().StringVar(&opts.branch, "branch", "update-workflows", "Branch used for pull requests")
+	cmd.Flags().BoolVar(&opts.yes, "yes", false, "Skip the repository preview and interactive confirmation")
+	addCheckpointFlags(cmd.Flags(), &opts.checkpoint)
+
+	return cmd
+}
+
+// loadWorkflowFiles reads the workflow files given explicitly and those found in dir.
+func loadWorkflowFiles(files []string, dir string) ([]localFile, error) {
+	paths := append([]string{}, files...)
+
+	if dir != "" {
+		for _, pattern := range []string{"*.yml", "*.yaml"} {
+			matches, err := filepath.Glob(filepath.Join(dir, pattern))
+			if err != nil {
+				return nil, fmt.Errorf("failed to list workflow directory %s: %w", dir, err)
+			}
+			paths = append(paths, matches...)
+		}
+	}
+
+	sort.Strings(paths)
+
+	var workflows []localFile
+	seen := map[string]string{}
+
+	for _, filePath := range paths {
+		name := filepath.Base(filePath)
+		if previous, ok := seen[name]; ok {
+			return nil, fmt.Errorf("workflow files %s and %s would both be written as %s", previous, filePath, name)
+		}
+		seen[name] = filePath
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read workflow file: %w", err)
+		}
+		workflows = append(workflows, localFile{name: name, content: string(content)})
+	}
+
+	return workflows, nil
+}
+
+func runWorkflowsApplyCommand(opts *workflowsApplyOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	workflows, err := loadWorkflowFiles(opts.workflowFiles, opts.workflowDir)
+	if err != nil {
+		return err
+	}
+
+	if len(workflows) == 0 {
+		return fmt.Errorf("at least one workflow is required (--workflow-file or --workflow-dir)")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	names := repoNames(repos)
+	workflowNames := make([]string, 0, len(workflows))
+	rollouts := make([]ghbatch.FileRollout, 0, len(workflows))
+	for _, workflow := range workflows {
+		workflowNames = append(workflowNames, workflow.name)
+		rollouts = append(rollouts, ghbatch.FileRollout{
+			Path: path.Join(workflowsDir, workflow.name),
+			Render: func(repoName string) (string, error) {
+				return render.File(workflow.name, workflow.content, render.RepoData{Owner: owner, Repo: repoName})
+			},
+			CommitMessage: fmt.Sprintf("Add/Update workflow %s", workflow.name),
+		})
+	}
+
+	confirmed, err := confirmFileRollout(ctx, githubService, owner, target.repoName, target.repoPrefix, names, opts.yes, rollouts...)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		log.Info("Aborted by user")
+		return nil
+	}
+
+	// Each workflow file of each repository is checkpointed on its own
+	var keys []string
+	for _, workflow := range workflows {
+		for _, name := range names {
+			keys = append(keys, fileRepoKey(workflow.name, name))
+		}
+	}
+
+	run, pendingKeys, err := startRun(opts.checkpoint, "workflows apply", owner, keys)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[string]bool, len(pendingKeys))
+	for _, key := range pendingKeys {
+		pending[key] = true
+	}
+
+	var failed int
+
+	// Workflows are applied one after another so that in PR mode they all land on the same branch and pull request
+	for i, workflow := range workflows {
+		rollout := rollouts[i]
+
+		var workflowRepos []string
+		for _, name := range names {
+			if pending[fileRepoKey(workflow.name, name)] {
+				workflowRepos = append(workflowRepos, name)
+			}
+		}
+		if len(workflowRepos) == 0 {
+			continue
+		}
+
+		rollout.OnResult = recordRolloutResult(run, func(repoName string) string {
+			return fileRepoKey(workflow.name, repoName)
+		})
+
+		if opts.pr {
+			rollout.PullRequest = &ghbatch.PullRequestOptions{
+				Branch: opts.branch,
+				Title:  "Add/Update GitHub Actions workflows",
+				Body:   fmt.Sprintf("This pull request adds or updates the following workflows: %s.", strings.Join(workflowNames, ", ")),
+			}
+		}
+
+		results := githubService.ApplyFileToRepos(ctx, owner, workflowRepos, rollout)
+		failed += displayFileRolloutResults("Workflow "+workflow.name, owner, target.repoPrefix, rollout.Path, results, isUser)
+	}
+
+	if failed > 0 {
+		return partialFailure("failed to apply %d workflow files", failed)
+	}
+	return nil
+}
+
+// fileRepoKey identifies a file of a repository in the run checkpoint of a multi-file rollout.
+func fileRepoKey(fileName, repoName string) string {
+	return fileName + ":" + repoName
+}
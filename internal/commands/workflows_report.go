@@ -0,0 +1,185 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// workflowsReportOptions holds the flags of the workflows report command.
+type workflowsReportOptions struct {
+	workflow       string
+	branch         string
+	runs           int
+	minSuccessRate float64
+}
+
+func newWorkflowsReportCmd() *cobra.Command {
+	opts := &workflowsReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report workflow success rates across repositories",
+		Long:  "Compute the success rate, failure count and average duration of the recent completed runs of a workflow in a specified repository, repositories with a given prefix, or all repositories in an organization or user account, aggregated across the fleet, highlighting repositories below a minimum success rate",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkflowsReportCommand(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.workflow, "workflow", "", "Workflow file name, e.g. ci.yml (required)")
+	cmd.Flags().StringVar(&opts.branch, "branch", "", "Only consider runs on this branch (default: all branches)")
+	cmd.Flags().IntVar(&opts.runs, "runs", 20, "Number of most recent completed runs to consider per repository")
+	cmd.Flags().Float64Var(&opts.minSuccessRate, "min-success-rate", 80, "Highlight repositories with a success rate below this percentage")
+
+	cmd.MarkFlagRequired("workflow")
+
+	return cmd
+}
+
+func runWorkflowsReportCommand(opts *workflowsReportOptions) error {
+	log := logger.GetLogger()
+
+	if err := ValidateTarget(); err != nil {
+		return err
+	}
+
+	if opts.workflow == "" {
+		return fmt.Errorf("--workflow is required")
+	}
+
+	if opts.runs <= 0 {
+		return fmt.Errorf("--runs must be greater than zero")
+	}
+
+	if opts.minSuccessRate < 0 || opts.minSuccessRate > 100 {
+		return fmt.Errorf("--min-success-rate must be between 0 and 100")
+	}
+
+	token, err := ResolveToken()
+	if err != nil {
+		return err
+	}
+
+	owner, isUser := ResolveOwner()
+
+	githubClient := newGitHubClient(token)
+	githubService := newGitHubService(githubClient)
+	ctx, cancel := commandContext()
+	defer cancel()
+
+	repos, err := resolveTargetRepos(ctx, githubService, owner, target.repoName, target.repoPrefix, isUser)
+	if err != nil {
+		log.Error("Failed to resolve repositories", "owner", owner, "prefix", target.repoPrefix, "error", err)
+		return err
+	}
+
+	if len(repos) == 0 {
+		log.Info("No repositories found matching the specified criteria", "owner", owner, "prefix", target.repoPrefix)
+		return errNoReposMatched
+	}
+
+	query := ghbatch.WorkflowRunQuery{Workflow: path.Base(opts.workflow), Branch: opts.branch, Limit: opts.runs}
+	results := githubService.GetWorkflowRunStatsForRepos(ctx, owner, repoNames(repos), query)
+	if failed := displayWorkflowRunStats(owner, target.repoPrefix, query.Workflow, results, opts.minSuccessRate, isUser); failed > 0 {
+		return partialFailure("failed to get workflow runs for %d repositories", failed)
+	}
+	return nil
+}
+
+// displayWorkflowRunStats prints the run statistics per repository, lowest success rate first, with fleet-wide
+// totals, and returns the number of repositories whose runs could not be listed.
+func displayWorkflowRunStats(owner, prefix, workflow string, results []ghbatch.WorkflowRunStats, minSuccessRate float64,
+	isUser bool,
+) int {
+	// Repositories with a success rate first, worst first, then those without decided runs
+	sort.Slice(results, func(i, j int) bool {
+		ri, oki := results[i].SuccessRate()
+		rj, okj := results[j].SuccessRate()
+		if oki != okj {
+			return oki
+		}
+		if ri != rj {
+			return ri < rj
+		}
+		return results[i].RepoName < results[j].RepoName
+	})
+
+	var missing, noRuns, red, failed, totalRuns, succeeded, runsFailed int
+	var totalDuration time.Duration
+
+	fmt.Printf("\n📋 Workflow %s:\n", workflow)
+	fmt.Println(strings.Repeat("-", longSeparatorLength))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tREPOSITORY\tRUNS\tPASSED\tFAILED\tSUCCESS\tAVG DURATION\tLAST")
+	for _, stats := range results {
+		switch {
+		case stats.Err != nil:
+			failed++
+			continue
+		case !stats.Found:
+			missing++
+			continue
+		case stats.Runs == 0:
+			noRuns++
+			fmt.Fprintf(w, "➖\t%s\t0\t-\t-\t-\t-\t-\n", stats.RepoName)
+			continue
+		}
+
+		totalRuns += stats.Runs
+		succeeded += stats.Succeeded
+		runsFailed += stats.Failed
+		totalDuration += stats.AverageDuration * time.Duration(stats.Runs)
+
+		icon, rateText := "➖", "-"
+		if rate, ok := stats.SuccessRate(); ok {
+			icon = "✅"
+			if rate < minSuccessRate {
+				icon = "❌"
+				red++
+			}
+			rateText = fmt.Sprintf("%.0f%%", rate)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%s\t%s\t%s\n", icon, stats.RepoName, stats.Runs, stats.Succeeded, stats.Failed,
+			rateText, stats.AverageDuration.Round(time.Second), stats.LastConclusion)
+	}
+	w.Flush()
+
+	for _, stats := range results {
+		if stats.Err != nil {
+			fmt.Printf("⚠️  %s/%s: %v\n", owner, stats.RepoName, stats.Err)
+		}
+	}
+	fmt.Println()
+
+	displaySummaryHeader(owner, prefix, isUser)
+	fmt.Printf("📁 Total Repositories: %d\n", len(results))
+	fmt.Printf("⚙️  With workflow %s: %d\n", workflow, len(results)-missing-failed)
+	fmt.Printf("❌ Below %.0f%% success: %d\n", minSuccessRate, red)
+	if noRuns > 0 {
+		fmt.Printf("➖ Without completed runs: %d\n", noRuns)
+	}
+	if failed > 0 {
+		fmt.Printf("⚠️  Could not be read: %d\n", failed)
+	}
+	if totalRuns > 0 {
+		fmt.Println(strings.Repeat("-", longSeparatorLength))
+		fmt.Printf("🏃 Total Runs: %d (%d passed, %d failed)\n", totalRuns, succeeded, runsFailed)
+		if succeeded+runsFailed > 0 {
+			fmt.Printf("📈 Fleet Success Rate: %.1f%%\n", float64(succeeded)/float64(succeeded+runsFailed)*100)
+		}
+		fmt.Printf("⏱️  Average Duration: %s\n", (totalDuration / time.Duration(totalRuns)).Round(time.Second))
+	}
+	fmt.Println("=" + strings.Repeat("=", longSeparatorLength))
+
+	return failed
+}
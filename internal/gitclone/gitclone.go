@@ -0,0 +1,172 @@
+package gitclone
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"go-repo-manager/internal/worker"
+)
+
+// Actions reported for a repository.
+const (
+	ActionCloned  = "cloned"
+	ActionUpdated = "updated"
+)
+
+// Options controls how repositories are mirrored locally.
+type Options struct {
+	// Dir is the directory repositories are cloned into, one subdirectory per repository.
+	Dir string
+	// Shallow limits clones and fetches to the latest commit.
+	Shallow bool
+	// Bare creates bare repositories (<name>.git) without a working tree.
+	Bare bool
+	// Token authenticates HTTPS git operations against GitHub. It is passed through the environment,
+	// so it never appears in process arguments or in the cloned repository's config.
+	Token string
+	// Concurrency is the maximum number of git processes run at the same time.
+	Concurrency int
+}
+
+// Repository identifies a repository to clone.
+type Repository struct {
+	Name     string
+	CloneURL string
+}
+
+// Result is the outcome of cloning or updating a single repository.
+type Result struct {
+	RepoName string
+	Path     string
+	Action   string
+	Err      error
+}
+
+// Cloner clones repositories or fetch-updates existing local copies.
+type Cloner struct {
+	opts Options
+	log  *slog.Logger
+}
+
+// New creates a Cloner. A non-positive concurrency is treated as 1.
+func New(opts Options, log *slog.Logger) *Cloner {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 1
+	}
+
+	return &Cloner{opts: opts, log: log}
+}
+
+// Path returns the local path of a repository.
+func (c *Cloner) Path(repoName string) string {
+	if c.opts.Bare {
+		repoName += ".git"
+	}
+
+	return filepath.Join(c.opts.Dir, repoName)
+}
+
+// Sync clones a repository, or fetches updates if it already exists locally.
+func (c *Cloner) Sync(ctx context.Context, repository Repository) Result {
+	path := c.Path(repository.Name)
+	result := Result{RepoName: repository.Name, Path: path}
+
+	if _, err := os.Stat(path); err == nil {
+		c.log.Info("Updating repository", "repo", repository.Name, "path", path)
+
+		result.Action = ActionUpdated
+		if err := c.runGit(ctx, c.fetchArgs(path)...); err != nil {
+			result.Err = fmt.Errorf("failed to update %s: %w", repository.Name, err)
+		}
+	} else {
+		c.log.Info("Cloning repository", "repo", repository.Name, "path", path)
+
+		result.Action = ActionCloned
+		if err := c.runGit(ctx, c.cloneArgs(repository.CloneURL, path)...); err != nil {
+			result.Err = fmt.Errorf("failed to clone %s: %w", repository.Name, err)
+		}
+	}
+
+	return result
+}
+
+// SyncAll clones or updates all repositories using at most Concurrency git processes.
+// Results are returned in the same order as the repositories.
+func (c *Cloner) SyncAll(ctx context.Context, repositories []Repository) []Result {
+	if err := os.MkdirAll(c.opts.Dir, 0o750); err != nil {
+		results := make([]Result, len(repositories))
+		for i, repository := range repositories {
+			results[i] = Result{RepoName: repository.Name, Err: fmt.Errorf("failed to create %s: %w", c.opts.Dir, err)}
+		}
+
+		return results
+	}
+
+	results, err := worker.Map(ctx, c.opts.Concurrency, repositories, c.Sync)
+	if err != nil {
+		panic(err)
+	}
+
+	return results
+}
+
+func (c *Cloner) cloneArgs(cloneURL, path string) []string {
+	args := []string{"clone", "--quiet"}
+	if c.opts.Shallow {
+		args = append(args, "--depth", "1")
+	}
+
+	if c.opts.Bare {
+		args = append(args, "--bare")
+	}
+
+	return append(args, cloneURL, path)
+}
+
+func (c *Cloner) fetchArgs(path string) []string {
+	args := []string{"--git-dir", path, "fetch", "--quiet", "--prune", "--tags"}
+	if !c.opts.Bare {
+		args[1] = filepath.Join(path, ".git")
+	}
+
+	if c.opts.Shallow {
+		args = append(args, "--depth", "1")
+	}
+
+	if c.opts.Bare {
+		// Bare clones have no fetch refspec, so branches must be mapped explicitly
+		args = append(args, "origin", "+refs/heads/*:refs/heads/*")
+	}
+
+	return args
+}
+
+func (c *Cloner) runGit(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+
+	if c.opts.Token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte("x-access-token:" + c.opts.Token))
+		cmd.Env = append(cmd.Env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.https://github.com/.extraheader",
+			"GIT_CONFIG_VALUE_0=AUTHORIZATION: basic "+credentials,
+		)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package gitclone
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper function to create a test logger that discards output
+func createTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelError,
+	}))
+}
+
+// Helper function to create a local source repository with a single commit
+func createSourceRepo(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", "main"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return dir
+}
+
+func TestSyncAll_ClonesThenUpdates(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"Working tree", Options{}},
+		{"Bare", Options{Bare: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := createSourceRepo(t)
+
+			tt.opts.Dir = filepath.Join(t.TempDir(), "mirror")
+			tt.opts.Concurrency = 2
+			cloner := New(tt.opts, createTestLogger())
+
+			repositories := []Repository{
+				{Name: "repo1", CloneURL: source},
+				{Name: "missing", CloneURL: filepath.Join(t.TempDir(), "does-not-exist")},
+			}
+
+			results := cloner.SyncAll(context.Background(), repositories)
+			require.Len(t, results, 2)
+			require.NoError(t, results[0].Err)
+			assert.Equal(t, ActionCloned, results[0].Action)
+			assert.DirExists(t, cloner.Path("repo1"))
+			assert.Error(t, results[1].Err)
+
+			// A second run fetches into the existing copy
+			results = cloner.SyncAll(context.Background(), repositories[:1])
+			require.NoError(t, results[0].Err)
+			assert.Equal(t, ActionUpdated, results[0].Action)
+		})
+	}
+}
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("out", "repo"), New(Options{Dir: "out"}, createTestLogger()).Path("repo"))
+	assert.Equal(t, filepath.Join("out", "repo.git"), New(Options{Dir: "out", Bare: true}, createTestLogger()).Path("repo"))
+}
@@ -0,0 +1,94 @@
+// Package gomod parses go.mod files fetched from repositories into the module requirements they declare.
+package gomod
+
+import (
+	"fmt"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// Requirement is a module required by a go.mod file.
+type Requirement struct {
+	Path string
+	// Version is the effective version, after applying a replace directive that pins another version.
+	Version  string
+	Indirect bool
+	// Replace is the replacement module path or local directory, empty if the requirement is not replaced.
+	Replace string
+}
+
+// Module is the parsed content of a go.mod file.
+type Module struct {
+	Path      string
+	GoVersion string
+	Requires  []Requirement
+}
+
+// Parse parses the content of a go.mod file. Replace directives are applied to the requirements they
+// cover; a replacement by a local directory keeps the required version.
+func Parse(content []byte) (*Module, error) {
+	file, err := modfile.Parse("go.mod", content, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	module := &Module{}
+	if file.Module != nil {
+		module.Path = file.Module.Mod.Path
+	}
+
+	if file.Go != nil {
+		module.GoVersion = file.Go.Version
+	}
+
+	for _, require := range file.Require {
+		requirement := Requirement{
+			Path:     require.Mod.Path,
+			Version:  require.Mod.Version,
+			Indirect: require.Indirect,
+		}
+
+		for _, replace := range file.Replace {
+			// A replace without a version on the left applies to every version of the module
+			if replace.Old.Path != requirement.Path || (replace.Old.Version != "" && replace.Old.Version != requirement.Version) {
+				continue
+			}
+
+			requirement.Replace = replace.New.Path
+			if replace.New.Version != "" {
+				requirement.Version = replace.New.Version
+			}
+		}
+
+		module.Requires = append(module.Requires, requirement)
+	}
+
+	return module, nil
+}
+
+// Find returns the requirement on the module with the given path, if the go.mod declares one.
+func (m *Module) Find(path string) (Requirement, bool) {
+	for _, requirement := range m.Requires {
+		if requirement.Path == path {
+			return requirement, true
+		}
+	}
+
+	return Requirement{}, false
+}
+
+// VersionBelow reports whether version is lower than limit in semantic version order. Versions that
+// are not valid semantic versions are never below the limit.
+func VersionBelow(version, limit string) bool {
+	if !semver.IsValid(version) {
+		return false
+	}
+
+	return semver.Compare(version, limit) < 0
+}
+
+// ValidVersion reports whether version is a valid semantic version such as v1.4 or v1.4.2.
+func ValidVersion(version string) bool {
+	return semver.IsValid(version)
+}
@@ -0,0 +1,76 @@
+package gomod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGoMod = `module github.com/testorg/service
+
+go 1.22
+
+require (
+	github.com/google/go-github/v62 v62.0.0
+	github.com/testorg/lib v1.3.2
+	golang.org/x/sys v0.14.0 // indirect
+	github.com/testorg/forked v1.0.0
+)
+
+replace github.com/testorg/lib v1.3.2 => github.com/testorg/lib v1.4.1
+
+replace github.com/testorg/forked => ../forked
+`
+
+func TestParse(t *testing.T) {
+	module, err := Parse([]byte(testGoMod))
+	require.NoError(t, err)
+
+	assert.Equal(t, "github.com/testorg/service", module.Path)
+	assert.Equal(t, "1.22", module.GoVersion)
+	require.Len(t, module.Requires, 4)
+
+	assert.Equal(t, Requirement{Path: "github.com/google/go-github/v62", Version: "v62.0.0"}, module.Requires[0])
+	assert.Equal(t, Requirement{Path: "github.com/testorg/lib", Version: "v1.4.1", Replace: "github.com/testorg/lib"}, module.Requires[1])
+	assert.True(t, module.Requires[2].Indirect)
+	assert.Equal(t, Requirement{Path: "github.com/testorg/forked", Version: "v1.0.0", Replace: "../forked"}, module.Requires[3])
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte("module\nrequire (\n"))
+	assert.Error(t, err)
+}
+
+func TestModule_Find(t *testing.T) {
+	module, err := Parse([]byte(testGoMod))
+	require.NoError(t, err)
+
+	requirement, found := module.Find("golang.org/x/sys")
+	assert.True(t, found)
+	assert.Equal(t, "v0.14.0", requirement.Version)
+
+	_, found = module.Find("golang.org/x/mod")
+	assert.False(t, found)
+}
+
+func TestVersionBelow(t *testing.T) {
+	tests := []struct {
+		version  string
+		limit    string
+		expected bool
+	}{
+		{"v1.3.9", "v1.4", true},
+		{"v1.4.0", "v1.4", false},
+		{"v1.4.0-rc.1", "v1.4.0", true},
+		{"v0.0.0-20240101000000-abcdef123456", "v0.1.0", true},
+		{"v2.0.0+incompatible", "v1.4", false},
+		{"latest", "v1.4", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, VersionBelow(tt.version, tt.limit))
+		})
+	}
+}
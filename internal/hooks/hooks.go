@@ -0,0 +1,136 @@
+// Package hooks runs user-supplied commands before and after each repository of a batch operation, for
+// side effects such as opening tickets or invalidating caches. The hooks of a run travel in its context.
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"go-repo-manager/internal/logger"
+	"go-repo-manager/internal/metrics"
+)
+
+// Environment variables describing the repository to a hook.
+const (
+	EnvOwner     = "GRM_OWNER"
+	EnvRepo      = "GRM_REPO"
+	EnvOperation = "GRM_OPERATION"
+	EnvHook      = "GRM_HOOK"
+	// EnvResult is success or failure; post-hooks only.
+	EnvResult = "GRM_RESULT"
+	// EnvError is the error of a failed repository, empty on success; post-hooks only.
+	EnvError = "GRM_ERROR"
+)
+
+// Hooks are the shell commands run around each repository a batch operation processes for an owner.
+type Hooks struct {
+	// Pre runs before a repository is processed. When it fails, the repository fails without being
+	// processed.
+	Pre string
+	// Post runs after a repository is processed, whatever the result. Its failure is only logged.
+	Post string
+	// Owner is the organization or user the repositories belong to.
+	Owner string
+}
+
+// hooksKey is the context key of the hooks.
+type hooksKey struct{}
+
+// WithHooks returns a context running h around the repositories processed under it.
+func WithHooks(ctx context.Context, h Hooks) context.Context {
+	if h.Pre == "" && h.Post == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, hooksKey{}, h)
+}
+
+// Owner returns the owner of the hooks of ctx, empty when ctx runs no hooks.
+func Owner(ctx context.Context) string {
+	h, _ := ctx.Value(hooksKey{}).(Hooks)
+	return h.Owner
+}
+
+// Pre runs the pre-hook of ctx, if any, for a repository and returns an error when it fails.
+func Pre(ctx context.Context, repoName string) error {
+	h, ok := ctx.Value(hooksKey{}).(Hooks)
+	if !ok || h.Pre == "" {
+		return nil
+	}
+
+	if err := run(ctx, h.Pre, h.env(ctx, "pre", repoName)); err != nil {
+		return fmt.Errorf("pre-hook failed: %w", err)
+	}
+
+	return nil
+}
+
+// Post runs the post-hook of ctx, if any, for a repository processed with the given result. A failure is
+// logged and does not change the result, as the repository has already been processed.
+func Post(ctx context.Context, repoName string, result error) {
+	h, ok := ctx.Value(hooksKey{}).(Hooks)
+	if !ok || h.Post == "" {
+		return
+	}
+
+	var message string
+	if result != nil {
+		message = result.Error()
+	}
+	env := append(h.env(ctx, "post", repoName), EnvResult+"="+metrics.Result(result), EnvError+"="+message)
+
+	if err := run(ctx, h.Post, env); err != nil {
+		logger.GetLogger().Warn("Post-hook failed", "owner", h.Owner, "repo", repoName, "error", err)
+	}
+}
+
+// env returns the environment describing a repository to a hook.
+func (h Hooks) env(ctx context.Context, hook, repoName string) []string {
+	return []string{
+		EnvOwner + "=" + h.Owner,
+		EnvRepo + "=" + repoName,
+		EnvOperation + "=" + metrics.Operation(ctx),
+		EnvHook + "=" + hook,
+	}
+}
+
+// run runs command with the shell of the platform and env added to the environment of the process. The
+// output of the command is logged at debug level; when it fails, the last line of its stderr is the error.
+func run(ctx context.Context, command string, env []string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if output := strings.TrimSpace(stdout.String() + stderr.String()); output != "" {
+		logger.GetLogger().Debug("Hook output", "command", command, "output", output)
+	}
+
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		lines := strings.Split(strings.TrimSpace(stderr.String()), "\n")
+		if last := strings.TrimSpace(lines[len(lines)-1]); last != "" {
+			return fmt.Errorf("%s: %s", err, last)
+		}
+
+		return err
+	}
+
+	return nil
+}
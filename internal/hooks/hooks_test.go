@@ -0,0 +1,47 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/metrics"
+)
+
+func TestPreAndPost(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "hooks.log")
+
+	ctx := WithHooks(metrics.WithOperation(context.Background(), "sync"), Hooks{
+		Pre:   `echo "$GRM_HOOK $GRM_OPERATION $GRM_OWNER/$GRM_REPO" >> ` + out,
+		Post:  `echo "$GRM_HOOK $GRM_OWNER/$GRM_REPO $GRM_RESULT $GRM_ERROR" >> ` + out,
+		Owner: "testorg",
+	})
+
+	require.NoError(t, Pre(ctx, "api"))
+	Post(ctx, "api", nil)
+	Post(ctx, "web", errors.New("boom"))
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "pre sync testorg/api\npost testorg/api success \npost testorg/web failure boom\n", string(data))
+}
+
+func TestPre_Failure(t *testing.T) {
+	ctx := WithHooks(context.Background(), Hooks{Pre: `echo "ticket system unavailable" >&2; exit 2`})
+
+	err := Pre(ctx, "api")
+	assert.EqualError(t, err, "pre-hook failed: exit status 2: ticket system unavailable")
+}
+
+func TestWithoutHooks(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, ctx, WithHooks(ctx, Hooks{Owner: "testorg"}))
+	assert.NoError(t, Pre(ctx, "api"))
+	Post(ctx, "api", nil)
+}
@@ -1,8 +1,11 @@
 package logger
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/MatusOllah/slogcolor"
 )
@@ -10,19 +13,81 @@ import (
 // Global logger instance.
 var Logger *slog.Logger
 
-// Setup initializes the logger with colored output using slogcolor.
-func Setup() {
-	// Initialize logger with colored output from slogcolor
-	opts := &slogcolor.Options{
-		Level:      slog.LevelInfo,
-		TimeFormat: "2006-01-02 15:04:05", // Standard Go time format
+// Log formats.
+const (
+	// FormatText logs human-readable lines, colored when written to the terminal.
+	FormatText = "text"
+	// FormatJSON logs one JSON object per line for log processors.
+	FormatJSON = "json"
+)
+
+// Options configures the logger. The zero value logs colored text at info level to stdout.
+type Options struct {
+	// Level is the minimum level that is logged.
+	Level slog.Level
+	// Format is FormatText or FormatJSON. Empty means FormatText.
+	Format string
+	// Output receives the log lines. Nil means stdout.
+	Output io.Writer
+}
+
+// Setup initializes the logger. Text logs on stdout are colored using slogcolor.
+func Setup(opts Options) {
+	Logger = slog.New(newHandler(opts))
+
+	// Set as default logger
+	slog.SetDefault(Logger)
+}
+
+// newHandler returns the handler writing logs in the format and to the output of the options.
+func newHandler(opts Options) slog.Handler {
+	if opts.Format == FormatJSON {
+		return slog.NewJSONHandler(outputOrStdout(opts.Output), &slog.HandlerOptions{Level: opts.Level})
+	}
+
+	if opts.Output != nil {
+		return slog.NewTextHandler(opts.Output, &slog.HandlerOptions{Level: opts.Level})
 	}
 
 	// Use slogcolor's handler for colored output
-	handler := slogcolor.NewHandler(os.Stdout, opts)
-	Logger = slog.New(handler)
+	return slogcolor.NewHandler(os.Stdout, &slogcolor.Options{
+		Level:      opts.Level,
+		TimeFormat: "2006-01-02 15:04:05", // Standard Go time format
+	})
+}
 
-	// Set as default logger
+// outputOrStdout returns the output, defaulting to stdout.
+func outputOrStdout(output io.Writer) io.Writer {
+	if output == nil {
+		return os.Stdout
+	}
+
+	return output
+}
+
+// ParseFormat validates a log format name: text or json.
+func ParseFormat(name string) (string, error) {
+	switch name {
+	case FormatText, FormatJSON:
+		return name, nil
+	default:
+		return "", fmt.Errorf("invalid log format %q: must be %s or %s", name, FormatText, FormatJSON)
+	}
+}
+
+// ParseLevel parses a level name: debug, info, warn or error.
+func ParseLevel(name string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(name)); err != nil || strings.ContainsAny(name, "+-") {
+		return level, fmt.Errorf("invalid log level %q: must be debug, info, warn or error", name)
+	}
+
+	return level, nil
+}
+
+// Disable discards all log output, e.g. while shell completions are written to stdout.
+func Disable() {
+	Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
 	slog.SetDefault(Logger)
 }
 
@@ -30,7 +95,7 @@ func Setup() {
 func GetLogger() *slog.Logger {
 	// If Logger is nil, initialize it
 	if Logger == nil {
-		Setup()
+		Setup(Options{})
 	}
 
 	return Logger
@@ -0,0 +1,74 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup_JSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	Setup(Options{Level: slog.LevelInfo, Format: FormatJSON, Output: &buf})
+	t.Cleanup(func() { Logger = nil })
+
+	GetLogger().Debug("hidden")
+	GetLogger().Info("Processing repository", "repo", "api")
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry), "expected exactly one JSON line, got %q", buf.String())
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, "Processing repository", entry["msg"])
+	assert.Equal(t, "api", entry["repo"])
+}
+
+func TestSetup_TextFormatToFileIsPlain(t *testing.T) {
+	var buf bytes.Buffer
+	Setup(Options{Output: &buf})
+	t.Cleanup(func() { Logger = nil })
+
+	GetLogger().Warn("Rate limited", "remaining", 0)
+
+	assert.Contains(t, buf.String(), `level=WARN msg="Rate limited" remaining=0`)
+	assert.NotContains(t, buf.String(), "\x1b[")
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    slog.Level
+		wantErr bool
+	}{
+		{"debug", slog.LevelDebug, false},
+		{"INFO", slog.LevelInfo, false},
+		{"warn", slog.LevelWarn, false},
+		{"error", slog.LevelError, false},
+		{"info+2", 0, true},
+		{"verbose", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, err := ParseLevel(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, level)
+		})
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("json")
+	require.NoError(t, err)
+	assert.Equal(t, FormatJSON, format)
+
+	_, err = ParseFormat("yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+// Package metrics collects Prometheus metrics of batch runs: GitHub API requests, rate limits, processed
+// repositories and operation durations. Server modes expose them for scraping and CLI runs can push them
+// to a Prometheus push gateway.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ContentType is the content type of the Prometheus text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Result label values of ReposProcessed and OperationDuration.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// pushTimeout bounds how long pushing to a push gateway may take.
+const pushTimeout = 10 * time.Second
+
+// Default is the registry the metrics below are registered with.
+var Default = NewRegistry()
+
+var (
+	// APIRequests counts the GitHub API requests sent over the network, by method and status code, with
+	// status "error" for requests that got no response. Responses served from the response cache are not
+	// counted.
+	APIRequests = Default.NewCounter("grm_github_api_requests_total",
+		"GitHub API requests sent, by method and status code.", "method", "status")
+	// RateLimitRemaining is the number of requests left in the current rate limit window, by rate limit
+	// resource such as core, search or graphql, as last reported by GitHub.
+	RateLimitRemaining = Default.NewGauge("grm_github_rate_limit_remaining",
+		"Requests left in the current GitHub rate limit window, by resource.", "resource")
+	// SecondaryRateLimits counts the requests throttled by secondary rate limits.
+	SecondaryRateLimits = Default.NewCounter("grm_github_secondary_rate_limits_total",
+		"GitHub API requests throttled by secondary rate limits.")
+	// ReposProcessed counts the repositories a batch operation finished, by operation and result.
+	ReposProcessed = Default.NewCounter("grm_repos_processed_total",
+		"Repositories processed by batch operations, by operation and result.", "operation", "result")
+	// OperationDuration is the duration of CLI runs, API jobs and webhook deliveries, by operation and result.
+	OperationDuration = Default.NewHistogram("grm_operation_duration_seconds",
+		"Duration of operations in seconds, by operation and result.",
+		[]float64{1, 5, 15, 30, 60, 300, 900, 1800, 3600}, "operation", "result")
+)
+
+// operationKey is the context key of the operation name.
+type operationKey struct{}
+
+// WithOperation returns a context attributing the repositories processed under it to an operation.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// Operation returns the operation of a context, or "unknown" when none is set.
+func Operation(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok && operation != "" {
+		return operation
+	}
+
+	return "unknown"
+}
+
+// Result returns the result label value of an error.
+func Result(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+
+	return ResultSuccess
+}
+
+// RecordRepo counts a repository processed by the operation of ctx.
+func RecordRepo(ctx context.Context, err error) {
+	ReposProcessed.Inc(Operation(ctx), Result(err))
+}
+
+// ObserveOperation records the duration of an operation that started at start.
+func ObserveOperation(operation string, start time.Time, err error) {
+	OperationDuration.Observe(time.Since(start).Seconds(), operation, Result(err))
+}
+
+// Push replaces the metrics of job on the push gateway at gatewayURL with the metrics of the registry.
+func (r *Registry) Push(ctx context.Context, gatewayURL, job string) error {
+	var body bytes.Buffer
+	if err := r.WriteText(&body); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pushTimeout)
+	defer cancel()
+
+	endpoint := strings.TrimSuffix(gatewayURL, "/") + "/metrics/job/" + url.PathEscape(job)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("failed to push metrics: %w", err)
+	}
+	req.Header.Set("Content-Type", ContentType)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push metrics to %s: %w", gatewayURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("failed to push metrics to %s: %s", gatewayURL, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation(t *testing.T) {
+	assert.Equal(t, "unknown", Operation(context.Background()))
+	assert.Equal(t, "sync", Operation(WithOperation(context.Background(), "sync")))
+}
+
+func TestResult(t *testing.T) {
+	assert.Equal(t, ResultSuccess, Result(nil))
+	assert.Equal(t, ResultFailure, Result(errors.New("boom")))
+}
+
+func TestRegistry_Push(t *testing.T) {
+	var method, path, body string
+
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := io.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.EscapedPath(), string(content)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer gateway.Close()
+
+	registry := NewRegistry()
+	registry.NewCounter("repos_total", "Repositories.").Add(3)
+
+	require.NoError(t, registry.Push(context.Background(), gateway.URL+"/", "nightly sync"))
+
+	assert.Equal(t, http.MethodPut, method)
+	assert.Equal(t, "/metrics/job/nightly%20sync", path)
+	assert.Contains(t, body, "repos_total 3\n")
+}
+
+func TestRegistry_PushError(t *testing.T) {
+	gateway := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer gateway.Close()
+
+	err := NewRegistry().Push(context.Background(), gateway.URL, "job")
+	assert.ErrorContains(t, err, "400 Bad Request")
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_WriteText(t *testing.T) {
+	registry := NewRegistry()
+	requests := registry.NewCounter("requests_total", "Requests sent.", "method", "status")
+	remaining := registry.NewGauge("remaining", "Requests left.\nPer resource.", "resource")
+	duration := registry.NewHistogram("duration_seconds", "Durations.", []float64{1, 5}, "operation")
+	registry.NewCounter("unused_total", "Never incremented.")
+
+	requests.Inc("GET", "200")
+	requests.Add(2, "GET", "200")
+	requests.Inc("POST", "error")
+	remaining.Set(4999, "core")
+	remaining.Set(4998, "core")
+	remaining.Set(29, `se"arch`)
+	duration.Observe(0.5, "sync")
+	duration.Observe(3, "sync")
+	duration.Observe(60, "sync")
+
+	var out strings.Builder
+	require.NoError(t, registry.WriteText(&out))
+
+	assert.Equal(t, `# HELP requests_total Requests sent.
+# TYPE requests_total counter
+requests_total{method="GET",status="200"} 3
+requests_total{method="POST",status="error"} 1
+# HELP remaining Requests left.\nPer resource.
+# TYPE remaining gauge
+remaining{resource="core"} 4998
+remaining{resource="se\"arch"} 29
+# HELP duration_seconds Durations.
+# TYPE duration_seconds histogram
+duration_seconds_bucket{operation="sync",le="1"} 1
+duration_seconds_bucket{operation="sync",le="5"} 2
+duration_seconds_bucket{operation="sync",le="+Inf"} 3
+duration_seconds_sum{operation="sync"} 63.5
+duration_seconds_count{operation="sync"} 3
+`, out.String())
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounter("throttled_total", "Throttled requests.").Inc()
+
+	recorder := httptest.NewRecorder()
+	registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, ContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), "throttled_total 1\n")
+}
@@ -0,0 +1,186 @@
+// Package plugin runs custom per-repository operations implemented by external executables, so teams can
+// extend the tool without forking it.
+//
+// A plugin is an executable named go-repo-manager-<name>, found on PATH or given by path. It is started once
+// per repository with the arguments given after its name and receives a Request as JSON on stdin. It
+// writes a Response as JSON on stdout and exits with status zero, or exits with a non-zero status to fail
+// the repository, the last line of its stderr being the error. Everything else a plugin writes to stderr is
+// logged at debug level.
+//
+// Plugins reach the GitHub API through the URL and token of the request, which proxy requests through the
+// client of the run with its authentication, rate limit handling, response cache and tracing.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/logger"
+)
+
+// ProtocolVersion is the version of the protocol spoken with plugins, sent in every request. It changes only
+// when a change would break existing plugins.
+const ProtocolVersion = 1
+
+// ExecutablePrefix is the prefix of the executable name of every plugin.
+const ExecutablePrefix = "go-repo-manager-"
+
+// Request is what a plugin receives on stdin for each repository.
+type Request struct {
+	ProtocolVersion int    `json:"protocol_version"`
+	Owner           string `json:"owner"`
+	// Repository is the repository to operate on, as returned by the GitHub API.
+	Repository *github.Repository `json:"repository"`
+	// Repositories are the names of all the repositories of the run, sorted.
+	Repositories []string `json:"repositories"`
+	// APIURL is the base URL of the GitHub API proxy, ending in a slash; REST paths such as
+	// repos/{owner}/{repo} and graphql are resolved against it.
+	APIURL string `json:"api_url"`
+	// APIToken must be sent as a bearer token in the Authorization header of requests to the proxy.
+	APIToken string `json:"api_token"`
+	// DryRun asks the plugin to report what it would change without changing anything.
+	DryRun bool `json:"dry_run"`
+}
+
+// Response is what a plugin writes on stdout for a repository.
+type Response struct {
+	// Changed reports whether the plugin changed the repository, or would have in a dry run.
+	Changed bool `json:"changed"`
+	// Message describes what the plugin did.
+	Message string `json:"message,omitempty"`
+	// Error fails the repository when not empty, like exiting with a non-zero status.
+	Error string `json:"error,omitempty"`
+}
+
+// Plugin is an executable implementing a custom operation.
+type Plugin struct {
+	// Name is the name of the plugin, its executable name without ExecutablePrefix.
+	Name string
+	// Path is the path of the executable.
+	Path string
+}
+
+// Lookup finds the plugin with the given name on PATH. A name containing a path separator is the path of
+// the executable instead.
+func Lookup(name string) (*Plugin, error) {
+	if strings.ContainsRune(name, filepath.Separator) {
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, fmt.Errorf("plugin %s not found: %w", name, err)
+		}
+		if info.IsDir() || info.Mode().Perm()&0o111 == 0 {
+			return nil, fmt.Errorf("plugin %s is not an executable file", name)
+		}
+
+		return &Plugin{Name: strings.TrimPrefix(filepath.Base(name), ExecutablePrefix), Path: name}, nil
+	}
+
+	path, err := exec.LookPath(ExecutablePrefix + name)
+	if err != nil {
+		return nil, fmt.Errorf("plugin %s not found: no %s executable on PATH", name, ExecutablePrefix+name)
+	}
+
+	return &Plugin{Name: name, Path: path}, nil
+}
+
+// List returns the plugins on PATH sorted by name. When several directories hold a plugin with the same
+// name, the first one wins, as it does for Lookup.
+func List() []*Plugin {
+	seen := map[string]bool{}
+	var plugins []*Plugin
+
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range entries {
+			name, ok := strings.CutPrefix(entry.Name(), ExecutablePrefix)
+			if !ok || name == "" || seen[name] {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil || info.IsDir() || info.Mode().Perm()&0o111 == 0 {
+				continue
+			}
+
+			seen[name] = true
+			plugins = append(plugins, &Plugin{Name: name, Path: filepath.Join(dir, entry.Name())})
+		}
+	}
+
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+
+	return plugins
+}
+
+// Run runs the plugin with args for the repository of req and returns its response. The plugin is killed
+// when ctx is done.
+func (p *Plugin) Run(ctx context.Context, req Request, args []string) (Response, error) {
+	req.ProtocolVersion = ProtocolVersion
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return Response{}, fmt.Errorf("failed to encode plugin request: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.Path, args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+	if stderr.Len() > 0 {
+		logger.GetLogger().Debug("Plugin output", "plugin", p.Name, "repo", req.Repository.GetName(),
+			"stderr", strings.TrimSpace(stderr.String()))
+	}
+
+	if runErr != nil {
+		if ctx.Err() != nil {
+			return Response{}, fmt.Errorf("plugin %s failed: %w", p.Name, ctx.Err())
+		}
+
+		var exitErr *exec.ExitError
+		if errors.As(runErr, &exitErr) {
+			if message := lastLine(stderr.String()); message != "" {
+				return Response{}, fmt.Errorf("plugin %s failed: %s", p.Name, message)
+			}
+		}
+
+		return Response{}, fmt.Errorf("plugin %s failed: %w", p.Name, runErr)
+	}
+
+	var resp Response
+	if len(bytes.TrimSpace(stdout.Bytes())) > 0 {
+		if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+			return Response{}, fmt.Errorf("plugin %s returned an invalid response: %w", p.Name, err)
+		}
+	}
+
+	if resp.Error != "" {
+		return resp, fmt.Errorf("plugin %s failed: %s", p.Name, resp.Error)
+	}
+
+	return resp, nil
+}
+
+// lastLine returns the last non-empty line of output.
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writePlugin writes a shell script plugin to dir and returns its path.
+func writePlugin(t *testing.T, dir, name, script string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, ExecutablePrefix+name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o755))
+
+	return path
+}
+
+func TestPlugin_Run(t *testing.T) {
+	path := writePlugin(t, t.TempDir(), "echo", `
+input=$(cat)
+case "$input" in
+  *'"protocol_version":1'*'"name":"api"'*'"dry_run":true'*) ;;
+  *) echo "unexpected request: $input" >&2; exit 1 ;;
+esac
+echo "checking $1" >&2
+echo '{"changed": true, "message": "would update '"$1"'"}'
+`)
+
+	p, err := Lookup(path)
+	require.NoError(t, err)
+	assert.Equal(t, "echo", p.Name)
+
+	resp, err := p.Run(context.Background(), Request{
+		Owner:      "testorg",
+		Repository: &github.Repository{Name: github.String("api")},
+		DryRun:     true,
+	}, []string{"README.md"})
+	require.NoError(t, err)
+	assert.Equal(t, Response{Changed: true, Message: "would update README.md"}, resp)
+}
+
+func TestPlugin_RunFailure(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{"exit status", "cat >/dev/null\necho 'starting' >&2\necho 'repository is not a Go module' >&2\nexit 3", "plugin failing failed: repository is not a Go module"},
+		{"error response", `cat >/dev/null; echo '{"error": "quota exceeded"}'`, "plugin failing failed: quota exceeded"},
+		{"invalid response", "cat >/dev/null; echo 'done'", "plugin failing returned an invalid response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Plugin{Name: "failing", Path: writePlugin(t, dir, "failing", tt.script)}
+
+			_, err := p.Run(context.Background(), Request{Repository: &github.Repository{Name: github.String("api")}}, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLookupAndList(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	writePlugin(t, first, "labels", "exit 0")
+	writePlugin(t, second, "labels", "exit 0")
+	writePlugin(t, second, "audit", "exit 0")
+	require.NoError(t, os.WriteFile(filepath.Join(second, ExecutablePrefix+"notes"), []byte("not executable"), 0o644))
+	t.Setenv("PATH", first+string(os.PathListSeparator)+second)
+
+	p, err := Lookup("labels")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(first, ExecutablePrefix+"labels"), p.Path)
+
+	_, err = Lookup("missing")
+	assert.EqualError(t, err, "plugin missing not found: no go-repo-manager-missing executable on PATH")
+
+	plugins := List()
+	require.Len(t, plugins, 2)
+	assert.Equal(t, "audit", plugins[0].Name)
+	assert.Equal(t, "labels", plugins[1].Name)
+	assert.Equal(t, p.Path, plugins[1].Path)
+}
@@ -0,0 +1,113 @@
+package plugin
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/logger"
+)
+
+// APIProxy serves the GitHub API to plugins on a loopback address, sending their requests through the
+// client of the run. Only requests carrying its token are accepted, so other local processes cannot borrow
+// the credentials of the run.
+type APIProxy struct {
+	// URL is the base URL of the proxy, ending in a slash.
+	URL string
+	// Token is the bearer token requests to the proxy must carry.
+	Token  string
+	server *http.Server
+}
+
+// StartAPIProxy starts a proxy for client on a free loopback port. It must be closed once the plugins are
+// done.
+func StartAPIProxy(client *github.Client) (*APIProxy, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("failed to generate plugin API token: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to start plugin API proxy: %w", err)
+	}
+
+	proxy := &APIProxy{URL: "http://" + listener.Addr().String() + "/", Token: hex.EncodeToString(secret)}
+	proxy.server = &http.Server{Handler: newProxyHandler(client, proxy.URL, proxy.Token), ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		if err := proxy.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.GetLogger().Error("Plugin API proxy failed", "error", err)
+		}
+	}()
+
+	return proxy, nil
+}
+
+// Close stops the proxy.
+func (p *APIProxy) Close() error {
+	return p.server.Close()
+}
+
+// newProxyHandler forwards authorized requests to the API base URL of client, replacing the proxy token by
+// the credentials of the client. Redirects are passed on rather than followed, and pagination links are
+// rewritten to go through the proxy at proxyURL.
+func newProxyHandler(client *github.Client, proxyURL, token string) http.Handler {
+	httpClient := client.Client()
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	baseURL := client.BaseURL.String()
+	authorization := []byte("Bearer " + token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), authorization) != 1 {
+			http.Error(w, "missing or invalid plugin API token", http.StatusUnauthorized)
+			return
+		}
+
+		target, err := client.BaseURL.Parse(strings.TrimPrefix(r.URL.EscapedPath(), "/"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		target.RawQuery = r.URL.RawQuery
+
+		out, err := http.NewRequestWithContext(r.Context(), r.Method, target.String(), r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		out.Header = r.Header.Clone()
+		out.Header.Del("Authorization")
+		out.ContentLength = r.ContentLength
+
+		resp, err := httpClient.Do(out)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		for name, values := range resp.Header {
+			for _, value := range values {
+				if name == "Link" {
+					value = strings.ReplaceAll(value, baseURL, proxyURL)
+				}
+				w.Header().Add(name, value)
+			}
+		}
+		w.WriteHeader(resp.StatusCode)
+		io.Copy(w, resp.Body)
+	})
+}
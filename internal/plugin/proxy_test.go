@@ -0,0 +1,67 @@
+package plugin
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIProxy(t *testing.T) {
+	var upstream *httptest.Server
+	upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer github-token", r.Header.Get("Authorization"))
+
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Link", `<`+upstream.URL+`/repos/testorg/api/issues?page=2>; rel="next"`)
+		w.Write([]byte(r.Method + " " + r.URL.RequestURI() + " " + string(body)))
+	}))
+	defer upstream.Close()
+
+	client := github.NewClient(nil).WithAuthToken("github-token")
+	client.BaseURL, _ = client.BaseURL.Parse(upstream.URL + "/")
+
+	proxy, err := StartAPIProxy(client)
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	req, err := http.NewRequest(http.MethodPost, proxy.URL+"repos/testorg/api/issues?state=open", strings.NewReader(`{"title":"x"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer "+proxy.Token)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `POST /repos/testorg/api/issues?state=open {"title":"x"}`, string(body))
+	assert.Equal(t, `<`+proxy.URL+`repos/testorg/api/issues?page=2>; rel="next"`, resp.Header.Get("Link"))
+}
+
+func TestAPIProxy_RejectsWrongToken(t *testing.T) {
+	client := github.NewClient(nil).WithAuthToken("github-token")
+
+	proxy, err := StartAPIProxy(client)
+	require.NoError(t, err)
+	defer proxy.Close()
+
+	for _, authorization := range []string{"", "Bearer github-token"} {
+		req, err := http.NewRequest(http.MethodGet, proxy.URL+"user", nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+}
@@ -0,0 +1,61 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// RepoData holds the per-repository variables available to templates.
+type RepoData struct {
+	Owner string
+	Repo  string
+}
+
+// ModuleData holds the per-repository variables available to templates of Go repository files.
+type ModuleData struct {
+	RepoData
+	// Module is the module path declared in the go.mod file of the repository.
+	Module string
+}
+
+// IssueData holds the per-issue variables available to templates.
+type IssueData struct {
+	RepoData
+	Number int
+	Title  string
+	URL    string
+	Author string
+}
+
+// ReleaseData holds the per-release variables available to templates.
+type ReleaseData struct {
+	RepoData
+	Tag string
+}
+
+// Text renders a template using the standard {{ }} delimiters, e.g. "Hello {{.Repo}}".
+func Text(name, text string, data any) (string, error) {
+	return execute(name, text, "{{", "}}", data)
+}
+
+// File renders the template of a repository file using [[ ]] delimiters, e.g. "module github.com/[[.Owner]]/[[.Repo]]".
+// Repository files such as GitHub Actions workflows contain "${{ }}" expressions of their own,
+// which are left untouched this way.
+func File(name, text string, data any) (string, error) {
+	return execute(name, text, "[[", "]]", data)
+}
+
+func execute(name, text, leftDelim, rightDelim string, data any) (string, error) {
+	tmpl, err := template.New(name).Delims(leftDelim, rightDelim).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template %s: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", name, err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestText(t *testing.T) {
+	out, err := Text("comment", "Hello {{.Owner}}/{{.Repo}}", RepoData{Owner: "testorg", Repo: "repo1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Hello testorg/repo1", out)
+
+	_, err = Text("broken", "{{.Missing", RepoData{})
+	assert.Error(t, err)
+
+	_, err = Text("unknown", "{{.Unknown}}", RepoData{})
+	assert.Error(t, err)
+}
+
+func TestFile_LeavesActionsExpressionsAlone(t *testing.T) {
+	workflow := "name: [[.Repo]] CI\nenv:\n  TOKEN: ${{ secrets.TOKEN }}\n"
+
+	out, err := File("ci.yml", workflow, RepoData{Owner: "testorg", Repo: "repo1"})
+	require.NoError(t, err)
+	assert.Equal(t, "name: repo1 CI\nenv:\n  TOKEN: ${{ secrets.TOKEN }}\n", out)
+}
+
+func TestFile_ModuleData(t *testing.T) {
+	config := "linters-settings:\n  goimports:\n    local-prefixes: [[.Module]]\n"
+
+	out, err := File(".golangci.yml", config, ModuleData{RepoData: RepoData{Owner: "testorg", Repo: "repo1"}, Module: "github.com/testorg/repo1"})
+	require.NoError(t, err)
+	assert.Equal(t, "linters-settings:\n  goimports:\n    local-prefixes: github.com/testorg/repo1\n", out)
+}
+
+func TestText_ReleaseData(t *testing.T) {
+	out, err := Text("name", "{{.Repo}} {{.Tag}}", ReleaseData{RepoData: RepoData{Owner: "acme", Repo: "api"}, Tag: "v2.0.0"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "api v2.0.0", out)
+}
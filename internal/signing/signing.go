@@ -0,0 +1,183 @@
+// Package signing signs commits with an OpenPGP or SSH private key, producing the armored signatures git
+// stores in the gpgsig header of a commit.
+package signing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"golang.org/x/crypto/ssh"
+)
+
+// Key formats.
+const (
+	FormatOpenPGP = "openpgp"
+	FormatSSH     = "ssh"
+)
+
+const (
+	// sshSigNamespace is the namespace git signs commits in.
+	sshSigNamespace = "git"
+	sshSigMagic     = "SSHSIG"
+	sshSigVersion   = 1
+	sshSigHash      = "sha512"
+	// sshSigLineLength is the width of the base64 lines of an armored SSH signature.
+	sshSigLineLength = 70
+)
+
+// Signer signs commit payloads with a private key. It implements the MessageSigner interface of go-github.
+type Signer struct {
+	// Format is FormatOpenPGP or FormatSSH.
+	Format string
+	sign   func(w io.Writer, r io.Reader) error
+}
+
+// Sign writes the armored signature of the payload read from r to w.
+func (s *Signer) Sign(w io.Writer, r io.Reader) error {
+	return s.sign(w, r)
+}
+
+// LoadFile reads an armored OpenPGP private key or an OpenSSH private key from path. The passphrase
+// decrypts a protected key and is ignored otherwise.
+func LoadFile(path string, passphrase []byte) (*Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read signing key %s: %w", path, err)
+	}
+
+	return Load(data, passphrase)
+}
+
+// Load parses an armored OpenPGP private key or an OpenSSH private key.
+func Load(key, passphrase []byte) (*Signer, error) {
+	if bytes.Contains(key, []byte("BEGIN PGP PRIVATE KEY BLOCK")) {
+		return loadOpenPGP(key, passphrase)
+	}
+
+	return loadSSH(key, passphrase)
+}
+
+func loadOpenPGP(key, passphrase []byte) (*Signer, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenPGP signing key: %w", err)
+	}
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, errors.New("OpenPGP signing key contains no private key")
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey.Encrypted {
+		if len(passphrase) == 0 {
+			return nil, errors.New("OpenPGP signing key is encrypted and no passphrase was given")
+		}
+		if err := entity.DecryptPrivateKeys(passphrase); err != nil {
+			return nil, fmt.Errorf("failed to decrypt OpenPGP signing key: %w", err)
+		}
+	}
+
+	return &Signer{
+		Format: FormatOpenPGP,
+		sign: func(w io.Writer, r io.Reader) error {
+			return openpgp.ArmoredDetachSign(w, entity, r, nil)
+		},
+	}, nil
+}
+
+func loadSSH(key, passphrase []byte) (*Signer, error) {
+	signer, err := ssh.ParsePrivateKey(key)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) {
+		if len(passphrase) == 0 {
+			return nil, errors.New("SSH signing key is encrypted and no passphrase was given")
+		}
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(key, passphrase)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: must be an armored OpenPGP or OpenSSH private key: %w", err)
+	}
+
+	return &Signer{
+		Format: FormatSSH,
+		sign: func(w io.Writer, r io.Reader) error {
+			return signSSH(w, r, signer)
+		},
+	}, nil
+}
+
+// sshSigBlob is the data signed by an SSH signature, per the SSHSIG protocol of OpenSSH.
+type sshSigBlob struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          string
+}
+
+// sshSignature is the body of an armored SSH signature following the magic preamble.
+type sshSignature struct {
+	Version       uint32
+	PublicKey     string
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     string
+}
+
+// signSSH writes the armored SSHSIG signature of the payload, as produced by ssh-keygen -Y sign -n git.
+func signSSH(w io.Writer, r io.Reader, signer ssh.Signer) error {
+	hash := sha512.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return err
+	}
+
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(sshSigBlob{
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHash,
+		Hash:          string(hash.Sum(nil)),
+	})...)
+
+	// RSA keys must sign with SHA-512 rather than the legacy SHA-1 algorithm.
+	var (
+		signature *ssh.Signature
+		err       error
+	)
+	if algorithmSigner, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		signature, err = signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to sign with SSH key: %w", err)
+	}
+
+	body := append([]byte(sshSigMagic), ssh.Marshal(sshSignature{
+		Version:       sshSigVersion,
+		PublicKey:     string(signer.PublicKey().Marshal()),
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHash,
+		Signature:     string(ssh.Marshal(signature)),
+	})...)
+
+	encoded := base64.StdEncoding.EncodeToString(body)
+
+	var armored strings.Builder
+	armored.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 0 {
+		n := min(len(encoded), sshSigLineLength)
+		armored.WriteString(encoded[:n] + "\n")
+		encoded = encoded[n:]
+	}
+	armored.WriteString("-----END SSH SIGNATURE-----\n")
+
+	_, err = io.WriteString(w, armored.String())
+
+	return err
+}
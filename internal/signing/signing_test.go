@@ -0,0 +1,140 @@
+package signing
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+const payload = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor Bot <bot@example.com> 1700000000 +0000\ncommitter Bot <bot@example.com> 1700000000 +0000\n\nUpdate CODEOWNERS"
+
+func TestSigner_SSH(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		key        crypto.PrivateKey
+		passphrase []byte
+	}{
+		{"Ed25519", ed25519Key, nil},
+		{"RSA", rsaKey, nil},
+		{"Encrypted", ed25519Key, []byte("secret")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var block *pem.Block
+			if tt.passphrase != nil {
+				block, err = ssh.MarshalPrivateKeyWithPassphrase(tt.key, "", tt.passphrase)
+			} else {
+				block, err = ssh.MarshalPrivateKey(tt.key, "")
+			}
+			require.NoError(t, err)
+
+			signer, err := Load(pem.EncodeToMemory(block), tt.passphrase)
+			require.NoError(t, err)
+			assert.Equal(t, FormatSSH, signer.Format)
+
+			var signature bytes.Buffer
+			require.NoError(t, signer.Sign(&signature, strings.NewReader(payload)))
+			verifySSHSignature(t, signature.String(), payload)
+		})
+	}
+}
+
+// verifySSHSignature checks an armored SSHSIG signature like ssh-keygen -Y verify would.
+func verifySSHSignature(t *testing.T, armored, message string) {
+	t.Helper()
+
+	require.True(t, strings.HasPrefix(armored, "-----BEGIN SSH SIGNATURE-----\n"))
+	require.True(t, strings.HasSuffix(armored, "\n-----END SSH SIGNATURE-----\n"))
+	encoded := strings.TrimSuffix(strings.TrimPrefix(armored, "-----BEGIN SSH SIGNATURE-----\n"), "-----END SSH SIGNATURE-----\n")
+	for _, line := range strings.Split(strings.TrimSpace(encoded), "\n") {
+		assert.LessOrEqual(t, len(line), sshSigLineLength)
+	}
+
+	body, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(encoded, "\n", ""))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(body, []byte(sshSigMagic)))
+
+	var sig sshSignature
+	require.NoError(t, ssh.Unmarshal(body[len(sshSigMagic):], &sig))
+	assert.Equal(t, uint32(1), sig.Version)
+	assert.Equal(t, "git", sig.Namespace)
+	assert.Equal(t, "sha512", sig.HashAlgorithm)
+
+	publicKey, err := ssh.ParsePublicKey([]byte(sig.PublicKey))
+	require.NoError(t, err)
+
+	var signature ssh.Signature
+	require.NoError(t, ssh.Unmarshal([]byte(sig.Signature), &signature))
+	if publicKey.Type() == ssh.KeyAlgoRSA {
+		assert.Equal(t, ssh.KeyAlgoRSASHA512, signature.Format)
+	}
+
+	hash := sha512.Sum512([]byte(message))
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(sshSigBlob{Namespace: "git", HashAlgorithm: "sha512", Hash: string(hash[:])})...)
+	assert.NoError(t, publicKey.Verify(signedData, &signature))
+}
+
+func TestSigner_OpenPGP(t *testing.T) {
+	entity, err := openpgp.NewEntity("Automation Bot", "", "bot@example.com", nil)
+	require.NoError(t, err)
+
+	var key bytes.Buffer
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+
+	path := filepath.Join(t.TempDir(), "signing.asc")
+	require.NoError(t, os.WriteFile(path, key.Bytes(), 0o600))
+
+	signer, err := LoadFile(path, nil)
+	require.NoError(t, err)
+	assert.Equal(t, FormatOpenPGP, signer.Format)
+
+	var signature bytes.Buffer
+	require.NoError(t, signer.Sign(&signature, strings.NewReader(payload)))
+	assert.Contains(t, signature.String(), "-----BEGIN PGP SIGNATURE-----")
+
+	_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity}, strings.NewReader(payload), &signature, nil)
+	assert.NoError(t, err)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(ed25519Key, "", []byte("secret"))
+	require.NoError(t, err)
+
+	_, err = Load(pem.EncodeToMemory(block), nil)
+	assert.ErrorContains(t, err, "encrypted and no passphrase")
+
+	_, err = Load(pem.EncodeToMemory(block), []byte("wrong"))
+	assert.Error(t, err)
+
+	_, err = Load([]byte("not a key"), nil)
+	assert.ErrorContains(t, err, "must be an armored OpenPGP or OpenSSH private key")
+
+	_, err = LoadFile(filepath.Join(t.TempDir(), "missing"), nil)
+	assert.ErrorContains(t, err, "failed to read signing key")
+}
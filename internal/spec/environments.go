@@ -0,0 +1,148 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Limits of the environment protection rules.
+const (
+	maxEnvironmentWaitTimer = 43200
+	maxEnvironmentReviewers = 6
+)
+
+// environmentsFile is the layout of an environments spec file.
+type environmentsFile struct {
+	Environments []environmentEntry `yaml:"environments"`
+}
+
+// environmentEntry is an environment of an environments spec file.
+type environmentEntry struct {
+	Name              string             `yaml:"name"`
+	WaitTimer         int                `yaml:"wait_timer"`
+	PreventSelfReview bool               `yaml:"prevent_self_review"`
+	CanAdminsBypass   *bool              `yaml:"can_admins_bypass"`
+	Reviewers         []environmentActor `yaml:"reviewers"`
+	ProtectedBranches bool               `yaml:"protected_branches"`
+	BranchPatterns    []string           `yaml:"branch_patterns"`
+}
+
+// environmentActor is a required reviewer of an environment: either a team slug or a user login.
+type environmentActor struct {
+	Team string `yaml:"team"`
+	User string `yaml:"user"`
+}
+
+// LoadEnvironments reads deployment environments from a YAML spec file:
+//
+//	environments:
+//	  - name: staging
+//	    branch_patterns: ["main", "release/*"]
+//	  - name: production
+//	    wait_timer: 30            # minutes, at most 43200
+//	    prevent_self_review: true
+//	    can_admins_bypass: false
+//	    reviewers:                # at most 6
+//	      - team: sre
+//	      - user: octocat
+//	    protected_branches: true  # or branch_patterns, not both
+//
+// Without protected_branches or branch_patterns deployments are allowed from any branch.
+func LoadEnvironments(path string) ([]ghbatch.EnvironmentSpec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read environments file %s: %w", path, err)
+	}
+
+	environments, err := parseEnvironments(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid environments file %s: %w", path, err)
+	}
+
+	return environments, nil
+}
+
+func parseEnvironments(content []byte) ([]ghbatch.EnvironmentSpec, error) {
+	var file environmentsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, err
+	}
+
+	if len(file.Environments) == 0 {
+		return nil, fmt.Errorf("no environments defined")
+	}
+
+	seen := map[string]bool{}
+	environments := make([]ghbatch.EnvironmentSpec, 0, len(file.Environments))
+	for i, entry := range file.Environments {
+		if entry.Name == "" {
+			return nil, fmt.Errorf("environment %d: name is required", i+1)
+		}
+		if seen[entry.Name] {
+			return nil, fmt.Errorf("duplicate environment %q", entry.Name)
+		}
+		seen[entry.Name] = true
+
+		environment, err := entry.toSpec()
+		if err != nil {
+			return nil, fmt.Errorf("environment %q: %w", entry.Name, err)
+		}
+		environments = append(environments, environment)
+	}
+
+	return environments, nil
+}
+
+// toSpec checks the protection rules of the entry and converts it to an environment spec.
+func (e environmentEntry) toSpec() (ghbatch.EnvironmentSpec, error) {
+	if e.WaitTimer < 0 || e.WaitTimer > maxEnvironmentWaitTimer {
+		return ghbatch.EnvironmentSpec{}, fmt.Errorf("wait_timer must be between 0 and %d minutes", maxEnvironmentWaitTimer)
+	}
+	if len(e.Reviewers) > maxEnvironmentReviewers {
+		return ghbatch.EnvironmentSpec{}, fmt.Errorf("at most %d reviewers are allowed", maxEnvironmentReviewers)
+	}
+	if e.ProtectedBranches && len(e.BranchPatterns) > 0 {
+		return ghbatch.EnvironmentSpec{}, fmt.Errorf("protected_branches and branch_patterns are mutually exclusive")
+	}
+
+	environment := ghbatch.EnvironmentSpec{
+		Name:              e.Name,
+		WaitTimer:         e.WaitTimer,
+		PreventSelfReview: e.PreventSelfReview,
+		CanAdminsBypass:   e.CanAdminsBypass,
+		ProtectedBranches: e.ProtectedBranches,
+	}
+
+	for i, actor := range e.Reviewers {
+		switch {
+		case actor.Team != "" && actor.User != "":
+			return ghbatch.EnvironmentSpec{}, fmt.Errorf("reviewer %d: set either team or user, not both", i+1)
+		case actor.Team != "":
+			environment.Reviewers = append(environment.Reviewers, ghbatch.EnvironmentReviewer{Type: ghbatch.ReviewerTypeTeam, Name: actor.Team})
+		case actor.User != "":
+			environment.Reviewers = append(environment.Reviewers, ghbatch.EnvironmentReviewer{Type: ghbatch.ReviewerTypeUser, Name: actor.User})
+		default:
+			return ghbatch.EnvironmentSpec{}, fmt.Errorf("reviewer %d: team or user is required", i+1)
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, pattern := range e.BranchPatterns {
+		if pattern == "" {
+			return ghbatch.EnvironmentSpec{}, fmt.Errorf("branch_patterns must not contain empty patterns")
+		}
+		if !seen[pattern] {
+			seen[pattern] = true
+			environment.BranchPatterns = append(environment.BranchPatterns, pattern)
+		}
+	}
+
+	return environment, nil
+}
@@ -0,0 +1,68 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func TestLoadEnvironments(t *testing.T) {
+	path := writeSpecFile(t, "environments.yaml", `
+environments:
+  - name: staging
+    branch_patterns: ["main", "release/*", "main"]
+  - name: production
+    wait_timer: 30
+    prevent_self_review: true
+    can_admins_bypass: false
+    reviewers:
+      - team: sre
+      - user: octocat
+    protected_branches: true
+`)
+
+	environments, err := LoadEnvironments(path)
+	require.NoError(t, err)
+	require.Len(t, environments, 2)
+
+	assert.Equal(t, ghbatch.EnvironmentSpec{Name: "staging", BranchPatterns: []string{"main", "release/*"}}, environments[0])
+
+	production := environments[1]
+	assert.Equal(t, 30, production.WaitTimer)
+	assert.True(t, production.PreventSelfReview)
+	require.NotNil(t, production.CanAdminsBypass)
+	assert.False(t, *production.CanAdminsBypass)
+	assert.True(t, production.ProtectedBranches)
+	assert.Equal(t, []ghbatch.EnvironmentReviewer{
+		{Type: ghbatch.ReviewerTypeTeam, Name: "sre"},
+		{Type: ghbatch.ReviewerTypeUser, Name: "octocat"},
+	}, production.Reviewers)
+}
+
+func TestLoadEnvironments_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "empty", content: "environments: []", wantErr: "no environments defined"},
+		{name: "unknown field", content: "environments: [{name: a, timer: 5}]", wantErr: "field timer not found"},
+		{name: "missing name", content: "environments: [{wait_timer: 5}]", wantErr: "environment 1: name is required"},
+		{name: "duplicate", content: "environments: [{name: a}, {name: a}]", wantErr: `duplicate environment "a"`},
+		{name: "wait timer", content: "environments: [{name: a, wait_timer: 50000}]", wantErr: "wait_timer must be between 0 and 43200"},
+		{name: "too many reviewers", content: "environments: [{name: a, reviewers: [{user: a}, {user: b}, {user: c}, {user: d}, {user: e}, {user: f}, {user: g}]}]", wantErr: "at most 6 reviewers"},
+		{name: "team and user", content: "environments: [{name: a, reviewers: [{team: t, user: u}]}]", wantErr: "reviewer 1: set either team or user"},
+		{name: "empty reviewer", content: "environments: [{name: a, reviewers: [{}]}]", wantErr: "reviewer 1: team or user is required"},
+		{name: "branch policies", content: "environments: [{name: a, protected_branches: true, branch_patterns: [main]}]", wantErr: "mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadEnvironments(writeSpecFile(t, "environments.yaml", tt.content))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package spec
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// LoadIssueImports reads the issues to import from a CSV file.
+//
+// The file has a header row naming the columns repo, title, body, labels and assignees, of which repo and
+// title are required. Labels and assignees are separated by ';'. Bodies may span several lines when quoted.
+func LoadIssueImports(path string) ([]ghbatch.IssueImport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open import file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	imports, err := parseIssueImportCSV(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse import file %s: %w", path, err)
+	}
+
+	return imports, nil
+}
+
+func parseIssueImportCSV(reader io.Reader) ([]ghbatch.IssueImport, error) {
+	csvReader := csv.NewReader(reader)
+
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case "repo", "title", "body", "labels", "assignees":
+			columns[column] = i
+		default:
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	for _, column := range []string{"repo", "title"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing required column %q", column)
+		}
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var imports []ghbatch.IssueImport
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// The line of the first field is where the row starts, even when a quoted body spans several lines
+		line, _ := csvReader.FieldPos(0)
+
+		issue := ghbatch.IssueImport{
+			Line:     line,
+			RepoName: field(record, "repo"),
+			Issue: ghbatch.NewIssue{
+				Title:     field(record, "title"),
+				Body:      field(record, "body"),
+				Labels:    splitList(field(record, "labels")),
+				Assignees: splitList(field(record, "assignees")),
+			},
+		}
+
+		if issue.RepoName == "" {
+			return nil, fmt.Errorf("line %d: repo is required", line)
+		}
+		if strings.Contains(issue.RepoName, "/") {
+			return nil, fmt.Errorf("line %d: repo %q must be a repository name within the target owner", line, issue.RepoName)
+		}
+		if issue.Issue.Title == "" {
+			return nil, fmt.Errorf("line %d: title is required", line)
+		}
+
+		imports = append(imports, issue)
+	}
+
+	return imports, nil
+}
+
+// splitList splits a ';' separated list, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,47 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func TestLoadIssueImports(t *testing.T) {
+	content := "repo,title,body,labels,assignees\n" +
+		"api,Broken login,\"Steps:\n1. open the page\",bug;legacy,alice\n" +
+		"web, Slow search ,,,\n"
+
+	imports, err := LoadIssueImports(writeSpecFile(t, "issues.csv", content))
+
+	require.NoError(t, err)
+	assert.Equal(t, []ghbatch.IssueImport{
+		{Line: 2, RepoName: "api", Issue: ghbatch.NewIssue{
+			Title: "Broken login", Body: "Steps:\n1. open the page", Labels: []string{"bug", "legacy"}, Assignees: []string{"alice"},
+		}},
+		{Line: 4, RepoName: "web", Issue: ghbatch.NewIssue{Title: "Slow search"}},
+	}, imports)
+}
+
+func TestLoadIssueImports_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Missing title column", "repo,body\napi,text\n"},
+		{"Unknown column", "repo,title,milestone\napi,foo,v1\n"},
+		{"Missing repo", "repo,title\n,foo\n"},
+		{"Missing title", "repo,title\napi,\n"},
+		{"Qualified repo", "repo,title\nacme/api,foo\n"},
+		{"Wrong field count", "repo,title\napi,foo,bar\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadIssueImports(writeSpecFile(t, "issues.csv", tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// labelColorPattern matches a hex label color without the leading #.
+var labelColorPattern = regexp.MustCompile(`^[0-9a-f]{6}$`)
+
+// labelEntry is the YAML representation of a label.
+type labelEntry struct {
+	Name        string `yaml:"name"`
+	Color       string `yaml:"color"`
+	Description string `yaml:"description"`
+}
+
+// LoadLabels reads issue labels from a YAML file holding a list of labels:
+//
+//	# labels.yaml
+//	- name: bug
+//	  color: d73a4a         # hex color, the leading # is optional
+//	  description: Something isn't working
+//
+// Colors are lowercased and label names must be unique, ignoring case.
+func LoadLabels(path string) ([]ghbatch.RepoLabel, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels file %s: %w", path, err)
+	}
+
+	labels, err := parseLabels(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid labels file %s: %w", path, err)
+	}
+
+	return labels, nil
+}
+
+func parseLabels(content []byte) ([]ghbatch.RepoLabel, error) {
+	var entries []labelEntry
+	if err := yaml.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no labels defined")
+	}
+
+	seen := make(map[string]bool, len(entries))
+	labels := make([]ghbatch.RepoLabel, 0, len(entries))
+
+	for i, entry := range entries {
+		name := strings.TrimSpace(entry.Name)
+		if name == "" {
+			return nil, fmt.Errorf("label %d: name is required", i+1)
+		}
+
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("label %d: duplicate label %q", i+1, name)
+		}
+		seen[strings.ToLower(name)] = true
+
+		color := strings.ToLower(strings.TrimPrefix(entry.Color, "#"))
+		if !labelColorPattern.MatchString(color) {
+			return nil, fmt.Errorf("label %d (%s): color must be six hex digits, got %q", i+1, name, entry.Color)
+		}
+
+		labels = append(labels, ghbatch.RepoLabel{Name: name, Color: color, Description: entry.Description})
+	}
+
+	return labels, nil
+}
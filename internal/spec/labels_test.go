@@ -0,0 +1,49 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func TestLoadLabels(t *testing.T) {
+	content := `
+- name: bug
+  color: "#D73A4A"
+  description: Something isn't working
+- name: needs-triage
+  color: ededed
+`
+
+	labels, err := LoadLabels(writeSpecFile(t, "labels.yaml", content))
+
+	require.NoError(t, err)
+	assert.Equal(t, []ghbatch.RepoLabel{
+		{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+		{Name: "needs-triage", Color: "ededed"},
+	}, labels)
+}
+
+func TestLoadLabels_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Empty", ""},
+		{"Not a list", "name: bug\ncolor: d73a4a\n"},
+		{"Missing name", "- color: d73a4a\n"},
+		{"Missing color", "- name: bug\n"},
+		{"Invalid color", "- name: bug\n  color: red\n"},
+		{"Duplicate name", "- name: bug\n  color: d73a4a\n- name: Bug\n  color: d73a4a\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLabels(writeSpecFile(t, "labels.yaml", tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package spec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// policyFile is the YAML representation of a compliance policy.
+type policyFile struct {
+	Name     string            `yaml:"name"`
+	Files    []policyFileEntry `yaml:"files"`
+	Content  []policyContent   `yaml:"content"`
+	Topics   []string          `yaml:"topics"`
+	Settings yaml.Node         `yaml:"settings"`
+}
+
+// policyFileEntry is a required file; any of its alternatives also satisfies the rule.
+type policyFileEntry struct {
+	Path         string   `yaml:"path"`
+	Alternatives []string `yaml:"alternatives"`
+}
+
+// policyContent is a file whose content must match a regular expression.
+type policyContent struct {
+	Path         string   `yaml:"path"`
+	Alternatives []string `yaml:"alternatives"`
+	Pattern      string   `yaml:"pattern"`
+}
+
+// LoadPolicy reads a compliance policy from a YAML file with the keys:
+//
+//	name: baseline
+//	files:                  # files that must exist
+//	  - path: LICENSE
+//	    alternatives: [LICENSE.md]
+//	content:                # files whose content must match a regular expression
+//	  - path: .github/CODEOWNERS
+//	    pattern: '@myorg/platform'
+//	topics: [team-owned]    # topics every repository must carry
+//	settings:               # repository settings and their required values
+//	  delete_branch_on_merge: true
+//
+// Each entry becomes one rule of the policy, in the order files, content, topics, settings, each in file order.
+func LoadPolicy(path string) (ghbatch.Policy, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ghbatch.Policy{}, fmt.Errorf("failed to open policy file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	policy, err := parsePolicy(file)
+	if err != nil {
+		return ghbatch.Policy{}, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+func parsePolicy(reader io.Reader) (ghbatch.Policy, error) {
+	var entry policyFile
+
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&entry); err != nil && !errors.Is(err, io.EOF) {
+		return ghbatch.Policy{}, err
+	}
+
+	policy := ghbatch.Policy{Name: entry.Name}
+
+	for i, file := range entry.Files {
+		if file.Path == "" {
+			return ghbatch.Policy{}, fmt.Errorf("files entry %d: path is required", i+1)
+		}
+
+		policy.Rules = append(policy.Rules, ghbatch.PolicyRule{
+			Name:  "file:" + file.Path,
+			Kind:  ghbatch.PolicyRuleFile,
+			Paths: append([]string{file.Path}, file.Alternatives...),
+		})
+	}
+
+	for i, content := range entry.Content {
+		if content.Path == "" || content.Pattern == "" {
+			return ghbatch.Policy{}, fmt.Errorf("content entry %d: path and pattern are required", i+1)
+		}
+
+		pattern, err := regexp.Compile(content.Pattern)
+		if err != nil {
+			return ghbatch.Policy{}, fmt.Errorf("content entry %d (%s): invalid pattern: %w", i+1, content.Path, err)
+		}
+
+		policy.Rules = append(policy.Rules, ghbatch.PolicyRule{
+			Name:    "content:" + content.Path,
+			Kind:    ghbatch.PolicyRuleContent,
+			Paths:   append([]string{content.Path}, content.Alternatives...),
+			Pattern: pattern,
+		})
+	}
+
+	for _, topic := range entry.Topics {
+		policy.Rules = append(policy.Rules, ghbatch.PolicyRule{Name: "topic:" + topic, Kind: ghbatch.PolicyRuleTopic, Topic: topic})
+	}
+
+	if entry.Settings.Kind != 0 && entry.Settings.Kind != yaml.MappingNode {
+		return ghbatch.Policy{}, fmt.Errorf("settings must map setting names to values")
+	}
+
+	for i := 0; i+1 < len(entry.Settings.Content); i += 2 {
+		setting, value := entry.Settings.Content[i].Value, entry.Settings.Content[i+1]
+		if _, ok := ghbatch.PolicySettings[setting]; !ok {
+			return ghbatch.Policy{}, fmt.Errorf("unknown setting %q, must be one of %s", setting, strings.Join(policySettingNames(), ", "))
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			return ghbatch.Policy{}, fmt.Errorf("setting %s: value must be a string or boolean", setting)
+		}
+
+		// Booleans are compared in their canonical form, whichever YAML spelling the file uses
+		required := value.Value
+		var boolean bool
+		if value.Tag == "!!bool" && value.Decode(&boolean) == nil {
+			required = strconv.FormatBool(boolean)
+		}
+
+		policy.Rules = append(policy.Rules, ghbatch.PolicyRule{
+			Name:    "setting:" + setting,
+			Kind:    ghbatch.PolicyRuleSetting,
+			Setting: setting,
+			Value:   required,
+		})
+	}
+
+	if len(policy.Rules) == 0 {
+		return ghbatch.Policy{}, fmt.Errorf("policy has no rules")
+	}
+
+	return policy, nil
+}
+
+// policySettingNames returns the settings a policy can require, sorted.
+func policySettingNames() []string {
+	names := make([]string, 0, len(ghbatch.PolicySettings))
+	for name := range ghbatch.PolicySettings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,72 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	path := writeSpecFile(t, "policy.yaml", `
+name: baseline
+files:
+  - path: LICENSE
+    alternatives: [LICENSE.md]
+  - path: README.md
+content:
+  - path: .github/CODEOWNERS
+    pattern: '@myorg/platform'
+topics: [team-owned]
+settings:
+  visibility: private
+  delete_branch_on_merge: True
+  allow_merge_commit: false
+`)
+
+	policy, err := LoadPolicy(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "baseline", policy.Name)
+	require.Len(t, policy.Rules, 7)
+
+	names := make([]string, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		names = append(names, rule.Name)
+	}
+	assert.Equal(t, []string{
+		"file:LICENSE", "file:README.md", "content:.github/CODEOWNERS", "topic:team-owned",
+		"setting:visibility", "setting:delete_branch_on_merge", "setting:allow_merge_commit",
+	}, names)
+
+	assert.Equal(t, []string{"LICENSE", "LICENSE.md"}, policy.Rules[0].Paths)
+	assert.Equal(t, ghbatch.PolicyRuleContent, policy.Rules[2].Kind)
+	assert.True(t, policy.Rules[2].Pattern.MatchString("* @myorg/platform"))
+	assert.Equal(t, "private", policy.Rules[4].Value)
+	assert.Equal(t, "true", policy.Rules[5].Value, "booleans are normalized")
+	assert.Equal(t, "false", policy.Rules[6].Value)
+}
+
+func TestLoadPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "Empty", content: "name: empty\n", wantErr: "policy has no rules"},
+		{name: "Unknown key", content: "required_files: [README.md]\n", wantErr: "field required_files not found"},
+		{name: "File without path", content: "files:\n  - alternatives: [a]\n", wantErr: "files entry 1: path is required"},
+		{name: "Invalid pattern", content: "content:\n  - path: README.md\n    pattern: '('\n", wantErr: "invalid pattern"},
+		{name: "Unknown setting", content: "settings:\n  has_cookies: true\n", wantErr: `unknown setting "has_cookies"`},
+		{name: "Non-scalar setting", content: "settings:\n  visibility: [private]\n", wantErr: "value must be a string or boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadPolicy(writeSpecFile(t, "policy.yaml", tt.content))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package spec
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// repoEntry is the YAML representation of a repository to create.
+type repoEntry struct {
+	Name           string   `yaml:"name"`
+	Description    string   `yaml:"description"`
+	Visibility     string   `yaml:"visibility"`
+	Topics         []string `yaml:"topics"`
+	Team           string   `yaml:"team"`
+	TeamPermission string   `yaml:"team_permission"`
+}
+
+// LoadRepoCreateRequests reads repository specs from a CSV or YAML file, chosen by the file extension.
+//
+// YAML files contain a list of entries with the keys name, description, visibility, team, team_permission
+// and topics. CSV files have a header row naming any of the same columns; topics are separated by ';'.
+func LoadRepoCreateRequests(path string) ([]ghbatch.RepoCreateRequest, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open spec file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var requests []ghbatch.RepoCreateRequest
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		requests, err = parseRepoCSV(file)
+	case ".yaml", ".yml":
+		requests, err = parseRepoYAML(file)
+	default:
+		return nil, fmt.Errorf("unsupported spec file %s: expected .csv, .yaml or .yml", path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse spec file %s: %w", path, err)
+	}
+
+	if err := validateRepoCreateRequests(requests); err != nil {
+		return nil, fmt.Errorf("invalid spec file %s: %w", path, err)
+	}
+
+	return requests, nil
+}
+
+func parseRepoYAML(reader io.Reader) ([]ghbatch.RepoCreateRequest, error) {
+	var entries []repoEntry
+
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(&entries); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	requests := make([]ghbatch.RepoCreateRequest, 0, len(entries))
+	for _, entry := range entries {
+		requests = append(requests, ghbatch.RepoCreateRequest(entry))
+	}
+
+	return requests, nil
+}
+
+func parseRepoCSV(reader io.Reader) ([]ghbatch.RepoCreateRequest, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.TrimLeadingSpace = true
+
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, column := range records[0] {
+		column = strings.ToLower(strings.TrimSpace(column))
+		switch column {
+		case "name", "description", "visibility", "team", "team_permission", "topics":
+			columns[column] = i
+		default:
+			return nil, fmt.Errorf("unknown column %q", column)
+		}
+	}
+
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("missing required column \"name\"")
+	}
+
+	field := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	requests := make([]ghbatch.RepoCreateRequest, 0, len(records)-1)
+
+	for _, record := range records[1:] {
+		request := ghbatch.RepoCreateRequest{
+			Name:           field(record, "name"),
+			Description:    field(record, "description"),
+			Visibility:     field(record, "visibility"),
+			Team:           field(record, "team"),
+			TeamPermission: field(record, "team_permission"),
+			Topics:         splitList(field(record, "topics")),
+		}
+
+		requests = append(requests, request)
+	}
+
+	return requests, nil
+}
+
+func validateRepoCreateRequests(requests []ghbatch.RepoCreateRequest) error {
+	seen := make(map[string]bool, len(requests))
+
+	for i, request := range requests {
+		if request.Name == "" {
+			return fmt.Errorf("entry %d: name is required", i+1)
+		}
+
+		if seen[request.Name] {
+			return fmt.Errorf("entry %d: duplicate repository name %q", i+1, request.Name)
+		}
+		seen[request.Name] = true
+
+		switch request.Visibility {
+		case "", "public", "private", "internal":
+		default:
+			return fmt.Errorf("entry %d (%s): invalid visibility %q", i+1, request.Name, request.Visibility)
+		}
+
+		switch request.TeamPermission {
+		case "", "pull", "triage", "push", "maintain", "admin":
+		default:
+			return fmt.Errorf("entry %d (%s): invalid team permission %q", i+1, request.Name, request.TeamPermission)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Helper function to write a spec file into a temporary directory
+func writeSpecFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoadRepoCreateRequests(t *testing.T) {
+	expected := []ghbatch.RepoCreateRequest{
+		{Name: "student-1", Description: "Alice", Visibility: "private", Team: "teachers", Topics: []string{"course", "2024"}},
+		{Name: "student-2", Visibility: "internal", TeamPermission: "admin"},
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{
+			name:     "CSV",
+			fileName: "repos.csv",
+			content: "name,description,visibility,team,team_permission,topics\n" +
+				"student-1,Alice,private,teachers,,course;2024\n" +
+				"student-2,,internal,,admin,\n",
+		},
+		{
+			name:     "YAML",
+			fileName: "repos.yaml",
+			content: `- name: student-1
+  description: Alice
+  visibility: private
+  team: teachers
+  topics: [course, "2024"]
+- name: student-2
+  visibility: internal
+  team_permission: admin
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests, err := LoadRepoCreateRequests(writeSpecFile(t, tt.fileName, tt.content))
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, requests)
+		})
+	}
+}
+
+func TestLoadRepoCreateRequests_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+	}{
+		{"Unsupported extension", "repos.txt", "name\nfoo\n"},
+		{"Missing name column", "repos.csv", "description\nfoo\n"},
+		{"Unknown column", "repos.csv", "name,owner\nfoo,bar\n"},
+		{"Duplicate names", "repos.csv", "name\nfoo\nfoo\n"},
+		{"Invalid visibility", "repos.yaml", "- name: foo\n  visibility: secret\n"},
+		{"Unknown YAML key", "repos.yaml", "- name: foo\n  colour: blue\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRepoCreateRequests(writeSpecFile(t, tt.fileName, tt.content))
+			assert.Error(t, err)
+		})
+	}
+}
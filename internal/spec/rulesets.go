@@ -0,0 +1,118 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+// Accepted values of the ruleset target and enforcement.
+var (
+	rulesetTargets      = []string{"branch", "tag"}
+	rulesetEnforcements = []string{"active", "evaluate", "disabled"}
+)
+
+// LoadRulesets reads repository rulesets from a JSON or YAML file holding a single ruleset or a list of them,
+// in the shape of the rulesets API, which is also what GitHub exports from the ruleset settings page:
+//
+//	name: protect-main
+//	target: branch          # branch (default) or tag
+//	enforcement: active     # active (default), evaluate or disabled
+//	conditions:
+//	  ref_name:
+//	    include: ["~DEFAULT_BRANCH"]
+//	rules:
+//	  - type: deletion
+//	  - type: pull_request
+//	    parameters:
+//	      required_approving_review_count: 1
+//
+// Read-only fields of exports such as id and source are ignored.
+func LoadRulesets(path string) ([]ghbatch.Ruleset, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rulesets file %s: %w", path, err)
+	}
+
+	rulesets, err := parseRulesets(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rulesets file %s: %w", path, err)
+	}
+
+	return rulesets, nil
+}
+
+func parseRulesets(content []byte) ([]ghbatch.Ruleset, error) {
+	// YAML is a superset of JSON, so both are decoded the same way
+	var document any
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, err
+	}
+
+	if _, ok := document.(map[string]any); ok {
+		document = []any{document}
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	var rulesets []ghbatch.Ruleset
+	if err := json.Unmarshal(data, &rulesets); err != nil {
+		return nil, fmt.Errorf("expected a ruleset or a list of rulesets: %w", err)
+	}
+
+	if len(rulesets) == 0 {
+		return nil, fmt.Errorf("no rulesets defined")
+	}
+
+	return rulesets, validateRulesets(rulesets)
+}
+
+// validateRulesets applies the defaults of the target and enforcement and checks every ruleset.
+func validateRulesets(rulesets []ghbatch.Ruleset) error {
+	seen := map[string]bool{}
+	for i := range rulesets {
+		ruleset := &rulesets[i]
+		ruleset.ID = 0
+
+		if ruleset.Name == "" {
+			return fmt.Errorf("ruleset %d: name is required", i+1)
+		}
+		if seen[ruleset.Name] {
+			return fmt.Errorf("duplicate ruleset %q", ruleset.Name)
+		}
+		seen[ruleset.Name] = true
+
+		if ruleset.Target == "" {
+			ruleset.Target = rulesetTargets[0]
+		}
+		if !slices.Contains(rulesetTargets, ruleset.Target) {
+			return fmt.Errorf("ruleset %q: target must be branch or tag", ruleset.Name)
+		}
+
+		if ruleset.Enforcement == "" {
+			ruleset.Enforcement = rulesetEnforcements[0]
+		}
+		if !slices.Contains(rulesetEnforcements, ruleset.Enforcement) {
+			return fmt.Errorf("ruleset %q: enforcement must be active, evaluate or disabled", ruleset.Name)
+		}
+
+		if len(ruleset.Rules) == 0 {
+			return fmt.Errorf("ruleset %q: at least one rule is required", ruleset.Name)
+		}
+		for j, rule := range ruleset.Rules {
+			if ruleType, _ := rule["type"].(string); ruleType == "" {
+				return fmt.Errorf("ruleset %q: rule %d has no type", ruleset.Name, j+1)
+			}
+		}
+	}
+
+	return nil
+}
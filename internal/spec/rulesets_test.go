@@ -0,0 +1,83 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadRulesets_YAML(t *testing.T) {
+	path := writeSpecFile(t, "rulesets.yaml", `
+- name: protect-main
+  conditions:
+    ref_name:
+      include: ["~DEFAULT_BRANCH"]
+  rules:
+    - type: deletion
+    - type: pull_request
+      parameters:
+        required_approving_review_count: 1
+- name: protect-tags
+  target: tag
+  enforcement: evaluate
+  rules:
+    - type: deletion
+`)
+
+	rulesets, err := LoadRulesets(path)
+	require.NoError(t, err)
+	require.Len(t, rulesets, 2)
+
+	assert.Equal(t, "protect-main", rulesets[0].Name)
+	assert.Equal(t, "branch", rulesets[0].Target)
+	assert.Equal(t, "active", rulesets[0].Enforcement)
+	require.Len(t, rulesets[0].Rules, 2)
+	assert.Equal(t, "pull_request", rulesets[0].Rules[1]["type"])
+	assert.Equal(t, map[string]any{"required_approving_review_count": float64(1)}, rulesets[0].Rules[1]["parameters"])
+
+	assert.Equal(t, "tag", rulesets[1].Target)
+	assert.Equal(t, "evaluate", rulesets[1].Enforcement)
+}
+
+func TestLoadRulesets_JSONExport(t *testing.T) {
+	path := writeSpecFile(t, "ruleset.json", `{
+  "id": 42,
+  "name": "protect-main",
+  "target": "branch",
+  "source_type": "Repository",
+  "source": "myorg/repo1",
+  "enforcement": "active",
+  "rules": [{"type": "non_fast_forward"}]
+}`)
+
+	rulesets, err := LoadRulesets(path)
+	require.NoError(t, err)
+	require.Len(t, rulesets, 1)
+	assert.Zero(t, rulesets[0].ID)
+	assert.Equal(t, "non_fast_forward", rulesets[0].Rules[0]["type"])
+}
+
+func TestLoadRulesets_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "empty", content: "[]", wantErr: "no rulesets defined"},
+		{name: "not a ruleset", content: "protect", wantErr: "expected a ruleset or a list of rulesets"},
+		{name: "missing name", content: "rules: [{type: deletion}]", wantErr: "ruleset 1: name is required"},
+		{name: "duplicate", content: "[{name: a, rules: [{type: deletion}]}, {name: a, rules: [{type: deletion}]}]", wantErr: `duplicate ruleset "a"`},
+		{name: "bad target", content: "{name: a, target: push, rules: [{type: deletion}]}", wantErr: "target must be branch or tag"},
+		{name: "bad enforcement", content: "{name: a, enforcement: on, rules: [{type: deletion}]}", wantErr: "enforcement must be"},
+		{name: "no rules", content: "{name: a}", wantErr: "at least one rule is required"},
+		{name: "rule without type", content: "{name: a, rules: [{parameters: {}}]}", wantErr: "rule 1 has no type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadRulesets(writeSpecFile(t, "rulesets.yaml", tt.content))
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
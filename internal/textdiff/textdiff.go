@@ -0,0 +1,158 @@
+// Package textdiff renders line-based unified diffs for previewing file changes.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxCells bounds the size of the LCS table; larger inputs are shown as a full replacement.
+const maxCells = 4_000_000
+
+// edit is a single line of the edit script: ' ' kept, '-' removed or '+' added.
+// oldPos and newPos count the old and new lines before it.
+type edit struct {
+	kind   byte
+	text   string
+	oldPos int
+	newPos int
+}
+
+// Unified returns the unified diff turning oldText into newText with the given number of context lines,
+// or an empty string when they are equal.
+func Unified(oldName, newName, oldText, newText string, context int) string {
+	if oldText == newText {
+		return ""
+	}
+
+	edits := diffLines(splitLines(oldText), splitLines(newText))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+
+	for _, hunk := range hunks(edits, context) {
+		writeHunk(&b, edits[hunk[0]:hunk[1]])
+	}
+
+	return b.String()
+}
+
+// splitLines splits text into lines without their line endings.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines computes an edit script from the longest common subsequence of the two line slices.
+func diffLines(a, b []string) []edit {
+	if len(a)*len(b) > maxCells {
+		return replaceAll(a, b)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]edit, 0, len(a)+len(b))
+	i, j := 0, 0
+
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, edit{kind: ' ', text: a[i], oldPos: i, newPos: j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{kind: '-', text: a[i], oldPos: i, newPos: j})
+			i++
+		default:
+			edits = append(edits, edit{kind: '+', text: b[j], oldPos: i, newPos: j})
+			j++
+		}
+	}
+
+	return edits
+}
+
+// replaceAll is the edit script removing every line of a and adding every line of b.
+func replaceAll(a, b []string) []edit {
+	edits := make([]edit, 0, len(a)+len(b))
+	for i, line := range a {
+		edits = append(edits, edit{kind: '-', text: line, oldPos: i})
+	}
+
+	for j, line := range b {
+		edits = append(edits, edit{kind: '+', text: line, oldPos: len(a), newPos: j})
+	}
+
+	return edits
+}
+
+// hunks returns the [start, end) ranges of edits to print: every change with up to context unchanged lines
+// around it, merging ranges that touch.
+func hunks(edits []edit, context int) [][2]int {
+	var ranges [][2]int
+
+	for i, e := range edits {
+		if e.kind == ' ' {
+			continue
+		}
+
+		start, end := max(i-context, 0), min(i+context+1, len(edits))
+		if n := len(ranges); n > 0 && start <= ranges[n-1][1] {
+			ranges[n-1][1] = max(ranges[n-1][1], end)
+
+			continue
+		}
+
+		ranges = append(ranges, [2]int{start, end})
+	}
+
+	return ranges
+}
+
+// writeHunk writes one hunk with its @@ header.
+func writeHunk(b *strings.Builder, edits []edit) {
+	var oldCount, newCount int
+	for _, e := range edits {
+		if e.kind != '+' {
+			oldCount++
+		}
+
+		if e.kind != '-' {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(b, "@@ -%s +%s @@\n", hunkRange(edits[0].oldPos, oldCount), hunkRange(edits[0].newPos, newCount))
+
+	for _, e := range edits {
+		b.WriteByte(e.kind)
+		b.WriteString(e.text)
+		b.WriteByte('\n')
+	}
+}
+
+// hunkRange formats the start,count of a hunk side; an empty side refers to the line before it.
+func hunkRange(pos, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", pos)
+	}
+
+	return fmt.Sprintf("%d,%d", pos+1, count)
+}
@@ -0,0 +1,43 @@
+package textdiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnified_Equal(t *testing.T) {
+	assert.Empty(t, Unified("a", "b", "same\n", "same\n", 3))
+}
+
+func TestUnified_NewFile(t *testing.T) {
+	out := Unified("/dev/null", "b/CODEOWNERS", "", "* @team\n/docs @writers\n", 3)
+
+	assert.Equal(t, "--- /dev/null\n+++ b/CODEOWNERS\n@@ -0,0 +1,2 @@\n+* @team\n+/docs @writers\n", out)
+}
+
+func TestUnified_ChangeWithContext(t *testing.T) {
+	oldText := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+	newText := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n"
+
+	out := Unified("a/f", "b/f", oldText, newText, 2)
+
+	assert.Equal(t, "--- a/f\n+++ b/f\n@@ -3,5 +3,5 @@\n 3\n 4\n-5\n+five\n 6\n 7\n", out)
+}
+
+func TestUnified_SeparateAndMergedHunks(t *testing.T) {
+	oldText := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	newText := "A\nb\nc\nd\ne\nf\ng\nh\ni\nJ\n"
+
+	separate := Unified("a/f", "b/f", oldText, newText, 1)
+	assert.Equal(t, "--- a/f\n+++ b/f\n@@ -1,2 +1,2 @@\n-a\n+A\n b\n@@ -9,2 +9,2 @@\n i\n-j\n+J\n", separate)
+
+	merged := Unified("a/f", "b/f", oldText, newText, 4)
+	assert.Contains(t, merged, "@@ -1,10 +1,10 @@\n")
+}
+
+func TestUnified_RemovedLines(t *testing.T) {
+	out := Unified("a/f", "b/f", "keep\ndrop\n", "keep\n", 3)
+
+	assert.Equal(t, "--- a/f\n+++ b/f\n@@ -1,2 +1,1 @@\n keep\n-drop\n", out)
+}
@@ -0,0 +1,65 @@
+// Package tracing exports OpenTelemetry traces of batch runs over OTLP. Until Setup is called every span
+// is a no-op, so instrumented code costs next to nothing when tracing is not configured.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName is the service spans are reported under unless OTEL_SERVICE_NAME overrides it.
+const ServiceName = "go-repo-manager"
+
+// endpointEnvVars are the standard environment variables configuring the OTLP endpoint.
+var endpointEnvVars = []string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "OTEL_EXPORTER_OTLP_ENDPOINT"}
+
+// Configured reports whether traces should be exported: when an endpoint is given or set through the
+// standard OTLP environment variables.
+func Configured(endpoint string) bool {
+	if endpoint != "" {
+		return true
+	}
+
+	for _, name := range endpointEnvVars {
+		if os.Getenv(name) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Setup installs a global tracer provider exporting spans in batches over OTLP/HTTP to endpoint, a URL
+// such as http://localhost:4318, or to the endpoint of the standard OTLP environment variables when it is
+// empty. The returned function flushes the pending spans and must be called before the process exits.
+func Setup(ctx context.Context, endpoint string) (func(ctx context.Context) error, error) {
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName)),
+		resource.Environment(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the traced service: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
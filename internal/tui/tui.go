@@ -0,0 +1,426 @@
+// Package tui provides an interactive terminal browser for per-repository batch reports.
+package tui
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Report is a table of per-repository results that can be browsed interactively.
+type Report struct {
+	Title string
+	// Columns are the column headers; every row has one cell per column.
+	Columns []string
+	Rows    []Row
+	// Detail loads the drill-down view of a row when it is opened. Nil disables drilling down.
+	Detail func(ctx context.Context, row Row) (string, error)
+	// Actions are follow-up actions triggered by a key on the selected row.
+	Actions []Action
+}
+
+// Row is one line of a report, typically a repository.
+type Row struct {
+	// Name identifies the row and is passed to Detail and actions, e.g. the repository name.
+	Name  string
+	Cells []string
+}
+
+// Action is a follow-up action run on the selected row when its key is pressed.
+type Action struct {
+	// Key is the single key triggering the action. It must not clash with the navigation keys.
+	Key         string
+	Description string
+	// Run performs the action and returns a status message shown below the table.
+	Run func(ctx context.Context, row Row) (string, error)
+}
+
+// Run shows the report in the terminal until the user quits.
+func Run(ctx context.Context, report Report) error {
+	_, err := tea.NewProgram(newModel(ctx, report), tea.WithAltScreen(), tea.WithContext(ctx)).Run()
+	if err != nil {
+		return fmt.Errorf("failed to run interactive report: %w", err)
+	}
+
+	return nil
+}
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+)
+
+// detailMsg carries a loaded drill-down view.
+type detailMsg struct {
+	name string
+	text string
+	err  error
+}
+
+// actionMsg carries the outcome of a follow-up action.
+type actionMsg struct {
+	text string
+	err  error
+}
+
+// model is the bubbletea model of the report browser.
+type model struct {
+	ctx    context.Context
+	report Report
+	// rows are the rows matching the filter in display order.
+	rows       []Row
+	cursor     int
+	offset     int
+	sortColumn int
+	descending bool
+	filter     string
+	filtering  bool
+	// detailName is the row whose drill-down view is open; empty in the table view.
+	detailName   string
+	detail       string
+	detailOffset int
+	loading      bool
+	status       string
+	width        int
+	height       int
+}
+
+func newModel(ctx context.Context, report Report) *model {
+	m := &model{ctx: ctx, report: report, sortColumn: -1, height: 24, width: 80}
+	m.refresh()
+
+	return m
+}
+
+func (m *model) Init() tea.Cmd {
+	return nil
+}
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.clampCursor()
+	case detailMsg:
+		if msg.name != m.detailName {
+			return m, nil
+		}
+		m.loading = false
+		m.detail = msg.text
+		if msg.err != nil {
+			m.detail = "Error: " + msg.err.Error()
+		}
+	case actionMsg:
+		m.status = msg.text
+		if msg.err != nil {
+			m.status = "Error: " + msg.err.Error()
+		}
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m *model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	if m.filtering {
+		m.handleFilterKey(msg)
+
+		return m, nil
+	}
+
+	if m.detailName != "" {
+		switch key {
+		case "esc", "backspace", "left", "h", "q":
+			m.detailName, m.detail, m.detailOffset = "", "", 0
+		case "up", "k":
+			m.detailOffset = max(m.detailOffset-1, 0)
+		case "down", "j":
+			m.detailOffset = min(m.detailOffset+1, max(len(strings.Split(m.detail, "\n"))-m.pageSize(), 0))
+		}
+
+		return m, nil
+	}
+
+	switch key {
+	case "q", "esc":
+		return m, tea.Quit
+	case "up", "k":
+		m.cursor--
+	case "down", "j":
+		m.cursor++
+	case "pgup":
+		m.cursor -= m.pageSize()
+	case "pgdown", " ":
+		m.cursor += m.pageSize()
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = len(m.rows) - 1
+	case "s", "tab":
+		m.sortBy((m.sortColumn + 1) % len(m.report.Columns))
+	case "r":
+		m.descending = !m.descending
+		m.refresh()
+	case "/":
+		m.filtering = true
+	case "enter", "right", "l":
+		return m, m.openDetail()
+	default:
+		if column, err := strconv.Atoi(key); err == nil && column >= 1 && column <= len(m.report.Columns) {
+			m.sortBy(column - 1)
+			break
+		}
+
+		for _, action := range m.report.Actions {
+			if action.Key == key {
+				return m, m.runAction(action)
+			}
+		}
+	}
+
+	m.clampCursor()
+
+	return m, nil
+}
+
+func (m *model) handleFilterKey(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		m.filtering = false
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filter = ""
+	case tea.KeyBackspace:
+		if m.filter != "" {
+			_, size := utf8.DecodeLastRuneInString(m.filter)
+			m.filter = m.filter[:len(m.filter)-size]
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.filter += string(msg.Runes)
+	}
+
+	m.refresh()
+}
+
+// sortBy sorts by the given column, toggling the direction when it is already the sort column.
+func (m *model) sortBy(column int) {
+	if column == m.sortColumn {
+		m.descending = !m.descending
+	} else {
+		m.sortColumn, m.descending = column, false
+	}
+
+	m.refresh()
+}
+
+// refresh recomputes the visible rows from the filter and sort order, keeping the selected row when possible.
+func (m *model) refresh() {
+	var selected string
+	if m.cursor < len(m.rows) {
+		selected = m.rows[m.cursor].Name
+	}
+
+	filter := strings.ToLower(m.filter)
+	m.rows = m.rows[:0]
+	for _, row := range m.report.Rows {
+		if filter == "" || strings.Contains(strings.ToLower(row.Name), filter) {
+			m.rows = append(m.rows, row)
+		}
+	}
+
+	if m.sortColumn >= 0 {
+		sort.SliceStable(m.rows, func(i, j int) bool {
+			a, b := m.rows[i].Cells[m.sortColumn], m.rows[j].Cells[m.sortColumn]
+			if m.descending {
+				a, b = b, a
+			}
+
+			return lessCell(a, b)
+		})
+	}
+
+	m.cursor = 0
+	for i, row := range m.rows {
+		if row.Name == selected {
+			m.cursor = i
+		}
+	}
+
+	m.clampCursor()
+}
+
+// lessCell orders numeric cells by value and all other cells alphabetically.
+func lessCell(a, b string) bool {
+	x, errA := strconv.ParseFloat(strings.TrimSuffix(a, "%"), 64)
+	y, errB := strconv.ParseFloat(strings.TrimSuffix(b, "%"), 64)
+	if errA == nil && errB == nil {
+		return x < y
+	}
+
+	return strings.ToLower(a) < strings.ToLower(b)
+}
+
+func (m *model) openDetail() tea.Cmd {
+	if m.report.Detail == nil || len(m.rows) == 0 {
+		return nil
+	}
+
+	row := m.rows[m.cursor]
+	m.detailName, m.detail, m.detailOffset, m.loading = row.Name, "", 0, true
+	detail, ctx := m.report.Detail, m.ctx
+
+	return func() tea.Msg {
+		text, err := detail(ctx, row)
+
+		return detailMsg{name: row.Name, text: text, err: err}
+	}
+}
+
+func (m *model) runAction(action Action) tea.Cmd {
+	if len(m.rows) == 0 {
+		return nil
+	}
+
+	row := m.rows[m.cursor]
+	m.status = fmt.Sprintf("%s (%s)...", action.Description, row.Name)
+	ctx := m.ctx
+
+	return func() tea.Msg {
+		text, err := action.Run(ctx, row)
+
+		return actionMsg{text: text, err: err}
+	}
+}
+
+// pageSize is the number of table rows that fit on the screen besides the title, header, status and help lines.
+func (m *model) pageSize() int {
+	return max(m.height-5, 1)
+}
+
+// clampCursor keeps the cursor on a row and scrolls the table so that it stays visible.
+func (m *model) clampCursor() {
+	m.cursor = max(min(m.cursor, len(m.rows)-1), 0)
+
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+m.pageSize() {
+		m.offset = m.cursor - m.pageSize() + 1
+	}
+}
+
+func (m *model) View() string {
+	var b strings.Builder
+
+	b.WriteString(headerStyle.Render(m.report.Title))
+	b.WriteString("\n")
+
+	if m.detailName != "" {
+		m.viewDetail(&b)
+	} else {
+		m.viewTable(&b)
+	}
+
+	return b.String()
+}
+
+func (m *model) viewDetail(b *strings.Builder) {
+	b.WriteString(headerStyle.Render(m.detailName))
+	b.WriteString("\n")
+
+	if m.loading {
+		b.WriteString("Loading...\n")
+	} else {
+		lines := strings.Split(m.detail, "\n")
+		end := min(m.detailOffset+m.pageSize(), len(lines))
+		b.WriteString(strings.Join(lines[min(m.detailOffset, end):end], "\n"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString(helpStyle.Render("↑/↓ scroll • esc back • ctrl+c quit"))
+}
+
+func (m *model) viewTable(b *strings.Builder) {
+	widths := m.columnWidths()
+
+	headers := make([]string, len(m.report.Columns))
+	for i, column := range m.report.Columns {
+		if i == m.sortColumn {
+			column += map[bool]string{false: " ▲", true: " ▼"}[m.descending]
+		}
+		headers[i] = column
+	}
+	b.WriteString(headerStyle.Render(formatRow(headers, widths)))
+	b.WriteString("\n")
+
+	end := min(m.offset+m.pageSize(), len(m.rows))
+	for i := m.offset; i < end; i++ {
+		line := formatRow(m.rows[i].Cells, widths)
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+
+	status := fmt.Sprintf("%d of %d rows", len(m.rows), len(m.report.Rows))
+	if m.filtering || m.filter != "" {
+		status += " • filter: " + m.filter
+		if m.filtering {
+			status += "█"
+		}
+	}
+	if m.status != "" {
+		status += " • " + m.status
+	}
+	b.WriteString(status)
+	b.WriteString("\n")
+
+	help := "↑/↓ move • 1-9/s sort • r reverse • / filter"
+	if m.report.Detail != nil {
+		help += " • enter details"
+	}
+	for _, action := range m.report.Actions {
+		help += fmt.Sprintf(" • %s %s", action.Key, strings.ToLower(action.Description))
+	}
+	b.WriteString(helpStyle.Render(help + " • q quit"))
+}
+
+// columnWidths returns the width of each column, wide enough for its header and every cell.
+func (m *model) columnWidths() []int {
+	widths := make([]int, len(m.report.Columns))
+	for i, column := range m.report.Columns {
+		widths[i] = utf8.RuneCountInString(column) + 2
+	}
+
+	for _, row := range m.report.Rows {
+		for i, cell := range row.Cells {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	return widths
+}
+
+// formatRow pads the cells to the column widths.
+func formatRow(cells []string, widths []int) string {
+	padded := make([]string, len(cells))
+	for i, cell := range cells {
+		padded[i] = cell + strings.Repeat(" ", max(widths[i]-utf8.RuneCountInString(cell), 0))
+	}
+
+	return strings.TrimRight(strings.Join(padded, "  "), " ")
+}
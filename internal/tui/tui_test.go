@@ -0,0 +1,131 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReport() Report {
+	return Report{
+		Title:   "Issues",
+		Columns: []string{"Repository", "Open"},
+		Rows: []Row{
+			{Name: "api", Cells: []string{"api", "12"}},
+			{Name: "web", Cells: []string{"web", "3"}},
+			{Name: "api-gateway", Cells: []string{"api-gateway", "40"}},
+		},
+	}
+}
+
+func press(m *model, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, key := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		switch key {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		}
+		_, cmd = m.Update(msg)
+	}
+
+	return cmd
+}
+
+func names(m *model) []string {
+	var out []string
+	for _, row := range m.rows {
+		out = append(out, row.Name)
+	}
+
+	return out
+}
+
+func TestModel_SortsNumericallyAndReverses(t *testing.T) {
+	m := newModel(context.Background(), testReport())
+
+	press(m, "2")
+	assert.Equal(t, []string{"web", "api", "api-gateway"}, names(m))
+
+	press(m, "2")
+	assert.Equal(t, []string{"api-gateway", "api", "web"}, names(m))
+
+	press(m, "1")
+	assert.Equal(t, []string{"api", "api-gateway", "web"}, names(m))
+	assert.Contains(t, m.View(), "Repository ▲")
+}
+
+func TestModel_FiltersRowsAndKeepsSelection(t *testing.T) {
+	m := newModel(context.Background(), testReport())
+
+	press(m, "down", "down")
+	require.Equal(t, "api-gateway", m.rows[m.cursor].Name)
+
+	press(m, "/", "a", "p", "i", "enter")
+	assert.Equal(t, []string{"api", "api-gateway"}, names(m))
+	assert.Equal(t, "api-gateway", m.rows[m.cursor].Name)
+	assert.Contains(t, m.View(), "2 of 3 rows • filter: api")
+
+	press(m, "/", "esc")
+	assert.Len(t, m.rows, 3)
+}
+
+func TestModel_DrillsIntoDetail(t *testing.T) {
+	report := testReport()
+	report.Detail = func(ctx context.Context, row Row) (string, error) {
+		if row.Name == "web" {
+			return "", errors.New("not found")
+		}
+		return "breakdown of " + row.Name, nil
+	}
+	m := newModel(context.Background(), report)
+
+	cmd := press(m, "enter")
+	require.NotNil(t, cmd)
+	assert.Contains(t, m.View(), "Loading...")
+
+	m.Update(cmd())
+	assert.Contains(t, m.View(), "breakdown of api")
+
+	press(m, "esc", "down", "enter")
+	m.Update(detailMsg{name: "api", text: "stale"})
+	assert.Contains(t, m.View(), "Loading...")
+
+	m.Update(detailMsg{name: "web", err: errors.New("not found")})
+	assert.Contains(t, m.View(), "Error: not found")
+}
+
+func TestModel_RunsActionOnSelectedRow(t *testing.T) {
+	var got string
+	report := testReport()
+	report.Actions = []Action{{Key: "o", Description: "Open", Run: func(ctx context.Context, row Row) (string, error) {
+		got = row.Name
+		return "opened " + row.Name, nil
+	}}}
+	m := newModel(context.Background(), report)
+
+	cmd := press(m, "down", "o")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+
+	assert.Equal(t, "web", got)
+	assert.Contains(t, m.View(), "opened web")
+	assert.Contains(t, m.View(), "o open")
+}
+
+func TestModel_ScrollsToKeepCursorVisible(t *testing.T) {
+	m := newModel(context.Background(), testReport())
+	m.Update(tea.WindowSizeMsg{Width: 80, Height: 7})
+
+	press(m, "down", "down")
+	assert.Equal(t, 1, m.offset)
+	assert.NotContains(t, m.View(), "api  ")
+}
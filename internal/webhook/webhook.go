@@ -0,0 +1,87 @@
+// Package webhook receives GitHub webhook deliveries and reports the repositories created.
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/logger"
+)
+
+// RepositoryCreated describes a repository announced by a repository created event.
+type RepositoryCreated struct {
+	DeliveryID string
+	Owner      string
+	// IsUser reports whether the owner is a user account rather than an organization.
+	IsUser   bool
+	RepoName string
+}
+
+// Handler verifies the signature of webhook deliveries and passes repository created events on.
+type Handler struct {
+	secret    []byte
+	onCreated func(event RepositoryCreated)
+}
+
+// NewHandler returns a handler accepting deliveries signed with secret and calling onCreated for every
+// repository created event. onCreated runs before the delivery is answered, so slow work belongs in a
+// goroutine: GitHub gives up on a delivery after ten seconds.
+func NewHandler(secret string, onCreated func(event RepositoryCreated)) *Handler {
+	return &Handler{secret: []byte(secret), onCreated: onCreated}
+}
+
+// ServeHTTP answers 401 to deliveries with a missing or invalid signature, 202 to repository created events
+// and 204 to every other event, which are ignored.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger()
+
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	deliveryID := github.DeliveryID(r)
+
+	payload, err := github.ValidatePayload(r, h.secret)
+	if err != nil {
+		log.Warn("Rejected webhook delivery", "delivery", deliveryID, "error", err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	eventType := github.WebHookType(r)
+	if eventType != "repository" {
+		log.Debug("Ignored webhook delivery", "delivery", deliveryID, "event", eventType)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	event, err := github.ParseWebHook(eventType, payload)
+	if err != nil {
+		log.Warn("Failed to parse webhook delivery", "delivery", deliveryID, "event", eventType, "error", err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	repositoryEvent := event.(*github.RepositoryEvent)
+	if repositoryEvent.GetAction() != "created" {
+		log.Debug("Ignored webhook delivery", "delivery", deliveryID, "event", eventType, "action", repositoryEvent.GetAction())
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	repository := repositoryEvent.GetRepo()
+	created := RepositoryCreated{
+		DeliveryID: deliveryID,
+		Owner:      repository.GetOwner().GetLogin(),
+		IsUser:     repository.GetOwner().GetType() == "User",
+		RepoName:   repository.GetName(),
+	}
+
+	log.Info("Received repository created event", "delivery", deliveryID, "owner", created.Owner, "repo", created.RepoName)
+	h.onCreated(created)
+
+	w.WriteHeader(http.StatusAccepted)
+}
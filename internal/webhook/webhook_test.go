@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "s3cret"
+
+func newDelivery(t *testing.T, event, payload, secret string) *http.Request {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", event)
+	req.Header.Set("X-GitHub-Delivery", "delivery-1")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	return req
+}
+
+func TestHandler(t *testing.T) {
+	created := `{"action":"created","repository":{"name":"new-repo","owner":{"login":"testorg","type":"Organization"}}}`
+
+	tests := []struct {
+		name       string
+		req        func(t *testing.T) *http.Request
+		wantStatus int
+		wantEvents []RepositoryCreated
+	}{
+		{
+			name:       "Repository created",
+			req:        func(t *testing.T) *http.Request { return newDelivery(t, "repository", created, testSecret) },
+			wantStatus: http.StatusAccepted,
+			wantEvents: []RepositoryCreated{{DeliveryID: "delivery-1", Owner: "testorg", RepoName: "new-repo"}},
+		},
+		{
+			name: "User repository created",
+			req: func(t *testing.T) *http.Request {
+				return newDelivery(t, "repository", `{"action":"created","repository":{"name":"dotfiles","owner":{"login":"alice","type":"User"}}}`, testSecret)
+			},
+			wantStatus: http.StatusAccepted,
+			wantEvents: []RepositoryCreated{{DeliveryID: "delivery-1", Owner: "alice", IsUser: true, RepoName: "dotfiles"}},
+		},
+		{
+			name:       "Invalid signature",
+			req:        func(t *testing.T) *http.Request { return newDelivery(t, "repository", created, "wrong") },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Missing signature",
+			req: func(t *testing.T) *http.Request {
+				req := newDelivery(t, "repository", created, testSecret)
+				req.Header.Del("X-Hub-Signature-256")
+				return req
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "Other action",
+			req: func(t *testing.T) *http.Request {
+				return newDelivery(t, "repository", `{"action":"archived","repository":{"name":"old"}}`, testSecret)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name: "Other event",
+			req: func(t *testing.T) *http.Request {
+				return newDelivery(t, "ping", `{"zen":"Keep it simple."}`, testSecret)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "Wrong method",
+			req:        func(t *testing.T) *http.Request { return httptest.NewRequest(http.MethodGet, "/webhook", nil) },
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []RepositoryCreated
+			handler := NewHandler(testSecret, func(event RepositoryCreated) { events = append(events, event) })
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, tt.req(t))
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			assert.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Package worker runs batch operations over repositories with a bounded pool of goroutines.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// PanicError is returned by Map, and handed to the onPanic function of Stream, when fn panics. It carries the recovered value and the stack of the
+// panicking goroutine so the panic can be reported from the caller's goroutine.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("worker panicked: %v\n%s", e.Value, e.Stack)
+}
+
+// Map calls fn for every item using at most limit goroutines and returns the results in the same order
+// as items. A limit below one runs the items one at a time.
+//
+// fn is called for every item, even after ctx is done, so each result reflects the cancellation the way
+// fn reports it (typically an error from the API call). If fn panics the panic is recovered, the context
+// passed to the remaining calls is cancelled and Map returns a *PanicError once all workers have stopped.
+func Map[T, R any](ctx context.Context, limit int, items []T, fn func(ctx context.Context, item T) R) ([]R, error) {
+	results := make([]R, len(items))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(limit, 1))
+
+	for i, item := range items {
+		g.Go(func() (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = &PanicError{Value: r, Stack: debug.Stack()}
+				}
+			}()
+
+			results[i] = fn(ctx, item)
+
+			return nil
+		})
+	}
+
+	return results, g.Wait()
+}
+
+// Stream calls fn for every item using at most limit goroutines and sends each result on the returned
+// channel as soon as it is ready, in completion order. The channel is closed once every item has been
+// processed; callers must drain it, since workers block until their result is received.
+//
+// As with Map, fn is called for every item even after ctx is done. If fn panics the remaining calls are
+// cancelled and the result of the item is the one onPanic builds from the *PanicError, so a panic never
+// escapes the goroutines of Stream.
+func Stream[T, R any](ctx context.Context, limit int, items []T, fn func(ctx context.Context, item T) R,
+	onPanic func(item T, err *PanicError) R,
+) <-chan R {
+	out := make(chan R)
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		defer close(out)
+		defer cancel()
+
+		// Panics of fn are recovered per item below, so Map has none left to report
+		_, _ = Map(ctx, limit, items, func(ctx context.Context, item T) struct{} {
+			result, err := call(ctx, item, fn)
+			if err != nil {
+				cancel()
+				result = onPanic(item, err)
+			}
+
+			out <- result
+
+			return struct{}{}
+		})
+	}()
+
+	return out
+}
+
+// call calls fn for item and returns a *PanicError if it panics.
+func call[T, R any](ctx context.Context, item T, fn func(ctx context.Context, item T) R) (result R, err *PanicError) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return fn(ctx, item), nil
+}
@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMap_OrderedResults(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3}
+
+	results, err := Map(context.Background(), 3, items, func(_ context.Context, item int) int {
+		time.Sleep(time.Duration(item) * time.Millisecond)
+
+		return item * 10
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{50, 10, 40, 20, 30}, results)
+}
+
+func TestMap_BoundedConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+
+	_, err := Map(context.Background(), 2, make([]int, 10), func(_ context.Context, _ int) struct{} {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		running.Add(-1)
+
+		return struct{}{}
+	})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestMap_Cancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results, err := Map(ctx, 1, []string{"a", "b"}, func(ctx context.Context, _ string) error {
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+	assert.ErrorIs(t, results[0], context.Canceled)
+	assert.ErrorIs(t, results[1], context.Canceled)
+}
+
+func TestMap_Panic(t *testing.T) {
+	results, err := Map(context.Background(), 1, []string{"boom", "after"}, func(ctx context.Context, item string) error {
+		if item == "boom" {
+			panic("kaboom")
+		}
+
+		return ctx.Err()
+	})
+
+	var panicErr *PanicError
+	require.True(t, errors.As(err, &panicErr))
+	assert.Equal(t, "kaboom", panicErr.Value)
+	// The panic cancels the context of the remaining items
+	assert.ErrorIs(t, results[1], context.Canceled)
+}
+
+func TestStream_CompletionOrder(t *testing.T) {
+	release := make(chan struct{})
+
+	results := Stream(context.Background(), 2, []string{"slow", "fast"}, func(_ context.Context, item string) string {
+		if item == "slow" {
+			<-release
+		}
+
+		return item
+	}, func(item string, err *PanicError) string {
+		return "panic"
+	})
+
+	assert.Equal(t, "fast", <-results)
+	close(release)
+	assert.Equal(t, "slow", <-results)
+
+	_, open := <-results
+	assert.False(t, open)
+}
+
+func TestStream_Panic(t *testing.T) {
+	results := Stream(context.Background(), 1, []string{"boom", "after"}, func(ctx context.Context, item string) error {
+		if item == "boom" {
+			panic("kaboom")
+		}
+
+		return ctx.Err()
+	}, func(item string, err *PanicError) error {
+		return err
+	})
+
+	var panicErr *PanicError
+	require.True(t, errors.As(<-results, &panicErr))
+	assert.Equal(t, "kaboom", panicErr.Value)
+	// The panic cancels the context of the remaining items
+	assert.ErrorIs(t, <-results, context.Canceled)
+
+	_, open := <-results
+	assert.False(t, open)
+}
@@ -0,0 +1,237 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Actions permission settings that can drift from a policy.
+const (
+	ActionsSettingAllowedActions     = "allowed-actions"
+	ActionsSettingWorkflowPermission = "workflow-permissions"
+	ActionsSettingApprovePRs         = "approve-prs"
+	ActionsSettingForkPRApproval     = "fork-pr-approval"
+)
+
+// Fork pull request approval policies for workflows from outside contributors.
+const (
+	ForkPRApprovalNewToGitHub = "first_time_contributors_new_to_github"
+	ForkPRApprovalFirstTime   = "first_time_contributors"
+	ForkPRApprovalAllExternal = "all_external_contributors"
+)
+
+// forkPRContributorApprovalPathFmt is the API path of the fork pull request approval policy of a repository.
+const forkPRContributorApprovalPathFmt = "repos/%s/%s/actions/permissions/fork-pr-contributor-approval"
+
+// ActionsPermissions holds the GitHub Actions settings of a repository.
+type ActionsPermissions struct {
+	Enabled bool
+	// AllowedActions is all, local_only or selected.
+	AllowedActions string
+	// DefaultWorkflowPermissions is the default GITHUB_TOKEN permission: read or write.
+	DefaultWorkflowPermissions   string
+	CanApprovePullRequestReviews bool
+	// ForkPRApprovalPolicy is empty when the repository does not support the setting, e.g. private repositories.
+	ForkPRApprovalPolicy string
+}
+
+// ActionsPermissionsPolicy is the desired GitHub Actions configuration. Empty fields are not enforced.
+type ActionsPermissionsPolicy struct {
+	AllowedActions               string
+	DefaultWorkflowPermissions   string
+	CanApprovePullRequestReviews *bool
+	ForkPRApprovalPolicy         string
+}
+
+// Drift lists the settings of current that do not match the policy.
+func (p ActionsPermissionsPolicy) Drift(current ActionsPermissions) []string {
+	var drift []string
+
+	// Allowed actions only apply while Actions is enabled
+	if p.AllowedActions != "" && current.Enabled && current.AllowedActions != p.AllowedActions {
+		drift = append(drift, ActionsSettingAllowedActions)
+	}
+
+	if p.DefaultWorkflowPermissions != "" && current.DefaultWorkflowPermissions != p.DefaultWorkflowPermissions {
+		drift = append(drift, ActionsSettingWorkflowPermission)
+	}
+
+	if p.CanApprovePullRequestReviews != nil && current.CanApprovePullRequestReviews != *p.CanApprovePullRequestReviews {
+		drift = append(drift, ActionsSettingApprovePRs)
+	}
+
+	if p.ForkPRApprovalPolicy != "" && current.ForkPRApprovalPolicy != "" &&
+		current.ForkPRApprovalPolicy != p.ForkPRApprovalPolicy {
+		drift = append(drift, ActionsSettingForkPRApproval)
+	}
+
+	return drift
+}
+
+// ActionsPermissionsResult is the outcome of checking, and optionally enforcing, a policy on a repository.
+type ActionsPermissionsResult struct {
+	RepoName string
+	// Current holds the settings found before any change.
+	Current ActionsPermissions
+	// Drift lists the settings that did not match the policy.
+	Drift []string
+	// Applied is true if the drifted settings were updated.
+	Applied bool
+	Err     error
+}
+
+// RepoErr implements Result.
+func (a ActionsPermissionsResult) RepoErr() error { return a.Err }
+
+// WithRepoErr implements Result.
+func (ActionsPermissionsResult) WithRepoErr(_, repoName string, err error) ActionsPermissionsResult {
+	return ActionsPermissionsResult{RepoName: repoName, Err: err}
+}
+
+// forkPRContributorApproval is the body of the fork pull request contributor approval endpoint,
+// which the GitHub client does not cover.
+type forkPRContributorApproval struct {
+	ApprovalPolicy string `json:"approval_policy"`
+}
+
+// GetActionsPermissions reads the GitHub Actions settings of a repository.
+func (s *Service) GetActionsPermissions(ctx context.Context, owner, repoName string) (*ActionsPermissions, error) {
+	permissions, _, err := s.client.Repositories.GetActionsPermissions(ctx, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Actions permissions for %s/%s: %w", owner, repoName, err)
+	}
+
+	workflow, _, err := s.client.Repositories.GetDefaultWorkflowPermissions(ctx, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default workflow permissions for %s/%s: %w", owner, repoName, err)
+	}
+
+	current := &ActionsPermissions{
+		Enabled:                      permissions.GetEnabled(),
+		AllowedActions:               permissions.GetAllowedActions(),
+		DefaultWorkflowPermissions:   workflow.GetDefaultWorkflowPermissions(),
+		CanApprovePullRequestReviews: workflow.GetCanApprovePullRequestReviews(),
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf(forkPRContributorApprovalPathFmt, owner, repoName), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := &forkPRContributorApproval{}
+
+	resp, err := s.client.Do(ctx, req, approval)
+	if err != nil {
+		// Repositories that do not support the setting report it as not found
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get fork pull request approval policy for %s/%s: %w", owner, repoName, err)
+		}
+	}
+
+	current.ForkPRApprovalPolicy = approval.ApprovalPolicy
+
+	return current, nil
+}
+
+// applyActionsPermissions updates the drifted settings of a repository to match the policy.
+func (s *Service) applyActionsPermissions(ctx context.Context, owner, repoName string, current ActionsPermissions,
+	policy ActionsPermissionsPolicy, drift []string,
+) error {
+	workflow := github.DefaultWorkflowPermissionRepository{}
+	workflowDrift := false
+
+	for _, setting := range drift {
+		switch setting {
+		case ActionsSettingAllowedActions:
+			_, _, err := s.client.Repositories.EditActionsPermissions(ctx, owner, repoName, github.ActionsPermissionsRepository{
+				Enabled:        github.Bool(current.Enabled),
+				AllowedActions: github.String(policy.AllowedActions),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set allowed actions for %s/%s: %w", owner, repoName, err)
+			}
+		case ActionsSettingWorkflowPermission:
+			workflow.DefaultWorkflowPermissions = github.String(policy.DefaultWorkflowPermissions)
+			workflowDrift = true
+		case ActionsSettingApprovePRs:
+			workflow.CanApprovePullRequestReviews = policy.CanApprovePullRequestReviews
+			workflowDrift = true
+		case ActionsSettingForkPRApproval:
+			req, err := s.client.NewRequest(http.MethodPut, fmt.Sprintf(forkPRContributorApprovalPathFmt, owner, repoName),
+				&forkPRContributorApproval{ApprovalPolicy: policy.ForkPRApprovalPolicy})
+			if err != nil {
+				return err
+			}
+
+			if _, err := s.client.Do(ctx, req, nil); err != nil {
+				return fmt.Errorf("failed to set fork pull request approval policy for %s/%s: %w", owner, repoName, err)
+			}
+		}
+	}
+
+	if !workflowDrift {
+		return nil
+	}
+
+	// The endpoint replaces both settings, so the one that did not drift is sent unchanged
+	if workflow.DefaultWorkflowPermissions == nil {
+		workflow.DefaultWorkflowPermissions = github.String(current.DefaultWorkflowPermissions)
+	}
+
+	if workflow.CanApprovePullRequestReviews == nil {
+		workflow.CanApprovePullRequestReviews = github.Bool(current.CanApprovePullRequestReviews)
+	}
+
+	if _, _, err := s.client.Repositories.EditDefaultWorkflowPermissions(ctx, owner, repoName, workflow); err != nil {
+		return fmt.Errorf("failed to set default workflow permissions for %s/%s: %w", owner, repoName, err)
+	}
+
+	return nil
+}
+
+// EnforceActionsPermissions compares the GitHub Actions settings of all the given repositories with a
+// policy and, when apply is true, updates the settings that drifted.
+func (s *Service) EnforceActionsPermissions(ctx context.Context, owner string, repoNames []string,
+	policy ActionsPermissionsPolicy, apply bool,
+) []ActionsPermissionsResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) ActionsPermissionsResult {
+		result := ActionsPermissionsResult{RepoName: repoName}
+
+		current, err := s.GetActionsPermissions(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get Actions permissions", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		result.Current = *current
+		result.Drift = policy.Drift(*current)
+
+		if !apply || len(result.Drift) == 0 {
+			return result
+		}
+
+		s.log.Info("Updating Actions permissions", "owner", owner, "repo", repoName, "settings", result.Drift)
+
+		err = s.applyActionsPermissions(ctx, owner, repoName, *current, policy, result.Drift)
+		s.recordMutation(ctx, audit.Record{Operation: OperationSetActionsPermissions, Owner: owner, Repo: repoName,
+			Target: strings.Join(result.Drift, ",")}, err)
+		if err != nil {
+			s.log.Error("Failed to update Actions permissions", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		result.Applied = true
+
+		return result
+	})
+}
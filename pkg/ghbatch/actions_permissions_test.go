@@ -0,0 +1,104 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsPermissionsPolicy_Drift(t *testing.T) {
+	current := ActionsPermissions{
+		Enabled:                    true,
+		AllowedActions:             "all",
+		DefaultWorkflowPermissions: "write",
+		ForkPRApprovalPolicy:       ForkPRApprovalFirstTime,
+	}
+
+	assert.Empty(t, ActionsPermissionsPolicy{}.Drift(current))
+
+	policy := ActionsPermissionsPolicy{
+		AllowedActions:               "selected",
+		DefaultWorkflowPermissions:   "read",
+		CanApprovePullRequestReviews: github.Bool(false),
+		ForkPRApprovalPolicy:         ForkPRApprovalAllExternal,
+	}
+	assert.Equal(t, []string{ActionsSettingAllowedActions, ActionsSettingWorkflowPermission, ActionsSettingForkPRApproval},
+		policy.Drift(current))
+
+	// Allowed actions do not apply with Actions disabled, nor the fork policy where it is unsupported
+	assert.Equal(t, []string{ActionsSettingWorkflowPermission}, policy.Drift(ActionsPermissions{DefaultWorkflowPermissions: "write"}))
+}
+
+func TestEnforceActionsPermissions_WithMockServer(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		edits = map[string]map[string]any{}
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			edits[r.URL.Path] = body
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/repos/testorg/drifted/actions/permissions":
+			json.NewEncoder(w).Encode(github.ActionsPermissionsRepository{Enabled: github.Bool(true), AllowedActions: github.String("all")})
+		case "/repos/testorg/drifted/actions/permissions/workflow":
+			json.NewEncoder(w).Encode(github.DefaultWorkflowPermissionRepository{
+				DefaultWorkflowPermissions: github.String("write"), CanApprovePullRequestReviews: github.Bool(true),
+			})
+		case "/repos/testorg/drifted/actions/permissions/fork-pr-contributor-approval":
+			json.NewEncoder(w).Encode(forkPRContributorApproval{ApprovalPolicy: ForkPRApprovalFirstTime})
+		case "/repos/testorg/compliant/actions/permissions":
+			json.NewEncoder(w).Encode(github.ActionsPermissionsRepository{Enabled: github.Bool(true), AllowedActions: github.String("selected")})
+		case "/repos/testorg/compliant/actions/permissions/workflow":
+			json.NewEncoder(w).Encode(github.DefaultWorkflowPermissionRepository{
+				DefaultWorkflowPermissions: github.String("read"), CanApprovePullRequestReviews: github.Bool(false),
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	policy := ActionsPermissionsPolicy{
+		AllowedActions:             "selected",
+		DefaultWorkflowPermissions: "read",
+		ForkPRApprovalPolicy:       ForkPRApprovalAllExternal,
+	}
+
+	results := service.EnforceActionsPermissions(context.Background(), "testorg", []string{"drifted", "compliant", "missing"}, policy, true)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Err)
+	assert.True(t, results[0].Applied)
+	assert.Equal(t, "write", results[0].Current.DefaultWorkflowPermissions)
+	assert.Equal(t, []string{ActionsSettingAllowedActions, ActionsSettingWorkflowPermission, ActionsSettingForkPRApproval}, results[0].Drift)
+
+	require.NoError(t, results[1].Err)
+	assert.Empty(t, results[1].Drift)
+	assert.False(t, results[1].Applied)
+	assert.Empty(t, results[1].Current.ForkPRApprovalPolicy)
+
+	assert.Error(t, results[2].Err)
+
+	assert.Equal(t, map[string]any{"enabled": true, "allowed_actions": "selected"}, edits["/repos/testorg/drifted/actions/permissions"])
+	// The setting that did not drift is sent unchanged
+	assert.Equal(t, map[string]any{"default_workflow_permissions": "read", "can_approve_pull_request_reviews": true},
+		edits["/repos/testorg/drifted/actions/permissions/workflow"])
+	assert.Equal(t, map[string]any{"approval_policy": ForkPRApprovalAllExternal},
+		edits["/repos/testorg/drifted/actions/permissions/fork-pr-contributor-approval"])
+	assert.Len(t, edits, 3)
+}
@@ -0,0 +1,164 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Autolink statuses.
+const (
+	AutolinkStatusAdded     = "added"
+	AutolinkStatusUpdated   = "updated"
+	AutolinkStatusUnchanged = "unchanged"
+	AutolinkStatusRemoved   = "removed"
+	AutolinkStatusNotFound  = "not-found"
+	AutolinkStatusFailed    = "failed"
+)
+
+// autolinkNumberPlaceholder is replaced by the reference number in the URL template of an autolink.
+const autolinkNumberPlaceholder = "<num>"
+
+// Autolink is an autolink reference turning keys like JIRA-123 into links.
+type Autolink struct {
+	// KeyPrefix is the prefix of the references, e.g. "JIRA-".
+	KeyPrefix string
+	// URLTemplate is the link target and must contain <num>, e.g. "https://jira.example.com/browse/JIRA-<num>".
+	URLTemplate string
+	// Alphanumeric also matches letters after the prefix; otherwise only digits are matched.
+	Alphanumeric bool
+}
+
+// Validate checks the autolink before it is sent to GitHub.
+func (a Autolink) Validate() error {
+	if a.KeyPrefix == "" {
+		return fmt.Errorf("autolink key prefix is required")
+	}
+
+	if !strings.Contains(a.URLTemplate, autolinkNumberPlaceholder) {
+		return fmt.Errorf("autolink URL template %q must contain %s", a.URLTemplate, autolinkNumberPlaceholder)
+	}
+
+	return nil
+}
+
+// AutolinkResult is the outcome of adding or removing an autolink in a repository.
+type AutolinkResult struct {
+	RepoName string
+	// Status is one of the AutolinkStatus constants.
+	Status string
+	Err    error
+}
+
+// RepoErr implements Result.
+func (a AutolinkResult) RepoErr() error { return a.Err }
+
+// WithRepoErr implements Result.
+func (AutolinkResult) WithRepoErr(_, repoName string, err error) AutolinkResult {
+	return AutolinkResult{RepoName: repoName, Err: err}
+}
+
+// findAutolink returns the autolink of a repository with the given key prefix, or nil.
+func (s *Service) findAutolink(ctx context.Context, owner, repoName, keyPrefix string) (*github.Autolink, error) {
+	autolinks, _, err := s.client.Repositories.ListAutolinks(ctx, owner, repoName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list autolinks for %s/%s: %w", owner, repoName, err)
+	}
+
+	for _, autolink := range autolinks {
+		if strings.EqualFold(autolink.GetKeyPrefix(), keyPrefix) {
+			return autolink, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// AddAutolink adds an autolink to a repository. An autolink with the same key prefix but a different target
+// is replaced, since GitHub does not allow editing autolinks.
+func (s *Service) AddAutolink(ctx context.Context, owner, repoName string, autolink Autolink) (string, error) {
+	existing, err := s.findAutolink(ctx, owner, repoName, autolink.KeyPrefix)
+	if err != nil {
+		return "", err
+	}
+
+	status := AutolinkStatusAdded
+	if existing != nil {
+		if existing.GetKeyPrefix() == autolink.KeyPrefix && existing.GetURLTemplate() == autolink.URLTemplate &&
+			existing.GetIsAlphanumeric() == autolink.Alphanumeric {
+			return AutolinkStatusUnchanged, nil
+		}
+
+		_, err := s.client.Repositories.DeleteAutolink(ctx, owner, repoName, existing.GetID())
+		s.recordMutation(ctx, audit.Record{Operation: OperationRemoveAutolink, Owner: owner, Repo: repoName, Target: existing.GetKeyPrefix()}, err)
+		if err != nil {
+			return "", fmt.Errorf("failed to remove outdated autolink %s from %s/%s: %w", existing.GetKeyPrefix(), owner, repoName, err)
+		}
+		status = AutolinkStatusUpdated
+	}
+
+	_, _, err = s.client.Repositories.AddAutolink(ctx, owner, repoName, &github.AutolinkOptions{
+		KeyPrefix:      github.String(autolink.KeyPrefix),
+		URLTemplate:    github.String(autolink.URLTemplate),
+		IsAlphanumeric: github.Bool(autolink.Alphanumeric),
+	})
+	s.recordMutation(ctx, audit.Record{Operation: OperationAddAutolink, Owner: owner, Repo: repoName, Target: autolink.KeyPrefix}, err)
+	if err != nil {
+		return "", fmt.Errorf("failed to add autolink %s to %s/%s: %w", autolink.KeyPrefix, owner, repoName, err)
+	}
+
+	return status, nil
+}
+
+// RemoveAutolink removes the autolink with the given key prefix from a repository.
+func (s *Service) RemoveAutolink(ctx context.Context, owner, repoName, keyPrefix string) (string, error) {
+	existing, err := s.findAutolink(ctx, owner, repoName, keyPrefix)
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		return AutolinkStatusNotFound, nil
+	}
+
+	_, err = s.client.Repositories.DeleteAutolink(ctx, owner, repoName, existing.GetID())
+	s.recordMutation(ctx, audit.Record{Operation: OperationRemoveAutolink, Owner: owner, Repo: repoName, Target: keyPrefix}, err)
+	if err != nil {
+		return "", fmt.Errorf("failed to remove autolink %s from %s/%s: %w", keyPrefix, owner, repoName, err)
+	}
+
+	return AutolinkStatusRemoved, nil
+}
+
+// AddAutolinkToRepos adds an autolink to all the given repositories.
+func (s *Service) AddAutolinkToRepos(ctx context.Context, owner string, repoNames []string, autolink Autolink) []AutolinkResult {
+	return s.updateAutolinks(ctx, owner, repoNames, func(ctx context.Context, repoName string) (string, error) {
+		return s.AddAutolink(ctx, owner, repoName, autolink)
+	})
+}
+
+// RemoveAutolinkFromRepos removes the autolink with the given key prefix from all the given repositories.
+func (s *Service) RemoveAutolinkFromRepos(ctx context.Context, owner string, repoNames []string, keyPrefix string) []AutolinkResult {
+	return s.updateAutolinks(ctx, owner, repoNames, func(ctx context.Context, repoName string) (string, error) {
+		return s.RemoveAutolink(ctx, owner, repoName, keyPrefix)
+	})
+}
+
+func (s *Service) updateAutolinks(ctx context.Context, owner string, repoNames []string,
+	update func(ctx context.Context, repoName string) (string, error),
+) []AutolinkResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) AutolinkResult {
+		status, err := update(ctx, repoName)
+		if err != nil {
+			s.log.Error("Failed to update autolinks", "owner", owner, "repo", repoName, "error", err)
+
+			return AutolinkResult{RepoName: repoName, Status: AutolinkStatusFailed, Err: err}
+		}
+
+		return AutolinkResult{RepoName: repoName, Status: status}
+	})
+}
@@ -0,0 +1,78 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutolink_Validate(t *testing.T) {
+	assert.NoError(t, Autolink{KeyPrefix: "JIRA-", URLTemplate: "https://jira.example.com/browse/JIRA-<num>"}.Validate())
+	assert.ErrorContains(t, Autolink{URLTemplate: "https://x/<num>"}.Validate(), "key prefix is required")
+	assert.ErrorContains(t, Autolink{KeyPrefix: "JIRA-", URLTemplate: "https://x/"}.Validate(), "must contain <num>")
+}
+
+func TestAutolinks_WithMockServer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+
+	existing := map[string][]map[string]any{
+		"current":  {{"id": 1, "key_prefix": "JIRA-", "url_template": "https://jira.example.com/browse/JIRA-<num>", "is_alphanumeric": false}},
+		"outdated": {{"id": 2, "key_prefix": "JIRA-", "url_template": "https://old.example.com/JIRA-<num>", "is_alphanumeric": false}},
+		"none":     {},
+	}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+		if r.Method != http.MethodGet {
+			mu.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(existing[repoName])
+		case http.MethodPost:
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]any{"id": 3})
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}, 1)
+
+	autolink := Autolink{KeyPrefix: "JIRA-", URLTemplate: "https://jira.example.com/browse/JIRA-<num>"}
+	results := service.AddAutolinkToRepos(context.Background(), "testorg", []string{"current", "outdated", "none"}, autolink)
+	require.Len(t, results, 3)
+
+	statuses := map[string]string{}
+	for _, result := range results {
+		require.NoError(t, result.Err)
+		statuses[result.RepoName] = result.Status
+	}
+	assert.Equal(t, map[string]string{
+		"current":  AutolinkStatusUnchanged,
+		"outdated": AutolinkStatusUpdated,
+		"none":     AutolinkStatusAdded,
+	}, statuses)
+	assert.ElementsMatch(t, []string{
+		"DELETE /repos/testorg/outdated/autolinks/2",
+		"POST /repos/testorg/outdated/autolinks",
+		"POST /repos/testorg/none/autolinks",
+	}, requests)
+
+	requests = nil
+	results = service.RemoveAutolinkFromRepos(context.Background(), "testorg", []string{"current", "none"}, "jira-")
+	require.Len(t, results, 2)
+	assert.Equal(t, AutolinkStatusRemoved, results[0].Status)
+	assert.Equal(t, AutolinkStatusNotFound, results[1].Status)
+	assert.Equal(t, []string{"DELETE /repos/testorg/current/autolinks/1"}, requests)
+}
@@ -0,0 +1,164 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// IssueBackup is an issue with its comments, oldest first.
+type IssueBackup struct {
+	Issue    *github.Issue          `json:"issue"`
+	Comments []*github.IssueComment `json:"comments"`
+}
+
+// RepositoryBackup is a snapshot of a repository's metadata and issue tracker.
+type RepositoryBackup struct {
+	Repository *github.Repository
+	// Issues holds the open and closed issues without pull requests, newest first.
+	Issues     []IssueBackup
+	Labels     []*github.Label
+	Milestones []*github.Milestone
+	Releases   []*github.RepositoryRelease
+}
+
+// StreamRepositoryBackups collects a snapshot of each repository, sending each on the returned channel as
+// soon as it is complete so that it can be written out without holding every snapshot in memory.
+func (s *Service) StreamRepositoryBackups(ctx context.Context, owner string, repoNames []string) <-chan RepoResult[*RepositoryBackup] {
+	return StreamConcurrently(ctx, s.maxConcurrency, owner, repoNames, func(ctx context.Context, repoName string) (*RepositoryBackup, error) {
+		backup, err := s.backupRepository(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to back up repository", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return backup, err
+	})
+}
+
+// backupRepository collects a snapshot of a repository: its metadata, issues with their comments, labels,
+// milestones and releases.
+func (s *Service) backupRepository(ctx context.Context, owner, repoName string) (*RepositoryBackup, error) {
+	s.log.Info("Backing up repository", "owner", owner, "repo", repoName)
+
+	var (
+		backup = &RepositoryBackup{}
+		err    error
+	)
+
+	if backup.Repository, err = s.GetRepository(ctx, owner, repoName); err != nil {
+		return nil, err
+	}
+
+	allIssues := func(*github.Issue) bool { return true }
+
+	issues, err := s.listMatchingIssues(ctx, owner, repoName, "all", time.Time{}, allIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.listRepositoryIssueComments(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issue := range issues {
+		issueComments := comments[issue.GetNumber()]
+		if issueComments == nil {
+			issueComments = []*github.IssueComment{}
+		}
+		backup.Issues = append(backup.Issues, IssueBackup{Issue: issue, Comments: issueComments})
+	}
+
+	if backup.Labels, err = s.listLabels(ctx, owner, repoName); err != nil {
+		return nil, err
+	}
+
+	if backup.Milestones, err = s.listMilestones(ctx, owner, repoName); err != nil {
+		return nil, err
+	}
+
+	if backup.Releases, err = s.ListReleases(ctx, owner, repoName); err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// listRepositoryIssueComments lists all issue comments of a repository in one paginated listing rather than
+// one per issue, grouped by issue number, oldest first.
+func (s *Service) listRepositoryIssueComments(ctx context.Context, owner, repoName string) (map[int][]*github.IssueComment, error) {
+	grouped := make(map[int][]*github.IssueComment)
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.String("created"),
+		Direction:   github.String("asc"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		// Issue number 0 lists the comments of every issue in the repository
+		comments, resp, err := s.client.Issues.ListComments(ctx, owner, repoName, 0, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issue comments for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, comment := range comments {
+			number, err := strconv.Atoi(path.Base(comment.GetIssueURL()))
+			if err != nil {
+				continue
+			}
+			grouped[number] = append(grouped[number], comment)
+		}
+
+		if resp.NextPage == 0 {
+			return grouped, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+// listLabels lists all labels of a repository.
+func (s *Service) listLabels(ctx context.Context, owner, repoName string) ([]*github.Label, error) {
+	var labels []*github.Label
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		page, resp, err := s.client.Issues.ListLabels(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list labels for %s/%s: %w", owner, repoName, err)
+		}
+
+		labels = append(labels, page...)
+
+		if resp.NextPage == 0 {
+			return labels, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+// listMilestones lists the open and closed milestones of a repository.
+func (s *Service) listMilestones(ctx context.Context, owner, repoName string) ([]*github.Milestone, error) {
+	var milestones []*github.Milestone
+	opts := &github.MilestoneListOptions{State: "all", ListOptions: github.ListOptions{PerPage: 100}}
+
+	for {
+		page, resp, err := s.client.Issues.ListMilestones(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list milestones for %s/%s: %w", owner, repoName, err)
+		}
+
+		milestones = append(milestones, page...)
+
+		if resp.NextPage == 0 {
+			return milestones, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,67 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamRepositoryBackups_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/repos/testorg/broken") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/repos/testorg/api":
+			json.NewEncoder(w).Encode(github.Repository{Name: github.String("api")})
+		case "/repos/testorg/api/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(2)},
+				{Number: github.Int(1)},
+				{Number: github.Int(3), PullRequestLinks: &github.PullRequestLinks{}},
+			})
+		case "/repos/testorg/api/issues/comments":
+			json.NewEncoder(w).Encode([]*github.IssueComment{
+				{ID: github.Int64(10), IssueURL: github.String("https://api.github.com/repos/testorg/api/issues/1")},
+				{ID: github.Int64(11), IssueURL: github.String("https://api.github.com/repos/testorg/api/issues/3")},
+				{ID: github.Int64(12), IssueURL: github.String("https://api.github.com/repos/testorg/api/issues/1")},
+			})
+		case "/repos/testorg/api/labels":
+			json.NewEncoder(w).Encode([]*github.Label{{Name: github.String("bug")}})
+		case "/repos/testorg/api/milestones":
+			assert.Equal(t, "all", r.URL.Query().Get("state"))
+			json.NewEncoder(w).Encode([]*github.Milestone{{Title: github.String("v1")}})
+		case "/repos/testorg/api/releases":
+			json.NewEncoder(w).Encode([]*github.RepositoryRelease{{TagName: github.String("v1.0.0")}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	results := make(map[string]RepoResult[*RepositoryBackup])
+	for result := range service.StreamRepositoryBackups(context.Background(), "testorg", []string{"api", "broken"}) {
+		results[result.RepoName] = result
+	}
+	require.Len(t, results, 2)
+
+	assert.Error(t, results["broken"].Err)
+
+	require.NoError(t, results["api"].Err)
+	backup := results["api"].Value
+	assert.Equal(t, "api", backup.Repository.GetName())
+	require.Len(t, backup.Issues, 2)
+	assert.Empty(t, backup.Issues[0].Comments)
+	require.Len(t, backup.Issues[1].Comments, 2)
+	assert.Equal(t, int64(12), backup.Issues[1].Comments[1].GetID())
+	assert.Len(t, backup.Labels, 1)
+	assert.Len(t, backup.Milestones, 1)
+	assert.Len(t, backup.Releases, 1)
+}
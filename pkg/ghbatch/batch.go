@@ -0,0 +1,173 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/go-github/v62/github"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/hooks"
+	"go-repo-manager/internal/metrics"
+	"go-repo-manager/internal/worker"
+)
+
+// RepoResultFunc is called once per repository as soon as its operation completes, with a nil error on success.
+// It may be called concurrently from multiple workers.
+type RepoResultFunc func(repoName string, err error)
+
+// errDone is returned by the function applied to an issue or pull request to leave out one that an
+// interrupted run already processed.
+var errDone = errors.New("already processed")
+
+// RepoResult is the outcome of a batch operation for one repository, delivered by the streaming methods
+// as soon as the repository completes.
+type RepoResult[T any] struct {
+	// Owner is the organization or user the repository belongs to.
+	Owner    string
+	RepoName string
+	Value    T
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RepoResult[T]) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoResult[T]) WithRepoErr(owner, repoName string, err error) RepoResult[T] {
+	return RepoResult[T]{Owner: owner, RepoName: repoName, Err: err}
+}
+
+// Result is the per-repository result of a batch operation run by CollectConcurrently. T is the result
+// type itself.
+type Result[T any] interface {
+	// RepoErr returns the error the repository failed with, nil when it succeeded.
+	RepoErr() error
+	// WithRepoErr returns the result of a repository of owner that failed with err before being processed.
+	WithRepoErr(owner, repoName string, err error) T
+}
+
+// CollectConcurrently runs fn for every repository name using at most maxConcurrency workers and returns
+// the results in the same order as repoNames. A panic in fn cancels the remaining calls and is re-raised
+// in the calling goroutine once every worker has stopped. Each repository is traced in its own span, run
+// between the hooks of ctx and counted in the metrics as failed when its result reports an error. A failing
+// pre-hook fails the repository without running fn.
+func CollectConcurrently[T Result[T]](ctx context.Context, maxConcurrency int, repoNames []string,
+	fn func(ctx context.Context, repoName string) T,
+) []T {
+	results, err := worker.Map(ctx, maxConcurrency, repoNames, func(ctx context.Context, repoName string) T {
+		ctx, span := startRepoSpan(ctx, repoName)
+		defer span.End()
+
+		var result T
+		if err := hooks.Pre(ctx, repoName); err != nil {
+			// Only a pre-hook fails a repository before fn runs, and the hooks know the owner
+			result = result.WithRepoErr(hooks.Owner(ctx), repoName, err)
+		} else {
+			result = fn(ctx, repoName)
+		}
+
+		err := result.RepoErr()
+		hooks.Post(ctx, repoName, err)
+		metrics.RecordRepo(ctx, err)
+		recordSpanError(span, err)
+
+		return result
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return results
+}
+
+// StreamConcurrently runs fn for every repository name of the owner using at most maxConcurrency workers
+// and sends each outcome on the returned channel as soon as it completes. A failing pre-hook of ctx fails
+// the repository without running fn. A panic in fn cancels the remaining calls and fails its repository
+// with an error carrying the panic value and stack. The channel is closed once every repository has been
+// processed and must be drained by the caller.
+func StreamConcurrently[T any](ctx context.Context, maxConcurrency int, owner string, repoNames []string,
+	fn func(ctx context.Context, repoName string) (T, error),
+) <-chan RepoResult[T] {
+	return worker.Stream(ctx, maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoResult[T] {
+		ctx, span := startRepoSpan(ctx, repoName)
+		defer span.End()
+
+		var value T
+		err := hooks.Pre(ctx, repoName)
+		if err == nil {
+			value, err = fn(ctx, repoName)
+		}
+		hooks.Post(ctx, repoName, err)
+		metrics.RecordRepo(ctx, err)
+		recordSpanError(span, err)
+
+		return RepoResult[T]{Owner: owner, RepoName: repoName, Value: value, Err: err}
+	}, func(repoName string, err *worker.PanicError) RepoResult[T] {
+		return RepoResult[T]{Owner: owner, RepoName: repoName, Err: err}
+	})
+}
+
+// startRepoSpan starts the span of the work done for one repository of a batch operation, parenting the
+// spans of its API requests.
+func startRepoSpan(ctx context.Context, repoName string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "repo "+repoName, trace.WithAttributes(
+		attribute.String("github.repository", repoName),
+		attribute.String("operation", metrics.Operation(ctx)),
+	))
+}
+
+// recordSpanError marks a span as failed with err when it is not nil.
+func recordSpanError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// repositoryNames returns the names of the repositories.
+func repositoryNames(repos []*github.Repository) []string {
+	names := make([]string, 0, len(repos))
+	for _, repository := range repos {
+		names = append(names, repository.GetName())
+	}
+
+	return names
+}
+
+// processReposConcurrently runs fn for every repository name using at most maxConcurrency workers.
+// Individual failures are logged and do not stop the processing of the remaining repositories.
+// If onResult is not nil it is invoked for every repository as soon as it completes.
+// It returns the names of the repositories that succeeded and the names of the ones that failed.
+func (s *Service) processReposConcurrently(ctx context.Context, operation string, repoNames []string,
+	fn func(ctx context.Context, repoName string) error, onResult RepoResultFunc,
+) ([]string, []string) {
+	results := CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoResult[struct{}] {
+		err := fn(ctx, repoName)
+		if onResult != nil {
+			onResult(repoName, err)
+		}
+
+		if err != nil {
+			s.log.Error("Repository operation failed", "operation", operation, "repo", repoName, "error", err)
+		}
+
+		return RepoResult[struct{}]{RepoName: repoName, Err: err}
+	})
+
+	var successRepos []string
+
+	var failedRepos []string
+
+	for _, result := range results {
+		if result.Err != nil {
+			failedRepos = append(failedRepos, result.RepoName)
+		} else {
+			successRepos = append(successRepos, result.RepoName)
+		}
+	}
+
+	return successRepos, failedRepos
+}
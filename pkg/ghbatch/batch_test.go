@@ -0,0 +1,71 @@
+package ghbatch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/hooks"
+)
+
+func TestCollectConcurrently_PreHookFailureSkipsRepository(t *testing.T) {
+	ctx := hooks.WithHooks(context.Background(), hooks.Hooks{Pre: `test "$GRM_REPO" != web`})
+
+	var ran []string
+	results := CollectConcurrently(ctx, 1, []string{"api", "web"}, func(ctx context.Context, repoName string) FileRolloutResult {
+		ran = append(ran, repoName)
+		return FileRolloutResult{RepoName: repoName}
+	})
+
+	assert.Equal(t, []string{"api"}, ran)
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "web", results[1].RepoName)
+	assert.EqualError(t, results[1].Err, "pre-hook failed: exit status 1")
+}
+
+func TestCollectConcurrently_PreHookFailureKeepsOwner(t *testing.T) {
+	ctx := hooks.WithHooks(context.Background(), hooks.Hooks{Pre: "false", Owner: "testorg"})
+
+	results := CollectConcurrently(ctx, 1, []string{"api"}, func(ctx context.Context, repoName string) RepoResult[int] {
+		return RepoResult[int]{Owner: "testorg", RepoName: repoName, Value: 1}
+	})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, "testorg", results[0].Owner)
+	assert.Equal(t, "api", results[0].RepoName)
+	assert.Error(t, results[0].Err)
+}
+
+func TestProcessReposConcurrently_PreHookFailureFailsRepository(t *testing.T) {
+	ctx := hooks.WithHooks(context.Background(), hooks.Hooks{Pre: `test "$GRM_REPO" != web`})
+	service := &Service{log: createTestLogger(), maxConcurrency: 1}
+
+	var ran []string
+	success, failed := service.processReposConcurrently(ctx, "archive", []string{"api", "web"},
+		func(ctx context.Context, repoName string) error {
+			ran = append(ran, repoName)
+			return nil
+		}, nil)
+
+	assert.Equal(t, []string{"api"}, ran)
+	assert.Equal(t, []string{"api"}, success)
+	assert.Equal(t, []string{"web"}, failed)
+}
+
+func TestStreamConcurrently_PanicFailsRepository(t *testing.T) {
+	results := StreamConcurrently(context.Background(), 1, "testorg", []string{"api"},
+		func(ctx context.Context, repoName string) (int, error) {
+			panic("kaboom")
+		})
+
+	result := <-results
+	assert.Equal(t, "testorg", result.Owner)
+	assert.Equal(t, "api", result.RepoName)
+	assert.ErrorContains(t, result.Err, "worker panicked: kaboom")
+
+	_, open := <-results
+	assert.False(t, open)
+}
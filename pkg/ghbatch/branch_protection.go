@@ -0,0 +1,90 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// BranchProtectionAudit is the branch protection state of a repository's default branch.
+type BranchProtectionAudit struct {
+	RepoName string
+	Branch   string
+	// Protected is true when the branch has any protection at all.
+	Protected bool
+	// RequiredReviews is true when at least one approving review is required.
+	RequiredReviews bool
+	// StatusChecks is true when at least one status check must pass.
+	StatusChecks bool
+	// AdminEnforcement is true when the rules also apply to administrators.
+	AdminEnforcement bool
+	Err              error
+}
+
+// RepoErr implements Result.
+func (a BranchProtectionAudit) RepoErr() error { return a.Err }
+
+// WithRepoErr implements Result.
+func (BranchProtectionAudit) WithRepoErr(_, repoName string, err error) BranchProtectionAudit {
+	return BranchProtectionAudit{RepoName: repoName, Err: err}
+}
+
+// Compliant reports whether the branch satisfies every audited rule.
+func (a BranchProtectionAudit) Compliant() bool {
+	return a.Err == nil && a.Protected && a.RequiredReviews && a.StatusChecks && a.AdminEnforcement
+}
+
+// AuditBranchProtection inspects the protection of the default branch of a repository.
+func (s *Service) AuditBranchProtection(ctx context.Context, owner string, repository *github.Repository) BranchProtectionAudit {
+	audit := BranchProtectionAudit{RepoName: repository.GetName(), Branch: repository.GetDefaultBranch()}
+
+	protection, _, err := s.client.Repositories.GetBranchProtection(ctx, owner, audit.RepoName, audit.Branch)
+	if errors.Is(err, github.ErrBranchNotProtected) {
+		return audit
+	}
+
+	if err != nil {
+		audit.Err = fmt.Errorf("failed to get branch protection for %s/%s@%s: %w", owner, audit.RepoName, audit.Branch, err)
+
+		return audit
+	}
+
+	audit.Protected = true
+	if reviews := protection.GetRequiredPullRequestReviews(); reviews != nil {
+		audit.RequiredReviews = reviews.RequiredApprovingReviewCount > 0
+	}
+
+	if checks := protection.GetRequiredStatusChecks(); checks != nil {
+		audit.StatusChecks = len(checks.GetChecks()) > 0 || len(checks.GetContexts()) > 0
+	}
+
+	if admins := protection.GetEnforceAdmins(); admins != nil {
+		audit.AdminEnforcement = admins.Enabled
+	}
+
+	return audit
+}
+
+// AuditBranchProtectionForRepos audits the default branch protection of all the given repositories.
+func (s *Service) AuditBranchProtectionForRepos(ctx context.Context, owner string,
+	repos []*github.Repository,
+) []BranchProtectionAudit {
+	byName := make(map[string]*github.Repository, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) BranchProtectionAudit {
+		audit := s.AuditBranchProtection(ctx, owner, byName[repoName])
+		if audit.Err != nil {
+			s.log.Error("Failed to audit branch protection", "owner", owner, "repo", repoName, "error", audit.Err)
+		}
+
+		return audit
+	})
+}
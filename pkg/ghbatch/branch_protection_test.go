@@ -0,0 +1,61 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditBranchProtectionForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/strict/branches/main/protection":
+			json.NewEncoder(w).Encode(map[string]any{
+				"required_pull_request_reviews": map[string]any{"required_approving_review_count": 2},
+				"required_status_checks":        map[string]any{"strict": true, "contexts": []string{"ci"}},
+				"enforce_admins":                map[string]any{"enabled": true},
+			})
+		case "/repos/testorg/lenient/branches/master/protection":
+			json.NewEncoder(w).Encode(map[string]any{
+				"required_pull_request_reviews": map[string]any{"required_approving_review_count": 1},
+				"enforce_admins":                map[string]any{"enabled": false},
+			})
+		case "/repos/testorg/open/branches/main/protection":
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Branch not protected"})
+		case "/repos/testorg/broken/branches/main/protection":
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Resource not accessible"})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	repos := []*github.Repository{
+		{Name: stringPtr("strict"), DefaultBranch: stringPtr("main")},
+		{Name: stringPtr("lenient"), DefaultBranch: stringPtr("master")},
+		{Name: stringPtr("open"), DefaultBranch: stringPtr("main")},
+		{Name: stringPtr("broken"), DefaultBranch: stringPtr("main")},
+	}
+
+	audits := service.AuditBranchProtectionForRepos(context.Background(), "testorg", repos)
+	require.Len(t, audits, 4)
+
+	assert.Equal(t, BranchProtectionAudit{
+		RepoName: "strict", Branch: "main", Protected: true, RequiredReviews: true, StatusChecks: true, AdminEnforcement: true,
+	}, audits[0])
+	assert.True(t, audits[0].Compliant())
+
+	assert.Equal(t, BranchProtectionAudit{RepoName: "lenient", Branch: "master", Protected: true, RequiredReviews: true}, audits[1])
+	assert.False(t, audits[1].Compliant())
+
+	assert.Equal(t, BranchProtectionAudit{RepoName: "open", Branch: "main"}, audits[2])
+
+	assert.Error(t, audits[3].Err)
+	assert.False(t, audits[3].Compliant())
+}
@@ -0,0 +1,175 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// ErrCodeScanningNotEnabled is returned when a repository has no code scanning analyses.
+var ErrCodeScanningNotEnabled = errors.New("code scanning is not enabled")
+
+// CodeScanningFilter narrows the alerts included in a code scanning report. Empty lists match everything.
+type CodeScanningFilter struct {
+	// Severities lists the severities to include, e.g. "critical", "high" or "warning".
+	Severities []string
+	// Tools lists the names of the analysis tools to include, e.g. "CodeQL".
+	Tools []string
+}
+
+// Matches reports whether an alert passes the filter.
+func (f CodeScanningFilter) Matches(alert *github.Alert) bool {
+	if len(f.Severities) > 0 && !slices.Contains(f.Severities, AlertSeverity(alert)) {
+		return false
+	}
+
+	return len(f.Tools) == 0 || slices.Contains(f.Tools, alert.GetTool().GetName())
+}
+
+// AlertSeverity returns the security severity of a code scanning alert (critical, high, medium or low),
+// falling back to the rule severity (error, warning or note) for rules without one.
+func AlertSeverity(alert *github.Alert) string {
+	if level := alert.GetRule().GetSecuritySeverityLevel(); level != "" {
+		return level
+	}
+
+	return alert.GetRule().GetSeverity()
+}
+
+// CodeScanningRuleCount is the number of open alerts of a single rule.
+type CodeScanningRuleCount struct {
+	RuleID      string
+	Description string
+	Severity    string
+	Tool        string
+	Count       int
+}
+
+// CodeScanningReport summarizes the open code scanning alerts of a repository.
+type CodeScanningReport struct {
+	RepoName string
+	// Enabled is false when the repository has no code scanning analyses.
+	Enabled bool
+	// Rules holds the alert counts per rule, most frequent first.
+	Rules []CodeScanningRuleCount
+	Total int
+	Err   error
+}
+
+// RepoErr implements Result.
+func (c CodeScanningReport) RepoErr() error { return c.Err }
+
+// WithRepoErr implements Result.
+func (CodeScanningReport) WithRepoErr(_, repoName string, err error) CodeScanningReport {
+	return CodeScanningReport{RepoName: repoName, Err: err}
+}
+
+// ListCodeScanningAlerts lists the open code scanning alerts of a repository.
+func (s *Service) ListCodeScanningAlerts(ctx context.Context, owner, repoName string) ([]*github.Alert, error) {
+	opts := &github.AlertListOptions{
+		State: "open",
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var alerts []*github.Alert
+
+	for {
+		page, resp, err := s.client.CodeScanning.ListAlertsForRepo(ctx, owner, repoName, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, ErrCodeScanningNotEnabled
+			}
+
+			return nil, fmt.Errorf("failed to list code scanning alerts for %s/%s: %w", owner, repoName, err)
+		}
+
+		alerts = append(alerts, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		// The options embed both cursor and offset pagination, so the offset page is set explicitly
+		opts.ListOptions.Page = resp.NextPage
+	}
+
+	return alerts, nil
+}
+
+// GetCodeScanningReports summarizes the open code scanning alerts of all the given repositories.
+func (s *Service) GetCodeScanningReports(ctx context.Context, owner string, repoNames []string,
+	filter CodeScanningFilter,
+) []CodeScanningReport {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) CodeScanningReport {
+		report := CodeScanningReport{RepoName: repoName}
+
+		alerts, err := s.ListCodeScanningAlerts(ctx, owner, repoName)
+		if errors.Is(err, ErrCodeScanningNotEnabled) {
+			return report
+		}
+
+		if err != nil {
+			s.log.Error("Failed to list code scanning alerts", "owner", owner, "repo", repoName, "error", err)
+			report.Err = err
+
+			return report
+		}
+
+		report.Enabled = true
+		report.Rules = countAlertsByRule(alerts, filter)
+
+		for _, rule := range report.Rules {
+			report.Total += rule.Count
+		}
+
+		return report
+	})
+}
+
+// countAlertsByRule groups the alerts passing the filter by rule, most frequent first.
+func countAlertsByRule(alerts []*github.Alert, filter CodeScanningFilter) []CodeScanningRuleCount {
+	byRule := map[string]*CodeScanningRuleCount{}
+
+	for _, alert := range alerts {
+		if !filter.Matches(alert) {
+			continue
+		}
+
+		id := alert.GetRule().GetID()
+
+		count, ok := byRule[id]
+		if !ok {
+			count = &CodeScanningRuleCount{
+				RuleID:      id,
+				Description: alert.GetRule().GetDescription(),
+				Severity:    AlertSeverity(alert),
+				Tool:        alert.GetTool().GetName(),
+			}
+			byRule[id] = count
+		}
+
+		count.Count++
+	}
+
+	counts := make([]CodeScanningRuleCount, 0, len(byRule))
+	for _, count := range byRule {
+		counts = append(counts, *count)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+
+		return counts[i].RuleID < counts[j].RuleID
+	})
+
+	return counts
+}
@@ -0,0 +1,67 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func codeScanningAlert(ruleID, severity, securityLevel, tool string) *github.Alert {
+	rule := &github.Rule{ID: stringPtr(ruleID), Severity: stringPtr(severity), Description: stringPtr(ruleID + " description")}
+	if securityLevel != "" {
+		rule.SecuritySeverityLevel = stringPtr(securityLevel)
+	}
+
+	return &github.Alert{Rule: rule, Tool: &github.Tool{Name: stringPtr(tool)}}
+}
+
+func TestGetCodeScanningReports_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/scanned/code-scanning/alerts":
+			assert.Equal(t, "open", r.URL.Query().Get("state"))
+			json.NewEncoder(w).Encode([]*github.Alert{
+				codeScanningAlert("go/sql-injection", "error", "high", "CodeQL"),
+				codeScanningAlert("go/sql-injection", "error", "high", "CodeQL"),
+				codeScanningAlert("go/log-injection", "error", "critical", "CodeQL"),
+				codeScanningAlert("unused-var", "note", "", "golangci-lint"),
+			})
+		case "/repos/testorg/unscanned/code-scanning/alerts":
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"message": "no analysis found"})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Resource not accessible"})
+		}
+	}, 2)
+
+	reports := service.GetCodeScanningReports(context.Background(), "testorg", []string{"scanned", "unscanned", "forbidden"},
+		CodeScanningFilter{Tools: []string{"CodeQL"}})
+	require.Len(t, reports, 3)
+
+	assert.True(t, reports[0].Enabled)
+	assert.Equal(t, 3, reports[0].Total)
+	assert.Equal(t, []CodeScanningRuleCount{
+		{RuleID: "go/sql-injection", Description: "go/sql-injection description", Severity: "high", Tool: "CodeQL", Count: 2},
+		{RuleID: "go/log-injection", Description: "go/log-injection description", Severity: "critical", Tool: "CodeQL", Count: 1},
+	}, reports[0].Rules)
+
+	assert.False(t, reports[1].Enabled)
+	assert.NoError(t, reports[1].Err)
+
+	assert.Error(t, reports[2].Err)
+}
+
+func TestCodeScanningFilter_Matches(t *testing.T) {
+	alert := codeScanningAlert("unused-var", "warning", "", "golangci-lint")
+
+	assert.True(t, CodeScanningFilter{}.Matches(alert))
+	assert.True(t, CodeScanningFilter{Severities: []string{"warning", "error"}}.Matches(alert))
+	assert.False(t, CodeScanningFilter{Severities: []string{"critical", "high"}}.Matches(alert))
+	assert.False(t, CodeScanningFilter{Tools: []string{"CodeQL"}}.Matches(alert))
+}
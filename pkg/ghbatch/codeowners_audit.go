@@ -0,0 +1,68 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// CodeownersPaths are the locations GitHub looks for a CODEOWNERS file, in order of precedence.
+var CodeownersPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// CodeownersAudit is the result of validating a repository's CODEOWNERS file.
+type CodeownersAudit struct {
+	RepoName string
+	// Path is the location of the CODEOWNERS file, empty if the repository has none.
+	Path string
+	// Errors lists the syntax errors and unknown or unauthorized owners GitHub detected in the file.
+	Errors []*github.CodeownersError
+	Err    error
+}
+
+// RepoErr implements Result.
+func (a CodeownersAudit) RepoErr() error { return a.Err }
+
+// WithRepoErr implements Result.
+func (CodeownersAudit) WithRepoErr(_, repoName string, err error) CodeownersAudit {
+	return CodeownersAudit{RepoName: repoName, Err: err}
+}
+
+// Valid reports whether the repository has a CODEOWNERS file without errors.
+func (a CodeownersAudit) Valid() bool {
+	return a.Err == nil && a.Path != "" && len(a.Errors) == 0
+}
+
+// AuditCodeowners checks that a repository has a CODEOWNERS file and collects the errors GitHub reports for it.
+func (s *Service) AuditCodeowners(ctx context.Context, owner, repoName string) CodeownersAudit {
+	audit := CodeownersAudit{RepoName: repoName}
+
+	audit.Path, audit.Err = s.findFirstFile(ctx, owner, repoName, CodeownersPaths)
+	if audit.Err != nil || audit.Path == "" {
+		return audit
+	}
+
+	// The errors API covers both syntax errors and owners that do not exist or lack write access
+	codeownersErrors, _, err := s.client.Repositories.GetCodeownersErrors(ctx, owner, repoName, nil)
+	if err != nil {
+		audit.Err = fmt.Errorf("failed to get CODEOWNERS errors for %s/%s: %w", owner, repoName, err)
+
+		return audit
+	}
+
+	audit.Errors = codeownersErrors.Errors
+
+	return audit
+}
+
+// AuditCodeownersForRepos audits the CODEOWNERS files of all the given repositories.
+func (s *Service) AuditCodeownersForRepos(ctx context.Context, owner string, repoNames []string) []CodeownersAudit {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) CodeownersAudit {
+		audit := s.AuditCodeowners(ctx, owner, repoName)
+		if audit.Err != nil {
+			s.log.Error("Failed to audit CODEOWNERS", "owner", owner, "repo", repoName, "error", audit.Err)
+		}
+
+		return audit
+	})
+}
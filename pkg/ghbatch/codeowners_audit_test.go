@@ -0,0 +1,67 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditCodeownersForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/valid/contents/.github/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @testorg/platform\n", "sha1"))
+		case "/repos/testorg/valid/codeowners/errors":
+			json.NewEncoder(w).Encode(github.CodeownersErrors{Errors: []*github.CodeownersError{}})
+		case "/repos/testorg/broken/contents/docs/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @ghost\n", "sha2"))
+		case "/repos/testorg/broken/codeowners/errors":
+			json.NewEncoder(w).Encode(github.CodeownersErrors{Errors: []*github.CodeownersError{
+				{Line: 1, Column: 3, Kind: "Unknown owner", Message: "Unknown owner on line 1", Path: "docs/CODEOWNERS"},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	audits := service.AuditCodeownersForRepos(context.Background(), "testorg", []string{"valid", "broken", "missing"})
+	require.Len(t, audits, 3)
+
+	assert.Equal(t, ".github/CODEOWNERS", audits[0].Path)
+	assert.True(t, audits[0].Valid())
+
+	assert.Equal(t, "docs/CODEOWNERS", audits[1].Path)
+	require.Len(t, audits[1].Errors, 1)
+	assert.Equal(t, "Unknown owner", audits[1].Errors[0].Kind)
+	assert.False(t, audits[1].Valid())
+
+	require.NoError(t, audits[2].Err)
+	assert.Empty(t, audits[2].Path)
+	assert.False(t, audits[2].Valid())
+}
+
+func TestAddCodeownersToRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/testorg/broken/contents/.github/CODEOWNERS":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodGet:
+			http.NotFound(w, r)
+		case r.Method == http.MethodPut:
+			json.NewEncoder(w).Encode(&github.RepositoryContentResponse{Content: &github.RepositoryContent{SHA: github.String("new-sha")}})
+		}
+	}, 2)
+
+	successRepos, failedRepos := service.AddCodeownersToRepos(context.Background(), "testorg",
+		[]string{"api", "web", "broken"}, "* @team", nil)
+
+	sort.Strings(successRepos)
+	assert.Equal(t, []string{"api", "web"}, successRepos)
+	assert.Equal(t, []string{"broken"}, failedRepos)
+}
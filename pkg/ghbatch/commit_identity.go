@@ -0,0 +1,32 @@
+package ghbatch
+
+import "github.com/google/go-github/v62/github"
+
+// CommitIdentity overrides the attribution and message of the file commits made by a service, e.g. to
+// attribute fleet changes to an automation bot instead of the token's user.
+type CommitIdentity struct {
+	// Name and Email are the author and committer of the commits; both or neither must be set.
+	Name  string
+	Email string
+	// Message replaces the commit message chosen by the operation when not empty.
+	Message string
+}
+
+// commitMessage returns the message of a file commit, overridden by the commit identity when set.
+func (s *Service) commitMessage(message string) string {
+	if s.identity.Message != "" {
+		return s.identity.Message
+	}
+
+	return message
+}
+
+// identityAuthor returns the author of file commits from the commit identity, or nil when the token's user
+// is the author.
+func (s *Service) identityAuthor() *github.CommitAuthor {
+	if s.identity.Name == "" {
+		return nil
+	}
+
+	return &github.CommitAuthor{Name: github.String(s.identity.Name), Email: github.String(s.identity.Email)}
+}
@@ -0,0 +1,88 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOrUpdateFile_CommitIdentity(t *testing.T) {
+	tests := []struct {
+		name        string
+		identity    CommitIdentity
+		wantMessage string
+		wantAuthor  map[string]any
+	}{
+		{
+			name:        "Defaults",
+			wantMessage: "Add CODEOWNERS",
+		},
+		{
+			name:        "Bot identity and message",
+			identity:    CommitIdentity{Name: "fleet-bot", Email: "fleet-bot@example.com", Message: "chore: sync CODEOWNERS"},
+			wantMessage: "chore: sync CODEOWNERS",
+			wantAuthor:  map[string]any{"name": "fleet-bot", "email": "fleet-bot@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]any
+			service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					http.NotFound(w, r)
+					return
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				fmt.Fprint(w, `{"content":{"sha":"new-blob"},"commit":{"sha":"commit-sha"}}`)
+			}, 1, WithCommitIdentity(tt.identity))
+
+			err := service.CreateOrUpdateFile(context.Background(), "testorg", "api", ".github/CODEOWNERS", "* @team\n", "Add CODEOWNERS")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantMessage, body["message"])
+			if tt.wantAuthor == nil {
+				assert.NotContains(t, body, "author")
+				assert.NotContains(t, body, "committer")
+				return
+			}
+			assert.Equal(t, tt.wantAuthor, body["author"])
+			assert.Equal(t, tt.wantAuthor, body["committer"])
+		})
+	}
+}
+
+func TestCreateOrUpdateFile_SignedWithCommitIdentity(t *testing.T) {
+	identity := CommitIdentity{Name: "fleet-bot", Email: "fleet-bot@example.com"}
+	signer := github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		payload, _ := io.ReadAll(r)
+		assert.Contains(t, string(payload), "\nauthor fleet-bot <fleet-bot@example.com> ")
+		_, err := io.WriteString(w, "signature")
+		return err
+	})
+
+	var tree, commit map[string]any
+	var refUpdated bool
+	handler := signedCommitHandler(t, &tree, &commit, &refUpdated)
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/api/contents/.github/CODEOWNERS":
+			http.NotFound(w, r)
+		case "/user":
+			t.Error("the commit identity must be used instead of the authenticated user")
+		default:
+			handler(w, r)
+		}
+	}, 1, WithCommitIdentity(identity), WithCommitSigner(signer))
+
+	err := service.CreateOrUpdateFile(context.Background(), "testorg", "api", ".github/CODEOWNERS", "* @team\n", "Add CODEOWNERS")
+	require.NoError(t, err)
+	assert.Equal(t, "fleet-bot", commit["author"].(map[string]any)["name"])
+}
@@ -0,0 +1,99 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Default permission granted to a team on a newly created repository.
+const defaultTeamPermission = "push"
+
+// RepoCreateRequest describes a repository to create from a template repository.
+type RepoCreateRequest struct {
+	Name        string
+	Description string
+	// Visibility is one of "public", "private" or "internal"; empty means private.
+	Visibility string
+	Topics     []string
+	// Team is the slug of an organization team to grant access; empty grants no team access.
+	Team string
+	// TeamPermission is one of "pull", "triage", "push", "maintain" or "admin"; empty means push.
+	TeamPermission string
+}
+
+// CreateRepositoryFromTemplate creates a repository from a template and applies its topics and team permission.
+func (s *Service) CreateRepositoryFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string,
+	request RepoCreateRequest,
+) error {
+	s.log.Info("Creating repository from template", "owner", owner, "repo", request.Name,
+		"template", templateOwner+"/"+templateRepo)
+
+	_, _, err := s.client.Repositories.CreateFromTemplate(ctx, templateOwner, templateRepo, &github.TemplateRepoRequest{
+		Name:        github.String(request.Name),
+		Owner:       github.String(owner),
+		Description: github.String(request.Description),
+		Private:     github.Bool(request.Visibility != "public"),
+	})
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreateRepo, Owner: owner, Repo: request.Name,
+		Target: templateOwner + "/" + templateRepo}, err)
+	if err != nil {
+		return fmt.Errorf("failed to create repository %s/%s from template %s/%s: %w",
+			owner, request.Name, templateOwner, templateRepo, err)
+	}
+
+	// The template endpoint only knows public/private, internal needs a follow-up edit
+	if request.Visibility == "internal" {
+		if err := s.SetRepositoryVisibility(ctx, owner, request.Name, request.Visibility); err != nil {
+			return err
+		}
+	}
+
+	if len(request.Topics) > 0 {
+		_, _, err = s.client.Repositories.ReplaceAllTopics(ctx, owner, request.Name, request.Topics)
+		s.recordMutation(ctx, audit.Record{Operation: OperationSetTopics, Owner: owner, Repo: request.Name,
+			Target: strings.Join(request.Topics, ",")}, err)
+		if err != nil {
+			return fmt.Errorf("failed to set topics for repository %s/%s: %w", owner, request.Name, err)
+		}
+	}
+
+	if request.Team != "" {
+		permission := request.TeamPermission
+		if permission == "" {
+			permission = defaultTeamPermission
+		}
+
+		_, err = s.client.Teams.AddTeamRepoBySlug(ctx, owner, request.Team, owner, request.Name,
+			&github.TeamAddTeamRepoOptions{Permission: permission})
+		s.recordMutation(ctx, audit.Record{Operation: OperationGrantTeam, Owner: owner, Repo: request.Name,
+			Target: request.Team + ":" + permission}, err)
+		if err != nil {
+			return fmt.Errorf("failed to grant team %s access to repository %s/%s: %w", request.Team, owner, request.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateReposFromTemplate creates all the requested repositories from a template.
+func (s *Service) CreateReposFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string,
+	requests []RepoCreateRequest,
+) ([]string, []string) {
+	byName := make(map[string]RepoCreateRequest, len(requests))
+	names := make([]string, 0, len(requests))
+
+	for _, request := range requests {
+		byName[request.Name] = request
+		names = append(names, request.Name)
+	}
+
+	return s.processReposConcurrently(ctx, "create", names,
+		func(ctx context.Context, repoName string) error {
+			return s.CreateRepositoryFromTemplate(ctx, owner, templateOwner, templateRepo, byName[repoName])
+		}, nil)
+}
@@ -0,0 +1,62 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateReposFromTemplate_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/repos/testorg/template/generate":
+			var body github.TemplateRepoRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "testorg", body.GetOwner())
+			if body.GetName() == "taken" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(map[string]string{"message": "name already exists"})
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.Repository{Name: body.Name})
+		case r.URL.Path == "/repos/testorg/student-1/topics":
+			json.NewEncoder(w).Encode(map[string][]string{"names": {"course"}})
+		case r.URL.Path == "/orgs/testorg/teams/teachers/repos/testorg/student-1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/repos/testorg/student-1":
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("student-1")})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	successRepos, failedRepos := service.CreateReposFromTemplate(context.Background(), "testorg", "testorg", "template",
+		[]RepoCreateRequest{
+			{Name: "student-1", Visibility: "internal", Topics: []string{"course"}, Team: "teachers"},
+			{Name: "student-2"},
+			{Name: "taken"},
+		})
+
+	sort.Strings(successRepos)
+	assert.Equal(t, []string{"student-1", "student-2"}, successRepos)
+	assert.Equal(t, []string{"taken"}, failedRepos)
+
+	assert.Equal(t, 1, calls["PATCH /repos/testorg/student-1"], "internal visibility needs a follow-up edit")
+	assert.Equal(t, 1, calls["PUT /repos/testorg/student-1/topics"])
+	assert.Equal(t, 1, calls["PUT /orgs/testorg/teams/teachers/repos/testorg/student-1"])
+	assert.Zero(t, calls["PUT /repos/testorg/student-2/topics"])
+}
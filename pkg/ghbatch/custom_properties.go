@@ -0,0 +1,196 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// maxCustomPropertyRepos is the number of repositories the bulk custom property endpoint accepts per request.
+const maxCustomPropertyRepos = 30
+
+// Custom property value types with a restricted set of values.
+const (
+	customPropertySingleSelect = "single_select"
+	customPropertyTrueFalse    = "true_false"
+)
+
+// customPropertyValuesPathFmt is the API path of the bulk custom property values endpoint of an organization.
+const customPropertyValuesPathFmt = "orgs/%s/properties/values"
+
+// customPropertyValue is a property value sent to the bulk endpoint. Unlike the GitHub client's type, a nil
+// value is sent as null, which unsets the property.
+type customPropertyValue struct {
+	PropertyName string  `json:"property_name"`
+	Value        *string `json:"value"`
+}
+
+// customPropertyValuesRequest is the body of the bulk custom property values endpoint.
+type customPropertyValuesRequest struct {
+	RepositoryNames []string              `json:"repository_names"`
+	Properties      []customPropertyValue `json:"properties"`
+}
+
+// GetCustomPropertySchema gets the custom properties defined by an organization.
+func (s *Service) GetCustomPropertySchema(ctx context.Context, org string) ([]*github.CustomProperty, error) {
+	schema, _, err := s.client.Organizations.GetAllCustomProperties(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom properties of %s: %w", org, err)
+	}
+
+	return schema, nil
+}
+
+// ValidateCustomPropertyValues checks values against the custom properties of an organization: every
+// property must be defined, single-select properties only take their allowed values and true/false
+// properties only take true or false. An empty value unsets the property and is always accepted.
+func ValidateCustomPropertyValues(schema []*github.CustomProperty, values map[string]string) error {
+	properties := make(map[string]*github.CustomProperty, len(schema))
+	for _, property := range schema {
+		properties[property.GetPropertyName()] = property
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property, ok := properties[name]
+		if !ok {
+			return fmt.Errorf("custom property %q is not defined by the organization", name)
+		}
+
+		value := values[name]
+		if value == "" {
+			continue
+		}
+
+		switch property.ValueType {
+		case customPropertySingleSelect:
+			if !slices.Contains(property.AllowedValues, value) {
+				return fmt.Errorf("invalid value %q for custom property %s: must be one of %s", value, name,
+					strings.Join(property.AllowedValues, ", "))
+			}
+		case customPropertyTrueFalse:
+			if value != "true" && value != "false" {
+				return fmt.Errorf("invalid value %q for custom property %s: must be true or false", value, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ListCustomPropertyValues lists the custom property values of every repository of an organization, keyed
+// by repository name and then property name. Unset properties are left out.
+func (s *Service) ListCustomPropertyValues(ctx context.Context, org string) (map[string]map[string]string, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	values := map[string]map[string]string{}
+
+	for {
+		page, resp, err := s.client.Organizations.ListCustomPropertyValues(ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list custom property values of %s: %w", org, err)
+		}
+
+		for _, repository := range page {
+			properties := map[string]string{}
+			for _, property := range repository.Properties {
+				if property.Value != nil {
+					properties[property.PropertyName] = *property.Value
+				}
+			}
+			values[repository.RepositoryName] = properties
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return values, nil
+}
+
+// CustomPropertyChanges returns the sorted names of the properties whose current value differs from values.
+func CustomPropertyChanges(current, values map[string]string) []string {
+	var changes []string
+	for name, value := range values {
+		if current[name] != value {
+			changes = append(changes, name)
+		}
+	}
+	sort.Strings(changes)
+
+	return changes
+}
+
+// SetCustomPropertyValues assigns custom property values to all the given repositories of an organization,
+// using the bulk endpoint in batches. An empty value unsets the property. It returns the names of the
+// repositories that succeeded and the names of the ones that failed.
+func (s *Service) SetCustomPropertyValues(ctx context.Context, org string, repoNames []string,
+	values map[string]string,
+) ([]string, []string) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	properties := make([]customPropertyValue, 0, len(names))
+	for _, name := range names {
+		property := customPropertyValue{PropertyName: name}
+		if values[name] != "" {
+			property.Value = github.String(values[name])
+		}
+		properties = append(properties, property)
+	}
+
+	var successRepos, failedRepos []string
+	for start := 0; start < len(repoNames); start += maxCustomPropertyRepos {
+		batch := repoNames[start:min(start+maxCustomPropertyRepos, len(repoNames))]
+		s.log.Info("Setting custom property values", "org", org, "repos", len(batch), "properties", names)
+
+		err := s.setCustomPropertyValues(ctx, org, batch, properties)
+		for _, repoName := range batch {
+			s.recordMutation(ctx, audit.Record{Operation: OperationSetCustomProperties, Owner: org, Repo: repoName,
+				Target: strings.Join(names, ",")}, err)
+		}
+		if err != nil {
+			s.log.Error("Failed to set custom property values", "org", org, "repos", batch, "error", err)
+			failedRepos = append(failedRepos, batch...)
+
+			continue
+		}
+
+		successRepos = append(successRepos, batch...)
+	}
+
+	return successRepos, failedRepos
+}
+
+func (s *Service) setCustomPropertyValues(ctx context.Context, org string, repoNames []string,
+	properties []customPropertyValue,
+) error {
+	req, err := s.client.NewRequest(http.MethodPatch, fmt.Sprintf(customPropertyValuesPathFmt, org),
+		customPropertyValuesRequest{RepositoryNames: repoNames, Properties: properties})
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.client.Do(ctx, req, nil); err != nil {
+		return fmt.Errorf("failed to set custom property values in %s: %w", org, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomPropertyValues(t *testing.T) {
+	schema := []*github.CustomProperty{
+		{PropertyName: github.String("owner-team"), ValueType: "string"},
+		{PropertyName: github.String("service-tier"), ValueType: "single_select", AllowedValues: []string{"1", "2", "3"}},
+		{PropertyName: github.String("pci"), ValueType: "true_false"},
+	}
+
+	assert.NoError(t, ValidateCustomPropertyValues(schema, map[string]string{"owner-team": "payments", "service-tier": "1", "pci": "true"}))
+	assert.NoError(t, ValidateCustomPropertyValues(schema, map[string]string{"service-tier": ""}))
+	assert.ErrorContains(t, ValidateCustomPropertyValues(schema, map[string]string{"cost-center": "x"}), `"cost-center" is not defined`)
+	assert.ErrorContains(t, ValidateCustomPropertyValues(schema, map[string]string{"service-tier": "4"}), "must be one of 1, 2, 3")
+	assert.ErrorContains(t, ValidateCustomPropertyValues(schema, map[string]string{"pci": "yes"}), "must be true or false")
+}
+
+func TestCustomPropertyChanges(t *testing.T) {
+	current := map[string]string{"owner-team": "payments", "service-tier": "2"}
+
+	assert.Equal(t, []string{"service-tier"}, CustomPropertyChanges(current, map[string]string{"owner-team": "payments", "service-tier": "1"}))
+	assert.Equal(t, []string{"owner-team"}, CustomPropertyChanges(current, map[string]string{"owner-team": ""}))
+	assert.Empty(t, CustomPropertyChanges(current, map[string]string{"service-tier": "2", "pci": ""}))
+}
+
+func TestListCustomPropertyValues_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/orgs/testorg/properties/values", r.URL.Path)
+
+		if r.URL.Query().Get("page") == "2" {
+			json.NewEncoder(w).Encode([]map[string]any{{"repository_name": "repo2", "properties": []any{}}})
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, "http://"+r.Host+r.URL.Path))
+		json.NewEncoder(w).Encode([]map[string]any{{
+			"repository_name": "repo1",
+			"properties": []any{
+				map[string]any{"property_name": "service-tier", "value": "1"},
+				map[string]any{"property_name": "owner-team", "value": nil},
+			},
+		}})
+	}, 1)
+
+	values, err := service.ListCustomPropertyValues(context.Background(), "testorg")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[string]string{
+		"repo1": {"service-tier": "1"},
+		"repo2": {},
+	}, values)
+}
+
+func TestSetCustomPropertyValues_WithMockServer(t *testing.T) {
+	var bodies []map[string]any
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPatch, r.Method)
+		require.Equal(t, "/orgs/testorg/properties/values", r.URL.Path)
+
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+
+		if len(bodies) == 2 {
+			http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, 1)
+
+	repoNames := make([]string, 31)
+	for i := range repoNames {
+		repoNames[i] = fmt.Sprintf("repo%02d", i)
+	}
+
+	success, failed := service.SetCustomPropertyValues(context.Background(), "testorg", repoNames,
+		map[string]string{"service-tier": "1", "owner-team": ""})
+	assert.Len(t, success, 30)
+	assert.Equal(t, []string{"repo30"}, failed)
+
+	require.Len(t, bodies, 2)
+	assert.Len(t, bodies[0]["repository_names"], 30)
+	assert.Equal(t, []any{
+		map[string]any{"property_name": "owner-team", "value": nil},
+		map[string]any{"property_name": "service-tier", "value": "1"},
+	}, bodies[0]["properties"])
+}
@@ -0,0 +1,56 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DependabotConfigPath is the location of the Dependabot configuration within a repository.
+const DependabotConfigPath = ".github/dependabot.yml"
+
+// dependabotMarkers maps files in the repository root to the Dependabot package ecosystem they indicate.
+var dependabotMarkers = []struct {
+	path      string
+	ecosystem string
+}{
+	{"go.mod", "gomod"},
+	{"Dockerfile", "docker"},
+	{"package.json", "npm"},
+}
+
+// DetectDependabotEcosystems detects the package ecosystems of a repository from marker files in its root.
+func (s *Service) DetectDependabotEcosystems(ctx context.Context, owner, repoName string) ([]string, error) {
+	var ecosystems []string
+
+	for _, marker := range dependabotMarkers {
+		_, _, found, err := s.GetFileContent(ctx, owner, repoName, marker.path)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			ecosystems = append(ecosystems, marker.ecosystem)
+		}
+	}
+
+	s.log.Info("Detected package ecosystems", "owner", owner, "repo", repoName, "ecosystems", ecosystems)
+
+	return ecosystems, nil
+}
+
+// RenderDependabotConfig renders a dependabot.yml with one root-directory update entry per ecosystem.
+func RenderDependabotConfig(ecosystems []string, interval string) string {
+	var builder strings.Builder
+
+	builder.WriteString("version: 2\nupdates:\n")
+
+	for _, ecosystem := range ecosystems {
+		fmt.Fprintf(&builder, "  - package-ecosystem: %q\n", ecosystem)
+		builder.WriteString("    directory: \"/\"\n")
+		builder.WriteString("    schedule:\n")
+		fmt.Fprintf(&builder, "      interval: %q\n", interval)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,42 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectDependabotEcosystems_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/repo1/contents/go.mod", "/repos/testorg/repo1/contents/Dockerfile":
+			json.NewEncoder(w).Encode(encodedFile("content", "sha"))
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	ecosystems, err := service.DetectDependabotEcosystems(context.Background(), "testorg", "repo1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gomod", "docker"}, ecosystems)
+}
+
+func TestRenderDependabotConfig(t *testing.T) {
+	expected := `version: 2
+updates:
+  - package-ecosystem: "gomod"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+  - package-ecosystem: "npm"
+    directory: "/"
+    schedule:
+      interval: "weekly"
+`
+
+	assert.Equal(t, expected, RenderDependabotConfig([]string{"gomod", "npm"}, "weekly"))
+}
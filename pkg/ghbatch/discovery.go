@@ -0,0 +1,197 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// Repository discovery backends.
+const (
+	// DiscoveryList pages through all repositories of the owner and filters them locally.
+	DiscoveryList = "list"
+	// DiscoverySearch queries the repository Search API, falling back to listing when search limits apply.
+	DiscoverySearch = "search"
+)
+
+// searchResultLimit is the maximum number of results the Search API returns for a query.
+const searchResultLimit = 1000
+
+// ErrSearchLimit is returned when a repository search cannot return all matching repositories, either
+// because there are more than the Search API returns or because it reported incomplete results.
+var ErrSearchLimit = errors.New("repository search limit exceeded")
+
+// DiscoveryQuery selects the repositories of an owner. Empty fields match all repositories.
+type DiscoveryQuery struct {
+	// Mode is the discovery backend, DiscoveryList or DiscoverySearch; empty means DiscoveryList.
+	Mode   string
+	Prefix string
+	// Topics restricts discovery to repositories carrying all of these topics.
+	Topics   []string
+	Language string
+	// Archived restricts discovery to archived (true) or active (false) repositories when not nil.
+	Archived *bool
+}
+
+// Matches reports whether a repository satisfies the query.
+func (q DiscoveryQuery) Matches(repository *github.Repository) bool {
+	if !strings.HasPrefix(repository.GetName(), q.Prefix) {
+		return false
+	}
+
+	if q.Language != "" && !strings.EqualFold(repository.GetLanguage(), q.Language) {
+		return false
+	}
+
+	if q.Archived != nil && repository.GetArchived() != *q.Archived {
+		return false
+	}
+
+	for _, topic := range q.Topics {
+		if !slices.Contains(repository.Topics, strings.ToLower(topic)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// prefixIsToken reports whether the name qualifier of a search finds every repository with the prefix. The
+// Search API matches whole words of the name, so the prefix must be empty or end at a word boundary: "svc-"
+// finds "svc-payments", but "svc-pay" does not.
+func (q DiscoveryQuery) prefixIsToken() bool {
+	if q.Prefix == "" {
+		return true
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(q.Prefix)
+
+	return !unicode.IsLetter(last) && !unicode.IsDigit(last)
+}
+
+// searchQualifiers builds the Search API query for the repositories of an owner.
+func (q DiscoveryQuery) searchQualifiers(owner string, isUser bool) string {
+	qualifiers := []string{"org:" + owner}
+	if isUser {
+		qualifiers = []string{"user:" + owner}
+	}
+
+	// Forks are excluded from search results unless asked for, but listing includes them
+	qualifiers = append(qualifiers, "fork:true")
+
+	if q.Prefix != "" {
+		qualifiers = append(qualifiers, q.Prefix+" in:name")
+	}
+
+	for _, topic := range q.Topics {
+		qualifiers = append(qualifiers, "topic:"+topic)
+	}
+
+	if q.Language != "" {
+		qualifiers = append(qualifiers, "language:"+q.Language)
+	}
+
+	if q.Archived != nil {
+		qualifiers = append(qualifiers, fmt.Sprintf("archived:%t", *q.Archived))
+	}
+
+	return strings.Join(qualifiers, " ")
+}
+
+// DiscoverRepositories finds the repositories of an owner matching the query with the selected backend.
+// The search backend falls back to listing when the Search API cannot return every match, and lists right
+// away when the prefix ends mid-word.
+func (s *Service) DiscoverRepositories(ctx context.Context, owner string, isUser bool,
+	query DiscoveryQuery,
+) ([]*github.Repository, error) {
+	switch {
+	case query.Mode != DiscoverySearch:
+	case !query.prefixIsToken():
+		s.log.Info("Repository search cannot match a prefix ending mid-word, listing instead", "owner", owner, "prefix", query.Prefix)
+	default:
+		repos, err := s.searchRepositories(ctx, owner, isUser, query)
+		if err == nil {
+			return repos, nil
+		}
+
+		if !isSearchLimitError(err) {
+			return nil, err
+		}
+
+		s.log.Warn("Repository search limits apply, falling back to listing", "owner", owner, "reason", err)
+	}
+
+	repos, err := s.GetRepositoriesWithPrefix(ctx, owner, query.Prefix, isUser)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]*github.Repository, 0, len(repos))
+
+	for _, repository := range repos {
+		if query.Matches(repository) {
+			matching = append(matching, repository)
+		}
+	}
+
+	return matching, nil
+}
+
+// searchRepositories finds the repositories of an owner through the Search API. The name qualifier
+// matches words anywhere in the name, so results are filtered by prefix locally.
+func (s *Service) searchRepositories(ctx context.Context, owner string, isUser bool,
+	query DiscoveryQuery,
+) ([]*github.Repository, error) {
+	q := query.searchQualifiers(owner, isUser)
+	s.log.Info("Searching repositories", "query", q)
+
+	var matching []*github.Repository
+
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	for {
+		result, resp, err := s.client.Search.Repositories(ctx, q, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search repositories for %s: %w", owner, err)
+		}
+
+		if result.GetTotal() > searchResultLimit {
+			return nil, fmt.Errorf("%w: %d matches", ErrSearchLimit, result.GetTotal())
+		}
+
+		if result.GetIncompleteResults() {
+			return nil, fmt.Errorf("%w: incomplete results", ErrSearchLimit)
+		}
+
+		for _, repository := range result.Repositories {
+			if query.Matches(repository) {
+				matching = append(matching, repository)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matching, nil
+}
+
+// isSearchLimitError reports whether err means the Search API could not serve the query, either because
+// of its result cap or because of its stricter rate limits.
+func isSearchLimitError(err error) bool {
+	var (
+		rateLimitErr *github.RateLimitError
+		abuseErr     *github.AbuseRateLimitError
+	)
+
+	return errors.Is(err, ErrSearchLimit) || errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr)
+}
@@ -0,0 +1,113 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoveryQuery_Matches(t *testing.T) {
+	repository := &github.Repository{
+		Name:     github.String("svc-billing"),
+		Language: github.String("Go"),
+		Topics:   []string{"payments", "backend"},
+		Archived: github.Bool(false),
+	}
+
+	assert.True(t, DiscoveryQuery{}.Matches(repository))
+	assert.True(t, DiscoveryQuery{Prefix: "svc-", Topics: []string{"Backend"}, Language: "go", Archived: github.Bool(false)}.Matches(repository))
+	assert.False(t, DiscoveryQuery{Prefix: "lib-"}.Matches(repository))
+	assert.False(t, DiscoveryQuery{Topics: []string{"payments", "frontend"}}.Matches(repository))
+	assert.False(t, DiscoveryQuery{Language: "Python"}.Matches(repository))
+	assert.False(t, DiscoveryQuery{Archived: github.Bool(true)}.Matches(repository))
+}
+
+func TestDiscoverRepositories_Search(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search/repositories":
+			assert.Equal(t, "org:testorg fork:true svc- in:name topic:backend language:go archived:false", r.URL.Query().Get("q"))
+			json.NewEncoder(w).Encode(github.RepositoriesSearchResult{
+				Total: github.Int(2),
+				Repositories: []*github.Repository{
+					{Name: github.String("svc-billing"), Language: github.String("Go"), Topics: []string{"backend"}},
+					// The name qualifier matches words anywhere in the name
+					{Name: github.String("legacy-svc-auth"), Language: github.String("Go"), Topics: []string{"backend"}},
+				},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	query := DiscoveryQuery{Mode: DiscoverySearch, Prefix: "svc-", Topics: []string{"backend"}, Language: "go", Archived: github.Bool(false)}
+	repos, err := service.DiscoverRepositories(context.Background(), "testorg", false, query)
+	require.NoError(t, err)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "svc-billing", repos[0].GetName())
+}
+
+func TestDiscoverRepositories_FallsBackToListing(t *testing.T) {
+	var listed bool
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search/repositories":
+			assert.Equal(t, "user:octocat fork:true archived:false", r.URL.Query().Get("q"))
+			json.NewEncoder(w).Encode(github.RepositoriesSearchResult{Total: github.Int(5000)})
+		case "/users/octocat/repos":
+			listed = true
+			json.NewEncoder(w).Encode([]*github.Repository{
+				{Name: github.String("active")},
+				{Name: github.String("old"), Archived: github.Bool(true)},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	query := DiscoveryQuery{Mode: DiscoverySearch, Archived: github.Bool(false)}
+	repos, err := service.DiscoverRepositories(context.Background(), "octocat", true, query)
+	require.NoError(t, err)
+	assert.True(t, listed)
+	require.Len(t, repos, 1)
+	assert.Equal(t, "active", repos[0].GetName())
+}
+
+func TestDiscoverRepositories_ListsForPartialTokenPrefix(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/testorg/repos":
+			json.NewEncoder(w).Encode([]*github.Repository{
+				{Name: github.String("svc-payments")},
+				{Name: github.String("svc-pay")},
+				{Name: github.String("svc-billing")},
+			})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	repos, err := service.DiscoverRepositories(context.Background(), "testorg", false, DiscoveryQuery{Mode: DiscoverySearch, Prefix: "svc-pay"})
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "svc-payments", repos[0].GetName())
+	assert.Equal(t, "svc-pay", repos[1].GetName())
+}
+
+func TestDiscoverRepositories_SearchError(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Validation Failed"}`, http.StatusUnprocessableEntity)
+	}, 1)
+
+	_, err := service.DiscoverRepositories(context.Background(), "testorg", false, DiscoveryQuery{Mode: DiscoverySearch})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrSearchLimit)
+}
@@ -0,0 +1,123 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DiscussionStats holds the discussion counts of a repository. Err is set when the repository could not be
+// counted.
+type DiscussionStats struct {
+	RepoName string
+	// Enabled reports whether the repository has discussions turned on.
+	Enabled bool
+	Total   int
+	Open    int
+	// Answered counts the discussions with a marked answer, which only question and answer categories have.
+	Answered int
+	// Unanswered counts the open discussions without a marked answer.
+	Unanswered int
+	Err        error
+}
+
+// Closed returns the number of closed discussions.
+func (d *DiscussionStats) Closed() int {
+	return d.Total - d.Open
+}
+
+// discussionCounts holds the aliased discussion totals of a repository in a GraphQL response.
+type discussionCounts struct {
+	HasDiscussionsEnabled bool                     `json:"hasDiscussionsEnabled"`
+	Total                 struct{ TotalCount int } `json:"total"`
+	Open                  struct{ TotalCount int } `json:"open"`
+	Answered              struct{ TotalCount int } `json:"answered"`
+	Unanswered            struct{ TotalCount int } `json:"unanswered"`
+}
+
+// GetDiscussionStats counts the discussions of repositories with GraphQL totalCount aggregates, batching up
+// to 50 repositories per query. The results are in the same order as repoNames.
+func (s *Service) GetDiscussionStats(ctx context.Context, owner string, repoNames []string) ([]*DiscussionStats, error) {
+	allStats := make([]*DiscussionStats, 0, len(repoNames))
+
+	for start := 0; start < len(repoNames); start += graphQLBatchSize {
+		batch := repoNames[start:min(start+graphQLBatchSize, len(repoNames))]
+
+		stats, err := s.countDiscussionsGraphQL(ctx, owner, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		allStats = append(allStats, stats...)
+	}
+
+	return allStats, nil
+}
+
+// countDiscussionsGraphQL counts the discussions of a batch of repositories in a single query, one alias per
+// repository.
+func (s *Service) countDiscussionsGraphQL(ctx context.Context, owner string, repoNames []string) ([]*DiscussionStats, error) {
+	s.log.Info("Fetching discussion counts with GraphQL", "owner", owner, "repos", len(repoNames))
+
+	declarations := make([]string, 0, len(repoNames))
+	fields := make([]string, 0, len(repoNames))
+	variables := map[string]any{"owner": owner}
+
+	for i, repoName := range repoNames {
+		alias := fmt.Sprintf("r%d", i)
+		declarations = append(declarations, fmt.Sprintf("$%s_name: String!", alias))
+		fields = append(fields, fmt.Sprintf(`%[1]s: repository(owner: $owner, name: $%[1]s_name) {
+    hasDiscussionsEnabled
+    total: discussions { totalCount }
+    open: discussions(states: OPEN) { totalCount }
+    answered: discussions(answered: true) { totalCount }
+    unanswered: discussions(answered: false, states: OPEN) { totalCount }
+  }`, alias))
+		variables[alias+"_name"] = repoName
+	}
+
+	query := fmt.Sprintf("query($owner: String!, %s) {\n  %s\n}", strings.Join(declarations, ", "), strings.Join(fields, "\n  "))
+	data := map[string]*discussionCounts{}
+
+	fieldErrors, err := s.graphQL(ctx, query, variables, &data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count discussions for %s: %w", owner, err)
+	}
+
+	// Errors are attributed to the repository whose alias starts their path
+	errorsByAlias := make(map[string]string, len(fieldErrors))
+	for _, e := range fieldErrors {
+		if alias, ok := e.Path[0].(string); ok {
+			errorsByAlias[alias] = e.Message
+		}
+	}
+
+	allStats := make([]*DiscussionStats, 0, len(repoNames))
+
+	for i, repoName := range repoNames {
+		alias := fmt.Sprintf("r%d", i)
+		stats := &DiscussionStats{RepoName: repoName}
+
+		counts := data[alias]
+		switch {
+		case errorsByAlias[alias] != "":
+			stats.Err = fmt.Errorf("failed to count discussions for %s/%s: %s", owner, repoName, errorsByAlias[alias])
+		case counts == nil:
+			stats.Err = fmt.Errorf("failed to count discussions for %s/%s: repository not returned", owner, repoName)
+		default:
+			stats.Enabled = counts.HasDiscussionsEnabled
+			stats.Total = counts.Total.TotalCount
+			stats.Open = counts.Open.TotalCount
+			stats.Answered = counts.Answered.TotalCount
+			stats.Unanswered = counts.Unanswered.TotalCount
+		}
+
+		if stats.Err != nil {
+			s.log.Error("Error fetching discussion stats", "owner", owner, "repo", repoName, "error", stats.Err)
+		}
+
+		allStats = append(allStats, stats)
+	}
+
+	return allStats, nil
+}
@@ -0,0 +1,57 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDiscussionStats(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var request graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		assert.Equal(t, "support", request.Variables["r0_name"])
+		assert.Contains(t, request.Query, "unanswered: discussions(answered: false, states: OPEN)")
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{
+				"r0": map[string]any{
+					"hasDiscussionsEnabled": true,
+					"total":                 map[string]int{"totalCount": 10},
+					"open":                  map[string]int{"totalCount": 4},
+					"answered":              map[string]int{"totalCount": 5},
+					"unanswered":            map[string]int{"totalCount": 3},
+				},
+				"r1": map[string]any{"hasDiscussionsEnabled": false},
+				"r2": nil,
+			},
+			"errors": []map[string]any{
+				{"type": "NOT_FOUND", "path": []string{"r2"}, "message": "Could not resolve to a Repository"},
+			},
+		})
+	}, 1)
+
+	stats, err := service.GetDiscussionStats(context.Background(), "testorg", []string{"support", "api", "missing"})
+	require.NoError(t, err)
+	require.Len(t, stats, 3)
+
+	assert.Equal(t, &DiscussionStats{RepoName: "support", Enabled: true, Total: 10, Open: 4, Answered: 5, Unanswered: 3}, stats[0])
+	assert.Equal(t, 6, stats[0].Closed())
+	assert.Equal(t, &DiscussionStats{RepoName: "api"}, stats[1])
+	assert.ErrorContains(t, stats[2].Err, "Could not resolve to a Repository")
+}
+
+func TestGetDiscussionStats_QueryError(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"errors": []map[string]any{{"message": "Field 'discussions' doesn't exist"}},
+		})
+	}, 1)
+
+	_, err := service.GetDiscussionStats(context.Background(), "testorg", []string{"support"})
+	assert.ErrorContains(t, err, "failed to count discussions for testorg")
+}
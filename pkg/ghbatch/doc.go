@@ -0,0 +1,18 @@
+// Package ghbatch runs GitHub operations on many repositories of an organization or user account at once.
+// It backs the commands of go-repo-manager and can be imported by other tools that need the same batching
+// without shelling out to the CLI.
+//
+// A service is created by New from a client made by NewGitHubClient, whose requests are retried after
+// secondary rate limits, counted in the metrics and traced:
+//
+//	service := ghbatch.New(ghbatch.NewGitHubClient(token), ghbatch.WithConcurrency(5))
+//	results := service.GetRepositories(ctx, "myorg", []string{"api", "web"})
+//
+// Options such as WithAuditLog and WithCommitSigner configure each service independently, so services with
+// different settings can be used side by side.
+//
+// Batch methods process up to the configured number of repositories at once and return one result per
+// repository in the order of the given names, or stream the results as they complete. A failing repository
+// never stops the others: its result carries the error. CollectConcurrently and StreamConcurrently run
+// custom per-repository work the same way.
+package ghbatch
@@ -0,0 +1,141 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// File drift statuses.
+const (
+	DriftStatusInSync   = "in-sync"
+	DriftStatusDiverged = "diverged"
+	DriftStatusMissing  = "missing"
+)
+
+// ManagedFile is a file of a source-of-truth repository that downstream repositories must carry unchanged.
+type ManagedFile struct {
+	Path    string
+	Content string
+}
+
+// FileDrift is the state of a managed file in a downstream repository.
+type FileDrift struct {
+	Path string
+	// Status is one of the DriftStatus constants.
+	Status string
+	// Content is the content of the file in the repository, empty when it is missing.
+	Content string
+}
+
+// RepoDrift is the drift of a downstream repository from the managed files.
+type RepoDrift struct {
+	RepoName string
+	// Files holds the state of each managed file, in the order of the managed files.
+	Files []FileDrift
+	Err   error
+}
+
+// RepoErr implements Result.
+func (d RepoDrift) RepoErr() error { return d.Err }
+
+// WithRepoErr implements Result.
+func (RepoDrift) WithRepoErr(_, repoName string, err error) RepoDrift {
+	return RepoDrift{RepoName: repoName, Err: err}
+}
+
+// Diverged reports whether any managed file is missing or differs in the repository.
+func (d RepoDrift) Diverged() bool {
+	for _, file := range d.Files {
+		if file.Status != DriftStatusInSync {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetManagedFiles reads the files at the given paths on the default branch of the source repository. A path
+// naming a directory expands to every file below it. The files are sorted by path.
+func (s *Service) GetManagedFiles(ctx context.Context, owner, repoName string, paths []string) ([]ManagedFile, error) {
+	head, err := s.getBranchHead(ctx, owner, repoName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.listTree(ctx, owner, repoName, head)
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := map[string]string{}
+	for _, path := range paths {
+		path = strings.Trim(path, "/")
+		found := false
+
+		for _, entry := range entries {
+			if entry.GetType() != "blob" || (entry.GetPath() != path && !strings.HasPrefix(entry.GetPath(), path+"/")) {
+				continue
+			}
+
+			blobs[entry.GetPath()] = entry.GetSHA()
+			found = true
+		}
+
+		if !found {
+			return nil, fmt.Errorf("managed path %s does not exist in %s/%s", path, owner, repoName)
+		}
+	}
+
+	files := make([]ManagedFile, 0, len(blobs))
+	for path, sha := range blobs {
+		content, err := s.getBlobContent(ctx, owner, repoName, sha)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, ManagedFile{Path: path, Content: content})
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+
+	return files, nil
+}
+
+// DetectDrift compares the managed files with their copies on the default branch of each repository.
+func (s *Service) DetectDrift(ctx context.Context, owner string, repoNames []string, files []ManagedFile) []RepoDrift {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoDrift {
+		drift := s.detectRepoDrift(ctx, owner, repoName, files)
+		if drift.Err != nil {
+			s.log.Error("Failed to detect drift", "owner", owner, "repo", repoName, "error", drift.Err)
+		}
+
+		return drift
+	})
+}
+
+func (s *Service) detectRepoDrift(ctx context.Context, owner, repoName string, files []ManagedFile) RepoDrift {
+	drift := RepoDrift{RepoName: repoName}
+
+	for _, file := range files {
+		content, _, found, err := s.GetFileContent(ctx, owner, repoName, file.Path)
+		if err != nil {
+			drift.Err = err
+
+			return drift
+		}
+
+		fileDrift := FileDrift{Path: file.Path, Status: DriftStatusInSync, Content: content}
+		switch {
+		case !found:
+			fileDrift.Status = DriftStatusMissing
+		case content != file.Content:
+			fileDrift.Status = DriftStatusDiverged
+		}
+
+		drift.Files = append(drift.Files, fileDrift)
+	}
+
+	return drift
+}
@@ -0,0 +1,110 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goldenHandler serves a source-of-truth repository with a workflow directory and a CODEOWNERS file.
+func goldenHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/golden":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "/repos/testorg/golden/git/ref/heads/main":
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"head"}}`)
+		case "/repos/testorg/golden/git/commits/head":
+			fmt.Fprint(w, `{"sha":"head","tree":{"sha":"root"}}`)
+		case "/repos/testorg/golden/git/trees/root":
+			fmt.Fprint(w, `{"sha":"root","tree":[
+				{"path":".github","type":"tree","sha":"gh"},
+				{"path":".github/CODEOWNERS","type":"blob","sha":"owners"},
+				{"path":".github/workflows","type":"tree","sha":"wf"},
+				{"path":".github/workflows/ci.yml","type":"blob","sha":"ci"},
+				{"path":".github/workflows/release.yml","type":"blob","sha":"release"},
+				{"path":".github/workflows-old/legacy.yml","type":"blob","sha":"legacy"},
+				{"path":"README.md","type":"blob","sha":"readme"}]}`)
+		case "/repos/testorg/golden/git/blobs/owners", "/repos/testorg/golden/git/blobs/ci", "/repos/testorg/golden/git/blobs/release":
+			sha := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			json.NewEncoder(w).Encode(map[string]string{
+				"content":  base64.StdEncoding.EncodeToString([]byte(sha + " content\n")),
+				"encoding": "base64",
+			})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestGetManagedFiles(t *testing.T) {
+	service := newTestService(t, goldenHandler(t), 1)
+
+	files, err := service.GetManagedFiles(context.Background(), "testorg", "golden", []string{".github/workflows/", ".github/CODEOWNERS"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []ManagedFile{
+		{Path: ".github/CODEOWNERS", Content: "owners content\n"},
+		{Path: ".github/workflows/ci.yml", Content: "ci content\n"},
+		{Path: ".github/workflows/release.yml", Content: "release content\n"},
+	}, files)
+}
+
+func TestGetManagedFiles_MissingPath(t *testing.T) {
+	service := newTestService(t, goldenHandler(t), 1)
+
+	_, err := service.GetManagedFiles(context.Background(), "testorg", "golden", []string{".golangci.yml"})
+	assert.ErrorContains(t, err, "managed path .golangci.yml does not exist in testorg/golden")
+}
+
+func TestDetectDrift(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/synced/contents/CODEOWNERS", "/repos/testorg/drifted/contents/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @platform\n", "sha"))
+		case "/repos/testorg/synced/contents/.golangci.yml":
+			json.NewEncoder(w).Encode(encodedFile("linters: {}\n", "sha"))
+		case "/repos/testorg/drifted/contents/.golangci.yml":
+			json.NewEncoder(w).Encode(encodedFile("linters: {enable: [gofmt]}\n", "sha"))
+		case "/repos/testorg/broken/contents/CODEOWNERS":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	files := []ManagedFile{
+		{Path: "CODEOWNERS", Content: "* @platform\n"},
+		{Path: ".golangci.yml", Content: "linters: {}\n"},
+		{Path: "SECURITY.md", Content: "Report issues to security@example.com\n"},
+	}
+
+	drifts := service.DetectDrift(context.Background(), "testorg", []string{"synced", "drifted", "broken"}, files)
+	require.Len(t, drifts, 3)
+
+	statuses := func(drift RepoDrift) []string {
+		var result []string
+		for _, file := range drift.Files {
+			result = append(result, file.Status)
+		}
+		return result
+	}
+
+	require.NoError(t, drifts[0].Err)
+	assert.Equal(t, []string{DriftStatusInSync, DriftStatusInSync, DriftStatusMissing}, statuses(drifts[0]))
+	assert.True(t, drifts[0].Diverged())
+
+	require.NoError(t, drifts[1].Err)
+	assert.Equal(t, []string{DriftStatusInSync, DriftStatusDiverged, DriftStatusMissing}, statuses(drifts[1]))
+	assert.Equal(t, "linters: {enable: [gofmt]}\n", drifts[1].Files[1].Content)
+
+	assert.Error(t, drifts[2].Err)
+}
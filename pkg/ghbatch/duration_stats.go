@@ -0,0 +1,38 @@
+package ghbatch
+
+import (
+	"slices"
+	"time"
+)
+
+// DurationStats summarizes a set of durations, such as the response times of issues.
+type DurationStats struct {
+	Count  int
+	Median time.Duration
+	P90    time.Duration
+}
+
+// SummarizeDurations computes the median and 90th percentile of durations with the nearest-rank method.
+// The zero value is returned for no durations.
+func SummarizeDurations(durations []time.Duration) DurationStats {
+	if len(durations) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	return DurationStats{
+		Count:  len(sorted),
+		Median: durationPercentile(sorted, 50),
+		P90:    durationPercentile(sorted, 90),
+	}
+}
+
+// durationPercentile returns the smallest of the sorted durations that at least percentile percent of them
+// do not exceed.
+func durationPercentile(sorted []time.Duration, percentile int) time.Duration {
+	rank := (percentile*len(sorted) + 99) / 100
+
+	return sorted[max(rank, 1)-1]
+}
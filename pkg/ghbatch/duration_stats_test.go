@@ -0,0 +1,26 @@
+package ghbatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeDurations(t *testing.T) {
+	assert.Equal(t, DurationStats{}, SummarizeDurations(nil))
+
+	assert.Equal(t, DurationStats{Count: 1, Median: time.Hour, P90: time.Hour}, SummarizeDurations([]time.Duration{time.Hour}))
+
+	var durations []time.Duration
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Hour)
+	}
+
+	stats := SummarizeDurations(durations)
+	assert.Equal(t, 10, stats.Count)
+	assert.Equal(t, 5*time.Hour, stats.Median)
+	assert.Equal(t, 9*time.Hour, stats.P90)
+	// The input is left unsorted
+	assert.Equal(t, 10*time.Hour, durations[0])
+}
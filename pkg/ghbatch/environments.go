@@ -0,0 +1,261 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Environment reviewer types.
+const (
+	ReviewerTypeUser = "User"
+	ReviewerTypeTeam = "Team"
+)
+
+// Environment statuses.
+const (
+	EnvironmentStatusCreated = "created"
+	EnvironmentStatusUpdated = "updated"
+)
+
+// EnvironmentReviewer is a user or team whose approval deployments to an environment require.
+type EnvironmentReviewer struct {
+	// Type is ReviewerTypeUser or ReviewerTypeTeam.
+	Type string
+	// Name is the user login or team slug.
+	Name string
+	// ID is resolved from Name by ResolveEnvironmentReviewers.
+	ID int64
+}
+
+// EnvironmentSpec is the desired configuration of a deployment environment.
+type EnvironmentSpec struct {
+	Name string
+	// WaitTimer is the number of minutes deployments wait before they proceed.
+	WaitTimer         int
+	Reviewers         []EnvironmentReviewer
+	PreventSelfReview bool
+	// CanAdminsBypass lets administrators skip the protection rules; nil keeps the GitHub default (allowed).
+	CanAdminsBypass *bool
+	// ProtectedBranches restricts deployments to protected branches.
+	ProtectedBranches bool
+	// BranchPatterns restricts deployments to branches matching these patterns. Existing patterns that are
+	// not listed are removed.
+	BranchPatterns []string
+}
+
+// EnvironmentChange is the outcome of applying one environment to a repository.
+type EnvironmentChange struct {
+	Name string
+	// Status is one of the EnvironmentStatus constants.
+	Status string
+}
+
+// EnvironmentResult is the outcome of applying environments to a repository.
+type EnvironmentResult struct {
+	RepoName string
+	Changes  []EnvironmentChange
+	Err      error
+}
+
+// RepoErr implements Result.
+func (e EnvironmentResult) RepoErr() error { return e.Err }
+
+// WithRepoErr implements Result.
+func (EnvironmentResult) WithRepoErr(_, repoName string, err error) EnvironmentResult {
+	return EnvironmentResult{RepoName: repoName, Err: err}
+}
+
+// ResolveEnvironmentReviewers looks up the IDs of the reviewers of the environments, which the environments
+// API requires. Teams are looked up in the owner organization.
+func (s *Service) ResolveEnvironmentReviewers(ctx context.Context, owner string, specs []EnvironmentSpec) error {
+	ids := map[EnvironmentReviewer]int64{}
+
+	for i := range specs {
+		for j := range specs[i].Reviewers {
+			reviewer := &specs[i].Reviewers[j]
+			key := EnvironmentReviewer{Type: reviewer.Type, Name: reviewer.Name}
+
+			if id, ok := ids[key]; ok {
+				reviewer.ID = id
+				continue
+			}
+
+			switch reviewer.Type {
+			case ReviewerTypeTeam:
+				team, _, err := s.client.Teams.GetTeamBySlug(ctx, owner, reviewer.Name)
+				if err != nil {
+					return fmt.Errorf("failed to look up team %s/%s: %w", owner, reviewer.Name, err)
+				}
+				reviewer.ID = team.GetID()
+			case ReviewerTypeUser:
+				user, _, err := s.client.Users.Get(ctx, reviewer.Name)
+				if err != nil {
+					return fmt.Errorf("failed to look up user %s: %w", reviewer.Name, err)
+				}
+				reviewer.ID = user.GetID()
+			default:
+				return fmt.Errorf("unknown reviewer type %q", reviewer.Type)
+			}
+
+			ids[key] = reviewer.ID
+		}
+	}
+
+	return nil
+}
+
+// ApplyEnvironments creates or updates the environments in all the given repositories. Reviewers must have
+// been resolved with ResolveEnvironmentReviewers.
+func (s *Service) ApplyEnvironments(ctx context.Context, owner string, repoNames []string,
+	specs []EnvironmentSpec,
+) []EnvironmentResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) EnvironmentResult {
+		result := EnvironmentResult{RepoName: repoName}
+
+		result.Changes, result.Err = s.applyEnvironments(ctx, owner, repoName, specs)
+		if result.Err != nil {
+			s.log.Error("Failed to apply environments", "owner", owner, "repo", repoName, "error", result.Err)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) applyEnvironments(ctx context.Context, owner, repoName string,
+	specs []EnvironmentSpec,
+) ([]EnvironmentChange, error) {
+	existing, err := s.listEnvironmentNames(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]EnvironmentChange, 0, len(specs))
+	for _, spec := range specs {
+		change := EnvironmentChange{Name: spec.Name, Status: EnvironmentStatusCreated}
+		if existing[spec.Name] {
+			change.Status = EnvironmentStatusUpdated
+		}
+
+		if err := s.applyEnvironment(ctx, owner, repoName, spec); err != nil {
+			return changes, err
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// listEnvironmentNames returns the names of the environments of a repository.
+func (s *Service) listEnvironmentNames(ctx context.Context, owner, repoName string) (map[string]bool, error) {
+	opts := &github.EnvironmentListOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	names := map[string]bool{}
+
+	for {
+		page, resp, err := s.client.Repositories.ListEnvironments(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list environments for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, environment := range page.Environments {
+			names[environment.GetName()] = true
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// applyEnvironment creates or updates a single environment and its deployment branch patterns.
+func (s *Service) applyEnvironment(ctx context.Context, owner, repoName string, spec EnvironmentSpec) error {
+	s.log.Info("Applying environment", "owner", owner, "repo", repoName, "environment", spec.Name)
+
+	request := &github.CreateUpdateEnvironment{
+		WaitTimer:         github.Int(spec.WaitTimer),
+		Reviewers:         make([]*github.EnvReviewers, 0, len(spec.Reviewers)),
+		CanAdminsBypass:   spec.CanAdminsBypass,
+		PreventSelfReview: github.Bool(spec.PreventSelfReview),
+	}
+
+	for _, reviewer := range spec.Reviewers {
+		request.Reviewers = append(request.Reviewers, &github.EnvReviewers{
+			Type: github.String(reviewer.Type),
+			ID:   github.Int64(reviewer.ID),
+		})
+	}
+
+	if spec.ProtectedBranches || len(spec.BranchPatterns) > 0 {
+		request.DeploymentBranchPolicy = &github.BranchPolicy{
+			ProtectedBranches:    github.Bool(spec.ProtectedBranches),
+			CustomBranchPolicies: github.Bool(len(spec.BranchPatterns) > 0),
+		}
+	}
+
+	_, _, err := s.client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, spec.Name, request)
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetEnvironment, Owner: owner, Repo: repoName, Target: spec.Name}, err)
+	if err != nil {
+		return fmt.Errorf("failed to apply environment %s to %s/%s: %w", spec.Name, owner, repoName, err)
+	}
+
+	if len(spec.BranchPatterns) == 0 {
+		return nil
+	}
+
+	return s.syncBranchPatterns(ctx, owner, repoName, spec.Name, spec.BranchPatterns)
+}
+
+// syncBranchPatterns adds the missing deployment branch patterns of an environment and removes those that
+// are not listed.
+func (s *Service) syncBranchPatterns(ctx context.Context, owner, repoName, environment string, patterns []string) error {
+	current, _, err := s.client.Repositories.ListDeploymentBranchPolicies(ctx, owner, repoName, environment)
+	if err != nil {
+		return fmt.Errorf("failed to list deployment branch policies of %s in %s/%s: %w", environment, owner, repoName, err)
+	}
+
+	wanted := make(map[string]bool, len(patterns))
+	for _, pattern := range patterns {
+		wanted[pattern] = true
+	}
+
+	for _, policy := range current.BranchPolicies {
+		if wanted[policy.GetName()] && policy.GetType() != "tag" {
+			delete(wanted, policy.GetName())
+			continue
+		}
+
+		_, err := s.client.Repositories.DeleteDeploymentBranchPolicy(ctx, owner, repoName, environment, policy.GetID())
+		s.recordMutation(ctx, audit.Record{Operation: OperationRemoveDeploymentBranch, Owner: owner, Repo: repoName,
+			Target: environment + "/" + policy.GetName()}, err)
+		if err != nil {
+			return fmt.Errorf("failed to remove deployment branch policy %s of %s in %s/%s: %w", policy.GetName(), environment, owner, repoName, err)
+		}
+	}
+
+	missing := make([]string, 0, len(wanted))
+	for pattern := range wanted {
+		missing = append(missing, pattern)
+	}
+	sort.Strings(missing)
+
+	for _, pattern := range missing {
+		_, _, err := s.client.Repositories.CreateDeploymentBranchPolicy(ctx, owner, repoName, environment,
+			&github.DeploymentBranchPolicyRequest{Name: github.String(pattern), Type: github.String("branch")})
+		s.recordMutation(ctx, audit.Record{Operation: OperationAddDeploymentBranch, Owner: owner, Repo: repoName,
+			Target: environment + "/" + pattern}, err)
+		if err != nil {
+			return fmt.Errorf("failed to add deployment branch policy %s to %s in %s/%s: %w", pattern, environment, owner, repoName, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEnvironmentReviewers_WithMockServer(t *testing.T) {
+	var lookups int
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+		switch r.URL.Path {
+		case "/orgs/testorg/teams/sre":
+			json.NewEncoder(w).Encode(map[string]any{"id": 11, "slug": "sre"})
+		case "/users/octocat":
+			json.NewEncoder(w).Encode(map[string]any{"id": 22, "login": "octocat"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	specs := []EnvironmentSpec{
+		{Name: "staging", Reviewers: []EnvironmentReviewer{{Type: ReviewerTypeTeam, Name: "sre"}}},
+		{Name: "production", Reviewers: []EnvironmentReviewer{{Type: ReviewerTypeTeam, Name: "sre"}, {Type: ReviewerTypeUser, Name: "octocat"}}},
+	}
+
+	require.NoError(t, service.ResolveEnvironmentReviewers(context.Background(), "testorg", specs))
+	assert.Equal(t, int64(11), specs[0].Reviewers[0].ID)
+	assert.Equal(t, int64(11), specs[1].Reviewers[0].ID)
+	assert.Equal(t, int64(22), specs[1].Reviewers[1].ID)
+	assert.Equal(t, 2, lookups, "reviewers are looked up once")
+
+	specs = []EnvironmentSpec{{Name: "staging", Reviewers: []EnvironmentReviewer{{Type: ReviewerTypeUser, Name: "ghost"}}}}
+	assert.ErrorContains(t, service.ResolveEnvironmentReviewers(context.Background(), "testorg", specs), "failed to look up user ghost")
+}
+
+func TestApplyEnvironments_WithMockServer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+		bodies   = map[string]map[string]any{}
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/environments":
+			json.NewEncoder(w).Encode(map[string]any{"total_count": 1, "environments": []map[string]any{{"name": "production"}}})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/environments/staging/deployment-branch-policies":
+			json.NewEncoder(w).Encode(map[string]any{"total_count": 2, "branch_policies": []map[string]any{
+				{"id": 1, "name": "main", "type": "branch"},
+				{"id": 2, "name": "develop", "type": "branch"},
+			}})
+		case r.Method == http.MethodPut:
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			bodies[r.URL.Path] = body
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			json.NewEncoder(w).Encode(map[string]any{"name": "env"})
+		case r.Method == http.MethodPost || r.Method == http.MethodDelete:
+			var body struct{ Name string }
+			json.NewDecoder(r.Body).Decode(&body)
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+body.Name)
+			json.NewEncoder(w).Encode(map[string]any{"id": 3})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	bypass := false
+	specs := []EnvironmentSpec{
+		{Name: "staging", BranchPatterns: []string{"main", "release/*"}},
+		{
+			Name:              "production",
+			WaitTimer:         30,
+			PreventSelfReview: true,
+			CanAdminsBypass:   &bypass,
+			Reviewers:         []EnvironmentReviewer{{Type: ReviewerTypeTeam, Name: "sre", ID: 11}},
+			ProtectedBranches: true,
+		},
+	}
+
+	results := service.ApplyEnvironments(context.Background(), "testorg", []string{"repo1"}, specs)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []EnvironmentChange{
+		{Name: "staging", Status: EnvironmentStatusCreated},
+		{Name: "production", Status: EnvironmentStatusUpdated},
+	}, results[0].Changes)
+
+	assert.Equal(t, []string{
+		"PUT /repos/testorg/repo1/environments/staging",
+		"DELETE /repos/testorg/repo1/environments/staging/deployment-branch-policies/2 ",
+		"POST /repos/testorg/repo1/environments/staging/deployment-branch-policies release/*",
+		"PUT /repos/testorg/repo1/environments/production",
+	}, requests)
+
+	assert.Equal(t, map[string]any{"protected_branches": false, "custom_branch_policies": true},
+		bodies["/repos/testorg/repo1/environments/staging"]["deployment_branch_policy"])
+
+	production := bodies["/repos/testorg/repo1/environments/production"]
+	assert.Equal(t, float64(30), production["wait_timer"])
+	assert.Equal(t, true, production["prevent_self_review"])
+	assert.Equal(t, false, production["can_admins_bypass"])
+	assert.Equal(t, []any{map[string]any{"type": "Team", "id": float64(11)}}, production["reviewers"])
+	assert.Equal(t, map[string]any{"protected_branches": true, "custom_branch_policies": false}, production["deployment_branch_policy"])
+}
@@ -0,0 +1,37 @@
+package ghbatch_test
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"go-repo-manager/pkg/ghbatch"
+)
+
+func ExampleNew() {
+	client := ghbatch.NewGitHubClient(os.Getenv("GITHUB_TOKEN"))
+	service := ghbatch.New(client, ghbatch.WithConcurrency(5), ghbatch.WithLogger(slog.Default()))
+
+	for _, result := range service.GetRepositories(context.Background(), "myorg", []string{"api", "web"}) {
+		if result.Err != nil {
+			fmt.Println(result.RepoName, "failed:", result.Err)
+			continue
+		}
+		fmt.Println(result.RepoName, result.Value.GetDefaultBranch())
+	}
+}
+
+func ExampleCollectConcurrently() {
+	lengths := ghbatch.CollectConcurrently(context.Background(), 2, []string{"api", "web-frontend"},
+		func(ctx context.Context, repoName string) ghbatch.RepoResult[int] {
+			return ghbatch.RepoResult[int]{RepoName: repoName, Value: len(repoName)}
+		})
+
+	for _, result := range lengths {
+		fmt.Println(result.RepoName, result.Value)
+	}
+	// Output:
+	// api 3
+	// web-frontend 12
+}
@@ -0,0 +1,209 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// FileSetRollout describes several files written to many repositories with a single commit per
+// repository, e.g. a directory of issue forms that must stay consistent.
+type FileSetRollout struct {
+	// Render returns the content of each file for a repository by path, allowing per-repository
+	// templating. Returning ErrSkipFile skips the repository.
+	Render func(repoName string) (map[string]string, error)
+	// CommitMessage is used for the commit that writes the changed files.
+	CommitMessage string
+	// PullRequest proposes the change through a pull request when set; nil commits to the default branch.
+	PullRequest *PullRequestOptions
+	// OnResult, when set, is called with the result of each repository as soon as it completes.
+	// It may be called concurrently from multiple workers.
+	OnResult func(result FileRolloutResult)
+}
+
+// ApplyFileSetToRepos writes a set of files to all the given repositories, one commit per repository.
+// The result status is created when none of the files existed, updated when some changed and unchanged
+// when all of them already have the content.
+func (s *Service) ApplyFileSetToRepos(ctx context.Context, owner string, repoNames []string,
+	rollout FileSetRollout,
+) []FileRolloutResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) FileRolloutResult {
+		result := s.applyFileSet(ctx, owner, repoName, rollout)
+		if result.Err != nil {
+			result.Status = FileStatusFailed
+			s.log.Error("Failed to apply files to repository", "owner", owner, "repo", repoName, "error", result.Err)
+		}
+
+		if rollout.OnResult != nil {
+			rollout.OnResult(result)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) applyFileSet(ctx context.Context, owner, repoName string, rollout FileSetRollout) FileRolloutResult {
+	result := FileRolloutResult{RepoName: repoName}
+
+	files, err := rollout.Render(repoName)
+	if errors.Is(err, ErrSkipFile) {
+		result.Status = FileStatusSkipped
+
+		return result
+	}
+
+	if err != nil {
+		result.Err = fmt.Errorf("failed to render files for %s: %w", repoName, err)
+
+		return result
+	}
+
+	head, err := s.getBranchHead(ctx, owner, repoName, "")
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	changes, existed, err := s.fileSetChanges(ctx, owner, repoName, head, files)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	switch {
+	case len(changes) == 0:
+		result.Status = FileStatusUnchanged
+
+		return result
+	case existed:
+		result.Status = FileStatusUpdated
+	default:
+		result.Status = FileStatusCreated
+	}
+
+	if rollout.PullRequest == nil {
+		result.Err = s.commitFileSet(ctx, owner, repoName, head, "", changes, rollout.CommitMessage)
+
+		return result
+	}
+
+	baseBranch, branch := head.branch, rollout.PullRequest.Branch
+	if err := s.ensureBranch(ctx, owner, repoName, branch, baseBranch); err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	// The branch may already carry an earlier version of the change
+	if head, err = s.getBranchHead(ctx, owner, repoName, branch); err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	if changes, _, err = s.fileSetChanges(ctx, owner, repoName, head, files); err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	if len(changes) > 0 {
+		if result.Err = s.commitFileSet(ctx, owner, repoName, head, branch, changes, rollout.CommitMessage); result.Err != nil {
+			return result
+		}
+	}
+
+	result.PullRequestURL, result.Err = s.openPullRequest(ctx, owner, repoName, branch, baseBranch, rollout.PullRequest)
+
+	return result
+}
+
+// fileChange is a file of a set whose content differs from the branch head.
+type fileChange struct {
+	path    string
+	content string
+	// entry is the existing tree entry of the file, nil when the file is new.
+	entry *github.TreeEntry
+}
+
+// fileSetChanges compares the files with the tree of the branch head by blob SHA and returns those that
+// differ, sorted by path, and whether any of the files already existed.
+func (s *Service) fileSetChanges(ctx context.Context, owner, repoName string, head *branchHead,
+	files map[string]string,
+) ([]fileChange, bool, error) {
+	entries, err := s.listTree(ctx, owner, repoName, head)
+	if err != nil {
+		return nil, false, err
+	}
+
+	byPath := make(map[string]*github.TreeEntry, len(entries))
+	for _, entry := range entries {
+		byPath[entry.GetPath()] = entry
+	}
+
+	var (
+		changes []fileChange
+		existed bool
+	)
+
+	for path, content := range files {
+		entry := byPath[path]
+		existed = existed || entry != nil
+
+		if entry == nil || entry.GetSHA() != gitBlobSHA(content) {
+			changes = append(changes, fileChange{path: path, content: content, entry: entry})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].path < changes[j].path })
+
+	return changes, existed, nil
+}
+
+// commitFileSet writes the changed files to the branch of head in a single commit and records each of them
+// in the audit log under branch, which is empty for the default branch.
+func (s *Service) commitFileSet(ctx context.Context, owner, repoName string, head *branchHead, branch string,
+	changes []fileChange, commitMessage string,
+) error {
+	entries := make([]*github.TreeEntry, 0, len(changes))
+	records := make([]audit.Record, 0, len(changes))
+
+	for _, change := range changes {
+		mode := "100644"
+		record := audit.Record{
+			Operation: OperationCommitFile,
+			Owner:     owner,
+			Repo:      repoName,
+			Path:      change.path,
+			Branch:    branch,
+			NewSHA:    gitBlobSHA(change.content),
+		}
+
+		if change.entry != nil {
+			mode = change.entry.GetMode()
+			record.OldSHA = change.entry.GetSHA()
+		}
+
+		content := change.content
+		entries = append(entries, &github.TreeEntry{Path: github.String(change.path), Mode: github.String(mode), Type: github.String("blob"), Content: &content})
+		records = append(records, record)
+	}
+
+	_, commit, err := s.commitTree(ctx, owner, repoName, head, entries, s.commitMessage(commitMessage))
+
+	for _, record := range records {
+		if err == nil {
+			record.CommitSHA, record.CommitURL = commit.GetSHA(), commit.GetHTMLURL()
+		}
+		s.recordMutation(ctx, record, err)
+	}
+
+	return err
+}
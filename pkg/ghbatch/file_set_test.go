@@ -0,0 +1,135 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyFileSetToRepos_DirectCommit(t *testing.T) {
+	bug, config := "name: Bug report\n", "blank_issues_enabled: false\n"
+
+	trees := map[string]string{
+		"synced": fmt.Sprintf(`[{"path":".github/ISSUE_TEMPLATE/bug.yml","mode":"100644","type":"blob","sha":%q},
+			{"path":".github/ISSUE_TEMPLATE/config.yml","mode":"100644","type":"blob","sha":%q}]`, gitBlobSHA(bug), gitBlobSHA(config)),
+		"partial": fmt.Sprintf(`[{"path":".github/ISSUE_TEMPLATE/bug.yml","mode":"100755","type":"blob","sha":"old-bug"},
+			{"path":".github/ISSUE_TEMPLATE/config.yml","mode":"100644","type":"blob","sha":%q}]`, gitBlobSHA(config)),
+		"fresh": `[{"path":"README.md","mode":"100644","type":"blob","sha":"readme"}]`,
+	}
+
+	var mu sync.Mutex
+	createdTrees := map[string][]any{}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.URL.Path, "/")
+		repoName := parts[3]
+
+		switch route := r.Method + " " + strings.Join(parts[4:], "/"); route {
+		case "GET ":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "GET git/ref/heads/main":
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"head"}}`)
+		case "GET git/commits/head":
+			fmt.Fprint(w, `{"sha":"head","tree":{"sha":"root"}}`)
+		case "GET git/trees/root":
+			fmt.Fprintf(w, `{"sha":"root","tree":%s}`, trees[repoName])
+		case "POST git/trees":
+			var body map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			mu.Lock()
+			createdTrees[repoName] = body["tree"].([]any)
+			mu.Unlock()
+			fmt.Fprint(w, `{"sha":"new-tree"}`)
+		case "POST git/commits":
+			var commit map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&commit))
+			assert.Equal(t, "Update issue templates", commit["message"])
+			fmt.Fprint(w, `{"sha":"new-commit"}`)
+		case "PATCH git/refs/heads/main":
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"new-commit"}}`)
+		default:
+			t.Errorf("unexpected request %s", route)
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	results := service.ApplyFileSetToRepos(context.Background(), "testorg", []string{"synced", "partial", "fresh", "skipped"}, FileSetRollout{
+		Render: func(repoName string) (map[string]string, error) {
+			if repoName == "skipped" {
+				return nil, ErrSkipFile
+			}
+			return map[string]string{".github/ISSUE_TEMPLATE/bug.yml": bug, ".github/ISSUE_TEMPLATE/config.yml": config}, nil
+		},
+		CommitMessage: "Update issue templates",
+	})
+
+	require.Len(t, results, 4)
+	for _, result := range results {
+		require.NoError(t, result.Err, result.RepoName)
+	}
+	assert.Equal(t, FileStatusUnchanged, results[0].Status)
+	assert.Equal(t, FileStatusUpdated, results[1].Status)
+	assert.Equal(t, FileStatusCreated, results[2].Status)
+	assert.Equal(t, FileStatusSkipped, results[3].Status)
+
+	assert.NotContains(t, createdTrees, "synced")
+	assert.Equal(t, []any{
+		map[string]any{"path": ".github/ISSUE_TEMPLATE/bug.yml", "mode": "100755", "type": "blob", "content": bug},
+	}, createdTrees["partial"], "only changed files are committed, keeping their mode")
+	assert.Len(t, createdTrees["fresh"], 2)
+}
+
+func TestApplyFileSetToRepos_PullRequest(t *testing.T) {
+	var commits []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/testorg/api":
+			fmt.Fprint(w, `{"default_branch":"main"}`)
+		case "GET /repos/testorg/api/git/ref/heads/main":
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"main-head"}}`)
+		case "GET /repos/testorg/api/git/ref/heads/templates":
+			fmt.Fprint(w, `{"ref":"refs/heads/templates","object":{"sha":"branch-head"}}`)
+		case "GET /repos/testorg/api/git/commits/main-head":
+			fmt.Fprint(w, `{"sha":"main-head","tree":{"sha":"main-tree"}}`)
+		case "GET /repos/testorg/api/git/commits/branch-head":
+			fmt.Fprint(w, `{"sha":"branch-head","tree":{"sha":"branch-tree"}}`)
+		case "GET /repos/testorg/api/git/trees/main-tree", "GET /repos/testorg/api/git/trees/branch-tree":
+			fmt.Fprint(w, `{"tree":[]}`)
+		case "POST /repos/testorg/api/git/trees":
+			fmt.Fprint(w, `{"sha":"new-tree"}`)
+		case "POST /repos/testorg/api/git/commits":
+			var commit map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&commit))
+			commits = append(commits, commit["parents"].([]any)[0].(string))
+			fmt.Fprint(w, `{"sha":"new-commit"}`)
+		case "PATCH /repos/testorg/api/git/refs/heads/templates":
+			fmt.Fprint(w, `{"ref":"refs/heads/templates","object":{"sha":"new-commit"}}`)
+		case "POST /repos/testorg/api/pulls":
+			json.NewEncoder(w).Encode(&github.PullRequest{HTMLURL: github.String("https://github.com/testorg/api/pull/7")})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.ApplyFileSetToRepos(context.Background(), "testorg", []string{"api"}, FileSetRollout{
+		Render:        func(string) (map[string]string, error) { return map[string]string{"a.yml": "a\n"}, nil },
+		CommitMessage: "Add templates",
+		PullRequest:   &PullRequestOptions{Branch: "templates", Title: "Add templates"},
+	})
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, FileStatusCreated, results[0].Status)
+	assert.Equal(t, "https://github.com/testorg/api/pull/7", results[0].PullRequestURL)
+	assert.Equal(t, []string{"branch-head"}, commits, "the commit lands on the pull request branch")
+}
@@ -0,0 +1,243 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// GetFileContent gets the content and blob SHA of a file on the default branch of a repository.
+// A missing file is not an error: found is false and the content and SHA are empty.
+func (s *Service) GetFileContent(ctx context.Context, owner, repoName, filePath string) (string, string, bool, error) {
+	return s.getFileContentOnRef(ctx, owner, repoName, filePath, "")
+}
+
+func (s *Service) getFileContentOnRef(ctx context.Context, owner, repoName, filePath, ref string,
+) (string, string, bool, error) {
+	var opts *github.RepositoryContentGetOptions
+	if ref != "" {
+		opts = &github.RepositoryContentGetOptions{Ref: ref}
+	}
+
+	fileContent, _, resp, err := s.client.Repositories.GetContents(ctx, owner, repoName, filePath, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", "", false, nil
+		}
+
+		return "", "", false, fmt.Errorf("failed to get file %s/%s:%s: %w", owner, repoName, filePath, err)
+	}
+
+	if fileContent == nil {
+		return "", "", false, fmt.Errorf("path %s/%s:%s is a directory", owner, repoName, filePath)
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return "", "", false, fmt.Errorf("failed to decode file %s/%s:%s: %w", owner, repoName, filePath, err)
+	}
+
+	return content, fileContent.GetSHA(), true, nil
+}
+
+// createOrUpdateFileOnBranch writes a file on the given branch (the default branch when empty).
+// sha must be the blob SHA of the existing file, or nil when creating a new file.
+func (s *Service) createOrUpdateFileOnBranch(ctx context.Context, owner, repoName, filePath, content,
+	commitMessage, branch string, sha *string,
+) error {
+	commitMessage = s.commitMessage(commitMessage)
+	opts := &github.RepositoryContentFileOptions{
+		Message:   github.String(commitMessage),
+		Content:   []byte(content),
+		SHA:       sha, // nil for new files, existing SHA for updates
+		Author:    s.identityAuthor(),
+		Committer: s.identityAuthor(),
+	}
+
+	if branch != "" {
+		opts.Branch = github.String(branch)
+	}
+
+	record := audit.Record{
+		Operation: OperationCommitFile,
+		Owner:     owner,
+		Repo:      repoName,
+		Path:      filePath,
+		Branch:    branch,
+	}
+	if sha != nil {
+		record.OldSHA = *sha
+	}
+
+	if s.signer != nil {
+		commit, err := s.commitFileSigned(ctx, owner, repoName, filePath, &content, commitMessage, branch)
+		if err != nil {
+			s.recordMutation(ctx, record, err)
+
+			return err
+		}
+
+		record.NewSHA, record.CommitSHA, record.CommitURL = commit.blobSHA, commit.commitSHA, commit.commitURL
+		s.recordMutation(ctx, record, nil)
+
+		return nil
+	}
+
+	response, _, err := s.client.Repositories.CreateFile(ctx, owner, repoName, filePath, opts)
+	if err != nil {
+		err = fmt.Errorf("failed to create/update file %s/%s:%s: %w", owner, repoName, filePath, err)
+		s.recordMutation(ctx, record, err)
+
+		return err
+	}
+
+	record.NewSHA = response.GetContent().GetSHA()
+	record.CommitSHA = response.Commit.GetSHA()
+	record.CommitURL = response.Commit.GetHTMLURL()
+	s.recordMutation(ctx, record, nil)
+
+	return nil
+}
+
+// deleteFileOnBranch deletes a file on the given branch (the default branch when empty). sha must be the
+// blob SHA of the existing file.
+func (s *Service) deleteFileOnBranch(ctx context.Context, owner, repoName, filePath, commitMessage,
+	branch, sha string,
+) error {
+	commitMessage = s.commitMessage(commitMessage)
+	opts := &github.RepositoryContentFileOptions{
+		Message:   github.String(commitMessage),
+		SHA:       github.String(sha),
+		Author:    s.identityAuthor(),
+		Committer: s.identityAuthor(),
+	}
+
+	if branch != "" {
+		opts.Branch = github.String(branch)
+	}
+
+	record := audit.Record{
+		Operation: OperationDeleteFile,
+		Owner:     owner,
+		Repo:      repoName,
+		Path:      filePath,
+		Branch:    branch,
+		OldSHA:    sha,
+	}
+
+	if s.signer != nil {
+		commit, err := s.commitFileSigned(ctx, owner, repoName, filePath, nil, commitMessage, branch)
+		if err != nil {
+			s.recordMutation(ctx, record, err)
+
+			return err
+		}
+
+		record.CommitSHA, record.CommitURL = commit.commitSHA, commit.commitURL
+		s.recordMutation(ctx, record, nil)
+
+		return nil
+	}
+
+	response, _, err := s.client.Repositories.DeleteFile(ctx, owner, repoName, filePath, opts)
+	if err != nil {
+		err = fmt.Errorf("failed to delete file %s/%s:%s: %w", owner, repoName, filePath, err)
+		s.recordMutation(ctx, record, err)
+
+		return err
+	}
+
+	record.CommitSHA = response.Commit.GetSHA()
+	record.CommitURL = response.Commit.GetHTMLURL()
+	s.recordMutation(ctx, record, nil)
+
+	return nil
+}
+
+// ensureBranch creates branch from the head of baseBranch unless it already exists.
+func (s *Service) ensureBranch(ctx context.Context, owner, repoName, branch, baseBranch string) error {
+	_, resp, err := s.client.Git.GetRef(ctx, owner, repoName, "heads/"+branch)
+	if err == nil {
+		return nil
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to get branch %s in %s/%s: %w", branch, owner, repoName, err)
+	}
+
+	base, _, err := s.client.Git.GetRef(ctx, owner, repoName, "heads/"+baseBranch)
+	if err != nil {
+		return fmt.Errorf("failed to get branch %s in %s/%s: %w", baseBranch, owner, repoName, err)
+	}
+
+	_, _, err = s.client.Git.CreateRef(ctx, owner, repoName, &github.Reference{
+		Ref:    github.String("refs/heads/" + branch),
+		Object: &github.GitObject{SHA: base.GetObject().SHA},
+	})
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreateBranch, Owner: owner, Repo: repoName, Branch: branch,
+		CommitSHA: base.GetObject().GetSHA()}, err)
+	if err != nil {
+		return fmt.Errorf("failed to create branch %s in %s/%s: %w", branch, owner, repoName, err)
+	}
+
+	return nil
+}
+
+// openPullRequest opens a pull request from branch into baseBranch, or returns the URL of the
+// pull request that is already open for that branch.
+func (s *Service) openPullRequest(ctx context.Context, owner, repoName, branch, baseBranch string,
+	opts *PullRequestOptions,
+) (string, error) {
+	pr, _, err := s.client.PullRequests.Create(ctx, owner, repoName, &github.NewPullRequest{
+		Title: github.String(opts.Title),
+		Body:  github.String(opts.Body),
+		Head:  github.String(branch),
+		Base:  github.String(baseBranch),
+	})
+	if err == nil {
+		s.recordMutation(ctx, audit.Record{Operation: OperationCreatePullRequest, Owner: owner, Repo: repoName, Branch: branch,
+			Target: issueTarget(pr.GetNumber(), opts.Title)}, nil)
+
+		return pr.GetHTMLURL(), nil
+	}
+
+	// Re-runs reuse the pull request opened by a previous run
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusUnprocessableEntity {
+		existing, _, listErr := s.client.PullRequests.List(ctx, owner, repoName, &github.PullRequestListOptions{
+			State: "open",
+			Head:  owner + ":" + branch,
+			Base:  baseBranch,
+		})
+		if listErr == nil && len(existing) > 0 {
+			return existing[0].GetHTMLURL(), nil
+		}
+	}
+
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreatePullRequest, Owner: owner, Repo: repoName, Branch: branch,
+		Target: opts.Title}, err)
+
+	return "", fmt.Errorf("failed to open pull request in %s/%s: %w", owner, repoName, err)
+}
+
+// findFirstFile returns the first of the given paths that exists in a repository, or an empty string
+// if none does.
+func (s *Service) findFirstFile(ctx context.Context, owner, repoName string, paths []string) (string, error) {
+	for _, path := range paths {
+		_, _, found, err := s.GetFileContent(ctx, owner, repoName, path)
+		if err != nil {
+			return "", err
+		}
+
+		if found {
+			return path, nil
+		}
+	}
+
+	return "", nil
+}
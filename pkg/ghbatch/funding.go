@@ -0,0 +1,74 @@
+package ghbatch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FundingConfigPath is the location of the sponsor button configuration of a repository.
+const FundingConfigPath = ".github/FUNDING.yml"
+
+// maxFundingEntries is the number of accounts or URLs GitHub accepts for the github and custom keys.
+const maxFundingEntries = 4
+
+// fundingListPlatforms are the FUNDING.yml keys that take a single value or a list of values.
+var fundingListPlatforms = map[string]bool{"github": true, "custom": true}
+
+// fundingPlatforms are the FUNDING.yml keys GitHub supports.
+var fundingPlatforms = map[string]bool{
+	"github": true, "patreon": true, "open_collective": true, "ko_fi": true, "tidelift": true,
+	"community_bridge": true, "liberapay": true, "issuehunt": true, "lfx_crowdfunding": true,
+	"polar": true, "buy_me_a_coffee": true, "thanks_dev": true, "custom": true,
+}
+
+// ValidateFundingConfig checks a FUNDING.yml before it is pushed: every key must be a platform GitHub
+// supports, github and custom take one value or a list of up to four, and the other platforms take a
+// single account name.
+func ValidateFundingConfig(content string) error {
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(content), &config); err != nil {
+		return fmt.Errorf("FUNDING.yml is not valid YAML: %w", err)
+	}
+
+	if len(config) == 0 {
+		return fmt.Errorf("FUNDING.yml does not configure any sponsor platform")
+	}
+
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !fundingPlatforms[key] {
+			return fmt.Errorf("FUNDING.yml: unknown sponsor platform %q", key)
+		}
+
+		switch value := config[key].(type) {
+		case string:
+			if strings.TrimSpace(value) == "" {
+				return fmt.Errorf("FUNDING.yml: %s must not be empty", key)
+			}
+		case []any:
+			if !fundingListPlatforms[key] {
+				return fmt.Errorf("FUNDING.yml: %s takes a single account name, not a list", key)
+			}
+			if len(value) == 0 || len(value) > maxFundingEntries {
+				return fmt.Errorf("FUNDING.yml: %s takes between 1 and %d entries, got %d", key, maxFundingEntries, len(value))
+			}
+			for _, entry := range value {
+				if s, ok := entry.(string); !ok || strings.TrimSpace(s) == "" {
+					return fmt.Errorf("FUNDING.yml: %s entries must be non-empty strings", key)
+				}
+			}
+		default:
+			return fmt.Errorf("FUNDING.yml: %s must be a string", key)
+		}
+	}
+
+	return nil
+}
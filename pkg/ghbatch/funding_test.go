@@ -0,0 +1,37 @@
+package ghbatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFundingConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "single accounts", content: "github: octocat\npatreon: octo\nko_fi: octo\n"},
+		{name: "lists", content: "github: [octocat, hubot]\ncustom: [\"https://example.com/donate\"]\n"},
+		{name: "invalid yaml", content: "github: [octocat", wantErr: "not valid YAML"},
+		{name: "empty", content: "", wantErr: "does not configure any sponsor platform"},
+		{name: "unknown platform", content: "paypal: octo\n", wantErr: `unknown sponsor platform "paypal"`},
+		{name: "empty value", content: "patreon: \"\"\n", wantErr: "patreon must not be empty"},
+		{name: "list on single platform", content: "patreon: [a, b]\n", wantErr: "patreon takes a single account name"},
+		{name: "too many entries", content: "github: [a, b, c, d, e]\n", wantErr: "between 1 and 4 entries, got 5"},
+		{name: "non-string entry", content: "custom: [1]\n", wantErr: "custom entries must be non-empty strings"},
+		{name: "nested value", content: "github:\n  user: octocat\n", wantErr: "github must be a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFundingConfig(tt.content)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
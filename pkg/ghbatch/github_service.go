@@ -0,0 +1,1997 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+	"go-repo-manager/internal/gomod"
+	"go-repo-manager/internal/logger"
+)
+
+const (
+	// Default concurrency value.
+	defaultConcurrency = 10
+)
+
+// IssueStats represents issue statistics for a repository.
+type IssueStats struct {
+	// Owner is the organization or user the repository belongs to.
+	Owner        string
+	RepoName     string
+	TotalIssues  int
+	OpenIssues   int
+	ClosedIssues int
+	// Labels breaks the counts down by label name. An issue carrying several labels counts towards each of
+	// them. Only GetIssueStatsForRepo fills it; the GraphQL backend leaves it nil.
+	Labels map[string]LabelCounts
+}
+
+// IssueStatsFilter narrows the issues counted by the issue statistics methods. The zero value counts all issues.
+type IssueStatsFilter struct {
+	// Labels restricts the count to issues carrying all of these labels.
+	Labels []string
+	// Since restricts the count to issues created at or after this time when not zero.
+	Since time.Time
+	// Until restricts the count to issues created before this time when not zero.
+	Until time.Time
+	// Assignee restricts the count to issues assigned to this login; "none" and "*" are also accepted.
+	Assignee string
+	// Milestone restricts the count to a milestone given by number or title; "none" and "*" are also accepted.
+	Milestone string
+}
+
+// GitHubClient defines the interface for GitHub API operations. It gains a method whenever Service does, so
+// code outside this module should depend on *Service and keep the interface for test doubles it can update.
+type GitHubClient interface {
+	// GetIssueStatsForRepo retrieves issue statistics for a single repository.
+	// It returns the total count of issues, open issues, and closed issues.
+	// Pull requests are excluded from the count as they are separate from issues in GitHub's API.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository within the organization or user account
+	//   - filter: Criteria narrowing the counted issues (zero value counts all issues)
+	//
+	// Returns:
+	//   - *IssueStats: Statistics containing issue counts for the repository
+	//   - error: Any error encountered during the API calls
+	GetIssueStatsForRepo(ctx context.Context, owner, repoName string, filter IssueStatsFilter) (*IssueStats, error)
+
+	// GetRepositoriesWithPrefix retrieves all repositories for an owner (organization or user) that have names
+	// starting with the specified prefix. If prefix is empty, it returns all repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - prefix: Repository name prefix to filter by (empty string matches all)
+	//   - isUser: true if owner is a user, false if it's an organization
+	//
+	// Returns:
+	//   - []*github.Repository: Slice of repositories matching the prefix
+	//   - error: Any error encountered during the API calls
+	GetRepositoriesWithPrefix(ctx context.Context, owner, prefix string, isUser bool) ([]*github.Repository, error)
+
+	// GetIssueStatsForReposWithPrefix retrieves issue statistics for all repositories
+	// for an owner (organization or user) that match the specified prefix. This is a convenience method
+	// that combines GetRepositoriesWithPrefix and GetIssueStatsForRepo.
+	//
+	// If any individual repository fails to return stats, it logs the error and continues
+	// processing other repositories rather than failing the entire operation.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - prefix: Repository name prefix to filter by (empty string matches all)
+	//   - isUser: true if owner is a user, false if it's an organization
+	//   - filter: Criteria narrowing the counted issues (zero value counts all issues)
+	//
+	// Returns:
+	//   - []*IssueStats: Slice of issue statistics for each matching repository
+	//   - error: Any error encountered during repository discovery (individual repo errors are logged)
+	GetIssueStatsForReposWithPrefix(ctx context.Context, owner, prefix string, isUser bool,
+		filter IssueStatsFilter) ([]*IssueStats, error)
+
+	// CreateOrUpdateFile creates or updates a file in a repository
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - filePath: Path to the file within the repository (e.g., ".github/CODEOWNERS")
+	//   - content: Content of the file
+	//   - commitMessage: Commit message for the file change
+	//
+	// Returns:
+	//   - error: Any error encountered during the file creation/update
+	CreateOrUpdateFile(ctx context.Context, owner, repoName, filePath, content, commitMessage string) error
+
+	// AddCodeownersToReposWithPrefix adds a CODEOWNERS file to all repositories
+	// for an owner (organization or user) that match the specified prefix.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - prefix: Repository name prefix to filter by (empty string matches all)
+	//   - isUser: true if owner is a user, false if it's an organization
+	//   - codeownersContent: Content of the CODEOWNERS file
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully updated
+	//   - []string: Slice of repository names that failed to update
+	//   - error: Any error encountered during repository discovery
+	AddCodeownersToReposWithPrefix(ctx context.Context, owner, prefix string, isUser bool,
+		codeownersContent string) ([]string, []string, error)
+
+	// AddCodeownersToRepos adds or updates the .github/CODEOWNERS file in the given repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - codeownersContent: Content of the CODEOWNERS file
+	//   - onResult: Optional callback invoked as soon as each repository completes (may be nil)
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully updated
+	//   - []string: Slice of repository names that failed to update
+	AddCodeownersToRepos(ctx context.Context, owner string, repoNames []string, codeownersContent string,
+		onResult RepoResultFunc) ([]string, []string)
+
+	// GetRepository retrieves a single repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - *github.Repository: The repository
+	//   - error: Any error encountered during the API call
+	GetRepository(ctx context.Context, owner, repoName string) (*github.Repository, error)
+
+	// SetRepositoryArchived archives or unarchives a single repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - archived: true to archive the repository, false to unarchive it
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	SetRepositoryArchived(ctx context.Context, owner, repoName string, archived bool) error
+
+	// SetArchivedForRepos archives or unarchives all the given repositories concurrently.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - archived: true to archive the repositories, false to unarchive them
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully updated
+	//   - []string: Slice of repository names that failed to update
+	SetArchivedForRepos(ctx context.Context, owner string, repoNames []string, archived bool) ([]string, []string)
+
+	// DeleteRepository permanently deletes a single repository. This cannot be undone.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	DeleteRepository(ctx context.Context, owner, repoName string) error
+
+	// DeleteRepos permanently deletes all the given repositories concurrently.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to delete
+	//   - onResult: Optional callback invoked as soon as each deletion completes (may be nil)
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully deleted
+	//   - []string: Slice of repository names that failed to delete
+	DeleteRepos(ctx context.Context, owner string, repoNames []string, onResult RepoResultFunc) ([]string, []string)
+
+	// SetRepositoryVisibility changes the visibility of a single repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - visibility: One of "public", "private" or "internal"
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	SetRepositoryVisibility(ctx context.Context, owner, repoName, visibility string) error
+
+	// SetVisibilityForRepos changes the visibility of all the given repositories concurrently.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - visibility: One of "public", "private" or "internal"
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully updated
+	//   - []string: Slice of repository names that failed to update
+	SetVisibilityForRepos(ctx context.Context, owner string, repoNames []string, visibility string) ([]string, []string)
+
+	// GetTeamIDsBySlug resolves team slugs within an organization to their numeric IDs.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization the teams belong to
+	//   - slugs: Team slugs to resolve
+	//
+	// Returns:
+	//   - []int64: Team IDs in the same order as the slugs
+	//   - error: Any error encountered while resolving a team
+	GetTeamIDsBySlug(ctx context.Context, org string, slugs []string) ([]int64, error)
+
+	// TransferRepository transfers a single repository to another organization or user.
+	// A transfer that GitHub accepts for background processing is treated as a success.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: Current GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - newOwner: Organization or username to transfer the repository to
+	//   - teamIDs: IDs of teams in the new organization to grant access (may be empty)
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	TransferRepository(ctx context.Context, owner, repoName, newOwner string, teamIDs []int64) error
+
+	// TransferRepos transfers all the given repositories concurrently to another organization or user.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: Current GitHub organization or username
+	//   - repoNames: Names of the repositories to transfer
+	//   - newOwner: Organization or username to transfer the repositories to
+	//   - teamIDs: IDs of teams in the new organization to grant access (may be empty)
+	//   - onResult: Optional callback invoked as soon as each transfer completes (may be nil)
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully transferred
+	//   - []string: Slice of repository names that failed to transfer
+	TransferRepos(ctx context.Context, owner string, repoNames []string, newOwner string, teamIDs []int64,
+		onResult RepoResultFunc) ([]string, []string)
+
+	// RenameRepository renames a single repository. GitHub redirects the old name to the new one.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - oldName: Current name of the repository
+	//   - newName: New name of the repository
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	RenameRepository(ctx context.Context, owner, oldName, newName string) error
+
+	// RenameRepos applies all the given renames concurrently.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - renames: Renames to apply, usually produced by PlanRenames
+	//   - onResult: Optional callback invoked with the old name as soon as each rename completes (may be nil)
+	//
+	// Returns:
+	//   - []string: Slice of old repository names that were successfully renamed
+	//   - []string: Slice of old repository names that failed to rename
+	RenameRepos(ctx context.Context, owner string, renames []RepoRename, onResult RepoResultFunc) ([]string, []string)
+
+	// CreateRepositoryFromTemplate creates a repository from a template repository and applies the requested
+	// visibility, topics and team permission in the same pass.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username that will own the new repository
+	//   - templateOwner: Owner of the template repository
+	//   - templateRepo: Name of the template repository
+	//   - request: Description of the repository to create
+	//
+	// Returns:
+	//   - error: Any error encountered during creation or while applying settings
+	CreateRepositoryFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string, request RepoCreateRequest) error
+
+	// CreateReposFromTemplate creates all the requested repositories concurrently from a template repository.
+	// Individual repository failures are logged and reported rather than aborting the batch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username that will own the new repositories
+	//   - templateOwner: Owner of the template repository
+	//   - templateRepo: Name of the template repository
+	//   - requests: Descriptions of the repositories to create
+	//
+	// Returns:
+	//   - []string: Slice of repository names that were successfully created
+	//   - []string: Slice of repository names that failed to be created or configured
+	CreateReposFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string,
+		requests []RepoCreateRequest) ([]string, []string)
+
+	// GetFileContent retrieves the content of a file on the default branch of a repository.
+	// A missing file is not an error; found is false in that case.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - filePath: Path to the file within the repository
+	//
+	// Returns:
+	//   - string: Decoded content of the file
+	//   - string: Blob SHA of the file, needed to update it
+	//   - bool: Whether the file exists
+	//   - error: Any error encountered during the API call
+	GetFileContent(ctx context.Context, owner, repoName, filePath string) (string, string, bool, error)
+
+	// ApplyFileToRepos writes a file to all the given repositories concurrently, either by committing
+	// directly to the default branch or by opening a pull request. Repositories whose file already has the
+	// rendered content are reported as unchanged and no commit is made.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to write the file to
+	//   - rollout: Description of the file and how to apply it
+	//
+	// Returns:
+	//   - []FileRolloutResult: Per-repository results in the same order as repoNames
+	ApplyFileToRepos(ctx context.Context, owner string, repoNames []string, rollout FileRollout) []FileRolloutResult
+
+	// GetLicenseTemplate retrieves the official text of a license, with its year/holder placeholders intact.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - spdxID: SPDX identifier of the license (e.g., "Apache-2.0")
+	//
+	// Returns:
+	//   - string: License template text
+	//   - error: Any error encountered during the API call
+	GetLicenseTemplate(ctx context.Context, spdxID string) (string, error)
+
+	// DetectDependabotEcosystems detects the Dependabot package ecosystems of a repository by checking
+	// for go.mod, Dockerfile and package.json in its root directory.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - []string: Detected ecosystems (e.g., "gomod", "docker", "npm"), empty if none were found
+	//   - error: Any error encountered during the API calls
+	DetectDependabotEcosystems(ctx context.Context, owner, repoName string) ([]string, error)
+
+	// ListIssues retrieves all issues of a repository matching the query, least recently updated first.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - query: Criteria the issues must match
+	//
+	// Returns:
+	//   - []*github.Issue: Matching issues
+	//   - error: Any error encountered during the API calls
+	ListIssues(ctx context.Context, owner, repoName string, query IssueQuery) ([]*github.Issue, error)
+
+	// CreateIssue opens an issue in a repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - issue: Title, body, labels and assignees of the issue
+	//
+	// Returns:
+	//   - *github.Issue: The created issue
+	//   - error: Any error encountered during the API call
+	CreateIssue(ctx context.Context, owner, repoName string, issue NewIssue) (*github.Issue, error)
+
+	// CreateIssues opens an issue in every given repository concurrently. The issue is rendered per
+	// repository so templates can refer to the repository name.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to open the issue in
+	//   - render: Function producing the issue for a repository
+	//   - onResult: Optional callback invoked as soon as the issue of each repository is created or fails (may be nil)
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results holding the created issue, in the same order as repoNames
+	CreateIssues(ctx context.Context, owner string, repoNames []string, render func(repoName string) (NewIssue, error),
+		onResult RepoResultFunc) []RepoIssueResults
+
+	// CommentOnIssue posts a comment on an issue or pull request.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue or pull request number
+	//   - body: Markdown body of the comment
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	CommentOnIssue(ctx context.Context, owner, repoName string, number int, body string) error
+
+	// AddLabelsToIssue adds labels to an issue or pull request, creating missing labels in the repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue or pull request number
+	//   - labels: Labels to add
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	AddLabelsToIssue(ctx context.Context, owner, repoName string, number int, labels []string) error
+
+	// RemoveLabelFromIssue removes a label from an issue or pull request.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue or pull request number
+	//   - label: Label to remove
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	RemoveLabelFromIssue(ctx context.Context, owner, repoName string, number int, label string) error
+
+	// CloseIssue closes an issue.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue number
+	//   - reason: State reason, "completed" or "not_planned" (empty leaves it to GitHub)
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	CloseIssue(ctx context.Context, owner, repoName string, number int, reason string) error
+
+	// LockIssue locks the conversation of an issue or pull request.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue or pull request number
+	//   - reason: Lock reason, one of IssueLockReasons (empty locks without a reason)
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	LockIssue(ctx context.Context, owner, repoName string, number int, reason string) error
+
+	// LockIssues locks the conversations of the issues closed before a cutoff in all the given repositories
+	// concurrently. Issues that are already locked or carry exempt labels are skipped.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Cutoff, lock reason, exemptions and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	LockIssues(ctx context.Context, owner string, repoNames []string, opts IssueLockOptions) []RepoIssueResults
+
+	// SetIssueMilestone sets the milestone of an issue or pull request.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - number: Issue or pull request number
+	//   - milestone: Number of the milestone within the repository
+	//
+	// Returns:
+	//   - error: Any error encountered during the API call
+	SetIssueMilestone(ctx context.Context, owner, repoName string, number, milestone int) error
+
+	// AssignMilestone sets a milestone, given by title, on the issues matching a query in all the given
+	// repositories concurrently. The milestone is created in repositories that do not have it yet.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Issue query, milestone title and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	AssignMilestone(ctx context.Context, owner string, repoNames []string, opts IssueMilestoneOptions) []RepoIssueResults
+
+	// CloseStaleIssues closes the open issues without activity since a cutoff in all the given repositories
+	// concurrently, optionally labeling and commenting on them first. Issues with exempt labels are left open.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Cutoff, comment, label, exemptions and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	CloseStaleIssues(ctx context.Context, owner string, repoNames []string, opts StaleIssueOptions) []RepoIssueResults
+
+	// LabelIssues applies and removes labels on the issues matching a query in all the given repositories
+	// concurrently. Only the label changes an issue actually needs are sent.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Issue query, labels to add and remove, and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	LabelIssues(ctx context.Context, owner string, repoNames []string, opts IssueLabelOptions) []RepoIssueResults
+
+	// AssignIssues adds and removes assignees on the issues matching a query in all the given repositories
+	// concurrently. Only the assignee changes an issue actually needs are sent.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Issue query, logins to assign and unassign, and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	AssignIssues(ctx context.Context, owner string, repoNames []string, opts IssueAssigneeOptions) []RepoIssueResults
+
+	// CommentOnIssues posts a rendered comment on the issues or pull requests matching a query in all the given
+	// repositories concurrently, pacing the comments to avoid secondary rate limits.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Issue query, comment renderer, pacing interval and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	CommentOnIssues(ctx context.Context, owner string, repoNames []string, opts IssueCommentOptions) []RepoIssueResults
+
+	// ListPullRequests retrieves all open pull requests of a repository matching the query.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - query: Criteria the pull requests must match
+	//
+	// Returns:
+	//   - []*github.PullRequest: Matching pull requests
+	//   - error: Any error encountered during the API calls
+	ListPullRequests(ctx context.Context, owner, repoName string, query PullRequestQuery) ([]*github.PullRequest, error)
+
+	// MergePullRequests merges the open pull requests matching a query in all the given repositories
+	// concurrently. Pull requests that fail the mergeability or checks preconditions are skipped.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Pull request query, merge method, preconditions and dry-run setting
+	//
+	// Returns:
+	//   - []RepoPullRequestResults: Per-repository results in the same order as repoNames
+	MergePullRequests(ctx context.Context, owner string, repoNames []string, opts MergePullRequestsOptions) []RepoPullRequestResults
+
+	// ApprovePullRequests submits an approving review to the open pull requests matching a query in all the
+	// given repositories concurrently. Pull requests the authenticated user already approved are skipped, as
+	// are pull requests beyond the approval cap.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Pull request query, review body, approval cap and dry-run setting
+	//
+	// Returns:
+	//   - []RepoPullRequestResults: Per-repository results in the same order as repoNames
+	//   - error: Any error encountered while looking up the authenticated user
+	ApprovePullRequests(ctx context.Context, owner string, repoNames []string,
+		opts ApprovePullRequestsOptions) ([]RepoPullRequestResults, error)
+
+	// ClosePullRequests closes the open pull requests matching a query in all the given repositories
+	// concurrently without merging them, optionally commenting first and deleting their head branches.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Pull request query, comment renderer, branch deletion and dry-run setting
+	//
+	// Returns:
+	//   - []RepoPullRequestResults: Per-repository results in the same order as repoNames
+	ClosePullRequests(ctx context.Context, owner string, repoNames []string, opts ClosePullRequestsOptions) []RepoPullRequestResults
+
+	// AuditBranchProtection inspects the protection of a repository's default branch: whether it is
+	// protected at all, requires approving reviews and status checks, and applies to administrators.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repository: The repository, whose default branch is audited
+	//
+	// Returns:
+	//   - BranchProtectionAudit: The audit result, with Err set if the protection could not be read
+	AuditBranchProtection(ctx context.Context, owner string, repository *github.Repository) BranchProtectionAudit
+
+	// AuditBranchProtectionForRepos audits the default branch protection of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to audit
+	//
+	// Returns:
+	//   - []BranchProtectionAudit: Per-repository audit results in the same order as repos
+	AuditBranchProtectionForRepos(ctx context.Context, owner string, repos []*github.Repository) []BranchProtectionAudit
+
+	// AuditCodeowners checks that a repository has a CODEOWNERS file and validates it through GitHub's
+	// CODEOWNERS errors API, which reports syntax errors as well as users and teams that do not exist
+	// or lack write access.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - CodeownersAudit: The audit result, with Err set if the file could not be checked
+	AuditCodeowners(ctx context.Context, owner, repoName string) CodeownersAudit
+
+	// AuditCodeownersForRepos audits the CODEOWNERS files of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to audit
+	//
+	// Returns:
+	//   - []CodeownersAudit: Per-repository audit results in the same order as repoNames
+	AuditCodeownersForRepos(ctx context.Context, owner string, repoNames []string) []CodeownersAudit
+
+	// ListCodeScanningAlerts retrieves all open code scanning alerts of a repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - []*github.Alert: Open alerts
+	//   - error: ErrCodeScanningNotEnabled if the repository has no analyses, or any other API error
+	ListCodeScanningAlerts(ctx context.Context, owner, repoName string) ([]*github.Alert, error)
+
+	// GetCodeScanningReports summarizes the open code scanning alerts per rule for all the given
+	// repositories concurrently. Repositories without code scanning are reported as not enabled.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to report on
+	//   - filter: Severities and tools to include
+	//
+	// Returns:
+	//   - []CodeScanningReport: Per-repository reports in the same order as repoNames
+	GetCodeScanningReports(ctx context.Context, owner string, repoNames []string, filter CodeScanningFilter) []CodeScanningReport
+
+	// GetTrafficReport collects the view and clone totals of a repository over the last 14 days.
+	// Traffic data requires push access to the repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - *TrafficReport: Views, unique visitors, clones and unique cloners
+	//   - error: Any error encountered during the API calls
+	GetTrafficReport(ctx context.Context, owner, repoName string) (*TrafficReport, error)
+
+	// GetTrafficReports collects the 14-day traffic totals of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to report on
+	//
+	// Returns:
+	//   - []TrafficReport: Per-repository reports in the same order as repoNames, with Err set on failure
+	GetTrafficReports(ctx context.Context, owner string, repoNames []string) []TrafficReport
+
+	// ListReleases retrieves all releases of a repository, newest first, including drafts and pre-releases.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - []*github.RepositoryRelease: All releases of the repository
+	//   - error: Any error encountered during the API calls
+	ListReleases(ctx context.Context, owner, repoName string) ([]*github.RepositoryRelease, error)
+
+	// GetLatestRelease retrieves the latest published full release of a repository. Drafts and
+	// pre-releases are not considered.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - *github.RepositoryRelease: The latest release, or nil if the repository has none
+	//   - error: Any error encountered during the API call
+	GetLatestRelease(ctx context.Context, owner, repoName string) (*github.RepositoryRelease, error)
+
+	// CreateRelease tags the head of the default branch of a repository and creates a release for the tag.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - release: Tag, name, notes and flags of the release
+	//
+	// Returns:
+	//   - ReleaseResult: The tag, the commit it points to and the URL of the release
+	//   - error: Any error encountered, including a tag that already exists
+	CreateRelease(ctx context.Context, owner, repoName string, release NewRelease) (ReleaseResult, error)
+
+	// CreateReleases creates a rendered release at the default branch head of all the given repositories
+	// concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to release
+	//   - render: Produces the release of a repository
+	//
+	// Returns:
+	//   - []ReleaseResult: Per-repository results in the same order as repoNames, with Err set on failure
+	CreateReleases(ctx context.Context, owner string, repoNames []string, render func(repoName string) (NewRelease, error)) []ReleaseResult
+
+	// UploadReleaseAsset uploads a local file to a release of a repository and verifies the SHA-256 checksum
+	// of the stored asset. An asset that does not match is deleted again.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - upload: Local file, asset name, release tag and replace setting
+	//
+	// Returns:
+	//   - ReleaseAssetResult: The release, asset name, size, checksum and download URL
+	//   - error: Any error encountered, including a missing release, an existing asset or a checksum mismatch
+	UploadReleaseAsset(ctx context.Context, owner, repoName string, upload ReleaseAssetUpload) (ReleaseAssetResult, error)
+
+	// UploadReleaseAssets uploads a rendered asset to a release of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - render: Produces the upload of a repository
+	//
+	// Returns:
+	//   - []ReleaseAssetResult: Per-repository results in the same order as repoNames, with Err set on failure
+	UploadReleaseAssets(ctx context.Context, owner string, repoNames []string, render func(repoName string) (ReleaseAssetUpload, error)) []ReleaseAssetResult
+
+	// PruneReleases deletes draft releases and old published releases, optionally with their tags, in all the
+	// given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Drafts, retention by count and age, tag deletion and dry-run setting
+	//
+	// Returns:
+	//   - []RepoReleasePruneResult: Per-repository results in the same order as repoNames
+	PruneReleases(ctx context.Context, owner string, repoNames []string, opts ReleasePruneOptions) []RepoReleasePruneResult
+
+	// GetReleaseReports reports the latest release of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to report on
+	//
+	// Returns:
+	//   - []ReleaseReport: Per-repository reports in the same order as repoNames, with Err set on failure
+	GetReleaseReports(ctx context.Context, owner string, repoNames []string) []ReleaseReport
+
+	// AuditLicense detects the license of a repository through GitHub's repository license API and reads
+	// the SPDX-License-Identifier tag of the license file, if it has one.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - LicenseAudit: The detected license, with an empty Path if the repository has none and Err set if it could not be checked
+	AuditLicense(ctx context.Context, owner, repoName string) LicenseAudit
+
+	// AuditLicensesForRepos detects the licenses of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to audit
+	//
+	// Returns:
+	//   - []LicenseAudit: Per-repository audit results in the same order as repoNames
+	AuditLicensesForRepos(ctx context.Context, owner string, repoNames []string) []LicenseAudit
+
+	// GetGoModule fetches and parses the go.mod file at the root of a repository's default branch.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - *gomod.Module: The parsed module with its requirements, or nil if the repository has no go.mod
+	//   - error: Any error encountered while fetching or parsing the file
+	GetGoModule(ctx context.Context, owner, repoName string) (*gomod.Module, error)
+
+	// GetGoModReports fetches and parses the go.mod files of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to inspect
+	//
+	// Returns:
+	//   - []GoModReport: Per-repository modules in the same order as repoNames
+	GetGoModReports(ctx context.Context, owner string, repoNames []string) []GoModReport
+
+	// GetWorkflowRunStats summarizes the most recent completed runs of a workflow in a repository:
+	// success and failure counts, average duration and the conclusion of the latest run.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - query: Workflow file name, optional branch and number of runs to consider
+	//
+	// Returns:
+	//   - *WorkflowRunStats: The run statistics, with Found false if the repository does not have the workflow
+	//   - error: Any error encountered while listing the runs
+	GetWorkflowRunStats(ctx context.Context, owner, repoName string, query WorkflowRunQuery) (*WorkflowRunStats, error)
+
+	// GetWorkflowRunStatsForRepos summarizes the recent runs of a workflow in all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to inspect
+	//   - query: Workflow file name, optional branch and number of runs to consider
+	//
+	// Returns:
+	//   - []WorkflowRunStats: Per-repository statistics in the same order as repoNames, with Err set on failure
+	GetWorkflowRunStatsForRepos(ctx context.Context, owner string, repoNames []string, query WorkflowRunQuery) []WorkflowRunStats
+
+	// GetSizeReport collects the disk size, branch and tag counts of a repository and whether its
+	// .gitattributes routes files through Git LFS.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repository: The repository, whose listed size is used
+	//
+	// Returns:
+	//   - *SizeReport: The size report
+	//   - error: Any error encountered while counting references or reading .gitattributes
+	GetSizeReport(ctx context.Context, owner string, repository *github.Repository) (*SizeReport, error)
+
+	// GetSizeReports collects the size reports of all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to inspect
+	//
+	// Returns:
+	//   - []SizeReport: Per-repository reports in the same order as repos, with Err set on failure
+	GetSizeReports(ctx context.Context, owner string, repos []*github.Repository) []SizeReport
+
+	// ListOpenPullRequests lists the open pull requests matching a query in all the given repositories
+	// concurrently, with their author, creation time and review state.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to inspect
+	//   - query: Author, labels, base branch and title filters
+	//
+	// Returns:
+	//   - []RepoOpenPullRequests: Per-repository pull requests in the same order as repoNames
+	ListOpenPullRequests(ctx context.Context, owner string, repoNames []string, query PullRequestQuery) []RepoOpenPullRequests
+
+	// AuditSecurityPolicies looks up the SECURITY.md file of all the given repositories concurrently,
+	// in the repository root, .github or docs directory.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to audit
+	//
+	// Returns:
+	//   - []SecurityPolicyAudit: Per-repository results in the same order as repoNames, with an empty Path if missing
+	AuditSecurityPolicies(ctx context.Context, owner string, repoNames []string) []SecurityPolicyAudit
+
+	// AuditHealth runs health checks against a repository: README, description, topics, license,
+	// contributing guidelines and issue templates.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repository: The repository, whose description, topics and license are used as listed
+	//   - checks: HealthCheck constants to run
+	//
+	// Returns:
+	//   - HealthAudit: Whether each check passed, with Err set if a check could not be run
+	AuditHealth(ctx context.Context, owner string, repository *github.Repository, checks []string) HealthAudit
+
+	// AuditHealthForRepos runs health checks against all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to audit
+	//   - checks: HealthCheck constants to run
+	//
+	// Returns:
+	//   - []HealthAudit: Per-repository results in the same order as repos
+	AuditHealthForRepos(ctx context.Context, owner string, repos []*github.Repository, checks []string) []HealthAudit
+
+	// GetActionsPermissions reads the GitHub Actions settings of a repository: whether Actions is enabled,
+	// which actions are allowed, the default GITHUB_TOKEN permissions and the fork pull request approval policy.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - *ActionsPermissions: The current settings
+	//   - error: Any error encountered while reading the settings
+	GetActionsPermissions(ctx context.Context, owner, repoName string) (*ActionsPermissions, error)
+
+	// EnforceActionsPermissions compares the GitHub Actions settings of all the given repositories with a
+	// policy concurrently and, when apply is true, updates the settings that drifted from it.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//   - policy: Desired settings; empty fields are not enforced
+	//   - apply: Update drifted settings instead of only reporting them
+	//
+	// Returns:
+	//   - []ActionsPermissionsResult: Per-repository results in the same order as repoNames
+	EnforceActionsPermissions(ctx context.Context, owner string, repoNames []string, policy ActionsPermissionsPolicy,
+		apply bool) []ActionsPermissionsResult
+
+	// DiscoverRepositories finds the repositories of an owner (organization or user) matching a query. The
+	// list backend pages through all repositories; the search backend uses the repository Search API with
+	// name, topic, language and archived qualifiers, which is much faster for large organizations, and falls
+	// back to listing when the Search API cannot return every match or is rate limited.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - isUser: true if owner is a user, false if it's an organization
+	//   - query: Discovery backend and repository filters
+	//
+	// Returns:
+	//   - []*github.Repository: Repositories matching the query
+	//   - error: Any error encountered during the API calls
+	DiscoverRepositories(ctx context.Context, owner string, isUser bool, query DiscoveryQuery) ([]*github.Repository, error)
+
+	// GetIssueStatsGraphQL counts the open and closed issues of repositories with GraphQL totalCount
+	// aggregates, one query per 50 repositories, instead of listing every issue. Filters GraphQL cannot
+	// express (creation date range, unassigned issues) are counted with GetIssueStatsForRepo instead.
+	//
+	// Repositories that cannot be counted are logged and left out rather than failing the operation.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to count
+	//   - filter: Criteria narrowing the counted issues (zero value counts all issues)
+	//
+	// Returns:
+	//   - []*IssueStats: Issue statistics in the same order as repoNames
+	//   - error: Any error failing a whole GraphQL query
+	GetIssueStatsGraphQL(ctx context.Context, owner string, repoNames []string,
+		filter IssueStatsFilter) ([]*IssueStats, error)
+
+	// StreamIssueStats retrieves issue statistics for all repositories of an owner (organization or user)
+	// matching a prefix, like GetIssueStatsForReposWithPrefix, but sends each repository's statistics on
+	// the returned channel as soon as they are counted instead of waiting for the whole batch.
+	//
+	// The channel delivers results in completion order and is closed once every repository has been
+	// processed. Callers must drain it; cancelling ctx makes the remaining repositories fail fast.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - prefix: Repository name prefix to filter by (empty string matches all)
+	//   - isUser: true if owner is a user, false if it's an organization
+	//   - filter: Criteria narrowing the counted issues (zero value counts all issues)
+	//
+	// Returns:
+	//   - <-chan RepoResult[*IssueStats]: Per-repository statistics or errors as they complete
+	//   - int: Number of results the channel will deliver
+	//   - error: Any error encountered during repository discovery
+	StreamIssueStats(ctx context.Context, owner, prefix string, isUser bool,
+		filter IssueStatsFilter) (<-chan RepoResult[*IssueStats], int, error)
+
+	// RestoreFiles reverts files to their state before changes recorded in the audit log, concurrently
+	// across repositories. Files created by the change are deleted; other files get their previous content
+	// back from the recorded blob. Files changed again since the recorded change are reported as conflicts
+	// unless the restore is forced.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - restores: Files to restore per repository
+	//   - opts: Commit message, force and optional pull request settings
+	//
+	// Returns:
+	//   - []RepoRestoreResult: Per-repository results in the same order as restores
+	RestoreFiles(ctx context.Context, owner string, restores []RepoRestore, opts RestoreOptions) []RepoRestoreResult
+
+	// ListOrganizations lists the organizations the authenticated user is a member of.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//
+	// Returns:
+	//   - []string: Logins of the organizations
+	//   - error: Any error encountered while listing the organizations
+	ListOrganizations(ctx context.Context) ([]string, error)
+
+	// GetTokenInfo validates the token and describes it: the user it belongs to, its OAuth scopes and the
+	// remaining core, search and GraphQL rate limits.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//
+	// Returns:
+	//   - *TokenInfo: Login, scopes and rate limits of the token
+	//   - error: Any error encountered, e.g. when the token is rejected
+	GetTokenInfo(ctx context.Context) (*TokenInfo, error)
+
+	// MoveFileInRepos moves a file to a new path on the default branch of all the given repositories
+	// concurrently, with a single commit per repository through the Git trees API. Repositories without
+	// the file, or where the new path is already taken, are left untouched and reported.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to move the file in
+	//   - move: Old and new path of the file and the commit message
+	//
+	// Returns:
+	//   - []FileMoveResult: Per-repository results in the same order as repoNames
+	MoveFileInRepos(ctx context.Context, owner string, repoNames []string, move FileMove) []FileMoveResult
+
+	// CheckPolicy checks a repository against the rules of a policy: required files, file content
+	// patterns, topics and settings.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repository: The repository to check
+	//   - policy: The rules to check
+	//
+	// Returns:
+	//   - PolicyResult: Whether the repository passed each rule, with Err set if a rule could not be checked
+	CheckPolicy(ctx context.Context, owner string, repository *github.Repository, policy Policy) PolicyResult
+
+	// CheckPolicyForRepos checks all the given repositories against a policy concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to check
+	//   - policy: The rules to check
+	//
+	// Returns:
+	//   - []PolicyResult: Per-repository results in the same order as repos
+	CheckPolicyForRepos(ctx context.Context, owner string, repos []*github.Repository, policy Policy) []PolicyResult
+
+	// GetManagedFiles reads the managed files of a source-of-truth repository from its default branch.
+	// Paths naming a directory expand to every file below it.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: Owner of the source repository
+	//   - repoName: Name of the source repository
+	//   - paths: Managed file and directory paths
+	//
+	// Returns:
+	//   - []ManagedFile: The files with their content, sorted by path
+	//   - error: Any error that occurred, including a path missing from the source repository
+	GetManagedFiles(ctx context.Context, owner, repoName string, paths []string) ([]ManagedFile, error)
+
+	// DetectDrift compares managed files with their copies in all the given repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the downstream repositories
+	//   - files: The managed files, as returned by GetManagedFiles
+	//
+	// Returns:
+	//   - []RepoDrift: Per-repository drift in the same order as repoNames
+	DetectDrift(ctx context.Context, owner string, repoNames []string, files []ManagedFile) []RepoDrift
+
+	// ApplyFileSetToRepos writes a set of files to all the given repositories concurrently with a single
+	// commit per repository, either on the default branch or on a pull request branch. Only files whose
+	// content differs are part of the commit, and repositories where none differ are left untouched.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to write the files to
+	//   - rollout: Files, commit message and optional pull request settings
+	//
+	// Returns:
+	//   - []FileRolloutResult: Per-repository results in the same order as repoNames
+	ApplyFileSetToRepos(ctx context.Context, owner string, repoNames []string, rollout FileSetRollout) []FileRolloutResult
+
+	// ListGitignoreTemplates lists the names of the community .gitignore templates.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//
+	// Returns:
+	//   - []string: Template names (e.g., "Go", "Node")
+	//   - error: Any error encountered during the API call
+	ListGitignoreTemplates(ctx context.Context) ([]string, error)
+
+	// GetGitignoreTemplate retrieves the content of a community .gitignore template.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - name: Template name as listed by ListGitignoreTemplates (e.g., "Go")
+	//
+	// Returns:
+	//   - string: Template content
+	//   - error: Any error encountered during the API call
+	GetGitignoreTemplate(ctx context.Context, name string) (string, error)
+
+	// ListRulesets retrieves the rulesets defined on a repository, with their rules and conditions.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//
+	// Returns:
+	//   - []Ruleset: Rulesets of the repository, excluding those inherited from the organization
+	//   - error: Any error encountered during the API calls
+	ListRulesets(ctx context.Context, owner, repoName string) ([]Ruleset, error)
+
+	// ApplyRulesets creates or updates rulesets, matched by name, across multiple repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to apply the rulesets to
+	//   - rulesets: Desired ruleset definitions
+	//   - dryRun: Only compare the definitions without changing anything
+	//
+	// Returns:
+	//   - []RulesetResult: Per-repository changes in the same order as repoNames
+	ApplyRulesets(ctx context.Context, owner string, repoNames []string, rulesets []Ruleset, dryRun bool) []RulesetResult
+
+	// AddAutolink adds an autolink reference to a repository, replacing one with the same key prefix.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - autolink: Key prefix and URL template of the autolink
+	//
+	// Returns:
+	//   - string: AutolinkStatusAdded, AutolinkStatusUpdated or AutolinkStatusUnchanged
+	//   - error: Any error encountered during the API calls
+	AddAutolink(ctx context.Context, owner, repoName string, autolink Autolink) (string, error)
+
+	// RemoveAutolink removes the autolink reference with the given key prefix from a repository.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - keyPrefix: Key prefix of the autolink (e.g., "JIRA-")
+	//
+	// Returns:
+	//   - string: AutolinkStatusRemoved or AutolinkStatusNotFound
+	//   - error: Any error encountered during the API calls
+	RemoveAutolink(ctx context.Context, owner, repoName, keyPrefix string) (string, error)
+
+	// AddAutolinkToRepos adds an autolink reference to multiple repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to add the autolink to
+	//   - autolink: Key prefix and URL template of the autolink
+	//
+	// Returns:
+	//   - []AutolinkResult: Per-repository results in the same order as repoNames
+	AddAutolinkToRepos(ctx context.Context, owner string, repoNames []string, autolink Autolink) []AutolinkResult
+
+	// RemoveAutolinkFromRepos removes an autolink reference from multiple repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to remove the autolink from
+	//   - keyPrefix: Key prefix of the autolink (e.g., "JIRA-")
+	//
+	// Returns:
+	//   - []AutolinkResult: Per-repository results in the same order as repoNames
+	RemoveAutolinkFromRepos(ctx context.Context, owner string, repoNames []string, keyPrefix string) []AutolinkResult
+
+	// GetCustomPropertySchema retrieves the custom properties defined by an organization.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//
+	// Returns:
+	//   - []*github.CustomProperty: Property definitions with their value types and allowed values
+	//   - error: Any error encountered during the API call
+	GetCustomPropertySchema(ctx context.Context, org string) ([]*github.CustomProperty, error)
+
+	// ListCustomPropertyValues retrieves the custom property values of every repository of an organization.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//
+	// Returns:
+	//   - map[string]map[string]string: Values by repository name and property name
+	//   - error: Any error encountered during the API calls
+	ListCustomPropertyValues(ctx context.Context, org string) (map[string]map[string]string, error)
+
+	// SetCustomPropertyValues assigns custom property values to multiple repositories in bulk.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//   - repoNames: Names of the repositories to update
+	//   - values: Values by property name; an empty value unsets the property
+	//
+	// Returns:
+	//   - []string: Names of repositories that were updated
+	//   - []string: Names of repositories that failed
+	SetCustomPropertyValues(ctx context.Context, org string, repoNames []string, values map[string]string) ([]string, []string)
+
+	// ResolveEnvironmentReviewers looks up the IDs of the team and user reviewers of deployment environments.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: Organization whose teams are looked up
+	//   - specs: Environments whose reviewer IDs are filled in place
+	//
+	// Returns:
+	//   - error: Any error encountered looking up a reviewer
+	ResolveEnvironmentReviewers(ctx context.Context, owner string, specs []EnvironmentSpec) error
+
+	// ApplyEnvironments creates or updates deployment environments with their protection rules in multiple
+	// repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - specs: Desired environments with resolved reviewers
+	//
+	// Returns:
+	//   - []EnvironmentResult: Per-repository results in the same order as repoNames
+	ApplyEnvironments(ctx context.Context, owner string, repoNames []string, specs []EnvironmentSpec) []EnvironmentResult
+
+	// SetSecretInRepos creates or rotates an Actions secret in multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - environment: Environment to scope the secret to, created when missing; empty for a repository secret
+	//   - name: Name of the secret
+	//   - value: Plain text value, encrypted with the repository or environment public key
+	//
+	// Returns:
+	//   - []SecretResult: Per-repository results in the same order as repoNames
+	SetSecretInRepos(ctx context.Context, owner string, repoNames []string, environment, name, value string) []SecretResult
+
+	// GetRunnerGroup finds a self-hosted runner group of an organization by name.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//   - name: Name of the runner group
+	//
+	// Returns:
+	//   - *github.RunnerGroup: The runner group with its ID and visibility
+	//   - error: Any error encountered, including a group that does not exist
+	GetRunnerGroup(ctx context.Context, org, name string) (*github.RunnerGroup, error)
+
+	// ListRunnerGroupRepos retrieves the repositories that have access to a runner group.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//   - groupID: ID of the runner group
+	//
+	// Returns:
+	//   - map[string]bool: Names of the repositories with access
+	//   - error: Any error encountered during the API calls
+	ListRunnerGroupRepos(ctx context.Context, org string, groupID int64) (map[string]bool, error)
+
+	// AssignReposToRunnerGroup gives multiple repositories access to a runner group.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//   - groupID: ID of the runner group, which must have selected visibility
+	//   - repos: Repositories to assign
+	//
+	// Returns:
+	//   - []string: Names of repositories that were assigned
+	//   - []string: Names of repositories that failed
+	AssignReposToRunnerGroup(ctx context.Context, org string, groupID int64, repos []*github.Repository) ([]string, []string)
+
+	// EnableDependabotSecurityUpdates turns on vulnerability alerts and Dependabot security updates in
+	// multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//
+	// Returns:
+	//   - []SecurityFeatureResult: Per-repository results in the same order as repoNames
+	EnableDependabotSecurityUpdates(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult
+
+	// EnableSecretScanning turns on secret scanning, optionally with push protection, in multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//   - pushProtection: Whether to also turn on push protection
+	//
+	// Returns:
+	//   - []SecurityFeatureResult: Per-repository results in the same order as repoNames
+	EnableSecretScanning(ctx context.Context, owner string, repoNames []string, pushProtection bool) []SecurityFeatureResult
+
+	// EnablePrivateVulnerabilityReporting turns on private vulnerability reporting in multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//
+	// Returns:
+	//   - []SecurityFeatureResult: Per-repository results in the same order as repoNames
+	EnablePrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult
+
+	// AuditPrivateVulnerabilityReporting checks whether private vulnerability reporting is on in multiple
+	// repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//
+	// Returns:
+	//   - []SecurityFeatureAudit: Per-repository states in the same order as repoNames
+	AuditPrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureAudit
+
+	// GetPagesReports retrieves the GitHub Pages site of multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//
+	// Returns:
+	//   - []PagesReport: Per-repository sites in the same order as repoNames
+	GetPagesReports(ctx context.Context, owner string, repoNames []string) []PagesReport
+
+	// ConfigurePages enables GitHub Pages or updates its publishing source in multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to configure
+	//   - config: Desired build type and source
+	//
+	// Returns:
+	//   - []PagesResult: Per-repository results in the same order as repos
+	ConfigurePages(ctx context.Context, owner string, repos []*github.Repository, config PagesConfig) []PagesResult
+
+	// DisablePagesForRepos unpublishes the GitHub Pages site of multiple repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to update
+	//
+	// Returns:
+	//   - []PagesResult: Per-repository results in the same order as repoNames
+	DisablePagesForRepos(ctx context.Context, owner string, repoNames []string) []PagesResult
+
+	// CheckMergeQueuePrerequisites checks whether the default branch of multiple repositories requires status
+	// checks, which a merge queue needs to test pull requests before merging them.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repos: Repositories to check
+	//
+	// Returns:
+	//   - []MergeQueuePrerequisites: Per-repository results in the same order as repos
+	CheckMergeQueuePrerequisites(ctx context.Context, owner string, repos []*github.Repository) []MergeQueuePrerequisites
+
+	// GetIssueResponseTimes finds how long each issue created in a date range waited for its first response,
+	// the first comment by someone other than the author and other than a bot.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//   - filter: The creation date range of the issues; other fields are ignored
+	//
+	// Returns:
+	//   - []RepoIssueResponses: The issues and their first responses, or the error, for each repository
+	GetIssueResponseTimes(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoIssueResponses
+
+	// GetIssueResolutionTimes lists the issues closed in a date range with the time each stayed open.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//   - filter: The closing date range of the issues; other fields are ignored
+	//
+	// Returns:
+	//   - []RepoIssueResolutions: The closed issues, or the error, for each repository
+	GetIssueResolutionTimes(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoIssueResolutions
+
+	// GetPullRequestLatencies measures, for the pull requests opened in a date range, the time from being
+	// ready for review to the first review and to the merge. Pull requests still in draft are left out.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to check
+	//   - filter: The creation date range of the pull requests; other fields are ignored
+	//
+	// Returns:
+	//   - []RepoPullRequestLatencies: The pull request latencies, or the error, for each repository
+	GetPullRequestLatencies(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoPullRequestLatencies
+
+	// GetTeamMemberships lists the members of every team of an organization.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization
+	//
+	// Returns:
+	//   - map[string][]string: The slugs of the teams of each member login
+	//   - error: Any error encountered while listing the teams or their members
+	GetTeamMemberships(ctx context.Context, org string) (map[string][]string, error)
+
+	// SearchIssues runs an issue search query, e.g. "label:security state:open", over repositories of an
+	// owner. Only issues are searched unless the query asks for pull requests.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - isUser: true if owner is a user, false if it's an organization
+	//   - repoNames: Names of the repositories whose issues are kept
+	//   - query: The search query, without repository, organization or user qualifiers
+	//
+	// Returns:
+	//   - IssueSearch: The matching issues and whether the Search API truncated them
+	//   - error: Any error encountered during the search
+	SearchIssues(ctx context.Context, owner string, isUser bool, repoNames []string, query string) (IssueSearch, error)
+
+	// GetRepositories retrieves the full repository objects of multiple repositories concurrently, including
+	// the settings that listing leaves out.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories
+	//
+	// Returns:
+	//   - []RepoResult[*github.Repository]: The repository, or the error, for each name in the same order
+	GetRepositories(ctx context.Context, owner string, repoNames []string) []RepoResult[*github.Repository]
+
+	// StreamRepositoryBackups collects a snapshot of each repository: its metadata, issues with their
+	// comments, labels, milestones and releases. Each snapshot is sent as soon as it is complete.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to back up
+	//
+	// Returns:
+	//   - <-chan RepoResult[*RepositoryBackup]: The snapshot, or the error, of each repository as it
+	//     completes; the channel is closed once every repository is done and must be drained
+	StreamRepositoryBackups(ctx context.Context, owner string, repoNames []string) <-chan RepoResult[*RepositoryBackup]
+
+	// StreamIssueExports lists the issues of each repository as flat records for exports. The issues of
+	// each repository are sent, oldest first, as soon as they are listed.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to export the issues of
+	//   - state: The issue state to export: open, closed or all
+	//
+	// Returns:
+	//   - <-chan RepoResult[[]ExportedIssue]: The issues, or the error, of each repository as it completes;
+	//     the channel is closed once every repository is done and must be drained
+	StreamIssueExports(ctx context.Context, owner string, repoNames []string, state string) <-chan RepoResult[[]ExportedIssue]
+
+	// ImportIssues creates issues read from an import file one after another in file order, pacing them to
+	// avoid secondary rate limits.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username owning the repositories
+	//   - imports: Issues to create with the repository and the line of the import file they come from
+	//   - interval: Minimum time between two created issues, zero to disable pacing
+	//   - onResult: Optional callback invoked as soon as each issue is created or fails (may be nil)
+	//
+	// Returns:
+	//   - []IssueImportResult: Per-issue results holding the created issue number and URL, in the same order as imports
+	ImportIssues(ctx context.Context, owner string, imports []IssueImport, interval time.Duration,
+		onResult func(result IssueImportResult)) []IssueImportResult
+
+	// GetOrgProject retrieves a Projects v2 board of an organization with its fields.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - org: GitHub organization owning the project
+	//   - number: Number of the project within the organization
+	//
+	// Returns:
+	//   - *Project: The project with its fields and their single select options
+	//   - error: Any error encountered, including a project that does not exist
+	GetOrgProject(ctx context.Context, org string, number int) (*Project, error)
+
+	// AddProjectItems adds the issues or pull requests matching a query in all the given repositories
+	// concurrently to a Projects v2 board, optionally setting a field value on every added item.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to process
+	//   - opts: Project, issue query, field value and dry-run setting
+	//
+	// Returns:
+	//   - []RepoIssueResults: Per-repository results in the same order as repoNames
+	AddProjectItems(ctx context.Context, owner string, repoNames []string, opts ProjectItemOptions) []RepoIssueResults
+
+	// GetDiscussionStats counts the total, open, answered and unanswered discussions of repositories with
+	// GraphQL totalCount aggregates, one query per 50 repositories.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to count
+	//
+	// Returns:
+	//   - []*DiscussionStats: Per-repository counts in the same order as repoNames; repositories that could not
+	//     be counted carry the error
+	//   - error: Any error failing a whole query
+	GetDiscussionStats(ctx context.Context, owner string, repoNames []string) ([]*DiscussionStats, error)
+
+	// EnsureLabels creates the labels missing from a repository and updates those whose color or
+	// description differ.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - labels: Labels the repository should have
+	//
+	// Returns:
+	//   - []string: Names of the labels created or updated
+	//   - error: Error if listing, creating or updating a label fails
+	EnsureLabels(ctx context.Context, owner, repoName string, labels []RepoLabel) ([]string, error)
+
+	// ApplyNewRepoPolicy brings a newly created repository in line with a policy: CODEOWNERS file,
+	// labels and rulesets.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoName: Name of the repository
+	//   - policy: Policy to apply
+	//
+	// Returns:
+	//   - NewRepoPolicyResult: What was changed, with the first error encountered
+	ApplyNewRepoPolicy(ctx context.Context, owner, repoName string, policy NewRepoPolicy) NewRepoPolicyResult
+
+	// RunRepoOperation runs a custom operation, such as one implemented by a plugin, on multiple
+	// repositories concurrently.
+	//
+	// Parameters:
+	//   - ctx: Context for cancellation and timeout control
+	//   - owner: GitHub organization or username
+	//   - repoNames: Names of the repositories to run the operation on
+	//   - op: The operation to run on each repository
+	//
+	// Returns:
+	//   - []RepoResult[RepoOperationResult]: The outcome, or the error, for each name in the same order
+	RunRepoOperation(ctx context.Context, owner string, repoNames []string, op RepoOperation) []RepoResult[RepoOperationResult]
+}
+
+// Service is the concrete implementation of GitHubClient.
+type Service struct {
+	client         *github.Client
+	log            *slog.Logger
+	maxConcurrency int
+	audit          audit.Writer
+	signer         github.MessageSigner
+	identity       CommitIdentity
+	userOnce       sync.Once
+	user           *github.User
+	userErr        error
+}
+
+// NewGitHubService returns a service processing one repository at a time with the default logger.
+func NewGitHubService(client *github.Client) *Service {
+	return New(client, WithConcurrency(1))
+}
+
+// NewGitHubServiceWithConcurrency returns a service processing up to maxConcurrency repositories at once
+// with the default logger.
+func NewGitHubServiceWithConcurrency(client *github.Client, maxConcurrency int) *Service {
+	return New(client, WithConcurrency(maxConcurrency))
+}
+
+// NewGitHubServiceWithLogger returns a service processing up to maxConcurrency repositories at once and
+// logging to log.
+func NewGitHubServiceWithLogger(client *github.Client, maxConcurrency int, log *slog.Logger) *Service {
+	return New(client, WithConcurrency(maxConcurrency), WithLogger(log))
+}
+
+// NewGitHubClient returns a GitHub client authenticating with token, for New. Its requests are retried
+// after secondary rate limits, logged at debug level, counted in the metrics, traced and, with WithCache,
+// served from the response cache when possible. An empty token makes unauthenticated requests.
+func NewGitHubClient(token string, opts ...ClientOption) *github.Client {
+	log := logger.GetLogger()
+
+	var cfg clientConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	// Secondary rate limits are retried below the authentication layer so the retried requests keep their token.
+	// Every attempt, including retries, is counted in the metrics and logged at debug level.
+	var transport http.RoundTripper = newThrottleTransport(
+		&metricsTransport{base: &loggingTransport{base: http.DefaultTransport, log: log}}, log)
+	if cfg.cache != nil {
+		transport = &cachingTransport{base: transport, cache: cfg.cache, log: log}
+	}
+
+	httpClient := &http.Client{Transport: &tracingTransport{base: transport}}
+
+	if token != "" {
+		return github.NewClient(httpClient).WithAuthToken(token)
+	} else {
+		log.Warn("No GitHub token provided. Rate limits will be more restrictive.")
+
+		return github.NewClient(httpClient)
+	}
+}
+
+// GetIssueStatsForRepo gets issue statistics for a single repository.
+func (s *Service) GetIssueStatsForRepo(ctx context.Context, owner, repoName string,
+	filter IssueStatsFilter,
+) (*IssueStats, error) {
+	s.log.Info("Fetching issue count", "owner", owner, "repo", repoName)
+
+	// Verify repository exists
+	_, _, err := s.client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, repoName, err)
+	}
+
+	stats := &IssueStats{Owner: owner, RepoName: repoName}
+
+	// List issues (excluding pull requests)
+	opts := &github.IssueListByRepoOptions{
+		State:    "all", // Get both open and closed issues
+		Labels:   filter.Labels,
+		Assignee: filter.Assignee,
+		// An issue created since a time has been updated since then too, so the server-side
+		// "updated since" filter safely trims the listing before the creation date is checked
+		Since: filter.Since,
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	if filter.Milestone != "" {
+		opts.Milestone, err = s.resolveMilestone(ctx, owner, repoName, filter.Milestone)
+		if err != nil {
+			return nil, err
+		}
+
+		// A milestone missing from this repository means none of its issues can match
+		if opts.Milestone == "" {
+			return stats, nil
+		}
+	}
+
+	for {
+		issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, issue := range issues {
+			// Skip pull requests (issues with PullRequestLinks are PRs)
+			if issue.PullRequestLinks == nil && filter.matchesCreated(issue) {
+				stats.TotalIssues++
+				if issue.GetState() == "open" {
+					stats.OpenIssues++
+				} else {
+					stats.ClosedIssues++
+				}
+				stats.countLabels(issue)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return stats, nil
+}
+
+// matchesCreated reports whether an issue was created within the filter's date range.
+func (f IssueStatsFilter) matchesCreated(issue *github.Issue) bool {
+	created := issue.GetCreatedAt().Time
+
+	if !f.Since.IsZero() && created.Before(f.Since) {
+		return false
+	}
+
+	return f.Until.IsZero() || created.Before(f.Until)
+}
+
+// GetRepositoriesWithPrefix gets all repositories for an owner that match a prefix.
+func (s *Service) GetRepositoriesWithPrefix(ctx context.Context, owner, prefix string, isUser bool) ([]*github.Repository, error) {
+	s.log.Info("Fetching repositories with prefix", "owner", owner, "prefix", prefix, "isUser", isUser)
+
+	if isUser {
+		return s.getUserRepositoriesWithPrefix(ctx, owner, prefix)
+	}
+
+	return s.getOrgRepositoriesWithPrefix(ctx, owner, prefix)
+}
+
+func (s *Service) getUserRepositoriesWithPrefix(ctx context.Context, owner, prefix string) ([]*github.Repository, error) {
+	var matchingRepos []*github.Repository
+
+	opts := &github.RepositoryListByUserOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		repos, resp, err := s.client.Repositories.ListByUser(ctx, owner, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories for user %s: %w", owner, err)
+		}
+
+		for _, repo := range repos {
+			if strings.HasPrefix(repo.GetName(), prefix) {
+				matchingRepos = append(matchingRepos, repo)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matchingRepos, nil
+}
+
+func (s *Service) getOrgRepositoriesWithPrefix(ctx context.Context, owner, prefix string) ([]*github.Repository, error) {
+	var matchingRepos []*github.Repository
+
+	opts := &github.RepositoryListByOrgOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		repos, resp, err := s.client.Repositories.ListByOrg(ctx, owner, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories for org %s: %w", owner, err)
+		}
+
+		for _, repo := range repos {
+			if strings.HasPrefix(repo.GetName(), prefix) {
+				matchingRepos = append(matchingRepos, repo)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matchingRepos, nil
+}
+
+// GetIssueStatsForReposWithPrefix gets issue statistics for all repositories matching a prefix.
+func (s *Service) GetIssueStatsForReposWithPrefix(ctx context.Context, owner, prefix string, isUser bool,
+	filter IssueStatsFilter,
+) ([]*IssueStats, error) {
+	repos, err := s.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(repos) == 0 {
+		s.log.Info("No repositories found with prefix", "prefix", prefix)
+
+		return nil, nil
+	}
+
+	s.log.Info("Found repositories with prefix", "count", len(repos), "prefix", prefix)
+
+	results := CollectConcurrently(ctx, s.maxConcurrency, repositoryNames(repos), func(ctx context.Context, repoName string) RepoResult[*IssueStats] {
+		stats, err := s.GetIssueStatsForRepo(ctx, owner, repoName, filter)
+
+		return RepoResult[*IssueStats]{Owner: owner, RepoName: repoName, Value: stats, Err: err}
+	})
+
+	return s.collectIssueStats(results), nil
+}
+
+// StreamIssueStats gets issue statistics for all repositories matching a prefix, delivering each
+// repository's statistics as soon as they are counted.
+func (s *Service) StreamIssueStats(ctx context.Context, owner, prefix string, isUser bool,
+	filter IssueStatsFilter,
+) (<-chan RepoResult[*IssueStats], int, error) {
+	repos, err := s.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.log.Info("Found repositories with prefix", "count", len(repos), "prefix", prefix)
+
+	return StreamConcurrently(ctx, s.maxConcurrency, owner, repositoryNames(repos), func(ctx context.Context, repoName string) (*IssueStats, error) {
+		return s.GetIssueStatsForRepo(ctx, owner, repoName, filter)
+	}), len(repos), nil
+}
+
+// CreateOrUpdateFile creates or updates a file in a repository.
+func (s *Service) CreateOrUpdateFile(ctx context.Context, owner, repoName, filePath, content, commitMessage string) error {
+	s.log.Info("Creating or updating file", "owner", owner, "repo", repoName, "file", filePath)
+
+	// Get the current file to check if it exists and get its SHA
+	_, existingSHA, found, err := s.GetFileContent(ctx, owner, repoName, filePath)
+	if err != nil {
+		return fmt.Errorf("failed to check if file exists %s/%s:%s: %w", owner, repoName, filePath, err)
+	}
+
+	var sha *string
+
+	if found {
+		// File exists, get its SHA for updating
+		sha = github.String(existingSHA)
+		s.log.Info("File exists, will update existing file", "file", filePath, "sha", existingSHA)
+	} else {
+		// File doesn't exist, we'll create it (sha remains nil)
+		s.log.Info("File doesn't exist, will create new file", "file", filePath)
+	}
+
+	// Create or update the file
+	if err := s.createOrUpdateFileOnBranch(ctx, owner, repoName, filePath, content, commitMessage, "", sha); err != nil {
+		return err
+	}
+
+	s.log.Info("Successfully created/updated file", "owner", owner, "repo", repoName, "file", filePath)
+
+	return nil
+}
+
+// AddCodeownersToReposWithPrefix adds a CODEOWNERS file to all repositories matching a prefix.
+func (s *Service) AddCodeownersToReposWithPrefix(ctx context.Context, owner, prefix string,
+	isUser bool, codeownersContent string,
+) ([]string, []string, error) {
+	repos, err := s.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(repos) == 0 {
+		s.log.Info("No repositories found with prefix", "prefix", prefix)
+
+		return nil, nil, nil
+	}
+
+	s.log.Info("Found repositories with prefix", "count", len(repos), "prefix", prefix)
+
+	successRepos, failedRepos := s.AddCodeownersToRepos(ctx, owner, repositoryNames(repos), codeownersContent, nil)
+
+	return successRepos, failedRepos, nil
+}
+
+// AddCodeownersToRepos adds or updates the CODEOWNERS file in all the given repositories.
+func (s *Service) AddCodeownersToRepos(ctx context.Context, owner string, repoNames []string,
+	codeownersContent string, onResult RepoResultFunc,
+) ([]string, []string) {
+	return s.processReposConcurrently(ctx, "add-codeowners", repoNames,
+		func(ctx context.Context, repoName string) error {
+			return s.CreateOrUpdateFile(ctx, owner, repoName, ".github/CODEOWNERS", codeownersContent, "Add/Update CODEOWNERS file")
+		}, onResult)
+}
@@ -0,0 +1,1722 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/gomod"
+)
+
+// Helper function to create a test logger that discards output
+func createTestLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
+		Level: slog.LevelError, // Only show errors to keep tests quiet
+	}))
+}
+
+// Helper function to create string pointers
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestNewGitHubService(t *testing.T) {
+	client := github.NewClient(nil)
+	service := NewGitHubService(client)
+
+	assert.NotNil(t, service)
+	assert.IsType(t, &Service{}, service)
+}
+
+func TestNewGitHubServiceWithConcurrency(t *testing.T) {
+	tests := []struct {
+		name                string
+		maxConcurrency      int
+		expectedConcurrency int
+	}{
+		{"Valid concurrency", 5, 5},
+		{"Zero concurrency defaults to 10", 0, 10},
+		{"Negative concurrency defaults to 10", -1, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := github.NewClient(nil)
+			service := NewGitHubServiceWithConcurrency(client, tt.maxConcurrency)
+
+			assert.Equal(t, tt.expectedConcurrency, service.maxConcurrency)
+		})
+	}
+}
+
+func TestNewGitHubClient(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"With token", "test-token"},
+		{"Without token", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewGitHubClient(tt.token)
+			assert.NotNil(t, client)
+			assert.IsType(t, &github.Client{}, client)
+		})
+	}
+}
+
+func TestGetIssueStatsForRepo_WithMockServer(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupServer   func() *httptest.Server
+		expectedStats *IssueStats
+		expectError   bool
+	}{
+		{
+			name: "Success with mixed issues and PRs",
+			setupServer: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					switch {
+					case strings.Contains(r.URL.Path, "/repos/testorg/testrepo") && r.Method == "GET" && !strings.Contains(r.URL.Path, "/issues"):
+						// Repository exists
+						repo := github.Repository{Name: stringPtr("testrepo")}
+						json.NewEncoder(w).Encode(repo)
+					case strings.Contains(r.URL.Path, "/repos/testorg/testrepo/issues"):
+						// Return issues as an array
+						issues := []*github.Issue{
+							{State: stringPtr("open"), PullRequestLinks: nil, Labels: []*github.Label{{Name: stringPtr("bug")}}},
+							{State: stringPtr("closed"), PullRequestLinks: nil, Labels: []*github.Label{{Name: stringPtr("bug")}}},
+							{State: stringPtr("open"), PullRequestLinks: &github.PullRequestLinks{}, Labels: []*github.Label{{Name: stringPtr("bug")}}}, // PR
+						}
+						w.Header().Set("Link", "") // No pagination
+						json.NewEncoder(w).Encode(issues)
+					default:
+						http.NotFound(w, r)
+					}
+				}))
+			},
+			expectedStats: &IssueStats{
+				Owner:        "testorg",
+				RepoName:     "testrepo",
+				TotalIssues:  2,
+				OpenIssues:   1,
+				ClosedIssues: 1,
+				Labels:       map[string]LabelCounts{"bug": {Open: 1, Closed: 1}},
+			},
+			expectError: false,
+		},
+		{
+			name: "Repository not found",
+			setupServer: func() *httptest.Server {
+				return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if strings.Contains(r.URL.Path, "/repos/testorg/nonexistent") {
+						w.WriteHeader(http.StatusNotFound)
+						json.NewEncoder(w).Encode(map[string]string{"message": "Not Found"})
+					}
+				}))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := tt.setupServer()
+			defer server.Close()
+
+			// Create GitHub client pointing to test server
+			client := github.NewClient(nil)
+			client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+			// Create service
+			logger := createTestLogger()
+			service := NewGitHubServiceWithLogger(client, 1, logger)
+
+			ctx := context.Background()
+			repoName := "testrepo"
+			if tt.expectError {
+				repoName = "nonexistent"
+			}
+
+			stats, err := service.GetIssueStatsForRepo(ctx, "testorg", repoName, IssueStatsFilter{})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, stats)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedStats, stats)
+			}
+		})
+	}
+}
+
+func TestGetRepositoriesWithPrefix_WithMockServer(t *testing.T) {
+	tests := []struct {
+		name          string
+		prefix        string
+		expectedRepos []string
+	}{
+		{
+			name:          "Filter by prefix",
+			prefix:        "test-",
+			expectedRepos: []string{"test-repo1", "test-repo2"},
+		},
+		{
+			name:          "Empty prefix returns all",
+			prefix:        "",
+			expectedRepos: []string{"test-repo1", "test-repo2", "other-repo"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.Contains(r.URL.Path, "/orgs/testorg/repos") {
+					repos := []github.Repository{
+						{Name: stringPtr("test-repo1")},
+						{Name: stringPtr("test-repo2")},
+						{Name: stringPtr("other-repo")},
+					}
+					w.Header().Set("Link", "") // No pagination
+					json.NewEncoder(w).Encode(repos)
+				}
+			}))
+			defer server.Close()
+
+			client := github.NewClient(nil)
+			client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+			logger := createTestLogger()
+			service := NewGitHubServiceWithLogger(client, 1, logger)
+
+			ctx := context.Background()
+			repos, err := service.GetRepositoriesWithPrefix(ctx, "testorg", tt.prefix, false)
+
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.expectedRepos), len(repos))
+
+			for i, expectedName := range tt.expectedRepos {
+				assert.Equal(t, expectedName, repos[i].GetName())
+			}
+		})
+	}
+}
+
+func TestGetIssueStatsForRepo_WithFilter(t *testing.T) {
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/testrepo":
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("testrepo")})
+		case "/repos/testorg/testrepo/milestones":
+			json.NewEncoder(w).Encode([]*github.Milestone{
+				{Number: github.Int(3), Title: stringPtr("v1.0")},
+				{Number: github.Int(4), Title: stringPtr("v2.0")},
+			})
+		case "/repos/testorg/testrepo/issues":
+			query := r.URL.Query()
+			assert.Equal(t, "bug", query.Get("labels"))
+			assert.Equal(t, "octocat", query.Get("assignee"))
+			assert.Equal(t, "4", query.Get("milestone"))
+			assert.Equal(t, since.Format(time.RFC3339), query.Get("since"))
+
+			issues := []*github.Issue{
+				{State: stringPtr("open"), CreatedAt: &github.Timestamp{Time: since.AddDate(0, 0, 1)}},
+				{State: stringPtr("closed"), CreatedAt: &github.Timestamp{Time: since.AddDate(0, 1, 0)}},
+				{State: stringPtr("open"), CreatedAt: &github.Timestamp{Time: since.AddDate(0, 0, -1)}}, // Before the range
+				{State: stringPtr("open"), CreatedAt: &github.Timestamp{Time: until}},                   // After the range
+			}
+			json.NewEncoder(w).Encode(issues)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+	service := NewGitHubServiceWithLogger(client, 1, createTestLogger())
+
+	filter := IssueStatsFilter{
+		Labels:    []string{"bug"},
+		Since:     since,
+		Until:     until,
+		Assignee:  "octocat",
+		Milestone: "v2.0",
+	}
+
+	stats, err := service.GetIssueStatsForRepo(context.Background(), "testorg", "testrepo", filter)
+	require.NoError(t, err)
+	assert.Equal(t, &IssueStats{Owner: "testorg", RepoName: "testrepo", TotalIssues: 2, OpenIssues: 1, ClosedIssues: 1}, stats)
+
+	// A milestone the repository does not have matches nothing
+	filter.Milestone = "v3.0"
+	stats, err = service.GetIssueStatsForRepo(context.Background(), "testorg", "testrepo", filter)
+	require.NoError(t, err)
+	assert.Equal(t, &IssueStats{Owner: "testorg", RepoName: "testrepo"}, stats)
+}
+
+func TestGetIssueStatsForReposWithPrefix_WithMockServer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.Contains(r.URL.Path, "/orgs/testorg/repos"):
+			repos := []github.Repository{
+				{Name: stringPtr("test-repo1")},
+				{Name: stringPtr("test-repo2")},
+			}
+			w.Header().Set("Link", "") // No pagination
+			json.NewEncoder(w).Encode(repos)
+		case strings.Contains(r.URL.Path, "/repos/testorg/test-repo1") && !strings.Contains(r.URL.Path, "/issues"):
+			repo := github.Repository{Name: stringPtr("test-repo1")}
+			json.NewEncoder(w).Encode(repo)
+		case strings.Contains(r.URL.Path, "/repos/testorg/test-repo2") && !strings.Contains(r.URL.Path, "/issues"):
+			repo := github.Repository{Name: stringPtr("test-repo2")}
+			json.NewEncoder(w).Encode(repo)
+		case strings.Contains(r.URL.Path, "/repos/testorg/test-repo1/issues"):
+			issues := []github.Issue{
+				{State: stringPtr("open"), PullRequestLinks: nil},
+				{State: stringPtr("closed"), PullRequestLinks: nil},
+			}
+			w.Header().Set("Link", "")
+			json.NewEncoder(w).Encode(issues)
+		case strings.Contains(r.URL.Path, "/repos/testorg/test-repo2/issues"):
+			issues := []github.Issue{
+				{State: stringPtr("open"), PullRequestLinks: nil},
+			}
+			w.Header().Set("Link", "")
+			json.NewEncoder(w).Encode(issues)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+	logger := createTestLogger()
+	service := NewGitHubServiceWithLogger(client, 2, logger) // Test concurrency
+
+	ctx := context.Background()
+	stats, err := service.GetIssueStatsForReposWithPrefix(ctx, "testorg", "test-", false, IssueStatsFilter{})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(stats))
+
+	// Sort stats by repo name for consistent testing
+	if len(stats) == 2 && stats[0].RepoName > stats[1].RepoName {
+		stats[0], stats[1] = stats[1], stats[0]
+	}
+
+	expected := []*IssueStats{
+		{RepoName: "test-repo1", TotalIssues: 2, OpenIssues: 1, ClosedIssues: 1},
+		{RepoName: "test-repo2", TotalIssues: 1, OpenIssues: 1, ClosedIssues: 0},
+	}
+
+	for i, expectedStat := range expected {
+		assert.Equal(t, expectedStat.RepoName, stats[i].RepoName)
+		assert.Equal(t, expectedStat.TotalIssues, stats[i].TotalIssues)
+		assert.Equal(t, expectedStat.OpenIssues, stats[i].OpenIssues)
+		assert.Equal(t, expectedStat.ClosedIssues, stats[i].ClosedIssues)
+	}
+}
+
+func TestGetIssueStatsForRepo_BusinessLogic(t *testing.T) {
+	tests := []struct {
+		name          string
+		issues        []*github.Issue
+		expectedStats *IssueStats
+	}{
+		{
+			name: "Mixed issues and PRs",
+			issues: []*github.Issue{
+				{State: stringPtr("open"), PullRequestLinks: nil},
+				{State: stringPtr("closed"), PullRequestLinks: nil},
+				{State: stringPtr("open"), PullRequestLinks: &github.PullRequestLinks{}}, // PR - excluded
+				{State: stringPtr("closed"), PullRequestLinks: nil},
+			},
+			expectedStats: &IssueStats{
+				RepoName:     "testrepo",
+				TotalIssues:  3,
+				OpenIssues:   1,
+				ClosedIssues: 2,
+			},
+		},
+		{
+			name: "Only open issues",
+			issues: []*github.Issue{
+				{State: stringPtr("open"), PullRequestLinks: nil},
+				{State: stringPtr("open"), PullRequestLinks: nil},
+			},
+			expectedStats: &IssueStats{
+				RepoName:     "testrepo",
+				TotalIssues:  2,
+				OpenIssues:   2,
+				ClosedIssues: 0,
+			},
+		},
+		{
+			name:   "No issues",
+			issues: []*github.Issue{},
+			expectedStats: &IssueStats{
+				RepoName:     "testrepo",
+				TotalIssues:  0,
+				OpenIssues:   0,
+				ClosedIssues: 0,
+			},
+		},
+		{
+			name: "Only pull requests",
+			issues: []*github.Issue{
+				{State: stringPtr("open"), PullRequestLinks: &github.PullRequestLinks{}},
+				{State: stringPtr("closed"), PullRequestLinks: &github.PullRequestLinks{}},
+			},
+			expectedStats: &IssueStats{
+				RepoName:     "testrepo",
+				TotalIssues:  0,
+				OpenIssues:   0,
+				ClosedIssues: 0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test the issue counting logic
+			stats := countIssues(tt.issues, "testrepo")
+
+			assert.Equal(t, tt.expectedStats, stats)
+		})
+	}
+}
+
+func TestGetRepositoriesWithPrefix_FilterLogic(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		repos    []*github.Repository
+		expected []*github.Repository
+	}{
+		{
+			name:   "Matching prefix",
+			prefix: "test-",
+			repos: []*github.Repository{
+				{Name: stringPtr("test-repo1")},
+				{Name: stringPtr("test-repo2")},
+				{Name: stringPtr("other-repo")},
+			},
+			expected: []*github.Repository{
+				{Name: stringPtr("test-repo1")},
+				{Name: stringPtr("test-repo2")},
+			},
+		},
+		{
+			name:   "Empty prefix matches all",
+			prefix: "",
+			repos: []*github.Repository{
+				{Name: stringPtr("repo1")},
+				{Name: stringPtr("repo2")},
+			},
+			expected: []*github.Repository{
+				{Name: stringPtr("repo1")},
+				{Name: stringPtr("repo2")},
+			},
+		},
+		{
+			name:   "No matches",
+			prefix: "nonexistent-",
+			repos: []*github.Repository{
+				{Name: stringPtr("repo1")},
+				{Name: stringPtr("repo2")},
+			},
+			expected: []*github.Repository{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Test the filtering logic
+			var matchingRepos []*github.Repository
+
+			for _, repo := range tt.repos {
+				if strings.HasPrefix(repo.GetName(), tt.prefix) {
+					matchingRepos = append(matchingRepos, repo)
+				}
+			}
+
+			assert.Equal(t, len(tt.expected), len(matchingRepos))
+			for i, expectedRepo := range tt.expected {
+				assert.Equal(t, expectedRepo.GetName(), matchingRepos[i].GetName())
+			}
+		})
+	}
+}
+
+// Helper function to count issues
+func countIssues(issues []*github.Issue, repoName string) *IssueStats {
+	stats := &IssueStats{RepoName: repoName}
+
+	for _, issue := range issues {
+		// Skip pull requests (issues with PullRequestLinks are PRs)
+		if issue.PullRequestLinks == nil {
+			stats.TotalIssues++
+			if issue.GetState() == "open" {
+				stats.OpenIssues++
+			} else {
+				stats.ClosedIssues++
+			}
+		}
+	}
+
+	return stats
+}
+
+// TestGitHubService_ErrorHandling tests error scenarios using interface mocks
+func TestGitHubService_ErrorHandling(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMocks  func() GitHubClient
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name: "Repository not found",
+			setupMocks: func() GitHubClient {
+				return &mockGitHubService{
+					shouldError: true,
+					errorMsg:    "repository not found",
+				}
+			},
+			expectError: true,
+			errorMsg:    "repository not found",
+		},
+		{
+			name: "API rate limit exceeded",
+			setupMocks: func() GitHubClient {
+				return &mockGitHubService{
+					shouldError: true,
+					errorMsg:    "API rate limit exceeded",
+				}
+			},
+			expectError: true,
+			errorMsg:    "API rate limit exceeded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := tt.setupMocks()
+			ctx := context.Background()
+
+			_, err := service.GetIssueStatsForRepo(ctx, "testorg", "testrepo", IssueStatsFilter{})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStreamIssueStats(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/testorg/repos":
+			json.NewEncoder(w).Encode([]*github.Repository{{Name: github.String("test-repo1")}, {Name: github.String("test-repo2")}})
+		case "/repos/testorg/test-repo1", "/repos/testorg/test-repo2":
+			json.NewEncoder(w).Encode(&github.Repository{})
+		case "/repos/testorg/test-repo1/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{{State: github.String("open")}, {State: github.String("closed")}})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	results, total, err := service.StreamIssueStats(context.Background(), "testorg", "test-", false, IssueStatsFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	received := map[string]RepoResult[*IssueStats]{}
+	for result := range results {
+		received[result.RepoName] = result
+	}
+
+	require.Len(t, received, 2)
+	require.NoError(t, received["test-repo1"].Err)
+	assert.Equal(t, "testorg", received["test-repo1"].Owner)
+	assert.Equal(t, 2, received["test-repo1"].Value.TotalIssues)
+	assert.Error(t, received["test-repo2"].Err)
+}
+
+func TestGetIssueStatsForReposWithPrefix_NoRepos(t *testing.T) {
+	service := &mockGitHubService{
+		shouldError: false,
+		repos:       []*github.Repository{}, // No repositories
+	}
+
+	ctx := context.Background()
+	stats, err := service.GetIssueStatsForReposWithPrefix(ctx, "testorg", "nonexistent-", false, IssueStatsFilter{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, stats)
+}
+
+// Simple mock implementation for testing error scenarios and edge cases
+type mockGitHubService struct {
+	shouldError bool
+	errorMsg    string
+	repos       []*github.Repository
+}
+
+func (m *mockGitHubService) GetIssueStatsForRepo(ctx context.Context, org, repoName string,
+	filter IssueStatsFilter) (*IssueStats, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &IssueStats{
+		RepoName:     repoName,
+		TotalIssues:  5,
+		OpenIssues:   3,
+		ClosedIssues: 2,
+	}, nil
+}
+
+func (m *mockGitHubService) GetRepositoriesWithPrefix(ctx context.Context, owner, prefix string,
+	isUser bool) ([]*github.Repository, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	if m.repos != nil {
+		return m.repos, nil
+	}
+	return []*github.Repository{
+		{Name: stringPtr("test-repo")},
+	}, nil
+}
+
+func (m *mockGitHubService) GetIssueStatsForReposWithPrefix(ctx context.Context, owner, prefix string, isUser bool,
+	filter IssueStatsFilter) ([]*IssueStats, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+
+	repos, err := m.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(repos) == 0 {
+		return nil, nil
+	}
+
+	return []*IssueStats{
+		{RepoName: "test-repo", TotalIssues: 5, OpenIssues: 3, ClosedIssues: 2},
+	}, nil
+}
+
+func (m *mockGitHubService) CreateOrUpdateFile(ctx context.Context, owner, repoName, filePath, content, commitMessage string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) AddCodeownersToReposWithPrefix(ctx context.Context, owner, prefix string,
+	isUser bool, codeownersContent string) ([]string, []string, error) {
+	if m.shouldError {
+		return nil, nil, errors.New(m.errorMsg)
+	}
+
+	repos, err := m.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(repos) == 0 {
+		return nil, nil, nil
+	}
+
+	var successRepos []string
+	for _, repo := range repos {
+		successRepos = append(successRepos, repo.GetName())
+	}
+
+	return successRepos, nil, nil
+}
+
+func (m *mockGitHubService) AddCodeownersToRepos(ctx context.Context, owner string, repoNames []string,
+	codeownersContent string, onResult RepoResultFunc) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) GetRepository(ctx context.Context, owner, repoName string) (*github.Repository, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &github.Repository{Name: stringPtr(repoName)}, nil
+}
+
+func (m *mockGitHubService) SetRepositoryArchived(ctx context.Context, owner, repoName string, archived bool) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) SetArchivedForRepos(ctx context.Context, owner string, repoNames []string,
+	archived bool) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) DeleteRepository(ctx context.Context, owner, repoName string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) DeleteRepos(ctx context.Context, owner string, repoNames []string,
+	onResult RepoResultFunc) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) SetRepositoryVisibility(ctx context.Context, owner, repoName, visibility string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) SetVisibilityForRepos(ctx context.Context, owner string, repoNames []string,
+	visibility string) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) GetTeamIDsBySlug(ctx context.Context, org string, slugs []string) ([]int64, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	teamIDs := make([]int64, len(slugs))
+	for i := range slugs {
+		teamIDs[i] = int64(i + 1)
+	}
+	return teamIDs, nil
+}
+
+func (m *mockGitHubService) TransferRepository(ctx context.Context, owner, repoName, newOwner string, teamIDs []int64) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) TransferRepos(ctx context.Context, owner string, repoNames []string, newOwner string,
+	teamIDs []int64, onResult RepoResultFunc) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) RenameRepository(ctx context.Context, owner, oldName, newName string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) RenameRepos(ctx context.Context, owner string, renames []RepoRename,
+	onResult RepoResultFunc) ([]string, []string) {
+	var oldNames []string
+	for _, rename := range renames {
+		oldNames = append(oldNames, rename.OldName)
+	}
+	if m.shouldError {
+		return nil, oldNames
+	}
+	return oldNames, nil
+}
+
+func (m *mockGitHubService) CreateRepositoryFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string,
+	request RepoCreateRequest) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) CreateReposFromTemplate(ctx context.Context, owner, templateOwner, templateRepo string,
+	requests []RepoCreateRequest) ([]string, []string) {
+	var names []string
+	for _, request := range requests {
+		names = append(names, request.Name)
+	}
+	if m.shouldError {
+		return nil, names
+	}
+	return names, nil
+}
+
+func (m *mockGitHubService) GetFileContent(ctx context.Context, owner, repoName, filePath string) (string, string, bool, error) {
+	if m.shouldError {
+		return "", "", false, errors.New(m.errorMsg)
+	}
+	return "", "", false, nil
+}
+
+func (m *mockGitHubService) ApplyFileToRepos(ctx context.Context, owner string, repoNames []string,
+	rollout FileRollout) []FileRolloutResult {
+	results := make([]FileRolloutResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = FileRolloutResult{RepoName: repoName, Status: FileStatusCreated}
+		if m.shouldError {
+			results[i] = FileRolloutResult{RepoName: repoName, Status: FileStatusFailed, Err: errors.New(m.errorMsg)}
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetLicenseTemplate(ctx context.Context, spdxID string) (string, error) {
+	if m.shouldError {
+		return "", errors.New(m.errorMsg)
+	}
+	return "Copyright (c) [year] [fullname]", nil
+}
+
+func (m *mockGitHubService) DetectDependabotEcosystems(ctx context.Context, owner, repoName string) ([]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []string{"gomod"}, nil
+}
+
+func (m *mockGitHubService) ListIssues(ctx context.Context, owner, repoName string, query IssueQuery) ([]*github.Issue, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []*github.Issue{{Number: github.Int(1), Title: stringPtr("test issue")}}, nil
+}
+
+func (m *mockGitHubService) CommentOnIssue(ctx context.Context, owner, repoName string, number int, body string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) AddLabelsToIssue(ctx context.Context, owner, repoName string, number int, labels []string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) CloseIssue(ctx context.Context, owner, repoName string, number int, reason string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) CloseStaleIssues(ctx context.Context, owner string, repoNames []string,
+	opts StaleIssueOptions) []RepoIssueResults {
+	results := make([]RepoIssueResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) LockIssue(ctx context.Context, owner, repoName string, number int, reason string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) LockIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueLockOptions) []RepoIssueResults {
+	results := make([]RepoIssueResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) SetIssueMilestone(ctx context.Context, owner, repoName string, number, milestone int) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) AssignMilestone(ctx context.Context, owner string, repoNames []string,
+	opts IssueMilestoneOptions) []RepoIssueResults {
+	results := make([]RepoIssueResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) AssignIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueAssigneeOptions) []RepoIssueResults {
+	results := make([]RepoIssueResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) RemoveLabelFromIssue(ctx context.Context, owner, repoName string, number int, label string) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) LabelIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueLabelOptions) []RepoIssueResults {
+	return m.CloseStaleIssues(ctx, owner, repoNames, StaleIssueOptions{})
+}
+
+func (m *mockGitHubService) CommentOnIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueCommentOptions) []RepoIssueResults {
+	return m.CloseStaleIssues(ctx, owner, repoNames, StaleIssueOptions{})
+}
+
+func (m *mockGitHubService) CreateIssue(ctx context.Context, owner, repoName string, issue NewIssue) (*github.Issue, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &github.Issue{Number: github.Int(1), Title: stringPtr(issue.Title)}, nil
+}
+
+func (m *mockGitHubService) CreateIssues(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (NewIssue, error), onResult RepoResultFunc) []RepoIssueResults {
+	return m.CloseStaleIssues(ctx, owner, repoNames, StaleIssueOptions{})
+}
+
+func (m *mockGitHubService) ListPullRequests(ctx context.Context, owner, repoName string,
+	query PullRequestQuery) ([]*github.PullRequest, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []*github.PullRequest{{Number: github.Int(1), Title: stringPtr("test pull request")}}, nil
+}
+
+func (m *mockGitHubService) MergePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts MergePullRequestsOptions) []RepoPullRequestResults {
+	results := make([]RepoPullRequestResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoPullRequestResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) ApprovePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts ApprovePullRequestsOptions) ([]RepoPullRequestResults, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return m.MergePullRequests(ctx, owner, repoNames, MergePullRequestsOptions{}), nil
+}
+
+func (m *mockGitHubService) ClosePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts ClosePullRequestsOptions) []RepoPullRequestResults {
+	return m.MergePullRequests(ctx, owner, repoNames, MergePullRequestsOptions{})
+}
+
+func (m *mockGitHubService) AuditBranchProtection(ctx context.Context, owner string,
+	repository *github.Repository) BranchProtectionAudit {
+	audit := BranchProtectionAudit{RepoName: repository.GetName(), Branch: repository.GetDefaultBranch()}
+	if m.shouldError {
+		audit.Err = errors.New(m.errorMsg)
+	}
+	return audit
+}
+
+func (m *mockGitHubService) AuditBranchProtectionForRepos(ctx context.Context, owner string,
+	repos []*github.Repository) []BranchProtectionAudit {
+	audits := make([]BranchProtectionAudit, len(repos))
+	for i, repository := range repos {
+		audits[i] = m.AuditBranchProtection(ctx, owner, repository)
+	}
+	return audits
+}
+
+func (m *mockGitHubService) AuditCodeowners(ctx context.Context, owner, repoName string) CodeownersAudit {
+	if m.shouldError {
+		return CodeownersAudit{RepoName: repoName, Err: errors.New(m.errorMsg)}
+	}
+	return CodeownersAudit{RepoName: repoName, Path: ".github/CODEOWNERS"}
+}
+
+func (m *mockGitHubService) AuditCodeownersForRepos(ctx context.Context, owner string, repoNames []string) []CodeownersAudit {
+	audits := make([]CodeownersAudit, len(repoNames))
+	for i, repoName := range repoNames {
+		audits[i] = m.AuditCodeowners(ctx, owner, repoName)
+	}
+	return audits
+}
+
+func (m *mockGitHubService) ListCodeScanningAlerts(ctx context.Context, owner, repoName string) ([]*github.Alert, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []*github.Alert{}, nil
+}
+
+func (m *mockGitHubService) GetCodeScanningReports(ctx context.Context, owner string, repoNames []string,
+	filter CodeScanningFilter) []CodeScanningReport {
+	reports := make([]CodeScanningReport, len(repoNames))
+	for i, repoName := range repoNames {
+		reports[i] = CodeScanningReport{RepoName: repoName, Enabled: true}
+		if m.shouldError {
+			reports[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return reports
+}
+
+func (m *mockGitHubService) GetTrafficReport(ctx context.Context, owner, repoName string) (*TrafficReport, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &TrafficReport{RepoName: repoName, Views: 10, UniqueVisitors: 2, Clones: 4, UniqueCloners: 1}, nil
+}
+
+func (m *mockGitHubService) GetTrafficReports(ctx context.Context, owner string, repoNames []string) []TrafficReport {
+	reports := make([]TrafficReport, len(repoNames))
+	for i, repoName := range repoNames {
+		report, err := m.GetTrafficReport(ctx, owner, repoName)
+		if err != nil {
+			reports[i] = TrafficReport{RepoName: repoName, Err: err}
+			continue
+		}
+		reports[i] = *report
+	}
+	return reports
+}
+
+func (m *mockGitHubService) ListReleases(ctx context.Context, owner, repoName string) ([]*github.RepositoryRelease, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []*github.RepositoryRelease{{TagName: stringPtr("v1.0.0")}}, nil
+}
+
+func (m *mockGitHubService) GetLatestRelease(ctx context.Context, owner, repoName string) (*github.RepositoryRelease, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &github.RepositoryRelease{TagName: stringPtr("v1.0.0")}, nil
+}
+
+func (m *mockGitHubService) GetReleaseReports(ctx context.Context, owner string, repoNames []string) []ReleaseReport {
+	reports := make([]ReleaseReport, len(repoNames))
+	for i, repoName := range repoNames {
+		reports[i] = ReleaseReport{RepoName: repoName, Tag: "v1.0.0"}
+		if m.shouldError {
+			reports[i] = ReleaseReport{RepoName: repoName, Err: errors.New(m.errorMsg)}
+		}
+	}
+	return reports
+}
+
+func (m *mockGitHubService) AuditLicense(ctx context.Context, owner, repoName string) LicenseAudit {
+	if m.shouldError {
+		return LicenseAudit{RepoName: repoName, Err: errors.New(m.errorMsg)}
+	}
+	return LicenseAudit{RepoName: repoName, Path: "LICENSE", SPDXID: "MIT"}
+}
+
+func (m *mockGitHubService) AuditLicensesForRepos(ctx context.Context, owner string, repoNames []string) []LicenseAudit {
+	audits := make([]LicenseAudit, len(repoNames))
+	for i, repoName := range repoNames {
+		audits[i] = m.AuditLicense(ctx, owner, repoName)
+	}
+	return audits
+}
+
+func (m *mockGitHubService) GetGoModule(ctx context.Context, owner, repoName string) (*gomod.Module, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &gomod.Module{Path: "github.com/" + owner + "/" + repoName, GoVersion: "1.22"}, nil
+}
+
+func (m *mockGitHubService) GetGoModReports(ctx context.Context, owner string, repoNames []string) []GoModReport {
+	reports := make([]GoModReport, len(repoNames))
+	for i, repoName := range repoNames {
+		module, err := m.GetGoModule(ctx, owner, repoName)
+		reports[i] = GoModReport{RepoName: repoName, Module: module, Err: err}
+	}
+	return reports
+}
+
+func (m *mockGitHubService) GetWorkflowRunStats(ctx context.Context, owner, repoName string, query WorkflowRunQuery) (*WorkflowRunStats, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &WorkflowRunStats{RepoName: repoName, Found: true, Runs: 10, Succeeded: 9, Failed: 1, LastConclusion: "success"}, nil
+}
+
+func (m *mockGitHubService) GetWorkflowRunStatsForRepos(ctx context.Context, owner string, repoNames []string, query WorkflowRunQuery) []WorkflowRunStats {
+	results := make([]WorkflowRunStats, len(repoNames))
+	for i, repoName := range repoNames {
+		stats, err := m.GetWorkflowRunStats(ctx, owner, repoName, query)
+		if err != nil {
+			results[i] = WorkflowRunStats{RepoName: repoName, Err: err}
+			continue
+		}
+		results[i] = *stats
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetSizeReport(ctx context.Context, owner string, repository *github.Repository) (*SizeReport, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &SizeReport{RepoName: repository.GetName(), SizeKB: repository.GetSize(), Branches: 1}, nil
+}
+
+func (m *mockGitHubService) GetSizeReports(ctx context.Context, owner string, repos []*github.Repository) []SizeReport {
+	reports := make([]SizeReport, len(repos))
+	for i, repository := range repos {
+		report, err := m.GetSizeReport(ctx, owner, repository)
+		if err != nil {
+			reports[i] = SizeReport{RepoName: repository.GetName(), Err: err}
+			continue
+		}
+		reports[i] = *report
+	}
+	return reports
+}
+
+func (m *mockGitHubService) ListOpenPullRequests(ctx context.Context, owner string, repoNames []string, query PullRequestQuery) []RepoOpenPullRequests {
+	results := make([]RepoOpenPullRequests, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoOpenPullRequests{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) AuditSecurityPolicies(ctx context.Context, owner string, repoNames []string) []SecurityPolicyAudit {
+	audits := make([]SecurityPolicyAudit, len(repoNames))
+	for i, repoName := range repoNames {
+		audits[i] = SecurityPolicyAudit{RepoName: repoName, Path: "SECURITY.md"}
+		if m.shouldError {
+			audits[i] = SecurityPolicyAudit{RepoName: repoName, Err: errors.New(m.errorMsg)}
+		}
+	}
+	return audits
+}
+
+func (m *mockGitHubService) AuditHealth(ctx context.Context, owner string, repository *github.Repository, checks []string) HealthAudit {
+	if m.shouldError {
+		return HealthAudit{RepoName: repository.GetName(), Err: errors.New(m.errorMsg)}
+	}
+	audit := HealthAudit{RepoName: repository.GetName(), Passed: make(map[string]bool)}
+	for _, check := range checks {
+		audit.Passed[check] = true
+	}
+	return audit
+}
+
+func (m *mockGitHubService) AuditHealthForRepos(ctx context.Context, owner string, repos []*github.Repository, checks []string) []HealthAudit {
+	audits := make([]HealthAudit, len(repos))
+	for i, repository := range repos {
+		audits[i] = m.AuditHealth(ctx, owner, repository, checks)
+	}
+	return audits
+}
+
+func (m *mockGitHubService) GetActionsPermissions(ctx context.Context, owner, repoName string) (*ActionsPermissions, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &ActionsPermissions{Enabled: true, AllowedActions: "all", DefaultWorkflowPermissions: "read"}, nil
+}
+
+func (m *mockGitHubService) EnforceActionsPermissions(ctx context.Context, owner string, repoNames []string,
+	policy ActionsPermissionsPolicy, apply bool) []ActionsPermissionsResult {
+	results := make([]ActionsPermissionsResult, len(repoNames))
+	for i, repoName := range repoNames {
+		current, err := m.GetActionsPermissions(ctx, owner, repoName)
+		if err != nil {
+			results[i] = ActionsPermissionsResult{RepoName: repoName, Err: err}
+			continue
+		}
+		drift := policy.Drift(*current)
+		results[i] = ActionsPermissionsResult{RepoName: repoName, Current: *current, Drift: drift, Applied: apply && len(drift) > 0}
+	}
+	return results
+}
+
+func (m *mockGitHubService) DiscoverRepositories(ctx context.Context, owner string, isUser bool, query DiscoveryQuery) ([]*github.Repository, error) {
+	return m.GetRepositoriesWithPrefix(ctx, owner, query.Prefix, isUser)
+}
+
+func (m *mockGitHubService) GetIssueStatsGraphQL(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) ([]*IssueStats, error) {
+	var allStats []*IssueStats
+
+	for _, repoName := range repoNames {
+		stats, err := m.GetIssueStatsForRepo(ctx, owner, repoName, filter)
+		if err == nil {
+			allStats = append(allStats, stats)
+		}
+	}
+
+	return allStats, nil
+}
+
+func (m *mockGitHubService) StreamIssueStats(ctx context.Context, owner, prefix string, isUser bool,
+	filter IssueStatsFilter,
+) (<-chan RepoResult[*IssueStats], int, error) {
+	repos, err := m.GetRepositoriesWithPrefix(ctx, owner, prefix, isUser)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make(chan RepoResult[*IssueStats], len(repos))
+	for _, repository := range repos {
+		stats, err := m.GetIssueStatsForRepo(ctx, owner, repository.GetName(), filter)
+		results <- RepoResult[*IssueStats]{RepoName: repository.GetName(), Value: stats, Err: err}
+	}
+
+	close(results)
+
+	return results, len(repos), nil
+}
+
+func (m *mockGitHubService) RestoreFiles(ctx context.Context, owner string, restores []RepoRestore,
+	opts RestoreOptions,
+) []RepoRestoreResult {
+	return nil
+}
+
+func (m *mockGitHubService) ListOrganizations(ctx context.Context) ([]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []string{"testorg"}, nil
+}
+
+func (m *mockGitHubService) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &TokenInfo{Login: "testuser", ScopesReported: true, Scopes: []string{"repo"}}, nil
+}
+
+func (m *mockGitHubService) MoveFileInRepos(ctx context.Context, owner string, repoNames []string, move FileMove) []FileMoveResult {
+	return nil
+}
+
+func (m *mockGitHubService) CheckPolicy(ctx context.Context, owner string, repository *github.Repository, policy Policy) PolicyResult {
+	return PolicyResult{RepoName: repository.GetName()}
+}
+
+func (m *mockGitHubService) CheckPolicyForRepos(ctx context.Context, owner string, repos []*github.Repository, policy Policy) []PolicyResult {
+	return nil
+}
+
+func (m *mockGitHubService) GetManagedFiles(ctx context.Context, owner, repoName string, paths []string) ([]ManagedFile, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return nil, nil
+}
+
+func (m *mockGitHubService) DetectDrift(ctx context.Context, owner string, repoNames []string, files []ManagedFile) []RepoDrift {
+	return nil
+}
+
+func (m *mockGitHubService) ApplyFileSetToRepos(ctx context.Context, owner string, repoNames []string, rollout FileSetRollout) []FileRolloutResult {
+	return nil
+}
+
+func (m *mockGitHubService) ListGitignoreTemplates(ctx context.Context) ([]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []string{"Go", "Node"}, nil
+}
+
+func (m *mockGitHubService) GetGitignoreTemplate(ctx context.Context, name string) (string, error) {
+	if m.shouldError {
+		return "", errors.New(m.errorMsg)
+	}
+	return "*.test\n", nil
+}
+
+func (m *mockGitHubService) ListRulesets(ctx context.Context, owner, repoName string) ([]Ruleset, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []Ruleset{{ID: 1, Name: "main", Target: "branch", Enforcement: "active"}}, nil
+}
+
+func (m *mockGitHubService) ApplyRulesets(ctx context.Context, owner string, repoNames []string, rulesets []Ruleset, dryRun bool) []RulesetResult {
+	results := make([]RulesetResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RulesetResult{RepoName: repoName}
+	}
+	return results
+}
+
+func (m *mockGitHubService) AddAutolink(ctx context.Context, owner, repoName string, autolink Autolink) (string, error) {
+	if m.shouldError {
+		return "", errors.New(m.errorMsg)
+	}
+	return AutolinkStatusAdded, nil
+}
+
+func (m *mockGitHubService) RemoveAutolink(ctx context.Context, owner, repoName, keyPrefix string) (string, error) {
+	if m.shouldError {
+		return "", errors.New(m.errorMsg)
+	}
+	return AutolinkStatusRemoved, nil
+}
+
+func (m *mockGitHubService) AddAutolinkToRepos(ctx context.Context, owner string, repoNames []string, autolink Autolink) []AutolinkResult {
+	results := make([]AutolinkResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = AutolinkResult{RepoName: repoName, Status: AutolinkStatusAdded}
+	}
+	return results
+}
+
+func (m *mockGitHubService) RemoveAutolinkFromRepos(ctx context.Context, owner string, repoNames []string, keyPrefix string) []AutolinkResult {
+	results := make([]AutolinkResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = AutolinkResult{RepoName: repoName, Status: AutolinkStatusRemoved}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetCustomPropertySchema(ctx context.Context, org string) ([]*github.CustomProperty, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return []*github.CustomProperty{{PropertyName: github.String("service-tier"), ValueType: "string"}}, nil
+}
+
+func (m *mockGitHubService) ListCustomPropertyValues(ctx context.Context, org string) (map[string]map[string]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return map[string]map[string]string{}, nil
+}
+
+func (m *mockGitHubService) SetCustomPropertyValues(ctx context.Context, org string, repoNames []string, values map[string]string) ([]string, []string) {
+	if m.shouldError {
+		return nil, repoNames
+	}
+	return repoNames, nil
+}
+
+func (m *mockGitHubService) ResolveEnvironmentReviewers(ctx context.Context, owner string, specs []EnvironmentSpec) error {
+	if m.shouldError {
+		return errors.New(m.errorMsg)
+	}
+	return nil
+}
+
+func (m *mockGitHubService) ApplyEnvironments(ctx context.Context, owner string, repoNames []string, specs []EnvironmentSpec) []EnvironmentResult {
+	results := make([]EnvironmentResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = EnvironmentResult{RepoName: repoName}
+	}
+	return results
+}
+
+func (m *mockGitHubService) SetSecretInRepos(ctx context.Context, owner string, repoNames []string, environment, name, value string) []SecretResult {
+	results := make([]SecretResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = SecretResult{RepoName: repoName, Status: SecretStatusCreated}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetRunnerGroup(ctx context.Context, org, name string) (*github.RunnerGroup, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &github.RunnerGroup{ID: github.Int64(1), Name: github.String(name), Visibility: github.String(RunnerGroupVisibilitySelected)}, nil
+}
+
+func (m *mockGitHubService) ListRunnerGroupRepos(ctx context.Context, org string, groupID int64) (map[string]bool, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return map[string]bool{}, nil
+}
+
+func (m *mockGitHubService) AssignReposToRunnerGroup(ctx context.Context, org string, groupID int64, repos []*github.Repository) ([]string, []string) {
+	names := make([]string, 0, len(repos))
+	for _, repository := range repos {
+		names = append(names, repository.GetName())
+	}
+	if m.shouldError {
+		return nil, names
+	}
+	return names, nil
+}
+
+func (m *mockGitHubService) EnableDependabotSecurityUpdates(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult {
+	results := make([]SecurityFeatureResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = SecurityFeatureResult{RepoName: repoName, Status: SecurityFeatureStatusEnabled}
+	}
+	return results
+}
+
+func (m *mockGitHubService) EnableSecretScanning(ctx context.Context, owner string, repoNames []string, pushProtection bool) []SecurityFeatureResult {
+	return m.EnableDependabotSecurityUpdates(ctx, owner, repoNames)
+}
+
+func (m *mockGitHubService) EnablePrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult {
+	return m.EnableDependabotSecurityUpdates(ctx, owner, repoNames)
+}
+
+func (m *mockGitHubService) AuditPrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureAudit {
+	audits := make([]SecurityFeatureAudit, len(repoNames))
+	for i, repoName := range repoNames {
+		audits[i] = SecurityFeatureAudit{RepoName: repoName, Enabled: true}
+	}
+	return audits
+}
+
+func (m *mockGitHubService) GetPagesReports(ctx context.Context, owner string, repoNames []string) []PagesReport {
+	reports := make([]PagesReport, len(repoNames))
+	for i, repoName := range repoNames {
+		reports[i] = PagesReport{RepoName: repoName}
+	}
+	return reports
+}
+
+func (m *mockGitHubService) ConfigurePages(ctx context.Context, owner string, repos []*github.Repository, config PagesConfig) []PagesResult {
+	results := make([]PagesResult, len(repos))
+	for i, repository := range repos {
+		results[i] = PagesResult{RepoName: repository.GetName(), Status: PagesStatusEnabled}
+	}
+	return results
+}
+
+func (m *mockGitHubService) DisablePagesForRepos(ctx context.Context, owner string, repoNames []string) []PagesResult {
+	results := make([]PagesResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = PagesResult{RepoName: repoName, Status: PagesStatusDisabled}
+	}
+	return results
+}
+
+func (m *mockGitHubService) CheckMergeQueuePrerequisites(ctx context.Context, owner string, repos []*github.Repository) []MergeQueuePrerequisites {
+	results := make([]MergeQueuePrerequisites, len(repos))
+	for i, repository := range repos {
+		results[i] = MergeQueuePrerequisites{RepoName: repository.GetName(), Branch: repository.GetDefaultBranch(), RequiredChecks: true}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetIssueResponseTimes(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoIssueResponses {
+	results := make([]RepoIssueResponses, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResponses{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetIssueResolutionTimes(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoIssueResolutions {
+	results := make([]RepoIssueResolutions, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResolutions{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetPullRequestLatencies(ctx context.Context, owner string, repoNames []string, filter IssueStatsFilter) []RepoPullRequestLatencies {
+	results := make([]RepoPullRequestLatencies, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoPullRequestLatencies{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetTeamMemberships(ctx context.Context, org string) (map[string][]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return map[string][]string{}, nil
+}
+
+func (m *mockGitHubService) SearchIssues(ctx context.Context, owner string, isUser bool, repoNames []string, query string) (IssueSearch, error) {
+	if m.shouldError {
+		return IssueSearch{}, errors.New(m.errorMsg)
+	}
+	return IssueSearch{Query: query}, nil
+}
+
+func (m *mockGitHubService) GetRepositories(ctx context.Context, owner string, repoNames []string) []RepoResult[*github.Repository] {
+	results := make([]RepoResult[*github.Repository], len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoResult[*github.Repository]{Owner: owner, RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		} else {
+			results[i].Value = &github.Repository{Name: github.String(repoName)}
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) StreamRepositoryBackups(ctx context.Context, owner string, repoNames []string) <-chan RepoResult[*RepositoryBackup] {
+	results := make(chan RepoResult[*RepositoryBackup], len(repoNames))
+	for _, repoName := range repoNames {
+		result := RepoResult[*RepositoryBackup]{Owner: owner, RepoName: repoName}
+		if m.shouldError {
+			result.Err = errors.New(m.errorMsg)
+		} else {
+			result.Value = &RepositoryBackup{Repository: &github.Repository{Name: github.String(repoName)}}
+		}
+		results <- result
+	}
+	close(results)
+	return results
+}
+
+func (m *mockGitHubService) StreamIssueExports(ctx context.Context, owner string, repoNames []string, state string) <-chan RepoResult[[]ExportedIssue] {
+	results := make(chan RepoResult[[]ExportedIssue], len(repoNames))
+	for _, repoName := range repoNames {
+		result := RepoResult[[]ExportedIssue]{Owner: owner, RepoName: repoName}
+		if m.shouldError {
+			result.Err = errors.New(m.errorMsg)
+		}
+		results <- result
+	}
+	close(results)
+	return results
+}
+
+func (m *mockGitHubService) ImportIssues(ctx context.Context, owner string, imports []IssueImport, interval time.Duration,
+	onResult func(result IssueImportResult)) []IssueImportResult {
+	results := make([]IssueImportResult, 0, len(imports))
+	for _, issue := range imports {
+		result := IssueImportResult{IssueImport: issue}
+		if m.shouldError {
+			result.Err = errors.New(m.errorMsg)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetOrgProject(ctx context.Context, org string, number int) (*Project, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	return &Project{ID: "PVT_1", Number: number}, nil
+}
+
+func (m *mockGitHubService) AddProjectItems(ctx context.Context, owner string, repoNames []string, opts ProjectItemOptions) []RepoIssueResults {
+	results := make([]RepoIssueResults, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoIssueResults{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) GetDiscussionStats(ctx context.Context, owner string, repoNames []string) ([]*DiscussionStats, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+	stats := make([]*DiscussionStats, 0, len(repoNames))
+	for _, repoName := range repoNames {
+		stats = append(stats, &DiscussionStats{RepoName: repoName, Enabled: true})
+	}
+	return stats, nil
+}
+
+func (m *mockGitHubService) CreateRelease(ctx context.Context, owner, repoName string, release NewRelease) (ReleaseResult, error) {
+	if m.shouldError {
+		return ReleaseResult{RepoName: repoName}, errors.New(m.errorMsg)
+	}
+	return ReleaseResult{RepoName: repoName, Tag: release.Tag}, nil
+}
+
+func (m *mockGitHubService) CreateReleases(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (NewRelease, error)) []ReleaseResult {
+	results := make([]ReleaseResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = ReleaseResult{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) UploadReleaseAsset(ctx context.Context, owner, repoName string, upload ReleaseAssetUpload) (ReleaseAssetResult, error) {
+	if m.shouldError {
+		return ReleaseAssetResult{RepoName: repoName}, errors.New(m.errorMsg)
+	}
+	return ReleaseAssetResult{RepoName: repoName, Name: upload.Name, Release: upload.Release}, nil
+}
+
+func (m *mockGitHubService) UploadReleaseAssets(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (ReleaseAssetUpload, error)) []ReleaseAssetResult {
+	results := make([]ReleaseAssetResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = ReleaseAssetResult{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) PruneReleases(ctx context.Context, owner string, repoNames []string, opts ReleasePruneOptions) []RepoReleasePruneResult {
+	results := make([]RepoReleasePruneResult, len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoReleasePruneResult{RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		}
+	}
+	return results
+}
+
+func (m *mockGitHubService) EnsureLabels(ctx context.Context, owner, repoName string, labels []RepoLabel) ([]string, error) {
+	if m.shouldError {
+		return nil, errors.New(m.errorMsg)
+	}
+
+	names := make([]string, 0, len(labels))
+	for _, label := range labels {
+		names = append(names, label.Name)
+	}
+
+	return names, nil
+}
+
+func (m *mockGitHubService) ApplyNewRepoPolicy(ctx context.Context, owner, repoName string, policy NewRepoPolicy) NewRepoPolicyResult {
+	if m.shouldError {
+		return NewRepoPolicyResult{RepoName: repoName, Err: errors.New(m.errorMsg)}
+	}
+
+	return NewRepoPolicyResult{RepoName: repoName, Codeowners: policy.Codeowners != ""}
+}
+
+func (m *mockGitHubService) RunRepoOperation(ctx context.Context, owner string, repoNames []string,
+	op RepoOperation) []RepoResult[RepoOperationResult] {
+	results := make([]RepoResult[RepoOperationResult], len(repoNames))
+	for i, repoName := range repoNames {
+		results[i] = RepoResult[RepoOperationResult]{Owner: owner, RepoName: repoName}
+		if m.shouldError {
+			results[i].Err = errors.New(m.errorMsg)
+		} else {
+			results[i].Value, results[i].Err = op(ctx, repoName)
+		}
+	}
+	return results
+}
+
+// Benchmark tests
+func BenchmarkIssueStatsProcessing(b *testing.B) {
+	issues := make([]*github.Issue, 1000)
+	for i := 0; i < 1000; i++ {
+		state := "open"
+		if i%2 == 0 {
+			state = "closed"
+		}
+
+		var prLinks *github.PullRequestLinks
+		if i%10 == 0 { // Every 10th is a PR
+			prLinks = &github.PullRequestLinks{}
+		}
+
+		issues[i] = &github.Issue{
+			State:            stringPtr(state),
+			PullRequestLinks: prLinks,
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		countIssues(issues, "benchmark-repo")
+	}
+}
+
+func BenchmarkServiceCreation(b *testing.B) {
+	client := github.NewClient(nil)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = NewGitHubService(client)
+	}
+}
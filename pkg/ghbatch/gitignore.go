@@ -0,0 +1,109 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// GitignorePath is the location of the ignore file of a repository.
+const GitignorePath = ".gitignore"
+
+// gitignoreLanguageTemplates maps GitHub primary languages to the community .gitignore template covering
+// them when the names differ. Other languages use the template of the same name, if there is one.
+var gitignoreLanguageTemplates = map[string]string{
+	"JavaScript":       "Node",
+	"TypeScript":       "Node",
+	"Vue":              "Node",
+	"Svelte":           "Node",
+	"C#":               "VisualStudio",
+	"F#":               "VisualStudio",
+	"Kotlin":           "Java",
+	"Groovy":           "Java",
+	"HCL":              "Terraform",
+	"Jupyter Notebook": "Python",
+}
+
+// ListGitignoreTemplates lists the names of the community .gitignore templates, e.g. "Go" or "Node".
+func (s *Service) ListGitignoreTemplates(ctx context.Context) ([]string, error) {
+	templates, _, err := s.client.Gitignores.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gitignore templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetGitignoreTemplate gets the content of a community .gitignore template by name.
+func (s *Service) GetGitignoreTemplate(ctx context.Context, name string) (string, error) {
+	template, _, err := s.client.Gitignores.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get gitignore template %s: %w", name, err)
+	}
+
+	return template.GetSource(), nil
+}
+
+// GitignoreTemplateName returns the template among available matching a primary language, or "" when
+// there is none.
+func GitignoreTemplateName(language string, available []string) string {
+	if language == "" {
+		return ""
+	}
+
+	if mapped, ok := gitignoreLanguageTemplates[language]; ok {
+		language = mapped
+	}
+
+	for _, name := range available {
+		if strings.EqualFold(name, language) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// MergeGitignore appends the sections of template missing from existing. Sections are blocks separated by
+// blank lines; a section is appended with its comments and only the patterns existing lacks. Existing is
+// returned unchanged when it already covers every pattern.
+func MergeGitignore(existing, template string) string {
+	present := map[string]bool{}
+	for _, line := range strings.Split(existing, "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+
+	var missing []string
+	for _, section := range strings.Split(strings.ReplaceAll(template, "\r\n", "\n"), "\n\n") {
+		var comments, patterns []string
+		for _, line := range strings.Split(section, "\n") {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case trimmed == "":
+			case strings.HasPrefix(trimmed, "#"):
+				comments = append(comments, line)
+			case !present[trimmed]:
+				patterns = append(patterns, line)
+				present[trimmed] = true
+			}
+		}
+
+		if len(patterns) > 0 {
+			missing = append(missing, strings.Join(append(comments, patterns...), "\n"))
+		}
+	}
+
+	if len(missing) == 0 {
+		return existing
+	}
+
+	merged := existing
+	if merged != "" && !strings.HasSuffix(merged, "\n") {
+		merged += "\n"
+	}
+	if merged != "" {
+		merged += "\n"
+	}
+
+	return merged + strings.Join(missing, "\n\n") + "\n"
+}
@@ -0,0 +1,78 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitignoreTemplates_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gitignore/templates":
+			json.NewEncoder(w).Encode([]string{"Go", "Node"})
+		case "/gitignore/templates/Go":
+			json.NewEncoder(w).Encode(map[string]string{"name": "Go", "source": "*.test\n"})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	templates, err := service.ListGitignoreTemplates(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Go", "Node"}, templates)
+
+	source, err := service.GetGitignoreTemplate(context.Background(), "Go")
+	require.NoError(t, err)
+	assert.Equal(t, "*.test\n", source)
+
+	_, err = service.GetGitignoreTemplate(context.Background(), "Cobol")
+	assert.Error(t, err)
+}
+
+func TestGitignoreTemplateName(t *testing.T) {
+	available := []string{"Go", "Node", "Python", "VisualStudio"}
+
+	assert.Equal(t, "Go", GitignoreTemplateName("Go", available))
+	assert.Equal(t, "Python", GitignoreTemplateName("python", available))
+	assert.Equal(t, "Node", GitignoreTemplateName("TypeScript", available))
+	assert.Equal(t, "VisualStudio", GitignoreTemplateName("C#", available))
+	assert.Equal(t, "", GitignoreTemplateName("Shell", available))
+	assert.Equal(t, "", GitignoreTemplateName("", available))
+}
+
+func TestMergeGitignore(t *testing.T) {
+	template := "# Binaries\n*.exe\n*.dll\n\n# Test binary\n*.test\n\n# Coverage\n*.out\n"
+
+	tests := []struct {
+		name     string
+		existing string
+		want     string
+	}{
+		{
+			name:     "appends missing sections and patterns",
+			existing: "*.exe\n.idea/",
+			want:     "*.exe\n.idea/\n\n# Binaries\n*.dll\n\n# Test binary\n*.test\n\n# Coverage\n*.out\n",
+		},
+		{
+			name:     "already covered",
+			existing: "*.exe\n*.dll\n*.test\n*.out\n",
+			want:     "*.exe\n*.dll\n*.test\n*.out\n",
+		},
+		{
+			name:     "empty file",
+			existing: "",
+			want:     "# Binaries\n*.exe\n*.dll\n\n# Test binary\n*.test\n\n# Coverage\n*.out\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MergeGitignore(tt.existing, template))
+		})
+	}
+}
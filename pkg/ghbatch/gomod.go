@@ -0,0 +1,49 @@
+package ghbatch
+
+import (
+	"context"
+
+	"go-repo-manager/internal/gomod"
+)
+
+// GoModule is a parsed go.mod file.
+type GoModule = gomod.Module
+
+// GoModReport is the parsed go.mod file at the root of a repository.
+type GoModReport struct {
+	RepoName string
+	// Module is nil if the repository has no go.mod file.
+	Module *gomod.Module
+	Err    error
+}
+
+// RepoErr implements Result.
+func (g GoModReport) RepoErr() error { return g.Err }
+
+// WithRepoErr implements Result.
+func (GoModReport) WithRepoErr(_, repoName string, err error) GoModReport {
+	return GoModReport{RepoName: repoName, Err: err}
+}
+
+// GetGoModule fetches and parses the go.mod file at the root of a repository's default branch.
+// A repository without a go.mod file yields a nil module.
+func (s *Service) GetGoModule(ctx context.Context, owner, repoName string) (*gomod.Module, error) {
+	content, _, found, err := s.GetFileContent(ctx, owner, repoName, "go.mod")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return gomod.Parse([]byte(content))
+}
+
+// GetGoModReports fetches and parses the go.mod files of all the given repositories.
+func (s *Service) GetGoModReports(ctx context.Context, owner string, repoNames []string) []GoModReport {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) GoModReport {
+		module, err := s.GetGoModule(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get go.mod", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return GoModReport{RepoName: repoName, Module: module, Err: err}
+	})
+}
@@ -0,0 +1,38 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGoModReports_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/service/contents/go.mod":
+			json.NewEncoder(w).Encode(encodedFile("module github.com/testorg/service\n\ngo 1.22\n\nrequire github.com/testorg/lib v1.3.2\n", "sha1"))
+		case "/repos/testorg/broken/contents/go.mod":
+			json.NewEncoder(w).Encode(encodedFile("module github.com/testorg/broken\nrequire (\n", "sha2"))
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	reports := service.GetGoModReports(context.Background(), "testorg", []string{"service", "broken", "frontend"})
+	require.Len(t, reports, 3)
+
+	require.NoError(t, reports[0].Err)
+	require.NotNil(t, reports[0].Module)
+	requirement, found := reports[0].Module.Find("github.com/testorg/lib")
+	assert.True(t, found)
+	assert.Equal(t, "v1.3.2", requirement.Version)
+
+	assert.Error(t, reports[1].Err)
+
+	require.NoError(t, reports[2].Err)
+	assert.Nil(t, reports[2].Module)
+}
@@ -0,0 +1,85 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// graphQLRequest is the body of a GraphQL API call.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// graphQLError is an error reported by the GraphQL API. Path locates the failed field, e.g. the alias of a
+// repository that does not exist; it is empty for errors affecting the whole query.
+type graphQLError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Path    []any  `json:"path"`
+}
+
+// graphQLResponse is the envelope of a GraphQL API response.
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []graphQLError  `json:"errors"`
+}
+
+// graphQLEndpoint returns the GraphQL endpoint relative to the REST base URL. GitHub Enterprise Server serves
+// REST under /api/v3/ and GraphQL under /api/graphql.
+func (s *Service) graphQLEndpoint() string {
+	if strings.HasSuffix(s.client.BaseURL.Path, "/api/v3/") {
+		return "../graphql"
+	}
+
+	return "graphql"
+}
+
+// graphQL runs a GraphQL query and decodes its data into data. Errors tied to a path are returned for the
+// caller to attribute, since GraphQL returns partial data alongside them; errors without a path fail the call.
+func (s *Service) graphQL(ctx context.Context, query string, variables map[string]any, data any) ([]graphQLError, error) {
+	req, err := s.client.NewRequest(http.MethodPost, s.graphQLEndpoint(), &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &graphQLResponse{}
+	if _, err := s.client.Do(ctx, req, response); err != nil {
+		return nil, fmt.Errorf("graphql request failed: %w", err)
+	}
+
+	var fieldErrors []graphQLError
+
+	for _, e := range response.Errors {
+		if len(e.Path) == 0 {
+			return nil, fmt.Errorf("graphql query failed: %s", e.Message)
+		}
+
+		fieldErrors = append(fieldErrors, e)
+	}
+
+	if len(response.Data) > 0 && string(response.Data) != "null" {
+		if err := json.Unmarshal(response.Data, data); err != nil {
+			return nil, fmt.Errorf("failed to decode graphql response: %w", err)
+		}
+	}
+
+	return fieldErrors, nil
+}
+
+// graphQLStrict runs a GraphQL query or mutation whose partial data is of no use, failing on any error.
+func (s *Service) graphQLStrict(ctx context.Context, query string, variables map[string]any, data any) error {
+	fieldErrors, err := s.graphQL(ctx, query, variables, data)
+	if err != nil {
+		return err
+	}
+
+	if len(fieldErrors) > 0 {
+		return fmt.Errorf("graphql query failed: %s", fieldErrors[0].Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// Repository health checks.
+const (
+	HealthCheckReadme         = "readme"
+	HealthCheckDescription    = "description"
+	HealthCheckTopics         = "topics"
+	HealthCheckLicense        = "license"
+	HealthCheckContributing   = "contributing"
+	HealthCheckIssueTemplates = "issue-templates"
+)
+
+// HealthChecks lists all repository health checks in display order.
+var HealthChecks = []string{
+	HealthCheckReadme, HealthCheckDescription, HealthCheckTopics,
+	HealthCheckLicense, HealthCheckContributing, HealthCheckIssueTemplates,
+}
+
+// ContributingPaths are the locations GitHub looks for contributing guidelines.
+var ContributingPaths = []string{".github/CONTRIBUTING.md", "CONTRIBUTING.md", "docs/CONTRIBUTING.md"}
+
+// PullRequestTemplatePaths are the locations GitHub looks for a single pull request template, the
+// preferred one first.
+var PullRequestTemplatePaths = []string{
+	".github/pull_request_template.md", ".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md", "PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md", "docs/PULL_REQUEST_TEMPLATE.md",
+}
+
+// IssueTemplateDir holds the issue forms, templates and template chooser config of a repository.
+const IssueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// legacyIssueTemplatePaths are the single-file issue template locations.
+var legacyIssueTemplatePaths = []string{".github/ISSUE_TEMPLATE.md", "ISSUE_TEMPLATE.md", "docs/ISSUE_TEMPLATE.md"}
+
+// HealthAudit is the result of running health checks against a repository.
+type HealthAudit struct {
+	RepoName string
+	// Passed maps each requested check to whether the repository passed it.
+	Passed map[string]bool
+	Err    error
+}
+
+// RepoErr implements Result.
+func (h HealthAudit) RepoErr() error { return h.Err }
+
+// WithRepoErr implements Result.
+func (HealthAudit) WithRepoErr(_, repoName string, err error) HealthAudit {
+	return HealthAudit{RepoName: repoName, Err: err}
+}
+
+// AuditHealth runs the given health checks against a repository. Description, topics and license are
+// read from the repository itself; the other checks look up files.
+func (s *Service) AuditHealth(ctx context.Context, owner string, repository *github.Repository,
+	checks []string,
+) HealthAudit {
+	repoName := repository.GetName()
+	audit := HealthAudit{RepoName: repoName, Passed: make(map[string]bool, len(checks))}
+
+	for _, check := range checks {
+		passed, err := s.runHealthCheck(ctx, owner, repository, check)
+		if err != nil {
+			audit.Err = err
+
+			return audit
+		}
+
+		audit.Passed[check] = passed
+	}
+
+	return audit
+}
+
+func (s *Service) runHealthCheck(ctx context.Context, owner string, repository *github.Repository,
+	check string,
+) (bool, error) {
+	repoName := repository.GetName()
+
+	switch check {
+	case HealthCheckReadme:
+		_, resp, err := s.client.Repositories.GetReadme(ctx, owner, repoName, nil)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed to get README for %s/%s: %w", owner, repoName, err)
+		}
+
+		return true, nil
+	case HealthCheckDescription:
+		return repository.GetDescription() != "", nil
+	case HealthCheckTopics:
+		return len(repository.Topics) > 0, nil
+	case HealthCheckLicense:
+		return repository.GetLicense() != nil, nil
+	case HealthCheckContributing:
+		path, err := s.findFirstFile(ctx, owner, repoName, ContributingPaths)
+
+		return path != "", err
+	case HealthCheckIssueTemplates:
+		return s.hasIssueTemplates(ctx, owner, repoName)
+	default:
+		return false, fmt.Errorf("unknown health check %q", check)
+	}
+}
+
+// hasIssueTemplates reports whether a repository has issue forms or templates, either in the
+// .github/ISSUE_TEMPLATE directory or as a single legacy template file.
+func (s *Service) hasIssueTemplates(ctx context.Context, owner, repoName string) (bool, error) {
+	_, entries, resp, err := s.client.Repositories.GetContents(ctx, owner, repoName, IssueTemplateDir, nil)
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return false, fmt.Errorf("failed to list %s in %s/%s: %w", IssueTemplateDir, owner, repoName, err)
+	}
+
+	if len(entries) > 0 {
+		return true, nil
+	}
+
+	path, err := s.findFirstFile(ctx, owner, repoName, legacyIssueTemplatePaths)
+
+	return path != "", err
+}
+
+// AuditHealthForRepos runs the given health checks against all the given repositories.
+func (s *Service) AuditHealthForRepos(ctx context.Context, owner string, repos []*github.Repository,
+	checks []string,
+) []HealthAudit {
+	byName := make(map[string]*github.Repository, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) HealthAudit {
+		audit := s.AuditHealth(ctx, owner, byName[repoName], checks)
+		if audit.Err != nil {
+			s.log.Error("Failed to audit repository health", "owner", owner, "repo", repoName, "error", audit.Err)
+		}
+
+		return audit
+	})
+}
@@ -0,0 +1,64 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditHealthForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/healthy/readme":
+			json.NewEncoder(w).Encode(encodedFile("# healthy\n", "sha1"))
+		case "/repos/testorg/healthy/contents/CONTRIBUTING.md":
+			json.NewEncoder(w).Encode(encodedFile("Contributions welcome\n", "sha2"))
+		case "/repos/testorg/healthy/contents/.github/ISSUE_TEMPLATE":
+			json.NewEncoder(w).Encode([]*github.RepositoryContent{
+				{Name: github.String("bug.yml"), Type: github.String("file")},
+			})
+		case "/repos/testorg/legacy/contents/.github/ISSUE_TEMPLATE.md":
+			json.NewEncoder(w).Encode(encodedFile("Describe the bug\n", "sha3"))
+		case "/repos/testorg/broken/readme":
+			http.Error(w, "boom", http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	repos := []*github.Repository{
+		{
+			Name:        github.String("healthy"),
+			Description: github.String("A healthy repository"),
+			Topics:      []string{"go"},
+			License:     &github.License{SPDXID: github.String("MIT")},
+		},
+		{Name: github.String("legacy")},
+		{Name: github.String("broken")},
+	}
+
+	audits := service.AuditHealthForRepos(context.Background(), "testorg", repos, HealthChecks)
+	require.Len(t, audits, 3)
+
+	require.NoError(t, audits[0].Err)
+	for _, check := range HealthChecks {
+		assert.True(t, audits[0].Passed[check], check)
+	}
+
+	require.NoError(t, audits[1].Err)
+	assert.Equal(t, map[string]bool{
+		HealthCheckReadme:         false,
+		HealthCheckDescription:    false,
+		HealthCheckTopics:         false,
+		HealthCheckLicense:        false,
+		HealthCheckContributing:   false,
+		HealthCheckIssueTemplates: true,
+	}, audits[1].Passed)
+
+	assert.Error(t, audits[2].Err)
+}
@@ -0,0 +1,81 @@
+package ghbatch
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// IssueStates are the accepted issue states of issue listings.
+var IssueStates = []string{"open", "closed", "all"}
+
+// ExportedIssue is the flat record of an issue written by issue exports.
+type ExportedIssue struct {
+	RepoName  string     `json:"repository"`
+	Number    int        `json:"number"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	Author    string     `json:"author"`
+	Labels    []string   `json:"labels"`
+	Assignees []string   `json:"assignees"`
+	CreatedAt time.Time  `json:"created_at"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	URL       string     `json:"url"`
+}
+
+// newExportedIssue flattens an issue of a repository.
+func newExportedIssue(repoName string, issue *github.Issue) ExportedIssue {
+	exported := ExportedIssue{
+		RepoName:  repoName,
+		Number:    issue.GetNumber(),
+		Title:     issue.GetTitle(),
+		State:     issue.GetState(),
+		Author:    issue.GetUser().GetLogin(),
+		Labels:    []string{},
+		Assignees: []string{},
+		CreatedAt: issue.GetCreatedAt().Time,
+		URL:       issue.GetHTMLURL(),
+	}
+
+	for _, label := range issue.Labels {
+		exported.Labels = append(exported.Labels, label.GetName())
+	}
+
+	for _, assignee := range issue.Assignees {
+		exported.Assignees = append(exported.Assignees, assignee.GetLogin())
+	}
+
+	if issue.ClosedAt != nil {
+		closed := issue.GetClosedAt().Time
+		exported.ClosedAt = &closed
+	}
+
+	return exported
+}
+
+// StreamIssueExports lists the issues, without pull requests, of each repository in a state, sending the
+// issues of each repository, oldest first, as soon as they are listed.
+func (s *Service) StreamIssueExports(ctx context.Context, owner string, repoNames []string,
+	state string,
+) <-chan RepoResult[[]ExportedIssue] {
+	allIssues := func(*github.Issue) bool { return true }
+
+	return StreamConcurrently(ctx, s.maxConcurrency, owner, repoNames, func(ctx context.Context, repoName string) ([]ExportedIssue, error) {
+		issues, err := s.listMatchingIssues(ctx, owner, repoName, state, time.Time{}, allIssues)
+		if err != nil {
+			s.log.Error("Failed to list issues", "owner", owner, "repo", repoName, "error", err)
+			return nil, err
+		}
+
+		exported := make([]ExportedIssue, 0, len(issues))
+		for _, issue := range issues {
+			exported = append(exported, newExportedIssue(repoName, issue))
+		}
+
+		slices.SortFunc(exported, func(a, b ExportedIssue) int { return a.Number - b.Number })
+
+		return exported, nil
+	})
+}
@@ -0,0 +1,52 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamIssueExports_WithMockServer(t *testing.T) {
+	created := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	closed := created.Add(48 * time.Hour)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/testorg/api/issues" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Equal(t, "closed", r.URL.Query().Get("state"))
+		json.NewEncoder(w).Encode([]*github.Issue{
+			{Number: github.Int(2), Title: github.String("Crash"), State: github.String("closed"),
+				User:      &github.User{Login: github.String("reporter")},
+				Labels:    []*github.Label{{Name: github.String("bug")}},
+				Assignees: []*github.User{{Login: github.String("dev")}},
+				CreatedAt: &github.Timestamp{Time: created}, ClosedAt: &github.Timestamp{Time: closed}},
+			{Number: github.Int(1), State: github.String("closed"), CreatedAt: &github.Timestamp{Time: created}},
+			{Number: github.Int(3), PullRequestLinks: &github.PullRequestLinks{}},
+		})
+	}, 1)
+
+	results := make(map[string]RepoResult[[]ExportedIssue])
+	for result := range service.StreamIssueExports(context.Background(), "testorg", []string{"api", "broken"}, "closed") {
+		results[result.RepoName] = result
+	}
+	require.Len(t, results, 2)
+	assert.Error(t, results["broken"].Err)
+
+	require.NoError(t, results["api"].Err)
+	issues := results["api"].Value
+	require.Len(t, issues, 2)
+	assert.Equal(t, 1, issues[0].Number)
+	assert.Equal(t, ExportedIssue{
+		RepoName: "api", Number: 2, Title: "Crash", State: "closed", Author: "reporter",
+		Labels: []string{"bug"}, Assignees: []string{"dev"}, CreatedAt: created, ClosedAt: &closed,
+	}, issues[1])
+}
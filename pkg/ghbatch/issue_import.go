@@ -0,0 +1,78 @@
+package ghbatch
+
+import (
+	"context"
+	"time"
+)
+
+// IssueImport is an issue to create in a repository of the target owner, read from an import file.
+type IssueImport struct {
+	// Line is the line of the import file the issue was read from, used to report failures.
+	Line     int
+	RepoName string
+	Issue    NewIssue
+}
+
+// IssueImportResult is the outcome of importing a single issue.
+type IssueImportResult struct {
+	IssueImport
+	Number int
+	URL    string
+	Err    error
+}
+
+// ImportIssues creates the given issues one after another in the order of the import file, waiting at least
+// interval between two of them to stay clear of GitHub's secondary rate limits on content creation. Issues
+// are created sequentially so their numbers follow the order of the file. Once ctx is done the remaining
+// issues fail with its error. onResult, when not nil, is called as soon as each issue is created or fails.
+func (s *Service) ImportIssues(ctx context.Context, owner string, imports []IssueImport,
+	interval time.Duration, onResult func(result IssueImportResult),
+) []IssueImportResult {
+	var pace <-chan time.Time
+
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		pace = ticker.C
+	}
+
+	results := make([]IssueImportResult, 0, len(imports))
+
+	for i, issue := range imports {
+		result := IssueImportResult{IssueImport: issue}
+
+		// The first issue is created right away, the following ones wait for the next tick
+		if pace != nil && i > 0 {
+			select {
+			case <-pace:
+			case <-ctx.Done():
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			results = append(results, result)
+
+			continue
+		}
+
+		created, err := s.CreateIssue(ctx, owner, issue.RepoName, issue.Issue)
+		if err != nil {
+			s.log.Error("Failed to import issue", "owner", owner, "repo", issue.RepoName, "line", issue.Line, "error", err)
+			result.Err = err
+		} else {
+			s.log.Info("Imported issue", "owner", owner, "repo", issue.RepoName, "line", issue.Line, "url", created.GetHTMLURL())
+			result.Number = created.GetNumber()
+			result.URL = created.GetHTMLURL()
+		}
+
+		if onResult != nil {
+			onResult(result)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,73 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportIssues_WithMockServer(t *testing.T) {
+	var created []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+		if r.Method != http.MethodPost || repoName == "archived" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+
+		var request github.IssueRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		created = append(created, repoName+":"+request.GetTitle())
+
+		json.NewEncoder(w).Encode(&github.Issue{
+			Number:  github.Int(len(created)),
+			Title:   request.Title,
+			HTMLURL: github.String("https://github.com/testorg/" + repoName + "/issues/1"),
+		})
+	}, 1)
+
+	imports := []IssueImport{
+		{Line: 2, RepoName: "api", Issue: NewIssue{Title: "First", Labels: []string{"bug"}}},
+		{Line: 3, RepoName: "archived", Issue: NewIssue{Title: "Second"}},
+		{Line: 5, RepoName: "web", Issue: NewIssue{Title: "Third"}},
+	}
+
+	var reported []int
+
+	start := time.Now()
+	results := service.ImportIssues(context.Background(), "testorg", imports, 10*time.Millisecond,
+		func(result IssueImportResult) { reported = append(reported, result.Line) })
+
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, []string{"api:First", "web:Third"}, created)
+	assert.Equal(t, []int{2, 3, 5}, reported)
+
+	require.Len(t, results, 3)
+	assert.Equal(t, 2, results[0].Line)
+	assert.Equal(t, "https://github.com/testorg/api/issues/1", results[0].URL)
+	assert.ErrorContains(t, results[1].Err, "failed to create issue in testorg/archived")
+	assert.Equal(t, 2, results[2].Number)
+}
+
+func TestImportIssues_Cancelled(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := service.ImportIssues(ctx, "testorg", []IssueImport{{RepoName: "api"}, {RepoName: "web"}}, time.Hour, nil)
+
+	require.Len(t, results, 2)
+	assert.ErrorIs(t, results[0].Err, context.Canceled)
+	assert.ErrorIs(t, results[1].Err, context.Canceled)
+}
@@ -0,0 +1,47 @@
+package ghbatch
+
+import "github.com/google/go-github/v62/github"
+
+// LabelCounts holds the open and closed issue counts of a label.
+type LabelCounts struct {
+	Open   int
+	Closed int
+}
+
+// Total returns the number of issues carrying the label.
+func (c LabelCounts) Total() int {
+	return c.Open + c.Closed
+}
+
+// countLabels adds an issue to the counts of each of its labels.
+func (s *IssueStats) countLabels(issue *github.Issue) {
+	for _, label := range issue.Labels {
+		if s.Labels == nil {
+			s.Labels = make(map[string]LabelCounts)
+		}
+
+		counts := s.Labels[label.GetName()]
+		if issue.GetState() == "open" {
+			counts.Open++
+		} else {
+			counts.Closed++
+		}
+		s.Labels[label.GetName()] = counts
+	}
+}
+
+// AggregateLabelCounts sums the per-label issue counts of several repositories.
+func AggregateLabelCounts(allStats []*IssueStats) map[string]LabelCounts {
+	aggregated := make(map[string]LabelCounts)
+
+	for _, stats := range allStats {
+		for label, counts := range stats.Labels {
+			total := aggregated[label]
+			total.Open += counts.Open
+			total.Closed += counts.Closed
+			aggregated[label] = total
+		}
+	}
+
+	return aggregated
+}
@@ -0,0 +1,29 @@
+package ghbatch
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueStats_CountLabels(t *testing.T) {
+	stats := &IssueStats{}
+
+	stats.countLabels(&github.Issue{State: stringPtr("open"), Labels: []*github.Label{{Name: stringPtr("bug")}, {Name: stringPtr("ui")}}})
+	stats.countLabels(&github.Issue{State: stringPtr("closed"), Labels: []*github.Label{{Name: stringPtr("bug")}}})
+	stats.countLabels(&github.Issue{State: stringPtr("open")})
+
+	assert.Equal(t, map[string]LabelCounts{"bug": {Open: 1, Closed: 1}, "ui": {Open: 1}}, stats.Labels)
+}
+
+func TestAggregateLabelCounts(t *testing.T) {
+	aggregated := AggregateLabelCounts([]*IssueStats{
+		{RepoName: "api", Labels: map[string]LabelCounts{"bug": {Open: 2, Closed: 3}, "enhancement": {Open: 1}}},
+		{RepoName: "web", Labels: map[string]LabelCounts{"bug": {Open: 1}}},
+		{RepoName: "docs"},
+	})
+
+	assert.Equal(t, map[string]LabelCounts{"bug": {Open: 3, Closed: 3}, "enhancement": {Open: 1}}, aggregated)
+	assert.Equal(t, 6, aggregated["bug"].Total())
+}
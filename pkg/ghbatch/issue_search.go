@@ -0,0 +1,102 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// issueTypeQualifier matches the search qualifiers choosing between issues and pull requests.
+var issueTypeQualifier = regexp.MustCompile(`(^|\s)-?(is|type):(issue|pr|pull-request)\b`)
+
+// IssueSearchResult is an issue found by SearchIssues.
+type IssueSearchResult struct {
+	RepoName string   `json:"repository"`
+	Number   int      `json:"number"`
+	Title    string   `json:"title"`
+	State    string   `json:"state"`
+	URL      string   `json:"url"`
+	Labels   []string `json:"labels"`
+}
+
+// IssueSearch is the outcome of SearchIssues. Truncated is set when the Search API did not return every
+// match, because there were more than it returns or because it reported incomplete results.
+type IssueSearch struct {
+	Query     string
+	Total     int
+	Truncated bool
+	Issues    []IssueSearchResult
+}
+
+// issueSearchQuery scopes a search query to a single repository, or to all repositories of the owner, and
+// restricts it to issues unless it already chooses between issues and pull requests.
+func issueSearchQuery(owner string, isUser bool, repoNames []string, query string) string {
+	scope := "org:" + owner
+	switch {
+	case len(repoNames) == 1:
+		scope = "repo:" + owner + "/" + repoNames[0]
+	case isUser:
+		scope = "user:" + owner
+	}
+
+	if !issueTypeQualifier.MatchString(query) {
+		query += " is:issue"
+	}
+
+	return scope + " " + query
+}
+
+// SearchIssues runs an issue search query over the given repositories of an owner. The Search API cannot
+// take a long list of repositories, so the query covers all repositories of the owner and the results are
+// filtered locally.
+func (s *Service) SearchIssues(ctx context.Context, owner string, isUser bool, repoNames []string,
+	query string,
+) (IssueSearch, error) {
+	search := IssueSearch{Query: issueSearchQuery(owner, isUser, repoNames, query)}
+	s.log.Info("Searching issues", "query", search.Query)
+
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	for {
+		result, resp, err := s.client.Search.Issues(ctx, search.Query, opts)
+		if err != nil {
+			return search, fmt.Errorf("failed to search issues for %s: %w", owner, err)
+		}
+
+		search.Total = result.GetTotal()
+		search.Truncated = search.Truncated || result.GetIncompleteResults() || search.Total > searchResultLimit
+
+		for _, issue := range result.Issues {
+			repoName := path.Base(issue.GetRepositoryURL())
+			if !slices.Contains(repoNames, repoName) {
+				continue
+			}
+
+			match := IssueSearchResult{
+				RepoName: repoName,
+				Number:   issue.GetNumber(),
+				Title:    issue.GetTitle(),
+				State:    issue.GetState(),
+				URL:      issue.GetHTMLURL(),
+				Labels:   []string{},
+			}
+			for _, label := range issue.Labels {
+				match.Labels = append(match.Labels, label.GetName())
+			}
+
+			search.Issues = append(search.Issues, match)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return search, nil
+}
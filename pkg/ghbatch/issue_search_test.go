@@ -0,0 +1,45 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueSearchQuery(t *testing.T) {
+	assert.Equal(t, "org:testorg label:bug is:issue", issueSearchQuery("testorg", false, []string{"api", "web"}, "label:bug"))
+	assert.Equal(t, "user:octocat label:bug is:issue", issueSearchQuery("octocat", true, []string{"api", "web"}, "label:bug"))
+	assert.Equal(t, "repo:testorg/api is:pr review:required", issueSearchQuery("testorg", false, []string{"api"}, "is:pr review:required"))
+	assert.Equal(t, "org:testorg label:this-is:issue is:issue", issueSearchQuery("testorg", false, nil, "label:this-is:issue"))
+}
+
+func TestSearchIssues_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search/issues", r.URL.Path)
+		assert.Equal(t, "org:testorg label:security state:open is:issue", r.URL.Query().Get("q"))
+
+		json.NewEncoder(w).Encode(github.IssuesSearchResult{
+			Total:             github.Int(1200),
+			IncompleteResults: github.Bool(false),
+			Issues: []*github.Issue{
+				{Number: github.Int(7), Title: github.String("CVE"), State: github.String("open"),
+					RepositoryURL: github.String("https://api.github.com/repos/testorg/api"),
+					Labels:        []*github.Label{{Name: github.String("security")}}},
+				{Number: github.Int(8), RepositoryURL: github.String("https://api.github.com/repos/testorg/other")},
+			},
+		})
+	}, 1)
+
+	search, err := service.SearchIssues(context.Background(), "testorg", false, []string{"api", "web"}, "label:security state:open")
+	require.NoError(t, err)
+
+	assert.True(t, search.Truncated)
+	assert.Equal(t, 1200, search.Total)
+	require.Len(t, search.Issues, 1)
+	assert.Equal(t, IssueSearchResult{RepoName: "api", Number: 7, Title: "CVE", State: "open", Labels: []string{"security"}}, search.Issues[0])
+}
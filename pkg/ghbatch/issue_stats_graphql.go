@@ -0,0 +1,192 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// graphQLBatchSize is the number of repositories counted in a single GraphQL query.
+const graphQLBatchSize = 50
+
+// issueCounts holds the aliased issue totals of a repository in a GraphQL response.
+type issueCounts struct {
+	Open   struct{ TotalCount int } `json:"open"`
+	Closed struct{ TotalCount int } `json:"closed"`
+}
+
+// supportsGraphQL reports whether the GraphQL issue filters can express the filter. They cannot bound the
+// creation date nor select unassigned issues. Their labels match issues carrying any of the labels rather
+// than all of them, and their milestone number has no equivalent of "none" or "*".
+func (f IssueStatsFilter) supportsGraphQL() bool {
+	return f.Since.IsZero() && f.Until.IsZero() && f.Assignee != "none" && len(f.Labels) <= 1 &&
+		f.Milestone != "none" && f.Milestone != "*"
+}
+
+// GetIssueStatsGraphQL counts the open and closed issues of repositories with GraphQL totalCount aggregates,
+// batching up to 50 repositories per query instead of listing every issue. Filters GraphQL cannot express
+// are counted with the REST implementation instead. Repositories that cannot be counted are logged and left
+// out, as with GetIssueStatsForReposWithPrefix.
+func (s *Service) GetIssueStatsGraphQL(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) ([]*IssueStats, error) {
+	if !filter.supportsGraphQL() {
+		s.log.Warn("Date range, unassigned, multiple label and none or any milestone filters are not supported by GraphQL, counting with REST")
+
+		return s.collectIssueStats(CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoResult[*IssueStats] {
+			stats, err := s.GetIssueStatsForRepo(ctx, owner, repoName, filter)
+
+			return RepoResult[*IssueStats]{Owner: owner, RepoName: repoName, Value: stats, Err: err}
+		})), nil
+	}
+
+	var allStats []*IssueStats
+
+	for start := 0; start < len(repoNames); start += graphQLBatchSize {
+		batch := repoNames[start:min(start+graphQLBatchSize, len(repoNames))]
+
+		stats, err := s.countIssuesGraphQL(ctx, owner, batch, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		allStats = append(allStats, stats...)
+	}
+
+	return allStats, nil
+}
+
+// countIssuesGraphQL counts the issues of a batch of repositories in a single query, one alias per repository.
+func (s *Service) countIssuesGraphQL(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) ([]*IssueStats, error) {
+	s.log.Info("Fetching issue counts with GraphQL", "owner", owner, "repos", len(repoNames))
+
+	var (
+		declarations []string
+		fields       []string
+	)
+
+	variables := map[string]any{"owner": owner}
+	// aliases maps each queried repository to its alias; unmatched holds the repositories that cannot have
+	// matching issues and need no query
+	aliases := make(map[string]string, len(repoNames))
+	unmatched := make(map[string]bool)
+
+	for i, repoName := range repoNames {
+		filterBy, ok, err := s.graphQLIssueFilters(ctx, owner, repoName, filter)
+		if err != nil {
+			s.log.Error("Error fetching repository stats", "owner", owner, "repo", repoName, "error", err)
+
+			continue
+		}
+
+		// A milestone missing from this repository means none of its issues can match
+		if !ok {
+			unmatched[repoName] = true
+
+			continue
+		}
+
+		alias := fmt.Sprintf("r%d", i)
+		aliases[repoName] = alias
+		declarations = append(declarations, fmt.Sprintf("$%s_name: String!, $%s_filter: IssueFilters", alias, alias))
+		fields = append(fields, fmt.Sprintf(`%[1]s: repository(owner: $owner, name: $%[1]s_name) {
+    open: issues(states: OPEN, filterBy: $%[1]s_filter) { totalCount }
+    closed: issues(states: CLOSED, filterBy: $%[1]s_filter) { totalCount }
+  }`, alias))
+		variables[alias+"_name"] = repoName
+		variables[alias+"_filter"] = filterBy
+	}
+
+	data := map[string]*issueCounts{}
+
+	var fieldErrors []graphQLError
+
+	if len(fields) > 0 {
+		query := fmt.Sprintf("query($owner: String!, %s) {\n  %s\n}", strings.Join(declarations, ", "), strings.Join(fields, "\n  "))
+
+		var err error
+
+		fieldErrors, err = s.graphQL(ctx, query, variables, &data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count issues for %s: %w", owner, err)
+		}
+	}
+
+	for _, e := range fieldErrors {
+		s.log.Error("Error fetching repository stats", "owner", owner, "path", e.Path, "error", e.Message)
+	}
+
+	var allStats []*IssueStats
+
+	for _, repoName := range repoNames {
+		if unmatched[repoName] {
+			allStats = append(allStats, &IssueStats{Owner: owner, RepoName: repoName})
+
+			continue
+		}
+
+		counts := data[aliases[repoName]]
+		if counts == nil {
+			continue
+		}
+
+		allStats = append(allStats, &IssueStats{
+			Owner:        owner,
+			RepoName:     repoName,
+			TotalIssues:  counts.Open.TotalCount + counts.Closed.TotalCount,
+			OpenIssues:   counts.Open.TotalCount,
+			ClosedIssues: counts.Closed.TotalCount,
+		})
+	}
+
+	return allStats, nil
+}
+
+// graphQLIssueFilters converts the filter into GraphQL IssueFilters for a repository. It returns false when
+// the filter names a milestone the repository does not have.
+func (s *Service) graphQLIssueFilters(ctx context.Context, owner, repoName string,
+	filter IssueStatsFilter,
+) (map[string]any, bool, error) {
+	filterBy := map[string]any{}
+
+	if len(filter.Labels) > 0 {
+		filterBy["labels"] = filter.Labels
+	}
+
+	if filter.Assignee != "" {
+		filterBy["assignee"] = filter.Assignee
+	}
+
+	if filter.Milestone != "" {
+		milestone, err := s.resolveMilestone(ctx, owner, repoName, filter.Milestone)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if milestone == "" {
+			return nil, false, nil
+		}
+
+		filterBy["milestoneNumber"] = milestone
+	}
+
+	return filterBy, true, nil
+}
+
+// collectIssueStats logs and drops the repositories that could not be counted.
+func (s *Service) collectIssueStats(results []RepoResult[*IssueStats]) []*IssueStats {
+	var allStats []*IssueStats
+
+	for _, result := range results {
+		if result.Err != nil {
+			s.log.Error("Error fetching repository stats", "owner", result.Owner, "repo", result.RepoName, "error", result.Err)
+			continue
+		}
+
+		allStats = append(allStats, result.Value)
+	}
+
+	return allStats
+}
@@ -0,0 +1,103 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueStatsGraphQL(t *testing.T) {
+	var queries int
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/graphql":
+			queries++
+
+			var request graphQLRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+			assert.Equal(t, "testorg", request.Variables["owner"])
+			assert.Equal(t, "api", request.Variables["r0_name"])
+			assert.Equal(t, map[string]any{"labels": []any{"bug"}, "milestoneNumber": "3"}, request.Variables["r0_filter"])
+			assert.Contains(t, request.Query, "r1: repository(owner: $owner, name: $r1_name)")
+
+			json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"r0": map[string]any{"open": map[string]int{"totalCount": 4}, "closed": map[string]int{"totalCount": 6}},
+					"r1": nil,
+				},
+				"errors": []map[string]any{
+					{"type": "NOT_FOUND", "path": []string{"r1"}, "message": "Could not resolve to a Repository"},
+				},
+			})
+		case "/repos/testorg/api/milestones", "/repos/testorg/missing/milestones":
+			json.NewEncoder(w).Encode([]*github.Milestone{{Title: github.String("v1"), Number: github.Int(3)}})
+		case "/repos/testorg/web/milestones":
+			json.NewEncoder(w).Encode([]*github.Milestone{})
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	filter := IssueStatsFilter{Labels: []string{"bug"}, Milestone: "v1"}
+	stats, err := service.GetIssueStatsGraphQL(context.Background(), "testorg", []string{"api", "missing", "web"}, filter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, queries)
+	require.Len(t, stats, 2)
+	assert.Equal(t, IssueStats{Owner: "testorg", RepoName: "api", TotalIssues: 10, OpenIssues: 4, ClosedIssues: 6}, *stats[0])
+	// The milestone does not exist in web, so none of its issues can match
+	assert.Equal(t, IssueStats{Owner: "testorg", RepoName: "web"}, *stats[1])
+}
+
+func TestGetIssueStatsGraphQL_QueryError(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"errors": []map[string]any{{"message": "Parse error"}},
+		})
+	}, 1)
+
+	_, err := service.GetIssueStatsGraphQL(context.Background(), "testorg", []string{"api"}, IssueStatsFilter{})
+	assert.ErrorContains(t, err, "Parse error")
+}
+
+func TestGetIssueStatsGraphQL_FallsBackToREST(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter IssueStatsFilter
+		param  string
+		want   string
+	}{
+		{"unassigned", IssueStatsFilter{Assignee: "none"}, "assignee", "none"},
+		{"all of several labels", IssueStatsFilter{Labels: []string{"bug", "security"}}, "labels", "bug,security"},
+		{"no milestone", IssueStatsFilter{Milestone: "none"}, "milestone", "none"},
+		{"any milestone", IssueStatsFilter{Milestone: "*"}, "milestone", "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/repos/testorg/api":
+					json.NewEncoder(w).Encode(&github.Repository{Name: github.String("api")})
+				case "/repos/testorg/api/issues":
+					assert.Equal(t, tt.want, r.URL.Query().Get(tt.param))
+					json.NewEncoder(w).Encode([]*github.Issue{{State: github.String("open")}})
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+					http.NotFound(w, r)
+				}
+			}, 1)
+
+			stats, err := service.GetIssueStatsGraphQL(context.Background(), "testorg", []string{"api"}, tt.filter)
+			require.NoError(t, err)
+			require.Len(t, stats, 1)
+			assert.Equal(t, 1, stats[0].OpenIssues)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package ghbatch
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplateConfig is the template chooser configuration in the issue template directory.
+const issueTemplateConfig = "config.yml"
+
+// ValidateIssueTemplate checks a file of the issue template directory before it is pushed: issue forms
+// must be YAML with the name, description and body keys GitHub requires, config.yml must be a YAML mapping
+// and Markdown templates are taken as they are.
+func ValidateIssueTemplate(fileName, content string) error {
+	switch strings.ToLower(path.Ext(fileName)) {
+	case ".md":
+		return nil
+	case ".yml", ".yaml":
+	default:
+		return fmt.Errorf("issue template %s must be a .yml, .yaml or .md file", fileName)
+	}
+
+	var document map[string]any
+	if err := yaml.Unmarshal([]byte(content), &document); err != nil {
+		return fmt.Errorf("issue template %s is not valid YAML: %w", fileName, err)
+	}
+
+	if fileName == issueTemplateConfig {
+		return nil
+	}
+
+	for _, key := range []string{"name", "description", "body"} {
+		if document[key] == nil {
+			return fmt.Errorf("issue form %s is missing the required key %q", fileName, key)
+		}
+	}
+
+	if _, ok := document["body"].([]any); !ok {
+		return fmt.Errorf("issue form %s: body must be a list of form elements", fileName)
+	}
+
+	return nil
+}
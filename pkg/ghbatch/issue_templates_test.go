@@ -0,0 +1,66 @@
+package ghbatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIssueTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		wantErr  string
+	}{
+		{
+			name:     "Issue form",
+			fileName: "bug.yml",
+			content:  "name: Bug report\ndescription: File a bug\nbody:\n  - type: textarea\n    attributes:\n      label: What happened?\n",
+		},
+		{
+			name:     "Config",
+			fileName: "config.yml",
+			content:  "blank_issues_enabled: false\n",
+		},
+		{
+			name:     "Markdown template",
+			fileName: "feature.md",
+			content:  "---\nname: Feature\n---\nDescribe the feature\n",
+		},
+		{
+			name:     "Missing description",
+			fileName: "bug.yml",
+			content:  "name: Bug report\nbody: []\n",
+			wantErr:  `issue form bug.yml is missing the required key "description"`,
+		},
+		{
+			name:     "Body is not a list",
+			fileName: "bug.yaml",
+			content:  "name: Bug\ndescription: Bug\nbody: text\n",
+			wantErr:  "body must be a list of form elements",
+		},
+		{
+			name:     "Invalid YAML",
+			fileName: "bug.yml",
+			content:  "name: [unclosed\n",
+			wantErr:  "not valid YAML",
+		},
+		{
+			name:     "Unsupported extension",
+			fileName: "notes.txt",
+			wantErr:  "must be a .yml, .yaml or .md file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIssueTemplate(tt.fileName, tt.content)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
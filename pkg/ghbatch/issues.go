@@ -0,0 +1,588 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// IssueQuery selects issues of a repository.
+type IssueQuery struct {
+	// State is "open", "closed" or "all"; empty means open.
+	State string
+	// Labels restricts the query to issues carrying all of these labels.
+	Labels []string
+	// ExemptLabels excludes issues carrying any of these labels.
+	ExemptLabels []string
+	// TitlePattern restricts the query to issues whose title matches when not nil.
+	TitlePattern *regexp.Regexp
+	// UpdatedBefore restricts the query to issues without activity since this time when not zero.
+	UpdatedBefore time.Time
+	// ClosedBefore restricts the query to issues closed before this time when not zero.
+	ClosedBefore time.Time
+	// Unlocked excludes issues whose conversation is already locked.
+	Unlocked bool
+	// IncludePullRequests also returns pull requests, which the issues API lists alongside issues.
+	IncludePullRequests bool
+	// OnlyPullRequests returns pull requests only. It implies IncludePullRequests.
+	OnlyPullRequests bool
+}
+
+// Matches reports whether an issue satisfies the client-side parts of the query.
+func (q IssueQuery) Matches(issue *github.Issue) bool {
+	if issue.IsPullRequest() && !q.IncludePullRequests && !q.OnlyPullRequests {
+		return false
+	}
+
+	if q.OnlyPullRequests && !issue.IsPullRequest() {
+		return false
+	}
+
+	if q.TitlePattern != nil && !q.TitlePattern.MatchString(issue.GetTitle()) {
+		return false
+	}
+
+	if !q.UpdatedBefore.IsZero() && !issue.GetUpdatedAt().Before(q.UpdatedBefore) {
+		return false
+	}
+
+	if !q.ClosedBefore.IsZero() && (issue.ClosedAt == nil || !issue.GetClosedAt().Before(q.ClosedBefore)) {
+		return false
+	}
+
+	if q.Unlocked && issue.GetLocked() {
+		return false
+	}
+
+	for _, label := range issue.Labels {
+		for _, exempt := range q.ExemptLabels {
+			if label.GetName() == exempt {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// IssueResult is the outcome of an operation on a single issue.
+type IssueResult struct {
+	Number int
+	Title  string
+	URL    string
+	Err    error
+}
+
+// RepoIssueResults groups the issue results of a repository. Err is set when the repository itself
+// could not be processed, e.g. because its issues could not be listed.
+type RepoIssueResults struct {
+	RepoName string
+	Issues   []IssueResult
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RepoIssueResults) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoIssueResults) WithRepoErr(_, repoName string, err error) RepoIssueResults {
+	return RepoIssueResults{RepoName: repoName, Err: err}
+}
+
+// StaleIssueOptions configures how stale issues are closed.
+type StaleIssueOptions struct {
+	// UpdatedBefore is the cutoff: open issues without activity since then are stale.
+	UpdatedBefore time.Time
+	// Comment is posted on each issue before closing it when not empty.
+	Comment string
+	// Label is added to each issue before closing it when not empty.
+	Label string
+	// ExemptLabels protects issues carrying any of these labels.
+	ExemptLabels []string
+	// DryRun only lists the stale issues without changing them.
+	DryRun bool
+}
+
+// IssueLockReasons lists the reasons a conversation can be locked with.
+var IssueLockReasons = []string{"off-topic", "too heated", "resolved", "spam"}
+
+// IssueLockOptions configures which closed issues get their conversation locked.
+type IssueLockOptions struct {
+	// ClosedBefore is the cutoff: issues closed before then are locked.
+	ClosedBefore time.Time
+	// Reason is one of IssueLockReasons, or empty to lock without a reason.
+	Reason string
+	// ExemptLabels protects issues carrying any of these labels.
+	ExemptLabels []string
+	// DryRun only lists the issues to lock without changing them.
+	DryRun bool
+}
+
+// IssueLabelOptions configures which labels are applied to and removed from matching issues.
+type IssueLabelOptions struct {
+	// Query selects the issues to relabel.
+	Query IssueQuery
+	// Add lists the labels to apply.
+	Add []string
+	// Remove lists the labels to remove.
+	Remove []string
+	// DryRun only lists the matching issues without changing them.
+	DryRun bool
+}
+
+// IssueAssigneeOptions configures which users are assigned to and unassigned from matching issues.
+type IssueAssigneeOptions struct {
+	// Query selects the issues to reassign.
+	Query IssueQuery
+	// Add lists the logins to assign.
+	Add []string
+	// Remove lists the logins to unassign.
+	Remove []string
+	// DryRun only lists the matching issues without changing them.
+	DryRun bool
+}
+
+// IssueCommentOptions configures the comment posted on matching issues.
+type IssueCommentOptions struct {
+	// Query selects the issues and pull requests to comment on.
+	Query IssueQuery
+	// Render produces the comment body for an issue.
+	Render func(repoName string, issue *github.Issue) (string, error)
+	// Interval is the minimum time between two comments across all repositories, to stay clear of
+	// GitHub's secondary rate limits on content creation. Zero disables pacing.
+	Interval time.Duration
+	// DryRun renders the comments without posting them.
+	DryRun bool
+	// Done, when set, reports the issues already commented on, e.g. by an interrupted run. They are left out.
+	Done func(repoName string, number int) bool
+	// OnResult, when set, is called with the result of each comment as soon as it is posted or fails.
+	OnResult func(repoName string, result IssueResult)
+}
+
+// NewIssue describes an issue to open.
+type NewIssue struct {
+	Title     string
+	Body      string
+	Labels    []string
+	Assignees []string
+}
+
+// ListIssues lists the issues of a repository matching the query.
+func (s *Service) ListIssues(ctx context.Context, owner, repoName string, query IssueQuery) ([]*github.Issue, error) {
+	state := query.State
+	if state == "" {
+		state = "open"
+	}
+
+	opts := &github.IssueListByRepoOptions{
+		State:     state,
+		Labels:    query.Labels,
+		Sort:      "updated",
+		Direction: "asc",
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var matching []*github.Issue
+
+	for {
+		issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, issue := range issues {
+			if query.Matches(issue) {
+				matching = append(matching, issue)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matching, nil
+}
+
+// resolveMilestone converts a milestone given by number, title, "none" or "*" into the value the issues API
+// expects. An empty result means the repository has no milestone with the given title.
+func (s *Service) resolveMilestone(ctx context.Context, owner, repoName, milestone string) (string, error) {
+	if milestone == "none" || milestone == "*" {
+		return milestone, nil
+	}
+
+	if _, err := strconv.Atoi(milestone); err == nil {
+		return milestone, nil
+	}
+
+	found, err := s.findMilestone(ctx, owner, repoName, milestone)
+	if err != nil || found == nil {
+		return "", err
+	}
+
+	return strconv.Itoa(found.GetNumber()), nil
+}
+
+// CreateIssue opens an issue in a repository.
+func (s *Service) CreateIssue(ctx context.Context, owner, repoName string, issue NewIssue) (*github.Issue, error) {
+	request := &github.IssueRequest{
+		Title: github.String(issue.Title),
+		Body:  github.String(issue.Body),
+	}
+
+	if len(issue.Labels) > 0 {
+		request.Labels = &issue.Labels
+	}
+
+	if len(issue.Assignees) > 0 {
+		request.Assignees = &issue.Assignees
+	}
+
+	created, _, err := s.client.Issues.Create(ctx, owner, repoName, request)
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreateIssue, Owner: owner, Repo: repoName,
+		Target: issueTarget(created.GetNumber(), issue.Title)}, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue in %s/%s: %w", owner, repoName, err)
+	}
+
+	return created, nil
+}
+
+// CreateIssues opens a rendered issue in every given repository. onResult, when not nil, is called as soon
+// as each repository completes.
+func (s *Service) CreateIssues(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (NewIssue, error), onResult RepoResultFunc,
+) []RepoIssueResults {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoIssueResults {
+		result := RepoIssueResults{RepoName: repoName}
+
+		issue, err := render(repoName)
+		if err == nil {
+			var created *github.Issue
+
+			created, err = s.CreateIssue(ctx, owner, repoName, issue)
+			if err == nil {
+				s.log.Info("Created issue", "owner", owner, "repo", repoName, "url", created.GetHTMLURL())
+				result.Issues = []IssueResult{{Number: created.GetNumber(), Title: created.GetTitle(), URL: created.GetHTMLURL()}}
+			}
+		}
+
+		if err != nil {
+			s.log.Error("Failed to create issue", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+		}
+
+		if onResult != nil {
+			onResult(repoName, err)
+		}
+
+		return result
+	})
+}
+
+// CommentOnIssue posts a comment on an issue or pull request.
+func (s *Service) CommentOnIssue(ctx context.Context, owner, repoName string, number int, body string) error {
+	_, _, err := s.client.Issues.CreateComment(ctx, owner, repoName, number, &github.IssueComment{Body: github.String(body)})
+	s.recordMutation(ctx, audit.Record{Operation: OperationComment, Owner: owner, Repo: repoName, Target: issueTarget(number, "")}, err)
+	if err != nil {
+		return fmt.Errorf("failed to comment on %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// AddLabelsToIssue adds labels to an issue or pull request.
+func (s *Service) AddLabelsToIssue(ctx context.Context, owner, repoName string, number int, labels []string) error {
+	_, _, err := s.client.Issues.AddLabelsToIssue(ctx, owner, repoName, number, labels)
+	s.recordMutation(ctx, audit.Record{Operation: OperationAddLabels, Owner: owner, Repo: repoName,
+		Target: issueTarget(number, strings.Join(labels, ","))}, err)
+	if err != nil {
+		return fmt.Errorf("failed to add labels to %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// RemoveLabelFromIssue removes a label from an issue or pull request.
+func (s *Service) RemoveLabelFromIssue(ctx context.Context, owner, repoName string, number int, label string) error {
+	_, err := s.client.Issues.RemoveLabelForIssue(ctx, owner, repoName, number, label)
+	s.recordMutation(ctx, audit.Record{Operation: OperationRemoveLabel, Owner: owner, Repo: repoName, Target: issueTarget(number, label)}, err)
+	if err != nil {
+		return fmt.Errorf("failed to remove label %q from %s/%s#%d: %w", label, owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// CloseIssue closes an issue with the given state reason ("completed" or "not_planned").
+func (s *Service) CloseIssue(ctx context.Context, owner, repoName string, number int, reason string) error {
+	request := &github.IssueRequest{State: github.String("closed")}
+	if reason != "" {
+		request.StateReason = github.String(reason)
+	}
+
+	_, _, err := s.client.Issues.Edit(ctx, owner, repoName, number, request)
+	s.recordMutation(ctx, audit.Record{Operation: OperationCloseIssue, Owner: owner, Repo: repoName, Target: issueTarget(number, reason)}, err)
+	if err != nil {
+		return fmt.Errorf("failed to close %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// CloseStaleIssues closes the stale issues of all the given repositories.
+func (s *Service) CloseStaleIssues(ctx context.Context, owner string, repoNames []string,
+	opts StaleIssueOptions,
+) []RepoIssueResults {
+	query := IssueQuery{State: "open", ExemptLabels: opts.ExemptLabels, UpdatedBefore: opts.UpdatedBefore}
+
+	return s.forEachIssue(ctx, owner, repoNames, query, opts.DryRun,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			return s.closeStaleIssue(ctx, owner, repoName, issue.GetNumber(), opts)
+		})
+}
+
+func (s *Service) closeStaleIssue(ctx context.Context, owner, repoName string, number int, opts StaleIssueOptions) error {
+	s.log.Info("Closing stale issue", "owner", owner, "repo", repoName, "issue", number)
+
+	if opts.Label != "" {
+		if err := s.AddLabelsToIssue(ctx, owner, repoName, number, []string{opts.Label}); err != nil {
+			return err
+		}
+	}
+
+	if opts.Comment != "" {
+		if err := s.CommentOnIssue(ctx, owner, repoName, number, opts.Comment); err != nil {
+			return err
+		}
+	}
+
+	return s.CloseIssue(ctx, owner, repoName, number, "not_planned")
+}
+
+// LockIssue locks the conversation of an issue or pull request with an optional reason.
+func (s *Service) LockIssue(ctx context.Context, owner, repoName string, number int, reason string) error {
+	var opts *github.LockIssueOptions
+	if reason != "" {
+		opts = &github.LockIssueOptions{LockReason: reason}
+	}
+
+	_, err := s.client.Issues.Lock(ctx, owner, repoName, number, opts)
+	s.recordMutation(ctx, audit.Record{Operation: OperationLockIssue, Owner: owner, Repo: repoName, Target: issueTarget(number, reason)}, err)
+	if err != nil {
+		return fmt.Errorf("failed to lock %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// LockIssues locks the conversations of the issues closed before the cutoff in all the given repositories.
+func (s *Service) LockIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueLockOptions,
+) []RepoIssueResults {
+	query := IssueQuery{State: "closed", ExemptLabels: opts.ExemptLabels, ClosedBefore: opts.ClosedBefore, Unlocked: true}
+
+	return s.forEachIssue(ctx, owner, repoNames, query, opts.DryRun,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			s.log.Info("Locking issue", "owner", owner, "repo", repoName, "issue", issue.GetNumber())
+			return s.LockIssue(ctx, owner, repoName, issue.GetNumber(), opts.Reason)
+		})
+}
+
+// LabelIssues applies and removes labels on the matching issues of all the given repositories.
+func (s *Service) LabelIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueLabelOptions,
+) []RepoIssueResults {
+	return s.forEachIssue(ctx, owner, repoNames, opts.Query, opts.DryRun,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			return s.relabelIssue(ctx, owner, repoName, issue, opts)
+		})
+}
+
+// relabelIssue only sends the label changes the issue actually needs.
+func (s *Service) relabelIssue(ctx context.Context, owner, repoName string, issue *github.Issue,
+	opts IssueLabelOptions,
+) error {
+	present := make(map[string]bool, len(issue.Labels))
+	for _, label := range issue.Labels {
+		present[label.GetName()] = true
+	}
+
+	var missing []string
+
+	for _, label := range opts.Add {
+		if !present[label] {
+			missing = append(missing, label)
+		}
+	}
+
+	if len(missing) > 0 {
+		if err := s.AddLabelsToIssue(ctx, owner, repoName, issue.GetNumber(), missing); err != nil {
+			return err
+		}
+	}
+
+	for _, label := range opts.Remove {
+		if !present[label] {
+			continue
+		}
+
+		if err := s.RemoveLabelFromIssue(ctx, owner, repoName, issue.GetNumber(), label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AssignIssues adds and removes assignees on the matching issues of all the given repositories.
+func (s *Service) AssignIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueAssigneeOptions,
+) []RepoIssueResults {
+	return s.forEachIssue(ctx, owner, repoNames, opts.Query, opts.DryRun,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			return s.reassignIssue(ctx, owner, repoName, issue, opts)
+		})
+}
+
+// reassignIssue only sends the assignee changes the issue actually needs.
+func (s *Service) reassignIssue(ctx context.Context, owner, repoName string, issue *github.Issue,
+	opts IssueAssigneeOptions,
+) error {
+	assigned := make(map[string]bool, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		assigned[strings.ToLower(assignee.GetLogin())] = true
+	}
+
+	var add, remove []string
+
+	for _, login := range opts.Add {
+		if !assigned[strings.ToLower(login)] {
+			add = append(add, login)
+		}
+	}
+
+	for _, login := range opts.Remove {
+		if assigned[strings.ToLower(login)] {
+			remove = append(remove, login)
+		}
+	}
+
+	if len(add) > 0 {
+		_, _, err := s.client.Issues.AddAssignees(ctx, owner, repoName, issue.GetNumber(), add)
+		s.recordMutation(ctx, audit.Record{Operation: OperationAssign, Owner: owner, Repo: repoName,
+			Target: issueTarget(issue.GetNumber(), strings.Join(add, ","))}, err)
+		if err != nil {
+			return fmt.Errorf("failed to assign %s to %s/%s#%d: %w", strings.Join(add, ", "), owner, repoName, issue.GetNumber(), err)
+		}
+	}
+
+	if len(remove) > 0 {
+		_, _, err := s.client.Issues.RemoveAssignees(ctx, owner, repoName, issue.GetNumber(), remove)
+		s.recordMutation(ctx, audit.Record{Operation: OperationUnassign, Owner: owner, Repo: repoName,
+			Target: issueTarget(issue.GetNumber(), strings.Join(remove, ","))}, err)
+		if err != nil {
+			return fmt.Errorf("failed to unassign %s from %s/%s#%d: %w", strings.Join(remove, ", "), owner, repoName, issue.GetNumber(), err)
+		}
+	}
+
+	return nil
+}
+
+// CommentOnIssues posts a rendered comment on the matching issues of all the given repositories.
+func (s *Service) CommentOnIssues(ctx context.Context, owner string, repoNames []string,
+	opts IssueCommentOptions,
+) []RepoIssueResults {
+	var pace <-chan time.Time
+
+	if opts.Interval > 0 && !opts.DryRun {
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+
+		pace = ticker.C
+	}
+
+	// Comments are rendered even in dry-run mode so template errors surface before anything is posted
+	return s.forEachIssue(ctx, owner, repoNames, opts.Query, false,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			if opts.Done != nil && opts.Done(repoName, issue.GetNumber()) {
+				return errDone
+			}
+
+			err := s.commentOnMatchingIssue(ctx, owner, repoName, issue, opts, pace)
+			if opts.OnResult != nil && !opts.DryRun {
+				opts.OnResult(repoName, IssueResult{Number: issue.GetNumber(), Title: issue.GetTitle(), URL: issue.GetHTMLURL(), Err: err})
+			}
+
+			return err
+		})
+}
+
+// commentOnMatchingIssue renders the comment of an issue and, unless in dry-run mode, posts it once pace
+// allows.
+func (s *Service) commentOnMatchingIssue(ctx context.Context, owner, repoName string, issue *github.Issue,
+	opts IssueCommentOptions, pace <-chan time.Time,
+) error {
+	body, err := opts.Render(repoName, issue)
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+
+	if pace != nil {
+		select {
+		case <-pace:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return s.CommentOnIssue(ctx, owner, repoName, issue.GetNumber(), body)
+}
+
+// forEachIssue lists the issues matching the query in every repository concurrently and applies fn to each
+// of them, unless dryRun is set. Issues within a repository are processed sequentially.
+func (s *Service) forEachIssue(ctx context.Context, owner string, repoNames []string, query IssueQuery,
+	dryRun bool, fn func(ctx context.Context, repoName string, issue *github.Issue) error,
+) []RepoIssueResults {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoIssueResults {
+		result := RepoIssueResults{RepoName: repoName}
+
+		issues, err := s.ListIssues(ctx, owner, repoName, query)
+		if err != nil {
+			s.log.Error("Failed to list issues", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, issue := range issues {
+			issueResult := IssueResult{Number: issue.GetNumber(), Title: issue.GetTitle(), URL: issue.GetHTMLURL()}
+			if !dryRun {
+				issueResult.Err = fn(ctx, repoName, issue)
+				if errors.Is(issueResult.Err, errDone) {
+					continue
+				}
+			}
+
+			result.Issues = append(result.Issues, issueResult)
+		}
+
+		return result
+	})
+}
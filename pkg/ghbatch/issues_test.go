@@ -0,0 +1,392 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueQuery_Matches(t *testing.T) {
+	cutoff := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	old := &github.Timestamp{Time: cutoff.AddDate(0, -1, 0)}
+	recent := &github.Timestamp{Time: cutoff.AddDate(0, 1, 0)}
+
+	tests := []struct {
+		name     string
+		query    IssueQuery
+		issue    *github.Issue
+		expected bool
+	}{
+		{"Plain issue", IssueQuery{}, &github.Issue{}, true},
+		{"Title matches", IssueQuery{TitlePattern: regexp.MustCompile("(?i)security")}, &github.Issue{Title: stringPtr("Security: bump deps")}, true},
+		{"Title does not match", IssueQuery{TitlePattern: regexp.MustCompile("(?i)security")}, &github.Issue{Title: stringPtr("Fix typo")}, false},
+		{"Pull request excluded", IssueQuery{}, &github.Issue{PullRequestLinks: &github.PullRequestLinks{}}, false},
+		{"Pull request included", IssueQuery{IncludePullRequests: true}, &github.Issue{PullRequestLinks: &github.PullRequestLinks{}}, true},
+		{"Only pull requests", IssueQuery{OnlyPullRequests: true}, &github.Issue{PullRequestLinks: &github.PullRequestLinks{}}, true},
+		{"Issue excluded by only pull requests", IssueQuery{OnlyPullRequests: true}, &github.Issue{}, false},
+		{"Inactive issue", IssueQuery{UpdatedBefore: cutoff}, &github.Issue{UpdatedAt: old}, true},
+		{"Recently updated issue", IssueQuery{UpdatedBefore: cutoff}, &github.Issue{UpdatedAt: recent}, false},
+		{
+			"Exempt label",
+			IssueQuery{ExemptLabels: []string{"pinned"}},
+			&github.Issue{Labels: []*github.Label{{Name: stringPtr("bug")}, {Name: stringPtr("pinned")}}},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.query.Matches(tt.issue))
+		})
+	}
+}
+
+func TestCloseStaleIssues_WithMockServer(t *testing.T) {
+	now := time.Now()
+	old := &github.Timestamp{Time: now.AddDate(0, 0, -100)}
+	recent := &github.Timestamp{Time: now.AddDate(0, 0, -1)}
+
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), UpdatedAt: old},
+				{Number: github.Int(2), UpdatedAt: recent},
+				{Number: github.Int(3), UpdatedAt: old, Labels: []*github.Label{{Name: stringPtr("pinned")}}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues/1/labels":
+			json.NewEncoder(w).Encode([]*github.Label{})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues/1/comments":
+			json.NewEncoder(w).Encode(github.IssueComment{})
+		case r.Method == http.MethodPatch && r.URL.Path == "/repos/testorg/repo1/issues/1":
+			var body github.IssueRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "closed", body.GetState())
+			assert.Equal(t, "not_planned", body.GetStateReason())
+			json.NewEncoder(w).Encode(github.Issue{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	opts := StaleIssueOptions{
+		UpdatedBefore: now.AddDate(0, 0, -30),
+		Comment:       "Closing as stale",
+		Label:         "stale",
+		ExemptLabels:  []string{"pinned"},
+	}
+
+	// Dry run only lists
+	results := service.CloseStaleIssues(context.Background(), "testorg", []string{"repo1"}, StaleIssueOptions{
+		UpdatedBefore: opts.UpdatedBefore, ExemptLabels: opts.ExemptLabels, DryRun: true,
+	})
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, 1, results[0].Issues[0].Number)
+	assert.Equal(t, []string{"GET /repos/testorg/repo1/issues"}, requests)
+
+	requests = nil
+	results = service.CloseStaleIssues(context.Background(), "testorg", []string{"repo1", "missing"}, opts)
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Issues[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, []string{
+		"GET /repos/testorg/repo1/issues",
+		"POST /repos/testorg/repo1/issues/1/labels",
+		"POST /repos/testorg/repo1/issues/1/comments",
+		"PATCH /repos/testorg/repo1/issues/1",
+		"GET /repos/testorg/missing/issues",
+	}, requests)
+}
+
+func TestLockIssues_WithMockServer(t *testing.T) {
+	now := time.Now()
+	old := &github.Timestamp{Time: now.AddDate(0, 0, -100)}
+	recent := &github.Timestamp{Time: now.AddDate(0, 0, -1)}
+
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			assert.Equal(t, "closed", r.URL.Query().Get("state"))
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), ClosedAt: old},
+				{Number: github.Int(2), ClosedAt: recent},
+				{Number: github.Int(3), ClosedAt: old, Locked: github.Bool(true)},
+				{Number: github.Int(4), ClosedAt: old, Labels: []*github.Label{{Name: stringPtr("keep-open")}}},
+			})
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/testorg/repo1/issues/1/lock":
+			var body github.LockIssueOptions
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "resolved", body.LockReason)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	opts := IssueLockOptions{ClosedBefore: now.AddDate(0, 0, -30), Reason: "resolved", ExemptLabels: []string{"keep-open"}}
+
+	results := service.LockIssues(context.Background(), "testorg", []string{"repo1", "missing"}, opts)
+	require.Len(t, results, 2)
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, 1, results[0].Issues[0].Number)
+	assert.NoError(t, results[0].Issues[0].Err)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, []string{
+		"GET /repos/testorg/repo1/issues",
+		"PUT /repos/testorg/repo1/issues/1/lock",
+		"GET /repos/testorg/missing/issues",
+	}, requests)
+}
+
+func TestLabelIssues_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			assert.Equal(t, "open", r.URL.Query().Get("state"))
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), Title: github.String("Security: rotate keys"), Labels: []*github.Label{{Name: stringPtr("triage")}}},
+				{Number: github.Int(2), Title: github.String("Security review done"), Labels: []*github.Label{{Name: stringPtr("security-review")}}},
+				{Number: github.Int(3), Title: github.String("Fix typo")},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues/1/labels":
+			var labels []string
+			json.NewDecoder(r.Body).Decode(&labels)
+			assert.Equal(t, []string{"security-review"}, labels)
+			json.NewEncoder(w).Encode([]*github.Label{})
+		case r.Method == http.MethodDelete && r.URL.Path == "/repos/testorg/repo1/issues/1/labels/triage":
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.LabelIssues(context.Background(), "testorg", []string{"repo1"}, IssueLabelOptions{
+		Query:  IssueQuery{TitlePattern: regexp.MustCompile(`(?i)security`)},
+		Add:    []string{"security-review"},
+		Remove: []string{"triage"},
+	})
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Issues, 2)
+	for _, issue := range results[0].Issues {
+		assert.NoError(t, issue.Err)
+	}
+	// Issue 2 already carries the label and has nothing to remove
+	assert.Equal(t, []string{
+		"GET /repos/testorg/repo1/issues",
+		"POST /repos/testorg/repo1/issues/1/labels",
+		"DELETE /repos/testorg/repo1/issues/1/labels/triage",
+	}, requests)
+}
+
+func TestAssignIssues_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			assert.Equal(t, "security", r.URL.Query().Get("labels"))
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), Assignees: []*github.User{{Login: github.String("Previous")}}},
+				{Number: github.Int(2), Assignees: []*github.User{{Login: github.String("oncall")}}},
+			})
+		case r.URL.Path == "/repos/testorg/repo1/issues/1/assignees":
+			var body struct {
+				Assignees []string `json:"assignees"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if r.Method == http.MethodPost {
+				assert.Equal(t, []string{"oncall"}, body.Assignees)
+			} else {
+				assert.Equal(t, []string{"previous"}, body.Assignees)
+			}
+			json.NewEncoder(w).Encode(github.Issue{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.AssignIssues(context.Background(), "testorg", []string{"repo1"}, IssueAssigneeOptions{
+		Query:  IssueQuery{Labels: []string{"security"}},
+		Add:    []string{"oncall"},
+		Remove: []string{"previous"},
+	})
+
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Issues, 2)
+	for _, issue := range results[0].Issues {
+		assert.NoError(t, issue.Err)
+	}
+	// Issue 2 is already assigned to the on-call user and has nobody to unassign
+	assert.Equal(t, []string{
+		"GET /repos/testorg/repo1/issues",
+		"POST /repos/testorg/repo1/issues/1/assignees",
+		"DELETE /repos/testorg/repo1/issues/1/assignees",
+	}, requests)
+}
+
+func TestCommentOnIssues_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	comments := map[string]string{}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1)},
+				{Number: github.Int(2), PullRequestLinks: &github.PullRequestLinks{}},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues/2/comments":
+			var comment github.IssueComment
+			json.NewDecoder(r.Body).Decode(&comment)
+			mu.Lock()
+			comments[r.URL.Path] = comment.GetBody()
+			mu.Unlock()
+			json.NewEncoder(w).Encode(comment)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	render := func(repoName string, issue *github.Issue) (string, error) {
+		if issue.GetNumber() == 0 {
+			return "", errors.New("missing number")
+		}
+
+		return fmt.Sprintf("%s#%d", repoName, issue.GetNumber()), nil
+	}
+
+	opts := IssueCommentOptions{
+		Query:    IssueQuery{OnlyPullRequests: true},
+		Render:   render,
+		Interval: time.Millisecond,
+		DryRun:   true,
+	}
+
+	results := service.CommentOnIssues(context.Background(), "testorg", []string{"repo1"}, opts)
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, 2, results[0].Issues[0].Number)
+	assert.Empty(t, comments)
+
+	opts.DryRun = false
+	results = service.CommentOnIssues(context.Background(), "testorg", []string{"repo1"}, opts)
+	require.Len(t, results[0].Issues, 1)
+	require.NoError(t, results[0].Issues[0].Err)
+	assert.Equal(t, map[string]string{"/repos/testorg/repo1/issues/2/comments": "repo1#2"}, comments)
+}
+
+func TestCommentOnIssues_SkipsDoneIssues(t *testing.T) {
+	var posted []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{{Number: github.Int(1)}, {Number: github.Int(2)}, {Number: github.Int(3)}})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues/3/comments":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodPost:
+			posted = append(posted, r.URL.Path)
+			json.NewEncoder(w).Encode(github.IssueComment{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	reported := map[int]bool{}
+
+	results := service.CommentOnIssues(context.Background(), "testorg", []string{"repo1"}, IssueCommentOptions{
+		Render: func(repoName string, issue *github.Issue) (string, error) { return "Heads up", nil },
+		Done:   func(repoName string, number int) bool { return number == 1 },
+		OnResult: func(repoName string, result IssueResult) {
+			reported[result.Number] = result.Err == nil
+		},
+	})
+
+	require.Len(t, results[0].Issues, 2)
+	assert.Equal(t, 2, results[0].Issues[0].Number)
+	assert.Equal(t, []string{"/repos/testorg/repo1/issues/2/comments"}, posted)
+	assert.Equal(t, map[int]bool{2: true, 3: false}, reported)
+}
+
+func TestCreateIssues_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/issues":
+			var request github.IssueRequest
+			json.NewDecoder(r.Body).Decode(&request)
+			assert.Equal(t, "Migrate repo1 to Go 1.23", request.GetTitle())
+			assert.Equal(t, []string{"chore"}, request.GetLabels())
+			assert.Equal(t, []string{"octocat"}, request.GetAssignees())
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.Issue{
+				Number:  github.Int(7),
+				Title:   request.Title,
+				HTMLURL: github.String("https://github.com/testorg/repo1/issues/7"),
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	var mu sync.Mutex
+	completed := map[string]bool{}
+
+	results := service.CreateIssues(context.Background(), "testorg", []string{"repo1", "missing"},
+		func(repoName string) (NewIssue, error) {
+			return NewIssue{
+				Title:     "Migrate " + repoName + " to Go 1.23",
+				Labels:    []string{"chore"},
+				Assignees: []string{"octocat"},
+			}, nil
+		}, func(repoName string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			completed[repoName] = err == nil
+		})
+
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, "https://github.com/testorg/repo1/issues/7", results[0].Issues[0].URL)
+	assert.Error(t, results[1].Err)
+	assert.Empty(t, results[1].Issues)
+	assert.Equal(t, map[string]bool{"repo1": true, "missing": false}, completed)
+}
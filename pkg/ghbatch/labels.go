@@ -0,0 +1,68 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// RepoLabel is an issue label a repository should have.
+type RepoLabel struct {
+	Name string
+	// Color is the hex color of the label without a leading #, e.g. "d73a4a".
+	Color       string
+	Description string
+}
+
+// EnsureLabels creates the labels missing from a repository and updates the color and description of those
+// that differ. Label names are matched case-insensitively, like GitHub does. It returns the names of the
+// labels created or updated.
+func (s *Service) EnsureLabels(ctx context.Context, owner, repoName string, labels []RepoLabel) ([]string, error) {
+	existing, err := s.listLabels(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*github.Label, len(existing))
+	for _, label := range existing {
+		byName[strings.ToLower(label.GetName())] = label
+	}
+
+	var changed []string
+	for _, label := range labels {
+		current, ok := byName[strings.ToLower(label.Name)]
+		if ok && strings.EqualFold(current.GetColor(), label.Color) && current.GetDescription() == label.Description {
+			continue
+		}
+
+		desired := &github.Label{
+			Name:        github.String(label.Name),
+			Color:       github.String(label.Color),
+			Description: github.String(label.Description),
+		}
+
+		if ok {
+			s.log.Info("Updating label", "owner", owner, "repo", repoName, "label", label.Name)
+			_, _, err := s.client.Issues.EditLabel(ctx, owner, repoName, current.GetName(), desired)
+			s.recordMutation(ctx, audit.Record{Operation: OperationUpdateLabel, Owner: owner, Repo: repoName, Target: label.Name}, err)
+			if err != nil {
+				return changed, fmt.Errorf("failed to update label %s in %s/%s: %w", label.Name, owner, repoName, err)
+			}
+		} else {
+			s.log.Info("Creating label", "owner", owner, "repo", repoName, "label", label.Name)
+			_, _, err := s.client.Issues.CreateLabel(ctx, owner, repoName, desired)
+			s.recordMutation(ctx, audit.Record{Operation: OperationCreateLabel, Owner: owner, Repo: repoName, Target: label.Name}, err)
+			if err != nil {
+				return changed, fmt.Errorf("failed to create label %s in %s/%s: %w", label.Name, owner, repoName, err)
+			}
+		}
+
+		changed = append(changed, label.Name)
+	}
+
+	return changed, nil
+}
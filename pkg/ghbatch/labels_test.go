@@ -0,0 +1,54 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureLabels_WithMockServer(t *testing.T) {
+	var writes []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/labels":
+			json.NewEncoder(w).Encode([]map[string]any{
+				{"name": "Bug", "color": "D73A4A", "description": "Something isn't working"},
+				{"name": "docs", "color": "0075ca", "description": ""},
+			})
+		case r.Method == http.MethodPatch || r.Method == http.MethodPost:
+			var label map[string]any
+			json.NewDecoder(r.Body).Decode(&label)
+			writes = append(writes, r.Method+" "+r.URL.Path+" "+label["color"].(string))
+			json.NewEncoder(w).Encode(label)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	changed, err := service.EnsureLabels(context.Background(), "testorg", "repo1", []RepoLabel{
+		{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+		{Name: "docs", Color: "0052cc"},
+		{Name: "needs-triage", Color: "ededed"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docs", "needs-triage"}, changed)
+	assert.Equal(t, []string{
+		"PATCH /repos/testorg/repo1/labels/docs 0052cc",
+		"POST /repos/testorg/repo1/labels ededed",
+	}, writes)
+}
+
+func TestEnsureLabels_ListError(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}, 1)
+
+	_, err := service.EnsureLabels(context.Background(), "testorg", "repo1", []RepoLabel{{Name: "bug", Color: "d73a4a"}})
+	assert.ErrorContains(t, err, "failed to list labels for testorg/repo1")
+}
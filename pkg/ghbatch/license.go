@@ -0,0 +1,144 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LicenseFilePaths lists the file names GitHub recognizes as a repository license.
+var LicenseFilePaths = []string{"LICENSE", "LICENSE.md", "LICENSE.txt", "LICENCE", "COPYING", "COPYING.md"}
+
+// GetLicenseTemplate gets the official text of a license by its SPDX identifier.
+func (s *Service) GetLicenseTemplate(ctx context.Context, spdxID string) (string, error) {
+	license, _, err := s.client.Licenses.Get(ctx, strings.ToLower(spdxID))
+	if err != nil {
+		return "", fmt.Errorf("failed to get license %s: %w", spdxID, err)
+	}
+
+	return license.GetBody(), nil
+}
+
+// RenderLicense substitutes the year and copyright holder placeholders used by GitHub's license templates.
+func RenderLicense(template string, year int, holder string) string {
+	replacer := strings.NewReplacer(
+		"[year]", strconv.Itoa(year),
+		"[yyyy]", strconv.Itoa(year),
+		"<year>", strconv.Itoa(year),
+		"[fullname]", holder,
+		"[name of copyright owner]", holder,
+		"<name of author>", holder,
+		"<copyright holders>", holder,
+	)
+
+	return replacer.Replace(template)
+}
+
+// LicenseNoAssertion is the SPDX identifier GitHub reports for a license file it cannot match to a known license.
+const LicenseNoAssertion = "NOASSERTION"
+
+// License audit statuses.
+const (
+	LicenseStatusApproved    = "approved"
+	LicenseStatusMissing     = "missing"
+	LicenseStatusNotApproved = "not-approved"
+	LicenseStatusMismatch    = "mismatch"
+	LicenseStatusFailed      = "failed"
+)
+
+// spdxTagPattern matches an SPDX-License-Identifier tag inside a license file.
+var spdxTagPattern = regexp.MustCompile(`SPDX-License-Identifier:\s*([A-Za-z0-9.+-]+)`)
+
+// LicenseAudit is the license GitHub detected for a repository.
+type LicenseAudit struct {
+	RepoName string
+	// Path is the location of the license file, empty if the repository has none.
+	Path string
+	// SPDXID is the identifier GitHub detected from the file contents, NOASSERTION if it did not recognize it.
+	SPDXID string
+	// DeclaredSPDXID is the SPDX-License-Identifier tag found in the license file, if any.
+	DeclaredSPDXID string
+	Err            error
+}
+
+// RepoErr implements Result.
+func (a LicenseAudit) RepoErr() error { return a.Err }
+
+// WithRepoErr implements Result.
+func (LicenseAudit) WithRepoErr(_, repoName string, err error) LicenseAudit {
+	return LicenseAudit{RepoName: repoName, Err: err}
+}
+
+// Status classifies the audit against an allow-list of SPDX identifiers. An empty allow-list approves
+// any recognized license.
+func (a LicenseAudit) Status(allowed []string) string {
+	switch {
+	case a.Err != nil:
+		return LicenseStatusFailed
+	case a.Path == "":
+		return LicenseStatusMissing
+	case a.SPDXID == "" || a.SPDXID == LicenseNoAssertion:
+		return LicenseStatusMismatch
+	case a.DeclaredSPDXID != "" && !strings.EqualFold(a.DeclaredSPDXID, a.SPDXID):
+		return LicenseStatusMismatch
+	}
+
+	if len(allowed) == 0 {
+		return LicenseStatusApproved
+	}
+
+	for _, id := range allowed {
+		if strings.EqualFold(id, a.SPDXID) {
+			return LicenseStatusApproved
+		}
+	}
+
+	return LicenseStatusNotApproved
+}
+
+// AuditLicense detects the license of a repository through the repository license API.
+func (s *Service) AuditLicense(ctx context.Context, owner, repoName string) LicenseAudit {
+	audit := LicenseAudit{RepoName: repoName}
+
+	license, resp, err := s.client.Repositories.License(ctx, owner, repoName)
+	if err != nil {
+		// A repository without a license file is reported as not found
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			audit.Err = fmt.Errorf("failed to get license for %s/%s: %w", owner, repoName, err)
+		}
+
+		return audit
+	}
+
+	audit.Path = license.GetPath()
+	audit.SPDXID = license.GetLicense().GetSPDXID()
+
+	content, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(license.GetContent(), "\n", ""))
+	if err != nil {
+		audit.Err = fmt.Errorf("failed to decode license file %s of %s/%s: %w", audit.Path, owner, repoName, err)
+
+		return audit
+	}
+
+	if match := spdxTagPattern.FindSubmatch(content); match != nil {
+		audit.DeclaredSPDXID = string(match[1])
+	}
+
+	return audit
+}
+
+// AuditLicensesForRepos detects the licenses of all the given repositories.
+func (s *Service) AuditLicensesForRepos(ctx context.Context, owner string, repoNames []string) []LicenseAudit {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) LicenseAudit {
+		audit := s.AuditLicense(ctx, owner, repoName)
+		if audit.Err != nil {
+			s.log.Error("Failed to audit license", "owner", owner, "repo", repoName, "error", audit.Err)
+		}
+
+		return audit
+	})
+}
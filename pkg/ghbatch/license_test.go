@@ -0,0 +1,74 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func repositoryLicense(path, spdxID, content string) *github.RepositoryLicense {
+	return &github.RepositoryLicense{
+		Path:     github.String(path),
+		Content:  github.String(base64.StdEncoding.EncodeToString([]byte(content))),
+		Encoding: github.String("base64"),
+		License:  &github.License{SPDXID: github.String(spdxID)},
+	}
+}
+
+func TestAuditLicensesForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/mit/license":
+			json.NewEncoder(w).Encode(repositoryLicense("LICENSE", "MIT", "MIT License\n"))
+		case "/repos/testorg/tagged/license":
+			json.NewEncoder(w).Encode(repositoryLicense("LICENSE.md", "Apache-2.0", "SPDX-License-Identifier: MIT\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	audits := service.AuditLicensesForRepos(context.Background(), "testorg", []string{"mit", "tagged", "unlicensed"})
+	require.Len(t, audits, 3)
+
+	assert.Equal(t, "LICENSE", audits[0].Path)
+	assert.Equal(t, "MIT", audits[0].SPDXID)
+	assert.Empty(t, audits[0].DeclaredSPDXID)
+
+	assert.Equal(t, "Apache-2.0", audits[1].SPDXID)
+	assert.Equal(t, "MIT", audits[1].DeclaredSPDXID)
+
+	require.NoError(t, audits[2].Err)
+	assert.Empty(t, audits[2].Path)
+}
+
+func TestLicenseAudit_Status(t *testing.T) {
+	allowed := []string{"MIT", "apache-2.0"}
+
+	tests := []struct {
+		name     string
+		audit    LicenseAudit
+		allowed  []string
+		expected string
+	}{
+		{"missing", LicenseAudit{}, allowed, LicenseStatusMissing},
+		{"approved", LicenseAudit{Path: "LICENSE", SPDXID: "Apache-2.0"}, allowed, LicenseStatusApproved},
+		{"not approved", LicenseAudit{Path: "LICENSE", SPDXID: "GPL-3.0"}, allowed, LicenseStatusNotApproved},
+		{"any license without allow-list", LicenseAudit{Path: "LICENSE", SPDXID: "GPL-3.0"}, nil, LicenseStatusApproved},
+		{"unrecognized", LicenseAudit{Path: "LICENSE", SPDXID: LicenseNoAssertion}, allowed, LicenseStatusMismatch},
+		{"tag mismatch", LicenseAudit{Path: "LICENSE", SPDXID: "MIT", DeclaredSPDXID: "BSD-3-Clause"}, allowed, LicenseStatusMismatch},
+		{"tag match", LicenseAudit{Path: "LICENSE", SPDXID: "MIT", DeclaredSPDXID: "mit"}, allowed, LicenseStatusApproved},
+		{"failed", LicenseAudit{Err: assert.AnError}, allowed, LicenseStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.audit.Status(tt.allowed))
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// branchRulesPathFmt is the API path of the rules that apply to a branch of a repository.
+const branchRulesPathFmt = "repos/%s/%s/rules/branches/%s"
+
+// requiredStatusChecksRule is the ruleset rule type requiring status checks.
+const requiredStatusChecksRule = "required_status_checks"
+
+// Accepted merge methods and grouping strategies of a merge queue. ALLGREEN requires every entry of a group
+// to pass its checks, HEADGREEN only the head of the group.
+var (
+	MergeQueueMergeMethods       = []string{"MERGE", "SQUASH", "REBASE"}
+	MergeQueueGroupingStrategies = []string{"ALLGREEN", "HEADGREEN"}
+)
+
+// MergeQueueSettings are the settings of a merge queue.
+type MergeQueueSettings struct {
+	MergeMethod       string
+	GroupingStrategy  string
+	MaxEntriesToBuild int
+	MaxEntriesToMerge int
+	MinEntriesToMerge int
+	// MinEntriesToMergeWaitMinutes is how long the queue waits for MinEntriesToMerge entries.
+	MinEntriesToMergeWaitMinutes int
+	// CheckResponseTimeoutMinutes is how long a required check may take before it is considered failed.
+	CheckResponseTimeoutMinutes int
+}
+
+// MergeQueuePrerequisites reports whether the default branch of a repository has required status checks,
+// without which a merge queue merges pull requests without testing them.
+type MergeQueuePrerequisites struct {
+	RepoName       string
+	Branch         string
+	RequiredChecks bool
+	Err            error
+}
+
+// RepoErr implements Result.
+func (m MergeQueuePrerequisites) RepoErr() error { return m.Err }
+
+// WithRepoErr implements Result.
+func (MergeQueuePrerequisites) WithRepoErr(_, repoName string, err error) MergeQueuePrerequisites {
+	return MergeQueuePrerequisites{RepoName: repoName, Err: err}
+}
+
+// MergeQueueRuleset builds a ruleset that turns on the merge queue for the default branch.
+func MergeQueueRuleset(name string, settings MergeQueueSettings) Ruleset {
+	return Ruleset{
+		Name:        name,
+		Target:      "branch",
+		Enforcement: "active",
+		Conditions:  map[string]any{"ref_name": map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+		Rules: []map[string]any{{
+			"type": "merge_queue",
+			"parameters": map[string]any{
+				"merge_method":                      settings.MergeMethod,
+				"grouping_strategy":                 settings.GroupingStrategy,
+				"max_entries_to_build":              settings.MaxEntriesToBuild,
+				"max_entries_to_merge":              settings.MaxEntriesToMerge,
+				"min_entries_to_merge":              settings.MinEntriesToMerge,
+				"min_entries_to_merge_wait_minutes": settings.MinEntriesToMergeWaitMinutes,
+				"check_response_timeout_minutes":    settings.CheckResponseTimeoutMinutes,
+			},
+		}},
+	}
+}
+
+// CheckMergeQueuePrerequisites checks whether the default branch of each repository requires status checks,
+// through a ruleset or a branch protection rule.
+func (s *Service) CheckMergeQueuePrerequisites(ctx context.Context, owner string, repos []*github.Repository) []MergeQueuePrerequisites {
+	branches := make(map[string]string, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		branches[repository.GetName()] = repository.GetDefaultBranch()
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) MergeQueuePrerequisites {
+		result := MergeQueuePrerequisites{RepoName: repoName, Branch: branches[repoName]}
+
+		result.RequiredChecks, result.Err = s.hasRequiredStatusChecks(ctx, owner, repoName, result.Branch)
+		if result.Err != nil {
+			s.log.Error("Failed to check required status checks", "owner", owner, "repo", repoName, "error", result.Err)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) hasRequiredStatusChecks(ctx context.Context, owner, repoName, branch string) (bool, error) {
+	// Rules are decoded generically since the GitHub client rejects rule types it does not know
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf(branchRulesPathFmt, owner, repoName, branch), nil)
+	if err != nil {
+		return false, err
+	}
+
+	var rules []map[string]any
+	if _, err := s.client.Do(ctx, req, &rules); err != nil {
+		return false, fmt.Errorf("failed to get rules of %s in %s/%s: %w", branch, owner, repoName, err)
+	}
+
+	for _, rule := range rules {
+		if rule["type"] == requiredStatusChecksRule {
+			return true, nil
+		}
+	}
+
+	checks, resp, err := s.client.Repositories.GetRequiredStatusChecks(ctx, owner, repoName, branch)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get required status checks of %s in %s/%s: %w", branch, owner, repoName, err)
+	}
+
+	return len(checks.GetChecks()) > 0 || len(checks.GetContexts()) > 0, nil
+}
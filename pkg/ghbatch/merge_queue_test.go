@@ -0,0 +1,71 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeQueueRuleset(t *testing.T) {
+	ruleset := MergeQueueRuleset("merge-queue", MergeQueueSettings{
+		MergeMethod: "SQUASH", GroupingStrategy: "ALLGREEN", MaxEntriesToBuild: 5, MaxEntriesToMerge: 5,
+		MinEntriesToMerge: 1, MinEntriesToMergeWaitMinutes: 5, CheckResponseTimeoutMinutes: 60,
+	})
+
+	assert.Equal(t, "branch", ruleset.Target)
+	assert.Equal(t, []any{"~DEFAULT_BRANCH"}, ruleset.Conditions["ref_name"].(map[string]any)["include"])
+	require.Len(t, ruleset.Rules, 1)
+	assert.Equal(t, "merge_queue", ruleset.Rules[0]["type"])
+	assert.Equal(t, "SQUASH", ruleset.Rules[0]["parameters"].(map[string]any)["merge_method"])
+	assert.Equal(t, 60, ruleset.Rules[0]["parameters"].(map[string]any)["check_response_timeout_minutes"])
+}
+
+func TestCheckMergeQueuePrerequisites_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch {
+		case strings.Contains(r.URL.Path, "/rules/branches/"):
+			if repoName == "ruleset" {
+				json.NewEncoder(w).Encode([]map[string]any{{"type": "deletion"}, {"type": "required_status_checks"}})
+				return
+			}
+			if repoName == "broken" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode([]map[string]any{{"type": "copilot_code_review"}})
+		case strings.HasSuffix(r.URL.Path, "/protection/required_status_checks"):
+			if repoName == "protected" {
+				json.NewEncoder(w).Encode(map[string]any{"strict": true, "checks": []map[string]any{{"context": "ci"}}})
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	repos := []*github.Repository{
+		{Name: github.String("ruleset"), DefaultBranch: github.String("main")},
+		{Name: github.String("protected"), DefaultBranch: github.String("master")},
+		{Name: github.String("none"), DefaultBranch: github.String("main")},
+		{Name: github.String("broken"), DefaultBranch: github.String("main")},
+	}
+
+	results := service.CheckMergeQueuePrerequisites(context.Background(), "testorg", repos)
+	require.Len(t, results, 4)
+
+	assert.True(t, results[0].RequiredChecks)
+	assert.True(t, results[1].RequiredChecks)
+	assert.Equal(t, "master", results[1].Branch)
+	assert.False(t, results[2].RequiredChecks)
+	assert.NoError(t, results[2].Err)
+	assert.ErrorContains(t, results[3].Err, "failed to get rules of main in testorg/broken")
+}
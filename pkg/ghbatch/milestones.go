@@ -0,0 +1,112 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// IssueMilestoneOptions configures the milestone set on matching issues.
+type IssueMilestoneOptions struct {
+	// Query selects the issues to assign.
+	Query IssueQuery
+	// Title is the milestone to assign. It is created in repositories that do not have it yet.
+	Title string
+	// DryRun only lists the matching issues without creating the milestone or changing them.
+	DryRun bool
+}
+
+// findMilestone returns the open or closed milestone of a repository with the given title, or nil when the
+// repository has none.
+func (s *Service) findMilestone(ctx context.Context, owner, repoName, title string) (*github.Milestone, error) {
+	milestones, err := s.listMilestones(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, milestone := range milestones {
+		if milestone.GetTitle() == title {
+			return milestone, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ensureMilestone returns the milestone of a repository with the given title, creating it when missing.
+func (s *Service) ensureMilestone(ctx context.Context, owner, repoName, title string) (*github.Milestone, error) {
+	milestone, err := s.findMilestone(ctx, owner, repoName, title)
+	if err != nil || milestone != nil {
+		return milestone, err
+	}
+
+	milestone, _, err = s.client.Issues.CreateMilestone(ctx, owner, repoName, &github.Milestone{Title: github.String(title)})
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreateMilestone, Owner: owner, Repo: repoName, Target: title}, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create milestone %q in %s/%s: %w", title, owner, repoName, err)
+	}
+
+	s.log.Info("Created milestone", "owner", owner, "repo", repoName, "milestone", title, "number", milestone.GetNumber())
+
+	return milestone, nil
+}
+
+// SetIssueMilestone sets the milestone of an issue or pull request by milestone number.
+func (s *Service) SetIssueMilestone(ctx context.Context, owner, repoName string, number, milestone int) error {
+	_, _, err := s.client.Issues.Edit(ctx, owner, repoName, number, &github.IssueRequest{Milestone: github.Int(milestone)})
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetMilestone, Owner: owner, Repo: repoName,
+		Target: issueTarget(number, "milestone "+strconv.Itoa(milestone))}, err)
+	if err != nil {
+		return fmt.Errorf("failed to set the milestone of %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return nil
+}
+
+// AssignMilestone sets a milestone on the matching issues of all the given repositories. Issues already in
+// the milestone are skipped, and the milestone is only created in repositories with issues to assign.
+func (s *Service) AssignMilestone(ctx context.Context, owner string, repoNames []string,
+	opts IssueMilestoneOptions,
+) []RepoIssueResults {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoIssueResults {
+		result := RepoIssueResults{RepoName: repoName}
+
+		issues, err := s.ListIssues(ctx, owner, repoName, opts.Query)
+		if err != nil {
+			s.log.Error("Failed to list issues", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, issue := range issues {
+			if issue.GetMilestone().GetTitle() == opts.Title {
+				continue
+			}
+
+			result.Issues = append(result.Issues, IssueResult{Number: issue.GetNumber(), Title: issue.GetTitle(), URL: issue.GetHTMLURL()})
+		}
+
+		if len(result.Issues) == 0 || opts.DryRun {
+			return result
+		}
+
+		milestone, err := s.ensureMilestone(ctx, owner, repoName, opts.Title)
+		if err != nil {
+			s.log.Error("Failed to resolve milestone", "owner", owner, "repo", repoName, "milestone", opts.Title, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for i := range result.Issues {
+			result.Issues[i].Err = s.SetIssueMilestone(ctx, owner, repoName, result.Issues[i].Number, milestone.GetNumber())
+		}
+
+		return result
+	})
+}
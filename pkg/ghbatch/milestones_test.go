@@ -0,0 +1,88 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignMilestone_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/existing/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1)},
+				{Number: github.Int(2), Milestone: &github.Milestone{Title: github.String("Q3")}},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/existing/milestones":
+			json.NewEncoder(w).Encode([]*github.Milestone{{Number: github.Int(4), Title: github.String("Q3")}})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/missing/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{{Number: github.Int(7)}})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/missing/milestones":
+			json.NewEncoder(w).Encode([]*github.Milestone{})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/missing/milestones":
+			var body github.Milestone
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "Q3", body.GetTitle())
+			json.NewEncoder(w).Encode(github.Milestone{Number: github.Int(1), Title: body.Title})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/empty/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{})
+		case r.Method == http.MethodPatch:
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			milestone := map[string]float64{"/repos/testorg/existing/issues/1": 4, "/repos/testorg/missing/issues/7": 1}[r.URL.Path]
+			assert.Equal(t, milestone, body["milestone"], r.URL.Path)
+			json.NewEncoder(w).Encode(github.Issue{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.AssignMilestone(context.Background(), "testorg", []string{"existing", "missing", "empty"},
+		IssueMilestoneOptions{Title: "Q3"})
+	require.Len(t, results, 3)
+
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, 1, results[0].Issues[0].Number)
+	assert.NoError(t, results[0].Issues[0].Err)
+	require.Len(t, results[1].Issues, 1)
+	assert.NoError(t, results[1].Issues[0].Err)
+	assert.Empty(t, results[2].Issues)
+
+	assert.Equal(t, []string{
+		"GET /repos/testorg/existing/issues",
+		"GET /repos/testorg/existing/milestones",
+		"PATCH /repos/testorg/existing/issues/1",
+		"GET /repos/testorg/missing/issues",
+		"GET /repos/testorg/missing/milestones",
+		"POST /repos/testorg/missing/milestones",
+		"PATCH /repos/testorg/missing/issues/7",
+		"GET /repos/testorg/empty/issues",
+	}, requests)
+}
+
+func TestAssignMilestone_DryRun(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/repos/testorg/repo1/issues" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode([]*github.Issue{{Number: github.Int(1)}})
+	}, 1)
+
+	results := service.AssignMilestone(context.Background(), "testorg", []string{"repo1"}, IssueMilestoneOptions{Title: "Q3", DryRun: true})
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Issues, 1)
+}
@@ -0,0 +1,132 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// File move statuses.
+const (
+	MoveStatusMoved             = "moved"
+	MoveStatusSourceMissing     = "source-missing"
+	MoveStatusDestinationExists = "destination-exists"
+	MoveStatusFailed            = "failed"
+)
+
+// FileMove describes a file to relocate within many repositories.
+type FileMove struct {
+	// From is the current path of the file, e.g. CODEOWNERS.
+	From string
+	// To is the new path of the file, e.g. .github/CODEOWNERS. Repositories where it already exists are
+	// left untouched.
+	To            string
+	CommitMessage string
+}
+
+// FileMoveResult is the outcome of a file move for a single repository.
+type FileMoveResult struct {
+	RepoName string
+	// Status is one of the MoveStatus constants.
+	Status    string
+	CommitURL string
+	Err       error
+}
+
+// RepoErr implements Result.
+func (f FileMoveResult) RepoErr() error { return f.Err }
+
+// WithRepoErr implements Result.
+func (FileMoveResult) WithRepoErr(_, repoName string, err error) FileMoveResult {
+	return FileMoveResult{RepoName: repoName, Err: err}
+}
+
+// MoveFileInRepos moves a file on the default branch of all the given repositories concurrently.
+func (s *Service) MoveFileInRepos(ctx context.Context, owner string, repoNames []string, move FileMove) []FileMoveResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) FileMoveResult {
+		result := s.moveFile(ctx, owner, repoName, move)
+		if result.Err != nil {
+			result.Status = MoveStatusFailed
+			s.log.Error("Failed to move file", "owner", owner, "repo", repoName, "from", move.From, "to", move.To,
+				"error", result.Err)
+		}
+
+		return result
+	})
+}
+
+// moveFile moves the file in a single commit through the Git trees API: the blob is reused at the new
+// path and removed from the old one, so the content and file mode are preserved.
+func (s *Service) moveFile(ctx context.Context, owner, repoName string, move FileMove) FileMoveResult {
+	result := FileMoveResult{RepoName: repoName}
+
+	head, err := s.getBranchHead(ctx, owner, repoName, "")
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	entries, err := s.listTree(ctx, owner, repoName, head)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	var source, destination *github.TreeEntry
+	for _, entry := range entries {
+		switch entry.GetPath() {
+		case move.From:
+			source = entry
+		case move.To:
+			destination = entry
+		}
+	}
+
+	switch {
+	case source == nil:
+		result.Status = MoveStatusSourceMissing
+
+		return result
+	case source.GetType() != "blob":
+		result.Err = fmt.Errorf("%s in %s/%s is a %s, not a file", move.From, owner, repoName, source.GetType())
+
+		return result
+	case destination != nil:
+		result.Status = MoveStatusDestinationExists
+
+		return result
+	}
+
+	changes := []*github.TreeEntry{
+		{Path: github.String(move.To), Mode: source.Mode, Type: github.String("blob"), SHA: source.SHA},
+		// An entry without content and SHA deletes the file.
+		{Path: github.String(move.From), Mode: source.Mode, Type: github.String("blob")},
+	}
+
+	created := audit.Record{Operation: OperationCommitFile, Owner: owner, Repo: repoName, Path: move.To, NewSHA: source.GetSHA()}
+	deleted := audit.Record{Operation: OperationDeleteFile, Owner: owner, Repo: repoName, Path: move.From, OldSHA: source.GetSHA()}
+
+	_, commit, err := s.commitTree(ctx, owner, repoName, head, changes, s.commitMessage(move.CommitMessage))
+	if err != nil {
+		s.recordMutation(ctx, created, err)
+		s.recordMutation(ctx, deleted, err)
+		result.Err = err
+
+		return result
+	}
+
+	created.CommitSHA, created.CommitURL = commit.GetSHA(), commit.GetHTMLURL()
+	deleted.CommitSHA, deleted.CommitURL = commit.GetSHA(), commit.GetHTMLURL()
+	s.recordMutation(ctx, created, nil)
+	s.recordMutation(ctx, deleted, nil)
+
+	result.Status = MoveStatusMoved
+	result.CommitURL = commit.GetHTMLURL()
+
+	return result
+}
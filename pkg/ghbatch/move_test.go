@@ -0,0 +1,115 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveFileInRepos(t *testing.T) {
+	tests := []struct {
+		name       string
+		tree       string
+		wantStatus string
+		wantErr    string
+	}{
+		{
+			name:       "Moves the file",
+			tree:       `{"sha":"base-tree","tree":[{"path":"CODEOWNERS","mode":"100644","type":"blob","sha":"owners-blob"},{"path":".github","mode":"040000","type":"tree","sha":"dir"}]}`,
+			wantStatus: MoveStatusMoved,
+		},
+		{
+			name:       "Source missing",
+			tree:       `{"sha":"base-tree","tree":[{"path":"README.md","mode":"100644","type":"blob","sha":"readme"}]}`,
+			wantStatus: MoveStatusSourceMissing,
+		},
+		{
+			name:       "Destination exists",
+			tree:       `{"sha":"base-tree","tree":[{"path":"CODEOWNERS","type":"blob","sha":"a"},{"path":".github/CODEOWNERS","type":"blob","sha":"b"}]}`,
+			wantStatus: MoveStatusDestinationExists,
+		},
+		{
+			name:       "Source is a directory",
+			tree:       `{"sha":"base-tree","tree":[{"path":"CODEOWNERS","mode":"040000","type":"tree","sha":"dir"}]}`,
+			wantStatus: MoveStatusFailed,
+			wantErr:    "CODEOWNERS in testorg/api is a tree, not a file",
+		},
+		{
+			name:       "Truncated tree",
+			tree:       `{"sha":"base-tree","truncated":true,"tree":[]}`,
+			wantStatus: MoveStatusFailed,
+			wantErr:    "too large",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tree map[string]any
+			var refUpdated bool
+			service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method + " " + r.URL.Path {
+				case "GET /repos/testorg/api":
+					fmt.Fprint(w, `{"default_branch":"main"}`)
+				case "GET /repos/testorg/api/git/ref/heads/main":
+					fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"parent-sha"}}`)
+				case "GET /repos/testorg/api/git/commits/parent-sha":
+					fmt.Fprint(w, `{"sha":"parent-sha","tree":{"sha":"base-tree"}}`)
+				case "GET /repos/testorg/api/git/trees/base-tree":
+					assert.Equal(t, "1", r.URL.Query().Get("recursive"))
+					fmt.Fprint(w, tt.tree)
+				case "POST /repos/testorg/api/git/trees":
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&tree))
+					fmt.Fprint(w, `{"sha":"new-tree"}`)
+				case "POST /repos/testorg/api/git/commits":
+					var commit map[string]any
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&commit))
+					assert.Equal(t, "Move CODEOWNERS to .github/CODEOWNERS", commit["message"])
+					assert.Equal(t, []any{"parent-sha"}, commit["parents"])
+					fmt.Fprint(w, `{"sha":"move-sha","html_url":"https://github.com/testorg/api/commit/move-sha"}`)
+				case "PATCH /repos/testorg/api/git/refs/heads/main":
+					body, _ := io.ReadAll(r.Body)
+					assert.JSONEq(t, `{"sha":"move-sha","force":false}`, string(body))
+					refUpdated = true
+					fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"move-sha"}}`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					http.NotFound(w, r)
+				}
+			}, 1)
+
+			results := service.MoveFileInRepos(context.Background(), "testorg", []string{"api"}, FileMove{
+				From:          "CODEOWNERS",
+				To:            ".github/CODEOWNERS",
+				CommitMessage: "Move CODEOWNERS to .github/CODEOWNERS",
+			})
+			require.Len(t, results, 1)
+			assert.Equal(t, tt.wantStatus, results[0].Status)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, results[0].Err, tt.wantErr)
+				return
+			}
+			require.NoError(t, results[0].Err)
+
+			if tt.wantStatus != MoveStatusMoved {
+				assert.Nil(t, tree)
+				assert.False(t, refUpdated)
+				return
+			}
+
+			assert.Equal(t, "base-tree", tree["base_tree"])
+			assert.Equal(t, []any{
+				map[string]any{"path": ".github/CODEOWNERS", "mode": "100644", "type": "blob", "sha": "owners-blob"},
+				map[string]any{"path": "CODEOWNERS", "mode": "100644", "type": "blob", "sha": nil},
+			}, tree["tree"])
+			assert.True(t, refUpdated)
+			assert.Equal(t, "https://github.com/testorg/api/commit/move-sha", results[0].CommitURL)
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package ghbatch
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Audit operations of file changes made through the contents API.
+const (
+	OperationCommitFile = "commit-file"
+	OperationDeleteFile = "delete-file"
+)
+
+// Audit operations of repository administration.
+const (
+	OperationCreateRepo    = "create-repo"
+	OperationDeleteRepo    = "delete-repo"
+	OperationArchiveRepo   = "archive-repo"
+	OperationUnarchiveRepo = "unarchive-repo"
+	OperationRenameRepo    = "rename-repo"
+	OperationTransferRepo  = "transfer-repo"
+	OperationSetVisibility = "set-visibility"
+	OperationSetTopics     = "set-topics"
+	OperationGrantTeam     = "grant-team"
+)
+
+// Audit operations of issue and pull request changes.
+const (
+	OperationCreateIssue        = "create-issue"
+	OperationComment            = "comment"
+	OperationAddLabels          = "add-labels"
+	OperationRemoveLabel        = "remove-label"
+	OperationAssign             = "assign"
+	OperationUnassign           = "unassign"
+	OperationCreateMilestone    = "create-milestone"
+	OperationSetMilestone       = "set-milestone"
+	OperationCloseIssue         = "close-issue"
+	OperationLockIssue          = "lock-issue"
+	OperationAddProjectItem     = "add-project-item"
+	OperationSetProjectField    = "set-project-field"
+	OperationCreatePullRequest  = "create-pull-request"
+	OperationClosePullRequest   = "close-pull-request"
+	OperationApprovePullRequest = "approve-pull-request"
+	OperationMergePullRequest   = "merge-pull-request"
+)
+
+// Audit operations of branches, tags and releases.
+const (
+	OperationCreateBranch       = "create-branch"
+	OperationDeleteBranch       = "delete-branch"
+	OperationDeleteTag          = "delete-tag"
+	OperationCreateRelease      = "create-release"
+	OperationDeleteRelease      = "delete-release"
+	OperationUploadReleaseAsset = "upload-release-asset"
+	OperationDeleteReleaseAsset = "delete-release-asset"
+)
+
+// Audit operations of repository settings.
+const (
+	OperationSetSecret              = "set-secret"
+	OperationSetEnvironment         = "set-environment"
+	OperationAddDeploymentBranch    = "add-deployment-branch"
+	OperationRemoveDeploymentBranch = "remove-deployment-branch"
+	OperationSetActionsPermissions  = "set-actions-permissions"
+	OperationAssignRunnerGroup      = "assign-runner-group"
+	OperationApplyRuleset           = "apply-ruleset"
+	OperationSetCustomProperties    = "set-custom-properties"
+	OperationEnableSecurityFeature  = "enable-security-feature"
+	OperationEnablePages            = "enable-pages"
+	OperationUpdatePages            = "update-pages"
+	OperationDisablePages           = "disable-pages"
+	OperationAddAutolink            = "add-autolink"
+	OperationRemoveAutolink         = "remove-autolink"
+	OperationCreateLabel            = "create-label"
+	OperationUpdateLabel            = "update-label"
+)
+
+// Audit record statuses.
+const (
+	AuditStatusSuccess = "success"
+	AuditStatusFailed  = "failed"
+)
+
+// AuditRecord is a single entry of the audit log, describing one mutation.
+type AuditRecord = audit.Record
+
+// AuditWriter is implemented by the destinations of audit records taken by WithAuditLog.
+type AuditWriter = audit.Writer
+
+// recordMutation completes a mutation record with the actor and writes it to the audit log, if any.
+// Failing to audit is logged but does not fail the mutation, which has already happened.
+func (s *Service) recordMutation(ctx context.Context, record audit.Record, err error) {
+	if s.audit == nil {
+		return
+	}
+
+	record.Actor = s.actor(ctx)
+	record.Status = AuditStatusSuccess
+
+	if err != nil {
+		record.Status = AuditStatusFailed
+		record.Error = err.Error()
+	}
+
+	if err := s.audit.Write(record); err != nil {
+		s.log.Error("Failed to write audit record", "operation", record.Operation, "repo", record.Repo, "error", err)
+	}
+}
+
+// issueTarget names an issue or pull request, followed by detail when not empty, as the target of an audit
+// record. An unknown number, such as the one of an issue that failed to be created, is left out.
+func issueTarget(number int, detail string) string {
+	if number == 0 {
+		return detail
+	}
+
+	target := "#" + strconv.Itoa(number)
+	if detail != "" {
+		target += " " + detail
+	}
+
+	return target
+}
+
+// actor returns the login of the authenticated user.
+func (s *Service) actor(ctx context.Context) string {
+	user, err := s.authenticatedUser(ctx)
+	if err != nil {
+		s.log.Warn("Failed to look up the authenticated user for the audit log", "error", err)
+
+		return ""
+	}
+
+	return user.GetLogin()
+}
+
+// authenticatedUser returns the user the token belongs to, looked up once per service.
+func (s *Service) authenticatedUser(ctx context.Context) (*github.User, error) {
+	s.userOnce.Do(func() {
+		s.user, _, s.userErr = s.client.Users.Get(ctx, "")
+	})
+
+	return s.user, s.userErr
+}
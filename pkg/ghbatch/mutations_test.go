@@ -0,0 +1,127 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/audit"
+)
+
+// recordingWriter collects audit records in memory.
+type recordingWriter struct {
+	mu      sync.Mutex
+	records []audit.Record
+}
+
+func (w *recordingWriter) Write(record audit.Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.records = append(w.records, record)
+
+	return nil
+}
+
+func TestCreateOrUpdateFile_RecordsMutation(t *testing.T) {
+	var userLookups int
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user":
+			userLookups++
+			json.NewEncoder(w).Encode(&github.User{Login: github.String("octocat")})
+		case r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(encodedFile("* @old", "old-sha"))
+		case r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS" && r.Method == http.MethodPut:
+			json.NewEncoder(w).Encode(&github.RepositoryContentResponse{
+				Content: &github.RepositoryContent{SHA: github.String("new-sha")},
+				Commit:  github.Commit{SHA: github.String("commit-sha"), HTMLURL: github.String("https://github.com/testorg/api/commit/commit-sha")},
+			})
+		case r.URL.Path == "/repos/testorg/web/contents/.github/CODEOWNERS" && r.Method == http.MethodGet:
+			http.NotFound(w, r)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}, 1)
+
+	writer := &recordingWriter{}
+	service.audit = writer
+
+	ctx := context.Background()
+	require.NoError(t, service.CreateOrUpdateFile(ctx, "testorg", "api", ".github/CODEOWNERS", "* @new", "Update CODEOWNERS"))
+	require.Error(t, service.CreateOrUpdateFile(ctx, "testorg", "web", ".github/CODEOWNERS", "* @new", "Add CODEOWNERS"))
+
+	require.Len(t, writer.records, 2)
+	assert.Equal(t, audit.Record{
+		Actor:     "octocat",
+		Operation: OperationCommitFile,
+		Owner:     "testorg",
+		Repo:      "api",
+		Path:      ".github/CODEOWNERS",
+		OldSHA:    "old-sha",
+		NewSHA:    "new-sha",
+		CommitSHA: "commit-sha",
+		CommitURL: "https://github.com/testorg/api/commit/commit-sha",
+		Status:    AuditStatusSuccess,
+	}, writer.records[0])
+	assert.Equal(t, AuditStatusFailed, writer.records[1].Status)
+	assert.Empty(t, writer.records[1].OldSHA)
+	assert.NotEmpty(t, writer.records[1].Error)
+	assert.Equal(t, 1, userLookups)
+}
+
+func TestRepositoryAdministration_RecordsMutations(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user":
+			json.NewEncoder(w).Encode(&github.User{Login: github.String("octocat")})
+		case r.URL.Path == "/repos/testorg/workshop-1" && r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/repos/testorg/api" && r.Method == http.MethodPatch:
+			json.NewEncoder(w).Encode(&github.Repository{Name: github.String("api")})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}, 1)
+
+	writer := &recordingWriter{}
+	service.audit = writer
+
+	ctx := context.Background()
+	successRepos, failedRepos := service.DeleteRepos(ctx, "testorg", []string{"workshop-1", "workshop-2"}, nil)
+	assert.Equal(t, []string{"workshop-1"}, successRepos)
+	assert.Equal(t, []string{"workshop-2"}, failedRepos)
+	require.NoError(t, service.SetRepositoryVisibility(ctx, "testorg", "api", "private"))
+
+	require.Len(t, writer.records, 3)
+	byRepo := make(map[string]audit.Record, len(writer.records))
+	for _, record := range writer.records {
+		byRepo[record.Repo] = record
+	}
+
+	assert.Equal(t, audit.Record{
+		Actor:     "octocat",
+		Operation: OperationDeleteRepo,
+		Owner:     "testorg",
+		Repo:      "workshop-1",
+		Status:    AuditStatusSuccess,
+	}, byRepo["workshop-1"])
+	assert.Equal(t, OperationDeleteRepo, byRepo["workshop-2"].Operation)
+	assert.Equal(t, AuditStatusFailed, byRepo["workshop-2"].Status)
+	assert.NotEmpty(t, byRepo["workshop-2"].Error)
+	assert.Equal(t, audit.Record{
+		Actor:     "octocat",
+		Operation: OperationSetVisibility,
+		Owner:     "testorg",
+		Repo:      "api",
+		Target:    "private",
+		Status:    AuditStatusSuccess,
+	}, byRepo["api"])
+}
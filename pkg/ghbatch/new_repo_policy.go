@@ -0,0 +1,54 @@
+package ghbatch
+
+import "context"
+
+// NewRepoPolicy is what newly created repositories are brought in line with.
+type NewRepoPolicy struct {
+	// Codeowners is the content of the CODEOWNERS file; no file is written when it is empty.
+	Codeowners string
+	Labels     []RepoLabel
+	// Rulesets protect the branches and tags of the repository.
+	Rulesets []Ruleset
+}
+
+// Empty reports whether the policy has nothing to apply.
+func (p NewRepoPolicy) Empty() bool {
+	return p.Codeowners == "" && len(p.Labels) == 0 && len(p.Rulesets) == 0
+}
+
+// NewRepoPolicyResult is the outcome of applying a NewRepoPolicy to a repository.
+type NewRepoPolicyResult struct {
+	RepoName string
+	// Codeowners reports whether the CODEOWNERS file was written.
+	Codeowners bool
+	// Labels are the names of the labels created or updated.
+	Labels   []string
+	Rulesets []RulesetChange
+	Err      error
+}
+
+// ApplyNewRepoPolicy writes the CODEOWNERS file, then the labels and then the rulesets of a policy to a
+// repository, stopping at the first failure. Rulesets come last so that they cannot block the CODEOWNERS
+// commit.
+func (s *Service) ApplyNewRepoPolicy(ctx context.Context, owner, repoName string, policy NewRepoPolicy) NewRepoPolicyResult {
+	result := NewRepoPolicyResult{RepoName: repoName}
+
+	if policy.Codeowners != "" {
+		if result.Err = s.CreateOrUpdateFile(ctx, owner, repoName, ".github/CODEOWNERS", policy.Codeowners, "Add/Update CODEOWNERS file"); result.Err != nil {
+			return result
+		}
+		result.Codeowners = true
+	}
+
+	if len(policy.Labels) > 0 {
+		if result.Labels, result.Err = s.EnsureLabels(ctx, owner, repoName, policy.Labels); result.Err != nil {
+			return result
+		}
+	}
+
+	if len(policy.Rulesets) > 0 {
+		result.Rulesets, result.Err = s.applyRulesets(ctx, owner, repoName, policy.Rulesets, false)
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyNewRepoPolicy_WithMockServer(t *testing.T) {
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/new-repo/contents/.github/CODEOWNERS":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/testorg/new-repo/contents/.github/CODEOWNERS":
+			json.NewEncoder(w).Encode(map[string]any{"content": map[string]any{"path": ".github/CODEOWNERS"}})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/new-repo/labels":
+			json.NewEncoder(w).Encode([]map[string]any{})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/new-repo/labels":
+			json.NewEncoder(w).Encode(map[string]any{"name": "bug"})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/new-repo/rulesets":
+			json.NewEncoder(w).Encode([]map[string]any{})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/new-repo/rulesets":
+			json.NewEncoder(w).Encode(map[string]any{"id": 1})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	result := service.ApplyNewRepoPolicy(context.Background(), "testorg", "new-repo", NewRepoPolicy{
+		Codeowners: "* @testorg/platform\n",
+		Labels:     []RepoLabel{{Name: "bug", Color: "d73a4a"}},
+		Rulesets:   []Ruleset{{Name: "protect-main", Target: "branch", Enforcement: "active"}},
+	})
+
+	require.NoError(t, result.Err)
+	assert.True(t, result.Codeowners)
+	assert.Equal(t, []string{"bug"}, result.Labels)
+	require.Len(t, result.Rulesets, 1)
+	assert.Equal(t, RulesetStatusCreated, result.Rulesets[0].Status)
+	assert.Equal(t, "POST /repos/testorg/new-repo/rulesets", requests[len(requests)-1])
+}
+
+func TestApplyNewRepoPolicy_StopsAtFirstFailure(t *testing.T) {
+	var rulesetsRequested bool
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/testorg/new-repo/rulesets" {
+			rulesetsRequested = true
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}, 1)
+
+	result := service.ApplyNewRepoPolicy(context.Background(), "testorg", "new-repo", NewRepoPolicy{
+		Labels:   []RepoLabel{{Name: "bug", Color: "d73a4a"}},
+		Rulesets: []Ruleset{{Name: "protect-main"}},
+	})
+
+	assert.ErrorContains(t, result.Err, "failed to list labels")
+	assert.False(t, rulesetsRequested)
+}
+
+func TestNewRepoPolicy_Empty(t *testing.T) {
+	assert.True(t, NewRepoPolicy{}.Empty())
+	assert.False(t, NewRepoPolicy{Codeowners: "* @owner"}.Empty())
+}
@@ -0,0 +1,74 @@
+package ghbatch
+
+import (
+	"log/slog"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/logger"
+)
+
+// Option configures a service created by New.
+type Option func(*Service)
+
+// WithConcurrency sets how many repositories batch methods process at once. Values below one fall back to
+// the default of 10 with a warning.
+func WithConcurrency(maxConcurrency int) Option {
+	return func(s *Service) {
+		s.maxConcurrency = maxConcurrency
+	}
+}
+
+// WithLogger sets the logger the service logs its progress and failures to, instead of the default
+// logger of the module.
+func WithLogger(log *slog.Logger) Option {
+	return func(s *Service) {
+		s.log = log
+	}
+}
+
+// WithAuditLog sends a record of every mutation the service makes to w.
+func WithAuditLog(w AuditWriter) Option {
+	return func(s *Service) {
+		s.audit = w
+	}
+}
+
+// WithCommitSigner makes the service write files through the Git Data API with commits signed by signer,
+// for branches that require signed commits. Without it files are committed unsigned through the contents
+// API.
+func WithCommitSigner(signer github.MessageSigner) Option {
+	return func(s *Service) {
+		s.signer = signer
+	}
+}
+
+// WithCommitIdentity makes the service commit files with the identity instead of the token's user and the
+// operation's message.
+func WithCommitIdentity(identity CommitIdentity) Option {
+	return func(s *Service) {
+		s.identity = identity
+	}
+}
+
+// New returns a service making its API requests with client, usually created by NewGitHubClient. Without
+// options it processes up to 10 repositories at once, logs to the default logger, audits nothing and
+// commits files unsigned as the token's user.
+func New(client *github.Client, opts ...Option) *Service {
+	s := &Service{
+		client:         client,
+		log:            logger.GetLogger(),
+		maxConcurrency: defaultConcurrency,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.maxConcurrency <= 0 {
+		s.log.Warn("Invalid maxConcurrency value, using default", "provided", s.maxConcurrency, "default", defaultConcurrency)
+		s.maxConcurrency = defaultConcurrency
+	}
+
+	return s
+}
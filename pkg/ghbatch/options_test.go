@@ -0,0 +1,22 @@
+package ghbatch
+
+import (
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_Options(t *testing.T) {
+	log := createTestLogger()
+
+	service := New(github.NewClient(nil), WithConcurrency(4), WithLogger(log))
+	assert.Equal(t, 4, service.maxConcurrency)
+	assert.Same(t, log, service.log)
+
+	service = New(github.NewClient(nil), WithConcurrency(0), WithLogger(log))
+	assert.Equal(t, defaultConcurrency, service.maxConcurrency)
+
+	service = New(github.NewClient(nil))
+	assert.Equal(t, defaultConcurrency, service.maxConcurrency)
+}
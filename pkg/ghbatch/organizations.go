@@ -0,0 +1,34 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// ListOrganizations lists the logins of the organizations the authenticated user is a member of.
+func (s *Service) ListOrganizations(ctx context.Context) ([]string, error) {
+	var logins []string
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		orgs, resp, err := s.client.Organizations.List(ctx, "", opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organizations: %w", err)
+		}
+
+		for _, org := range orgs {
+			logins = append(logins, org.GetLogin())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return logins, nil
+}
@@ -0,0 +1,40 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOrganizations_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/user/orgs", r.URL.Path)
+
+		if r.URL.Query().Get("page") == "2" {
+			json.NewEncoder(w).Encode([]*github.Organization{{Login: github.String("gamma")}})
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, r.URL.Path))
+		json.NewEncoder(w).Encode([]*github.Organization{{Login: github.String("alpha")}, {Login: github.String("beta")}})
+	}, 1)
+
+	orgs, err := service.ListOrganizations(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alpha", "beta", "gamma"}, orgs)
+}
+
+func TestListOrganizations_Error(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}, 1)
+
+	_, err := service.ListOrganizations(context.Background())
+	assert.ErrorContains(t, err, "failed to list organizations")
+}
@@ -0,0 +1,37 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// Owner is an organization or user account whose repositories are targeted.
+type Owner struct {
+	Login  string
+	IsUser bool
+}
+
+// GetRepositoriesForOwners discovers the repositories matching the prefix of every owner and merges them,
+// keeping the order of the owners. Each repository carries its owner.
+func (s *Service) GetRepositoriesForOwners(ctx context.Context, owners []Owner, prefix string) ([]*github.Repository, error) {
+	var merged []*github.Repository
+
+	for _, owner := range owners {
+		repos, err := s.GetRepositoriesWithPrefix(ctx, owner.Login, prefix, owner.IsUser)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover repositories of %s: %w", owner.Login, err)
+		}
+
+		for _, repository := range repos {
+			if repository.GetOwner().GetLogin() == "" {
+				repository.Owner = &github.User{Login: github.String(owner.Login)}
+			}
+		}
+
+		merged = append(merged, repos...)
+	}
+
+	return merged, nil
+}
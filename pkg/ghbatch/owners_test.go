@@ -0,0 +1,48 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRepositoriesForOwners_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/alpha/repos":
+			json.NewEncoder(w).Encode([]*github.Repository{
+				{Name: github.String("api-one"), Owner: &github.User{Login: github.String("alpha")}},
+				{Name: github.String("web"), Owner: &github.User{Login: github.String("alpha")}},
+			})
+		case "/users/octocat/repos":
+			// Owner left out to check that it is filled in from the target
+			json.NewEncoder(w).Encode([]*github.Repository{{Name: github.String("api-two")}})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	owners := []Owner{{Login: "alpha"}, {Login: "octocat", IsUser: true}}
+	repos, err := service.GetRepositoriesForOwners(context.Background(), owners, "api-")
+	require.NoError(t, err)
+
+	var names []string
+	for _, repository := range repos {
+		names = append(names, repository.GetOwner().GetLogin()+"/"+repository.GetName())
+	}
+	assert.Equal(t, []string{"alpha/api-one", "octocat/api-two"}, names)
+}
+
+func TestGetRepositoriesForOwners_Error(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}, 1)
+
+	_, err := service.GetRepositoriesForOwners(context.Background(), []Owner{{Login: "missing"}}, "")
+	assert.ErrorContains(t, err, "failed to discover repositories of missing")
+}
@@ -0,0 +1,214 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Pages build types.
+const (
+	// PagesBuildTypeLegacy publishes a branch as is.
+	PagesBuildTypeLegacy = "legacy"
+	// PagesBuildTypeWorkflow publishes the artifact of a GitHub Actions workflow.
+	PagesBuildTypeWorkflow = "workflow"
+)
+
+// Pages statuses.
+const (
+	PagesStatusEnabled    = "enabled"
+	PagesStatusUpdated    = "updated"
+	PagesStatusUnchanged  = "unchanged"
+	PagesStatusDisabled   = "disabled"
+	PagesStatusNotEnabled = "not-enabled"
+	PagesStatusFailed     = "failed"
+)
+
+// PagesConfig is the desired publishing source of a GitHub Pages site.
+type PagesConfig struct {
+	// BuildType is PagesBuildTypeLegacy or PagesBuildTypeWorkflow.
+	BuildType string
+	// Branch is the branch a legacy site is published from; empty for the default branch of the repository.
+	Branch string
+	// Path is the directory a legacy site is published from: / or /docs.
+	Path string
+}
+
+// PagesReport is the GitHub Pages site of a repository.
+type PagesReport struct {
+	RepoName  string
+	Enabled   bool
+	URL       string
+	BuildType string
+	Branch    string
+	Path      string
+	Err       error
+}
+
+// RepoErr implements Result.
+func (p PagesReport) RepoErr() error { return p.Err }
+
+// WithRepoErr implements Result.
+func (PagesReport) WithRepoErr(_, repoName string, err error) PagesReport {
+	return PagesReport{RepoName: repoName, Err: err}
+}
+
+// PagesResult is the outcome of configuring or disabling GitHub Pages in a repository.
+type PagesResult struct {
+	RepoName string
+	// Status is one of the PagesStatus constants.
+	Status string
+	URL    string
+	Err    error
+}
+
+// RepoErr implements Result.
+func (p PagesResult) RepoErr() error { return p.Err }
+
+// WithRepoErr implements Result.
+func (PagesResult) WithRepoErr(_, repoName string, err error) PagesResult {
+	return PagesResult{RepoName: repoName, Err: err}
+}
+
+// GetPagesReports gets the GitHub Pages site of all the given repositories.
+func (s *Service) GetPagesReports(ctx context.Context, owner string, repoNames []string) []PagesReport {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) PagesReport {
+		report := PagesReport{RepoName: repoName}
+
+		pages, err := s.getPages(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get Pages site", "owner", owner, "repo", repoName, "error", err)
+			report.Err = err
+
+			return report
+		}
+
+		if pages != nil {
+			report.Enabled = true
+			report.URL = pages.GetHTMLURL()
+			report.BuildType = pages.GetBuildType()
+			report.Branch = pages.GetSource().GetBranch()
+			report.Path = pages.GetSource().GetPath()
+		}
+
+		return report
+	})
+}
+
+// ConfigurePages enables GitHub Pages with the given source in all the given repositories, and updates the
+// source of sites published differently.
+func (s *Service) ConfigurePages(ctx context.Context, owner string, repos []*github.Repository, config PagesConfig) []PagesResult {
+	byName := make(map[string]*github.Repository, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) PagesResult {
+		result, err := s.configurePages(ctx, owner, byName[repoName], config)
+		if err != nil {
+			s.log.Error("Failed to configure Pages", "owner", owner, "repo", repoName, "error", err)
+
+			return PagesResult{RepoName: repoName, Status: PagesStatusFailed, Err: err}
+		}
+
+		return result
+	})
+}
+
+func (s *Service) configurePages(ctx context.Context, owner string, repository *github.Repository, config PagesConfig) (PagesResult, error) {
+	repoName := repository.GetName()
+
+	var source *github.PagesSource
+	if config.BuildType == PagesBuildTypeLegacy {
+		branch := config.Branch
+		if branch == "" {
+			branch = repository.GetDefaultBranch()
+		}
+		source = &github.PagesSource{Branch: github.String(branch), Path: github.String(config.Path)}
+	}
+
+	current, err := s.getPages(ctx, owner, repoName)
+	if err != nil {
+		return PagesResult{}, err
+	}
+
+	if current == nil {
+		s.log.Info("Enabling Pages", "owner", owner, "repo", repoName, "build_type", config.BuildType)
+
+		pages, _, err := s.client.Repositories.EnablePages(ctx, owner, repoName, &github.Pages{
+			BuildType: github.String(config.BuildType),
+			Source:    source,
+		})
+		s.recordMutation(ctx, audit.Record{Operation: OperationEnablePages, Owner: owner, Repo: repoName, Target: config.BuildType}, err)
+		if err != nil {
+			return PagesResult{}, fmt.Errorf("failed to enable Pages in %s/%s: %w", owner, repoName, err)
+		}
+
+		return PagesResult{RepoName: repoName, Status: PagesStatusEnabled, URL: pages.GetHTMLURL()}, nil
+	}
+
+	result := PagesResult{RepoName: repoName, Status: PagesStatusUnchanged, URL: current.GetHTMLURL()}
+	if current.GetBuildType() == config.BuildType && (source == nil ||
+		(current.GetSource().GetBranch() == source.GetBranch() && current.GetSource().GetPath() == source.GetPath())) {
+		return result, nil
+	}
+
+	s.log.Info("Updating Pages source", "owner", owner, "repo", repoName, "build_type", config.BuildType)
+
+	// The custom domain is removed unless it is sent again
+	update := &github.PagesUpdate{CNAME: current.CNAME, BuildType: github.String(config.BuildType), Source: source}
+	_, err = s.client.Repositories.UpdatePages(ctx, owner, repoName, update)
+	s.recordMutation(ctx, audit.Record{Operation: OperationUpdatePages, Owner: owner, Repo: repoName, Target: config.BuildType}, err)
+	if err != nil {
+		return PagesResult{}, fmt.Errorf("failed to update Pages in %s/%s: %w", owner, repoName, err)
+	}
+
+	result.Status = PagesStatusUpdated
+	return result, nil
+}
+
+// DisablePagesForRepos unpublishes the GitHub Pages site of all the given repositories. Repositories without a
+// site are reported as not enabled.
+func (s *Service) DisablePagesForRepos(ctx context.Context, owner string, repoNames []string) []PagesResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) PagesResult {
+		s.log.Info("Disabling Pages", "owner", owner, "repo", repoName)
+
+		resp, err := s.client.Repositories.DisablePages(ctx, owner, repoName)
+		switch {
+		case resp != nil && resp.StatusCode == http.StatusNotFound:
+			return PagesResult{RepoName: repoName, Status: PagesStatusNotEnabled}
+		}
+
+		s.recordMutation(ctx, audit.Record{Operation: OperationDisablePages, Owner: owner, Repo: repoName}, err)
+
+		switch {
+		case err != nil:
+			err = fmt.Errorf("failed to disable Pages in %s/%s: %w", owner, repoName, err)
+			s.log.Error("Failed to disable Pages", "owner", owner, "repo", repoName, "error", err)
+
+			return PagesResult{RepoName: repoName, Status: PagesStatusFailed, Err: err}
+		default:
+			return PagesResult{RepoName: repoName, Status: PagesStatusDisabled}
+		}
+	})
+}
+
+// getPages gets the GitHub Pages site of a repository, or nil when it has none.
+func (s *Service) getPages(ctx context.Context, owner, repoName string) (*github.Pages, error) {
+	pages, resp, err := s.client.Repositories.GetPagesInfo(ctx, owner, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get Pages site of %s/%s: %w", owner, repoName, err)
+	}
+
+	return pages, nil
+}
@@ -0,0 +1,100 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPages_WithMockServer(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		writes = map[string]map[string]any{}
+	)
+
+	sites := map[string]map[string]any{
+		"docs": {
+			"html_url": "https://testorg.github.io/docs/", "build_type": "legacy", "cname": "docs.example.com",
+			"source": map[string]any{"branch": "gh-pages", "path": "/"},
+		},
+		"site": {
+			"html_url": "https://testorg.github.io/site/", "build_type": "workflow",
+		},
+	}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch r.Method {
+		case http.MethodGet:
+			site, ok := sites[repoName]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(site)
+		case http.MethodPost, http.MethodPut:
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			writes[r.Method+" "+repoName] = body
+			mu.Unlock()
+
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(map[string]any{"html_url": "https://testorg.github.io/" + repoName + "/"})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			if _, ok := sites[repoName]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}, 2)
+
+	ctx := context.Background()
+
+	reports := service.GetPagesReports(ctx, "testorg", []string{"docs", "none"})
+	require.Len(t, reports, 2)
+	assert.Equal(t, PagesReport{
+		RepoName: "docs", Enabled: true, URL: "https://testorg.github.io/docs/", BuildType: "legacy", Branch: "gh-pages", Path: "/",
+	}, reports[0])
+	assert.Equal(t, PagesReport{RepoName: "none"}, reports[1])
+
+	repos := []*github.Repository{
+		{Name: github.String("docs"), DefaultBranch: github.String("main")},
+		{Name: github.String("site"), DefaultBranch: github.String("main")},
+		{Name: github.String("none"), DefaultBranch: github.String("trunk")},
+	}
+	results := service.ConfigurePages(ctx, "testorg", repos, PagesConfig{BuildType: PagesBuildTypeLegacy, Path: "/docs"})
+	require.Len(t, results, 3)
+	assert.Equal(t, PagesStatusUpdated, results[0].Status)
+	assert.Equal(t, PagesStatusUpdated, results[1].Status)
+	assert.Equal(t, PagesResult{RepoName: "none", Status: PagesStatusEnabled, URL: "https://testorg.github.io/none/"}, results[2])
+
+	assert.Equal(t, map[string]any{
+		"cname": "docs.example.com", "build_type": "legacy", "source": map[string]any{"branch": "main", "path": "/docs"},
+	}, writes["PUT docs"])
+	assert.Equal(t, map[string]any{
+		"build_type": "legacy", "source": map[string]any{"branch": "trunk", "path": "/docs"},
+	}, writes["POST none"])
+
+	writes = map[string]map[string]any{}
+	results = service.ConfigurePages(ctx, "testorg", repos[1:2], PagesConfig{BuildType: PagesBuildTypeWorkflow})
+	assert.Equal(t, PagesStatusUnchanged, results[0].Status)
+	assert.Empty(t, writes)
+
+	results = service.DisablePagesForRepos(ctx, "testorg", []string{"docs", "none"})
+	assert.Equal(t, PagesStatusDisabled, results[0].Status)
+	assert.Equal(t, PagesStatusNotEnabled, results[1].Status)
+}
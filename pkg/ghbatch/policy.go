@@ -0,0 +1,170 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// Policy rule kinds.
+const (
+	PolicyRuleFile    = "file"
+	PolicyRuleContent = "content"
+	PolicyRuleTopic   = "topic"
+	PolicyRuleSetting = "setting"
+)
+
+// PolicySettings maps the repository settings a policy can require to their value as a string.
+var PolicySettings = map[string]func(repository *github.Repository) string{
+	"visibility":             func(r *github.Repository) string { return r.GetVisibility() },
+	"default_branch":         func(r *github.Repository) string { return r.GetDefaultBranch() },
+	"has_issues":             func(r *github.Repository) string { return strconv.FormatBool(r.GetHasIssues()) },
+	"has_wiki":               func(r *github.Repository) string { return strconv.FormatBool(r.GetHasWiki()) },
+	"has_projects":           func(r *github.Repository) string { return strconv.FormatBool(r.GetHasProjects()) },
+	"has_discussions":        func(r *github.Repository) string { return strconv.FormatBool(r.GetHasDiscussions()) },
+	"allow_merge_commit":     func(r *github.Repository) string { return strconv.FormatBool(r.GetAllowMergeCommit()) },
+	"allow_squash_merge":     func(r *github.Repository) string { return strconv.FormatBool(r.GetAllowSquashMerge()) },
+	"allow_rebase_merge":     func(r *github.Repository) string { return strconv.FormatBool(r.GetAllowRebaseMerge()) },
+	"allow_auto_merge":       func(r *github.Repository) string { return strconv.FormatBool(r.GetAllowAutoMerge()) },
+	"delete_branch_on_merge": func(r *github.Repository) string { return strconv.FormatBool(r.GetDeleteBranchOnMerge()) },
+}
+
+// Policy is a set of rules every repository must comply with.
+type Policy struct {
+	Name  string
+	Rules []PolicyRule
+}
+
+// PolicyRule is a single requirement of a policy.
+type PolicyRule struct {
+	// Name identifies the rule in reports, e.g. file:LICENSE.
+	Name string
+	// Kind is one of the PolicyRule constants.
+	Kind string
+	// Paths are the files of file and content rules. A file rule passes when any of them exists; a content
+	// rule checks the first one that exists.
+	Paths []string
+	// Pattern must match the content of the file of a content rule.
+	Pattern *regexp.Regexp
+	// Topic is the topic a topic rule requires.
+	Topic string
+	// Setting is the PolicySettings key of a setting rule and Value the value it requires.
+	Setting string
+	Value   string
+}
+
+// PolicyResult is the outcome of checking a repository against a policy.
+type PolicyResult struct {
+	RepoName string
+	// Passed holds whether the repository passed each rule, in the order of the policy's rules.
+	Passed []bool
+	Err    error
+}
+
+// RepoErr implements Result.
+func (r PolicyResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (PolicyResult) WithRepoErr(_, repoName string, err error) PolicyResult {
+	return PolicyResult{RepoName: repoName, Err: err}
+}
+
+// Compliant reports whether the repository passed every rule.
+func (r PolicyResult) Compliant() bool {
+	return r.Err == nil && !slices.Contains(r.Passed, false)
+}
+
+// CheckPolicy checks a repository against the rules of a policy. Setting rules read the full repository,
+// since listings omit the merge settings.
+func (s *Service) CheckPolicy(ctx context.Context, owner string, repository *github.Repository,
+	policy Policy,
+) PolicyResult {
+	repoName := repository.GetName()
+	result := PolicyResult{RepoName: repoName, Passed: make([]bool, 0, len(policy.Rules))}
+
+	full := repository
+	for _, rule := range policy.Rules {
+		if rule.Kind == PolicyRuleSetting && full == repository {
+			var err error
+			if full, err = s.GetRepository(ctx, owner, repoName); err != nil {
+				result.Err = err
+
+				return result
+			}
+		}
+
+		passed, err := s.checkPolicyRule(ctx, owner, full, rule)
+		if err != nil {
+			result.Err = err
+
+			return result
+		}
+
+		result.Passed = append(result.Passed, passed)
+	}
+
+	return result
+}
+
+func (s *Service) checkPolicyRule(ctx context.Context, owner string, repository *github.Repository,
+	rule PolicyRule,
+) (bool, error) {
+	repoName := repository.GetName()
+
+	switch rule.Kind {
+	case PolicyRuleFile:
+		path, err := s.findFirstFile(ctx, owner, repoName, rule.Paths)
+
+		return path != "", err
+	case PolicyRuleContent:
+		path, err := s.findFirstFile(ctx, owner, repoName, rule.Paths)
+		if err != nil || path == "" {
+			return false, err
+		}
+
+		content, _, _, err := s.GetFileContent(ctx, owner, repoName, path)
+		if err != nil {
+			return false, err
+		}
+
+		return rule.Pattern.MatchString(content), nil
+	case PolicyRuleTopic:
+		return slices.Contains(repository.Topics, rule.Topic), nil
+	case PolicyRuleSetting:
+		value, ok := PolicySettings[rule.Setting]
+		if !ok {
+			return false, fmt.Errorf("unknown policy setting %q", rule.Setting)
+		}
+
+		return value(repository) == rule.Value, nil
+	default:
+		return false, fmt.Errorf("unknown policy rule kind %q", rule.Kind)
+	}
+}
+
+// CheckPolicyForRepos checks all the given repositories against a policy.
+func (s *Service) CheckPolicyForRepos(ctx context.Context, owner string, repos []*github.Repository,
+	policy Policy,
+) []PolicyResult {
+	byName := make(map[string]*github.Repository, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) PolicyResult {
+		result := s.CheckPolicy(ctx, owner, byName[repoName], policy)
+		if result.Err != nil {
+			s.log.Error("Failed to check repository against policy", "owner", owner, "repo", repoName,
+				"policy", policy.Name, "error", result.Err)
+		}
+
+		return result
+	})
+}
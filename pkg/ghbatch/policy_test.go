@@ -0,0 +1,64 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPolicyForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/compliant/contents/LICENSE.md", "/repos/testorg/drifted/contents/LICENSE":
+			json.NewEncoder(w).Encode(encodedFile("MIT License\n", "license-sha"))
+		case "/repos/testorg/compliant/contents/.github/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @testorg/platform\n", "owners-sha"))
+		case "/repos/testorg/drifted/contents/.github/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @alice\n", "owners-sha"))
+		case "/repos/testorg/compliant":
+			json.NewEncoder(w).Encode(&github.Repository{Name: github.String("compliant"), DeleteBranchOnMerge: github.Bool(true)})
+		case "/repos/testorg/drifted":
+			json.NewEncoder(w).Encode(&github.Repository{Name: github.String("drifted")})
+		case "/repos/testorg/broken/contents/LICENSE":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	policy := Policy{
+		Name: "baseline",
+		Rules: []PolicyRule{
+			{Name: "file:LICENSE", Kind: PolicyRuleFile, Paths: []string{"LICENSE", "LICENSE.md"}},
+			{Name: "content:.github/CODEOWNERS", Kind: PolicyRuleContent, Paths: []string{".github/CODEOWNERS"}, Pattern: regexp.MustCompile(`@testorg/platform\b`)},
+			{Name: "topic:team-owned", Kind: PolicyRuleTopic, Topic: "team-owned"},
+			{Name: "setting:delete_branch_on_merge", Kind: PolicyRuleSetting, Setting: "delete_branch_on_merge", Value: "true"},
+		},
+	}
+
+	repos := []*github.Repository{
+		{Name: github.String("compliant"), Topics: []string{"go", "team-owned"}},
+		{Name: github.String("drifted")},
+		{Name: github.String("broken")},
+	}
+
+	results := service.CheckPolicyForRepos(context.Background(), "testorg", repos, policy)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []bool{true, true, true, true}, results[0].Passed)
+	assert.True(t, results[0].Compliant())
+
+	require.NoError(t, results[1].Err)
+	assert.Equal(t, []bool{true, false, false, false}, results[1].Passed)
+	assert.False(t, results[1].Compliant())
+
+	assert.Error(t, results[2].Err)
+	assert.False(t, results[2].Compliant())
+}
@@ -0,0 +1,219 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// ProjectField is a field of a Projects v2 board.
+type ProjectField struct {
+	ID   string
+	Name string
+	// DataType is the GraphQL ProjectV2FieldType, e.g. TEXT, NUMBER, DATE or SINGLE_SELECT.
+	DataType string
+	// Options maps the option names of a single select field to their IDs.
+	Options map[string]string
+}
+
+// Project is a Projects v2 board with its fields.
+type Project struct {
+	ID     string
+	Number int
+	Title  string
+	Fields []ProjectField
+}
+
+// ProjectFieldValue is a value to set on a field of the items added to a project.
+type ProjectFieldValue struct {
+	FieldID string
+	// Value is the GraphQL ProjectV2FieldValue, e.g. {"text": "..."} or {"singleSelectOptionId": "..."}.
+	Value map[string]any
+}
+
+// ProjectItemOptions configures which issues are added to a project.
+type ProjectItemOptions struct {
+	// ProjectID is the node ID of the project.
+	ProjectID string
+	// Query selects the issues and pull requests to add.
+	Query IssueQuery
+	// FieldValue is set on every added item when not nil.
+	FieldValue *ProjectFieldValue
+	// DryRun only lists the matching issues without adding them.
+	DryRun bool
+}
+
+// projectQuery fetches an organization project with its fields.
+const projectQuery = `query($org: String!, $number: Int!) {
+  organization(login: $org) {
+    projectV2(number: $number) {
+      id
+      title
+      fields(first: 100) {
+        nodes {
+          ... on ProjectV2FieldCommon { id name dataType }
+          ... on ProjectV2SingleSelectField { options { id name } }
+        }
+      }
+    }
+  }
+}`
+
+// addProjectItemMutation adds an issue or pull request to a project. Adding an item that is already in the
+// project returns the existing item.
+const addProjectItemMutation = `mutation($project: ID!, $content: ID!) {
+  addProjectV2ItemById(input: {projectId: $project, contentId: $content}) { item { id } }
+}`
+
+// updateProjectItemFieldMutation sets the value of a field of a project item.
+const updateProjectItemFieldMutation = `mutation($project: ID!, $item: ID!, $field: ID!, $value: ProjectV2FieldValue!) {
+  updateProjectV2ItemFieldValue(input: {projectId: $project, itemId: $item, fieldId: $field, value: $value}) { projectV2Item { id } }
+}`
+
+// FieldValue converts a value given as text into the value of the named field of the project. Text, number,
+// date (YYYY-MM-DD) and single select fields are supported; single select values are matched by option name.
+func (p *Project) FieldValue(name, value string) (*ProjectFieldValue, error) {
+	for _, field := range p.Fields {
+		if !strings.EqualFold(field.Name, name) {
+			continue
+		}
+
+		fieldValue := &ProjectFieldValue{FieldID: field.ID}
+
+		switch field.DataType {
+		case "TEXT":
+			fieldValue.Value = map[string]any{"text": value}
+		case "NUMBER":
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("field %q expects a number, got %q", field.Name, value)
+			}
+			fieldValue.Value = map[string]any{"number": number}
+		case "DATE":
+			if _, err := time.Parse(time.DateOnly, value); err != nil {
+				return nil, fmt.Errorf("field %q expects a date as YYYY-MM-DD, got %q", field.Name, value)
+			}
+			fieldValue.Value = map[string]any{"date": value}
+		case "SINGLE_SELECT":
+			for option, id := range field.Options {
+				if strings.EqualFold(option, value) {
+					fieldValue.Value = map[string]any{"singleSelectOptionId": id}
+				}
+			}
+			if fieldValue.Value == nil {
+				return nil, fmt.Errorf("field %q has no option %q", field.Name, value)
+			}
+		default:
+			return nil, fmt.Errorf("field %q of type %s cannot be set", field.Name, field.DataType)
+		}
+
+		return fieldValue, nil
+	}
+
+	return nil, fmt.Errorf("project %q has no field %q", p.Title, name)
+}
+
+// GetOrgProject retrieves a Projects v2 board of an organization by number, with its fields.
+func (s *Service) GetOrgProject(ctx context.Context, org string, number int) (*Project, error) {
+	var data struct {
+		Organization struct {
+			ProjectV2 *struct {
+				ID     string `json:"id"`
+				Title  string `json:"title"`
+				Fields struct {
+					Nodes []struct {
+						ID       string `json:"id"`
+						Name     string `json:"name"`
+						DataType string `json:"dataType"`
+						Options  []struct {
+							ID   string `json:"id"`
+							Name string `json:"name"`
+						} `json:"options"`
+					} `json:"nodes"`
+				} `json:"fields"`
+			} `json:"projectV2"`
+		} `json:"organization"`
+	}
+
+	if err := s.graphQLStrict(ctx, projectQuery, map[string]any{"org": org, "number": number}, &data); err != nil {
+		return nil, fmt.Errorf("failed to get project %d of %s: %w", number, org, err)
+	}
+
+	if data.Organization.ProjectV2 == nil {
+		return nil, fmt.Errorf("project %d of %s not found", number, org)
+	}
+
+	project := &Project{ID: data.Organization.ProjectV2.ID, Number: number, Title: data.Organization.ProjectV2.Title}
+
+	for _, node := range data.Organization.ProjectV2.Fields.Nodes {
+		field := ProjectField{ID: node.ID, Name: node.Name, DataType: node.DataType}
+
+		if len(node.Options) > 0 {
+			field.Options = make(map[string]string, len(node.Options))
+			for _, option := range node.Options {
+				field.Options[option.Name] = option.ID
+			}
+		}
+
+		project.Fields = append(project.Fields, field)
+	}
+
+	return project, nil
+}
+
+// AddProjectItems adds the matching issues and pull requests of all the given repositories to a project.
+func (s *Service) AddProjectItems(ctx context.Context, owner string, repoNames []string,
+	opts ProjectItemOptions,
+) []RepoIssueResults {
+	return s.forEachIssue(ctx, owner, repoNames, opts.Query, opts.DryRun,
+		func(ctx context.Context, repoName string, issue *github.Issue) error {
+			return s.addProjectItem(ctx, owner, repoName, issue, opts)
+		})
+}
+
+func (s *Service) addProjectItem(ctx context.Context, owner, repoName string, issue *github.Issue,
+	opts ProjectItemOptions,
+) error {
+	var added struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID string `json:"id"`
+			} `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+
+	variables := map[string]any{"project": opts.ProjectID, "content": issue.GetNodeID()}
+	err := s.graphQLStrict(ctx, addProjectItemMutation, variables, &added)
+	s.recordMutation(ctx, audit.Record{Operation: OperationAddProjectItem, Owner: owner, Repo: repoName,
+		Target: issueTarget(issue.GetNumber(), opts.ProjectID)}, err)
+	if err != nil {
+		return fmt.Errorf("failed to add %s/%s#%d to the project: %w", owner, repoName, issue.GetNumber(), err)
+	}
+
+	s.log.Info("Added project item", "owner", owner, "repo", repoName, "issue", issue.GetNumber())
+
+	if opts.FieldValue == nil {
+		return nil
+	}
+
+	variables = map[string]any{
+		"project": opts.ProjectID,
+		"item":    added.AddProjectV2ItemByID.Item.ID,
+		"field":   opts.FieldValue.FieldID,
+		"value":   opts.FieldValue.Value,
+	}
+	err = s.graphQLStrict(ctx, updateProjectItemFieldMutation, variables, &struct{}{})
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetProjectField, Owner: owner, Repo: repoName,
+		Target: issueTarget(issue.GetNumber(), opts.FieldValue.FieldID)}, err)
+	if err != nil {
+		return fmt.Errorf("failed to set the project field of %s/%s#%d: %w", owner, repoName, issue.GetNumber(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectFieldValue(t *testing.T) {
+	project := &Project{Title: "Roadmap", Fields: []ProjectField{
+		{ID: "F_text", Name: "Notes", DataType: "TEXT"},
+		{ID: "F_number", Name: "Estimate", DataType: "NUMBER"},
+		{ID: "F_date", Name: "Due", DataType: "DATE"},
+		{ID: "F_status", Name: "Status", DataType: "SINGLE_SELECT", Options: map[string]string{"Todo": "O_1", "Done": "O_2"}},
+		{ID: "F_iteration", Name: "Sprint", DataType: "ITERATION"},
+	}}
+
+	tests := []struct {
+		name, field, value string
+		expected           *ProjectFieldValue
+		wantErr            string
+	}{
+		{"Text", "Notes", "migrated", &ProjectFieldValue{FieldID: "F_text", Value: map[string]any{"text": "migrated"}}, ""},
+		{"Number", "estimate", "3.5", &ProjectFieldValue{FieldID: "F_number", Value: map[string]any{"number": 3.5}}, ""},
+		{"Date", "Due", "2024-07-01", &ProjectFieldValue{FieldID: "F_date", Value: map[string]any{"date": "2024-07-01"}}, ""},
+		{"Single select", "Status", "todo", &ProjectFieldValue{FieldID: "F_status", Value: map[string]any{"singleSelectOptionId": "O_1"}}, ""},
+		{"Invalid number", "Estimate", "many", nil, "expects a number"},
+		{"Invalid date", "Due", "July", nil, "expects a date"},
+		{"Unknown option", "Status", "Blocked", nil, `has no option "Blocked"`},
+		{"Unsupported type", "Sprint", "1", nil, "cannot be set"},
+		{"Unknown field", "Owner", "me", nil, `has no field "Owner"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := project.FieldValue(tt.field, tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, value)
+		})
+	}
+}
+
+func TestGetOrgProject_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var request graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		if request.Variables["number"] == float64(404) {
+			json.NewEncoder(w).Encode(map[string]any{
+				"data":   map[string]any{"organization": map[string]any{"projectV2": nil}},
+				"errors": []map[string]any{{"type": "NOT_FOUND", "path": []string{"organization", "projectV2"}, "message": "Could not resolve to a ProjectV2"}},
+			})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"organization": map[string]any{"projectV2": map[string]any{
+			"id": "PVT_1", "title": "Roadmap",
+			"fields": map[string]any{"nodes": []map[string]any{
+				{"id": "F_title", "name": "Title", "dataType": "TITLE"},
+				{"id": "F_status", "name": "Status", "dataType": "SINGLE_SELECT", "options": []map[string]string{{"id": "O_1", "name": "Todo"}}},
+			}},
+		}}}})
+	}, 1)
+
+	project, err := service.GetOrgProject(context.Background(), "testorg", 7)
+	require.NoError(t, err)
+	assert.Equal(t, &Project{ID: "PVT_1", Number: 7, Title: "Roadmap", Fields: []ProjectField{
+		{ID: "F_title", Name: "Title", DataType: "TITLE"},
+		{ID: "F_status", Name: "Status", DataType: "SINGLE_SELECT", Options: map[string]string{"Todo": "O_1"}},
+	}}, project)
+
+	_, err = service.GetOrgProject(context.Background(), "testorg", 404)
+	assert.ErrorContains(t, err, "Could not resolve to a ProjectV2")
+}
+
+func TestAddProjectItems_WithMockServer(t *testing.T) {
+	var mutations []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/repo1/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), NodeID: github.String("I_1")},
+				{Number: github.Int(2), NodeID: github.String("I_2")},
+			})
+		case "/graphql":
+			var request graphQLRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+			switch {
+			case strings.Contains(request.Query, "addProjectV2ItemById"):
+				content := request.Variables["content"].(string)
+				mutations = append(mutations, "add "+content)
+				if content == "I_2" {
+					json.NewEncoder(w).Encode(map[string]any{
+						"errors": []map[string]any{{"type": "FORBIDDEN", "path": []string{"addProjectV2ItemById"}, "message": "Resource not accessible"}},
+					})
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"addProjectV2ItemById": map[string]any{"item": map[string]string{"id": "PVTI_" + content}}}})
+			case strings.Contains(request.Query, "updateProjectV2ItemFieldValue"):
+				assert.Equal(t, map[string]any{"singleSelectOptionId": "O_1"}, request.Variables["value"])
+				mutations = append(mutations, "update "+request.Variables["item"].(string))
+				json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{}})
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.AddProjectItems(context.Background(), "testorg", []string{"repo1"}, ProjectItemOptions{
+		ProjectID:  "PVT_1",
+		FieldValue: &ProjectFieldValue{FieldID: "F_status", Value: map[string]any{"singleSelectOptionId": "O_1"}},
+	})
+
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Issues, 2)
+	assert.NoError(t, results[0].Issues[0].Err)
+	assert.ErrorContains(t, results[0].Issues[1].Err, "Resource not accessible")
+	assert.Equal(t, []string{"add I_1", "update PVTI_I_1", "add I_2"}, mutations)
+}
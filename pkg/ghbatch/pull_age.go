@@ -0,0 +1,136 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// Review states of an open pull request, from the latest review of each reviewer.
+const (
+	ReviewStateApproved         = "approved"
+	ReviewStateChangesRequested = "changes requested"
+	ReviewStateCommented        = "commented"
+	// ReviewStatePending means reviewers were requested but none has reviewed yet.
+	ReviewStatePending = "review requested"
+	ReviewStateNone    = "no review"
+)
+
+// OpenPullRequest describes an open pull request with its age and review state.
+type OpenPullRequest struct {
+	Number      int
+	Title       string
+	URL         string
+	Author      string
+	Draft       bool
+	CreatedAt   time.Time
+	ReviewState string
+	// Err is set if the reviews could not be listed; ReviewState is then empty.
+	Err error
+}
+
+// RepoOpenPullRequests groups the open pull requests of a repository. Err is set when they could not be listed.
+type RepoOpenPullRequests struct {
+	RepoName     string
+	PullRequests []OpenPullRequest
+	Err          error
+}
+
+// RepoErr implements Result.
+func (r RepoOpenPullRequests) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoOpenPullRequests) WithRepoErr(_, repoName string, err error) RepoOpenPullRequests {
+	return RepoOpenPullRequests{RepoName: repoName, Err: err}
+}
+
+// ListOpenPullRequests lists the open pull requests matching the query in all the given repositories,
+// with the review state of each.
+func (s *Service) ListOpenPullRequests(ctx context.Context, owner string, repoNames []string,
+	query PullRequestQuery,
+) []RepoOpenPullRequests {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoOpenPullRequests {
+		result := RepoOpenPullRequests{RepoName: repoName}
+
+		prs, err := s.ListPullRequests(ctx, owner, repoName, query)
+		if err != nil {
+			s.log.Error("Failed to list pull requests", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, pr := range prs {
+			open := OpenPullRequest{
+				Number:    pr.GetNumber(),
+				Title:     pr.GetTitle(),
+				URL:       pr.GetHTMLURL(),
+				Author:    pr.GetUser().GetLogin(),
+				Draft:     pr.GetDraft(),
+				CreatedAt: pr.GetCreatedAt().Time,
+			}
+
+			open.ReviewState, open.Err = s.reviewState(ctx, owner, repoName, pr)
+			if open.Err != nil {
+				s.log.Error("Failed to get pull request reviews", "owner", owner, "repo", repoName, "pr", pr.GetNumber(),
+					"error", open.Err)
+			}
+
+			result.PullRequests = append(result.PullRequests, open)
+		}
+
+		return result
+	})
+}
+
+// reviewState derives the review state of a pull request from the latest review of each reviewer:
+// any outstanding change request wins over approvals.
+func (s *Service) reviewState(ctx context.Context, owner, repoName string, pr *github.PullRequest) (string, error) {
+	latest := make(map[string]string)
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		reviews, resp, err := s.client.PullRequests.ListReviews(ctx, owner, repoName, pr.GetNumber(), opts)
+		if err != nil {
+			return "", fmt.Errorf("failed to list reviews for %s/%s#%d: %w", owner, repoName, pr.GetNumber(), err)
+		}
+
+		// Reviews are listed oldest first; comments do not override an approval or change request
+		for _, review := range reviews {
+			state := review.GetState()
+			login := review.GetUser().GetLogin()
+
+			if state == "COMMENTED" && latest[login] != "" {
+				continue
+			}
+
+			latest[login] = state
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	counts := make(map[string]int)
+	for _, state := range latest {
+		counts[state]++
+	}
+
+	switch {
+	case counts["CHANGES_REQUESTED"] > 0:
+		return ReviewStateChangesRequested, nil
+	case counts["APPROVED"] > 0:
+		return ReviewStateApproved, nil
+	case counts["COMMENTED"] > 0:
+		return ReviewStateCommented, nil
+	case len(pr.RequestedReviewers) > 0 || len(pr.RequestedTeams) > 0:
+		return ReviewStatePending, nil
+	default:
+		return ReviewStateNone, nil
+	}
+}
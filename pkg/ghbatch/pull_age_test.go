@@ -0,0 +1,62 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func review(login, state string) *github.PullRequestReview {
+	return &github.PullRequestReview{User: &github.User{Login: github.String(login)}, State: github.String(state)}
+}
+
+func TestListOpenPullRequests_WithMockServer(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/api/pulls":
+			json.NewEncoder(w).Encode([]*github.PullRequest{
+				{Number: github.Int(1), User: &github.User{Login: github.String("alice")}, CreatedAt: &github.Timestamp{Time: created}},
+				{Number: github.Int(2), User: &github.User{Login: github.String("bob")}},
+				{Number: github.Int(3), RequestedReviewers: []*github.User{{Login: github.String("carol")}}},
+				{Number: github.Int(4), Draft: github.Bool(true)},
+				{Number: github.Int(5)},
+			})
+		case "/repos/testorg/api/pulls/1/reviews":
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{review("carol", "APPROVED"), review("carol", "COMMENTED")})
+		case "/repos/testorg/api/pulls/2/reviews":
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{
+				review("carol", "CHANGES_REQUESTED"), review("dave", "APPROVED"),
+			})
+		case "/repos/testorg/api/pulls/3/reviews", "/repos/testorg/api/pulls/4/reviews":
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{})
+		case "/repos/testorg/api/pulls/5/reviews":
+			http.Error(w, "boom", http.StatusInternalServerError)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	results := service.ListOpenPullRequests(context.Background(), "testorg", []string{"api", "missing"}, PullRequestQuery{})
+	require.Len(t, results, 2)
+
+	prs := results[0].PullRequests
+	require.Len(t, prs, 5)
+	assert.Equal(t, "alice", prs[0].Author)
+	assert.Equal(t, created, prs[0].CreatedAt)
+	assert.Equal(t, ReviewStateApproved, prs[0].ReviewState)
+	assert.Equal(t, ReviewStateChangesRequested, prs[1].ReviewState)
+	assert.Equal(t, ReviewStatePending, prs[2].ReviewState)
+	assert.Equal(t, ReviewStateNone, prs[3].ReviewState)
+	assert.True(t, prs[3].Draft)
+	assert.Error(t, prs[4].Err)
+
+	assert.Error(t, results[1].Err)
+}
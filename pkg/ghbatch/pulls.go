@@ -0,0 +1,486 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"sync/atomic"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Pull request result statuses.
+const (
+	// PullRequestStatusMatched means the pull request matched but was left untouched in a dry run.
+	PullRequestStatusMatched  = "matched"
+	PullRequestStatusMerged   = "merged"
+	PullRequestStatusApproved = "approved"
+	PullRequestStatusClosed   = "closed"
+	// PullRequestStatusSkipped means the pull request matched the query but failed a precondition.
+	PullRequestStatusSkipped = "skipped"
+	PullRequestStatusFailed  = "failed"
+)
+
+// Merge methods accepted by GitHub.
+const (
+	MergeMethodMerge  = "merge"
+	MergeMethodSquash = "squash"
+	MergeMethodRebase = "rebase"
+)
+
+// PullRequestQuery selects the open pull requests of a repository.
+type PullRequestQuery struct {
+	// Author restricts the query to pull requests opened by this login, e.g. "dependabot[bot]".
+	Author string
+	// Labels restricts the query to pull requests carrying all of these labels.
+	Labels []string
+	// Base restricts the query to pull requests targeting this branch.
+	Base string
+	// TitlePattern restricts the query to pull requests whose title matches when not nil.
+	TitlePattern *regexp.Regexp
+}
+
+// Matches reports whether a pull request satisfies the client-side parts of the query.
+func (q PullRequestQuery) Matches(pr *github.PullRequest) bool {
+	if q.Author != "" && pr.GetUser().GetLogin() != q.Author {
+		return false
+	}
+
+	if q.TitlePattern != nil && !q.TitlePattern.MatchString(pr.GetTitle()) {
+		return false
+	}
+
+	for _, want := range q.Labels {
+		found := false
+
+		for _, label := range pr.Labels {
+			if label.GetName() == want {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PullRequestResult is the outcome of an operation on a single pull request.
+type PullRequestResult struct {
+	Number int
+	Title  string
+	URL    string
+	Status string
+	// Reason explains why a pull request was skipped, or adds detail to its status.
+	Reason string
+	Err    error
+}
+
+// RepoPullRequestResults groups the pull request results of a repository. Err is set when the repository
+// itself could not be processed, e.g. because its pull requests could not be listed.
+type RepoPullRequestResults struct {
+	RepoName     string
+	PullRequests []PullRequestResult
+	Err          error
+}
+
+// RepoErr implements Result.
+func (r RepoPullRequestResults) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoPullRequestResults) WithRepoErr(_, repoName string, err error) RepoPullRequestResults {
+	return RepoPullRequestResults{RepoName: repoName, Err: err}
+}
+
+// MergePullRequestsOptions configures how matching pull requests are merged.
+type MergePullRequestsOptions struct {
+	// Query selects the pull requests to merge.
+	Query PullRequestQuery
+	// Method is the merge method: merge, squash or rebase.
+	Method string
+	// RequireChecks skips pull requests whose statuses and check runs are not all successful.
+	RequireChecks bool
+	// RequireMergeable skips pull requests GitHub does not report as cleanly mergeable.
+	RequireMergeable bool
+	// DryRun evaluates the pull requests without merging them.
+	DryRun bool
+}
+
+// ApprovePullRequestsOptions configures how matching pull requests are approved.
+type ApprovePullRequestsOptions struct {
+	// Query selects the pull requests to approve.
+	Query PullRequestQuery
+	// Body is the optional review comment.
+	Body string
+	// Max caps the number of approvals across all repositories in one run when greater than zero. Failed
+	// approvals do not count; in a dry run the matched pull requests do.
+	Max int
+	// DryRun evaluates the pull requests without approving them.
+	DryRun bool
+}
+
+// ClosePullRequestsOptions configures how matching pull requests are closed.
+type ClosePullRequestsOptions struct {
+	// Query selects the pull requests to close.
+	Query PullRequestQuery
+	// Comment renders the comment posted before closing a pull request when not nil.
+	Comment func(repoName string, pr *github.PullRequest) (string, error)
+	// DeleteBranch deletes the head branch after closing, unless it lives in a fork.
+	DeleteBranch bool
+	// DryRun renders the comments without closing anything.
+	DryRun bool
+	// Done, when set, reports the pull requests already processed by an interrupted run. They are left out.
+	Done func(repoName string, number int) bool
+	// OnResult, when set, is called with the result of each pull request as soon as it is closed or fails.
+	OnResult func(repoName string, result PullRequestResult)
+}
+
+// ListPullRequests lists the open pull requests of a repository matching the query.
+func (s *Service) ListPullRequests(ctx context.Context, owner, repoName string,
+	query PullRequestQuery,
+) ([]*github.PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		State: "open",
+		Base:  query.Base,
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	var matching []*github.PullRequest
+
+	for {
+		prs, resp, err := s.client.PullRequests.List(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, pr := range prs {
+			if query.Matches(pr) {
+				matching = append(matching, pr)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matching, nil
+}
+
+// MergePullRequests merges the matching pull requests of all the given repositories.
+func (s *Service) MergePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts MergePullRequestsOptions,
+) []RepoPullRequestResults {
+	return s.forEachPullRequest(ctx, owner, repoNames, opts.Query,
+		func(ctx context.Context, repoName string, pr *github.PullRequest) (string, string, error) {
+			return s.mergePullRequest(ctx, owner, repoName, pr, opts)
+		})
+}
+
+// ApprovePullRequests submits an approving review to the matching pull requests of all the given repositories.
+func (s *Service) ApprovePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts ApprovePullRequestsOptions,
+) ([]RepoPullRequestResults, error) {
+	me, _, err := s.client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the authenticated user: %w", err)
+	}
+
+	// With a limit, approvals are made one at a time so that failed ones do not take up the places of
+	// pull requests processed concurrently
+	var mu sync.Mutex
+	var approvals atomic.Int64
+
+	return s.forEachPullRequest(ctx, owner, repoNames, opts.Query,
+		func(ctx context.Context, repoName string, pr *github.PullRequest) (string, string, error) {
+			approved, err := s.hasApproved(ctx, owner, repoName, pr.GetNumber(), me.GetLogin())
+			if err != nil {
+				return PullRequestStatusFailed, "", err
+			}
+
+			if approved {
+				return PullRequestStatusSkipped, "already approved", nil
+			}
+
+			if opts.Max > 0 {
+				mu.Lock()
+				defer mu.Unlock()
+
+				if approvals.Load() >= int64(opts.Max) {
+					return PullRequestStatusSkipped, "approval limit reached", nil
+				}
+			}
+
+			if opts.DryRun {
+				approvals.Add(1)
+				return PullRequestStatusMatched, "", nil
+			}
+
+			status, reason, err := s.approvePullRequest(ctx, owner, repoName, pr.GetNumber(), opts.Body)
+			if err == nil {
+				approvals.Add(1)
+			}
+
+			return status, reason, err
+		}), nil
+}
+
+// ClosePullRequests closes the matching pull requests of all the given repositories without merging them.
+func (s *Service) ClosePullRequests(ctx context.Context, owner string, repoNames []string,
+	opts ClosePullRequestsOptions,
+) []RepoPullRequestResults {
+	return s.forEachPullRequest(ctx, owner, repoNames, opts.Query,
+		func(ctx context.Context, repoName string, pr *github.PullRequest) (string, string, error) {
+			if opts.Done != nil && opts.Done(repoName, pr.GetNumber()) {
+				return "", "", errDone
+			}
+
+			var comment string
+
+			// Comments are rendered even in dry-run mode so template errors surface before anything is closed
+			if opts.Comment != nil {
+				var err error
+				if comment, err = opts.Comment(repoName, pr); err != nil {
+					return PullRequestStatusFailed, "", err
+				}
+			}
+
+			if opts.DryRun {
+				return PullRequestStatusMatched, "", nil
+			}
+
+			status, reason, err := s.closePullRequest(ctx, owner, repoName, pr, comment, opts.DeleteBranch)
+			if opts.OnResult != nil {
+				opts.OnResult(repoName, PullRequestResult{Number: pr.GetNumber(), Title: pr.GetTitle(), URL: pr.GetHTMLURL(),
+					Status: status, Reason: reason, Err: err})
+			}
+
+			return status, reason, err
+		})
+}
+
+func (s *Service) closePullRequest(ctx context.Context, owner, repoName string, pr *github.PullRequest,
+	comment string, deleteBranch bool,
+) (string, string, error) {
+	s.log.Info("Closing pull request", "owner", owner, "repo", repoName, "pr", pr.GetNumber())
+
+	if comment != "" {
+		if err := s.CommentOnIssue(ctx, owner, repoName, pr.GetNumber(), comment); err != nil {
+			return PullRequestStatusFailed, "", err
+		}
+	}
+
+	_, _, err := s.client.PullRequests.Edit(ctx, owner, repoName, pr.GetNumber(), &github.PullRequest{State: github.String("closed")})
+	s.recordMutation(ctx, audit.Record{Operation: OperationClosePullRequest, Owner: owner, Repo: repoName,
+		Target: issueTarget(pr.GetNumber(), "")}, err)
+	if err != nil {
+		return PullRequestStatusFailed, "", fmt.Errorf("failed to close %s/%s#%d: %w", owner, repoName, pr.GetNumber(), err)
+	}
+
+	if !deleteBranch {
+		return PullRequestStatusClosed, "", nil
+	}
+
+	// Branches of forks belong to someone else and are left alone
+	if pr.GetHead().GetRepo().GetFullName() != owner+"/"+repoName {
+		return PullRequestStatusClosed, "head branch is in a fork, not deleted", nil
+	}
+
+	branch := pr.GetHead().GetRef()
+
+	_, err = s.client.Git.DeleteRef(ctx, owner, repoName, "heads/"+branch)
+	s.recordMutation(ctx, audit.Record{Operation: OperationDeleteBranch, Owner: owner, Repo: repoName, Branch: branch}, err)
+	if err != nil {
+		return PullRequestStatusFailed, "", fmt.Errorf("closed %s/%s#%d but failed to delete branch %s: %w",
+			owner, repoName, pr.GetNumber(), branch, err)
+	}
+
+	return PullRequestStatusClosed, "branch " + branch + " deleted", nil
+}
+
+// hasApproved reports whether the given user has submitted an approving review on a pull request.
+func (s *Service) hasApproved(ctx context.Context, owner, repoName string, number int, login string) (bool, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		reviews, resp, err := s.client.PullRequests.ListReviews(ctx, owner, repoName, number, opts)
+		if err != nil {
+			return false, fmt.Errorf("failed to list reviews for %s/%s#%d: %w", owner, repoName, number, err)
+		}
+
+		for _, review := range reviews {
+			if review.GetUser().GetLogin() == login && review.GetState() == "APPROVED" {
+				return true, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return false, nil
+}
+
+func (s *Service) approvePullRequest(ctx context.Context, owner, repoName string, number int,
+	body string,
+) (string, string, error) {
+	s.log.Info("Approving pull request", "owner", owner, "repo", repoName, "pr", number)
+
+	review := &github.PullRequestReviewRequest{Event: github.String("APPROVE")}
+	if body != "" {
+		review.Body = github.String(body)
+	}
+
+	_, _, err := s.client.PullRequests.CreateReview(ctx, owner, repoName, number, review)
+	s.recordMutation(ctx, audit.Record{Operation: OperationApprovePullRequest, Owner: owner, Repo: repoName,
+		Target: issueTarget(number, "")}, err)
+	if err != nil {
+		return PullRequestStatusFailed, "", fmt.Errorf("failed to approve %s/%s#%d: %w", owner, repoName, number, err)
+	}
+
+	return PullRequestStatusApproved, "", nil
+}
+
+// mergePullRequest checks the preconditions of a pull request and merges it, returning the status and,
+// for skipped pull requests, the reason.
+func (s *Service) mergePullRequest(ctx context.Context, owner, repoName string, pr *github.PullRequest,
+	opts MergePullRequestsOptions,
+) (string, string, error) {
+	if opts.RequireMergeable {
+		// Mergeability is only computed for single pull requests, not in listings
+		full, _, err := s.client.PullRequests.Get(ctx, owner, repoName, pr.GetNumber())
+		if err != nil {
+			return PullRequestStatusFailed, "", fmt.Errorf("failed to get %s/%s#%d: %w", owner, repoName, pr.GetNumber(), err)
+		}
+
+		if !full.GetMergeable() {
+			return PullRequestStatusSkipped, "not mergeable (" + full.GetMergeableState() + ")", nil
+		}
+	}
+
+	if opts.RequireChecks {
+		passed, reason, err := s.checksPassed(ctx, owner, repoName, pr.GetHead().GetSHA())
+		if err != nil {
+			return PullRequestStatusFailed, "", err
+		}
+
+		if !passed {
+			return PullRequestStatusSkipped, reason, nil
+		}
+	}
+
+	if opts.DryRun {
+		return PullRequestStatusMatched, "", nil
+	}
+
+	s.log.Info("Merging pull request", "owner", owner, "repo", repoName, "pr", pr.GetNumber(), "method", opts.Method)
+
+	// Pin the head the checks were evaluated on, so commits pushed since then are not merged unchecked
+	_, _, err := s.client.PullRequests.Merge(ctx, owner, repoName, pr.GetNumber(), "",
+		&github.PullRequestOptions{MergeMethod: opts.Method, SHA: pr.GetHead().GetSHA()})
+	s.recordMutation(ctx, audit.Record{Operation: OperationMergePullRequest, Owner: owner, Repo: repoName,
+		Target: issueTarget(pr.GetNumber(), opts.Method), CommitSHA: pr.GetHead().GetSHA()}, err)
+	if err != nil {
+		return PullRequestStatusFailed, "", fmt.Errorf("failed to merge %s/%s#%d: %w", owner, repoName, pr.GetNumber(), err)
+	}
+
+	return PullRequestStatusMerged, "", nil
+}
+
+// checksPassed reports whether all commit statuses and check runs of a commit succeeded. A commit without
+// any statuses or check runs passes. The reason describes why the checks did not pass.
+func (s *Service) checksPassed(ctx context.Context, owner, repoName, ref string) (bool, string, error) {
+	status, _, err := s.client.Repositories.GetCombinedStatus(ctx, owner, repoName, ref, nil)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get commit status for %s/%s@%s: %w", owner, repoName, ref, err)
+	}
+
+	// The combined state is "pending" when there are no statuses at all
+	if status.GetTotalCount() > 0 && status.GetState() != "success" {
+		return false, "commit status " + status.GetState(), nil
+	}
+
+	opts := &github.ListCheckRunsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		runs, resp, err := s.client.Checks.ListCheckRunsForRef(ctx, owner, repoName, ref, opts)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to list check runs for %s/%s@%s: %w", owner, repoName, ref, err)
+		}
+
+		for _, run := range runs.CheckRuns {
+			if run.GetStatus() != "completed" {
+				return false, "check " + run.GetName() + " " + run.GetStatus(), nil
+			}
+
+			switch run.GetConclusion() {
+			case "success", "neutral", "skipped":
+			default:
+				return false, "check " + run.GetName() + " " + run.GetConclusion(), nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return true, "", nil
+}
+
+// forEachPullRequest lists the pull requests matching the query in every repository concurrently and
+// applies fn to each of them. Pull requests within a repository are processed sequentially, since merging
+// one can change the mergeability of the others.
+func (s *Service) forEachPullRequest(ctx context.Context, owner string, repoNames []string,
+	query PullRequestQuery, fn func(ctx context.Context, repoName string, pr *github.PullRequest) (string, string, error),
+) []RepoPullRequestResults {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoPullRequestResults {
+		result := RepoPullRequestResults{RepoName: repoName}
+
+		prs, err := s.ListPullRequests(ctx, owner, repoName, query)
+		if err != nil {
+			s.log.Error("Failed to list pull requests", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, pr := range prs {
+			prResult := PullRequestResult{Number: pr.GetNumber(), Title: pr.GetTitle(), URL: pr.GetHTMLURL()}
+			prResult.Status, prResult.Reason, prResult.Err = fn(ctx, repoName, pr)
+			if errors.Is(prResult.Err, errDone) {
+				continue
+			}
+
+			if prResult.Err != nil {
+				s.log.Error("Failed to process pull request", "owner", owner, "repo", repoName, "pr", pr.GetNumber(),
+					"error", prResult.Err)
+			}
+
+			result.PullRequests = append(result.PullRequests, prResult)
+		}
+
+		return result
+	})
+}
@@ -0,0 +1,266 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestQuery_Matches(t *testing.T) {
+	pr := &github.PullRequest{
+		Title:  stringPtr("Bump golang.org/x/net"),
+		User:   &github.User{Login: stringPtr("dependabot[bot]")},
+		Labels: []*github.Label{{Name: stringPtr("dependencies")}, {Name: stringPtr("go")}},
+	}
+
+	tests := []struct {
+		name     string
+		query    PullRequestQuery
+		expected bool
+	}{
+		{"Empty query", PullRequestQuery{}, true},
+		{"Author matches", PullRequestQuery{Author: "dependabot[bot]"}, true},
+		{"Author differs", PullRequestQuery{Author: "renovate[bot]"}, false},
+		{"All labels present", PullRequestQuery{Labels: []string{"go", "dependencies"}}, true},
+		{"Label missing", PullRequestQuery{Labels: []string{"go", "security"}}, false},
+		{"Title matches", PullRequestQuery{TitlePattern: regexp.MustCompile("^Bump ")}, true},
+		{"Title differs", PullRequestQuery{TitlePattern: regexp.MustCompile("^Update ")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.query.Matches(pr))
+		})
+	}
+}
+
+func TestMergePullRequests_WithMockServer(t *testing.T) {
+	bot := &github.User{Login: stringPtr("dependabot[bot]")}
+	pr := func(number int, sha string, user *github.User) *github.PullRequest {
+		return &github.PullRequest{Number: github.Int(number), User: user, Head: &github.PullRequestBranch{SHA: stringPtr(sha)}}
+	}
+
+	var mu sync.Mutex
+	var merged []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/testorg/repo1/pulls":
+			json.NewEncoder(w).Encode([]*github.PullRequest{
+				pr(1, "green", bot),
+				pr(2, "red", bot),
+				pr(3, "green", &github.User{Login: stringPtr("someone")}),
+				pr(4, "green", bot),
+			})
+		case r.URL.Path == "/repos/testorg/repo1/pulls/1" || r.URL.Path == "/repos/testorg/repo1/pulls/2":
+			json.NewEncoder(w).Encode(github.PullRequest{Mergeable: github.Bool(true), MergeableState: stringPtr("clean")})
+		case r.URL.Path == "/repos/testorg/repo1/pulls/4":
+			json.NewEncoder(w).Encode(github.PullRequest{Mergeable: github.Bool(false), MergeableState: stringPtr("dirty")})
+		case r.URL.Path == "/repos/testorg/repo1/commits/green/status":
+			json.NewEncoder(w).Encode(github.CombinedStatus{State: stringPtr("pending"), TotalCount: github.Int(0)})
+		case r.URL.Path == "/repos/testorg/repo1/commits/green/check-runs":
+			json.NewEncoder(w).Encode(github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+				{Name: stringPtr("test"), Status: stringPtr("completed"), Conclusion: stringPtr("success")},
+				{Name: stringPtr("lint"), Status: stringPtr("completed"), Conclusion: stringPtr("skipped")},
+			}})
+		case r.URL.Path == "/repos/testorg/repo1/commits/red/status":
+			json.NewEncoder(w).Encode(github.CombinedStatus{State: stringPtr("success"), TotalCount: github.Int(1)})
+		case r.URL.Path == "/repos/testorg/repo1/commits/red/check-runs":
+			json.NewEncoder(w).Encode(github.ListCheckRunsResults{CheckRuns: []*github.CheckRun{
+				{Name: stringPtr("test"), Status: stringPtr("completed"), Conclusion: stringPtr("failure")},
+			}})
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/testorg/repo1/pulls/1/merge":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "squash", body["merge_method"])
+			assert.Equal(t, "green", body["sha"])
+			mu.Lock()
+			merged = append(merged, r.URL.Path)
+			mu.Unlock()
+			json.NewEncoder(w).Encode(github.PullRequestMergeResult{Merged: github.Bool(true)})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	opts := MergePullRequestsOptions{
+		Query:            PullRequestQuery{Author: "dependabot[bot]"},
+		Method:           MergeMethodSquash,
+		RequireChecks:    true,
+		RequireMergeable: true,
+		DryRun:           true,
+	}
+
+	results := service.MergePullRequests(context.Background(), "testorg", []string{"repo1"}, opts)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].PullRequests, 3)
+	assert.Equal(t, PullRequestStatusMatched, results[0].PullRequests[0].Status)
+	assert.Empty(t, merged)
+
+	opts.DryRun = false
+	results = service.MergePullRequests(context.Background(), "testorg", []string{"repo1", "missing"}, opts)
+	require.Len(t, results, 2)
+
+	prs := results[0].PullRequests
+	require.Len(t, prs, 3)
+	assert.Equal(t, PullRequestStatusMerged, prs[0].Status)
+	assert.Equal(t, PullRequestStatusSkipped, prs[1].Status)
+	assert.Equal(t, "check test failure", prs[1].Reason)
+	assert.Equal(t, PullRequestStatusSkipped, prs[2].Status)
+	assert.Equal(t, "not mergeable (dirty)", prs[2].Reason)
+	assert.Equal(t, []string{"/repos/testorg/repo1/pulls/1/merge"}, merged)
+
+	assert.Error(t, results[1].Err)
+}
+
+func TestApprovePullRequests_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	var approved []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user":
+			json.NewEncoder(w).Encode(github.User{Login: stringPtr("release-bot")})
+		case r.URL.Path == "/repos/testorg/repo1/pulls":
+			json.NewEncoder(w).Encode([]*github.PullRequest{
+				{Number: github.Int(1)}, {Number: github.Int(2)}, {Number: github.Int(3)},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/pulls/1/reviews":
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{
+				{User: &github.User{Login: stringPtr("release-bot")}, State: stringPtr("APPROVED")},
+			})
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{
+				{User: &github.User{Login: stringPtr("someone")}, State: stringPtr("APPROVED")},
+			})
+		case r.Method == http.MethodPost:
+			var review github.PullRequestReviewRequest
+			json.NewDecoder(r.Body).Decode(&review)
+			assert.Equal(t, "APPROVE", review.GetEvent())
+			assert.Equal(t, "LGTM", review.GetBody())
+			mu.Lock()
+			approved = append(approved, r.URL.Path)
+			mu.Unlock()
+			json.NewEncoder(w).Encode(github.PullRequestReview{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results, err := service.ApprovePullRequests(context.Background(), "testorg", []string{"repo1"},
+		ApprovePullRequestsOptions{Body: "LGTM", Max: 1})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	prs := results[0].PullRequests
+	require.Len(t, prs, 3)
+	assert.Equal(t, PullRequestStatusSkipped, prs[0].Status)
+	assert.Equal(t, "already approved", prs[0].Reason)
+	assert.Equal(t, PullRequestStatusApproved, prs[1].Status)
+	assert.Equal(t, PullRequestStatusSkipped, prs[2].Status)
+	assert.Equal(t, "approval limit reached", prs[2].Reason)
+	assert.Equal(t, []string{"/repos/testorg/repo1/pulls/2/reviews"}, approved)
+}
+
+func TestApprovePullRequests_FailedApprovalDoesNotCountTowardsMax(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/user":
+			json.NewEncoder(w).Encode(github.User{Login: stringPtr("release-bot")})
+		case r.URL.Path == "/repos/testorg/repo1/pulls":
+			json.NewEncoder(w).Encode([]*github.PullRequest{{Number: github.Int(1)}, {Number: github.Int(2)}})
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode([]*github.PullRequestReview{})
+		case r.URL.Path == "/repos/testorg/repo1/pulls/1/reviews":
+			http.Error(w, `{"message": "Can not approve your own pull request"}`, http.StatusUnprocessableEntity)
+		default:
+			json.NewEncoder(w).Encode(github.PullRequestReview{})
+		}
+	}, 1)
+
+	results, err := service.ApprovePullRequests(context.Background(), "testorg", []string{"repo1"},
+		ApprovePullRequestsOptions{Max: 1})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	prs := results[0].PullRequests
+	require.Len(t, prs, 2)
+	assert.Equal(t, PullRequestStatusFailed, prs[0].Status)
+	assert.Error(t, prs[0].Err)
+	assert.Equal(t, PullRequestStatusApproved, prs[1].Status)
+}
+
+func TestClosePullRequests_WithMockServer(t *testing.T) {
+	head := func(fullName, ref string) *github.PullRequestBranch {
+		return &github.PullRequestBranch{Ref: stringPtr(ref), Repo: &github.Repository{FullName: stringPtr(fullName)}}
+	}
+
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			mu.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/pulls":
+			json.NewEncoder(w).Encode([]*github.PullRequest{
+				{Number: github.Int(1), Head: head("testorg/repo1", "old-bot/update")},
+				{Number: github.Int(2), Head: head("someone/repo1", "patch")},
+			})
+		case r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(github.IssueComment{})
+		case r.Method == http.MethodPatch:
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "closed", body["state"])
+			json.NewEncoder(w).Encode(github.PullRequest{})
+		case r.Method == http.MethodDelete && r.URL.Path == "/repos/testorg/repo1/git/refs/heads/old-bot/update":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	opts := ClosePullRequestsOptions{
+		Comment: func(repoName string, pr *github.PullRequest) (string, error) {
+			return "Closing, the bot was decommissioned", nil
+		},
+		DeleteBranch: true,
+		DryRun:       true,
+	}
+
+	results := service.ClosePullRequests(context.Background(), "testorg", []string{"repo1"}, opts)
+	require.Len(t, results[0].PullRequests, 2)
+	assert.Equal(t, PullRequestStatusMatched, results[0].PullRequests[0].Status)
+	assert.Empty(t, requests)
+
+	opts.DryRun = false
+	results = service.ClosePullRequests(context.Background(), "testorg", []string{"repo1"}, opts)
+
+	prs := results[0].PullRequests
+	require.Len(t, prs, 2)
+	for _, pr := range prs {
+		require.NoError(t, pr.Err)
+		assert.Equal(t, PullRequestStatusClosed, pr.Status)
+	}
+	assert.Equal(t, "branch old-bot/update deleted", prs[0].Reason)
+	assert.Equal(t, "head branch is in a fork, not deleted", prs[1].Reason)
+	assert.Equal(t, []string{
+		"POST /repos/testorg/repo1/issues/1/comments",
+		"PATCH /repos/testorg/repo1/pulls/1",
+		"DELETE /repos/testorg/repo1/git/refs/heads/old-bot/update",
+		"POST /repos/testorg/repo1/issues/2/comments",
+		"PATCH /repos/testorg/repo1/pulls/2",
+	}, requests)
+}
@@ -0,0 +1,205 @@
+package ghbatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// ReleaseAssetUpload describes a local file to attach to a release.
+type ReleaseAssetUpload struct {
+	// Path is the local file to upload.
+	Path string
+	// Name is the name of the asset, the base name of Path when empty.
+	Name string
+	// Release is the tag of the release to upload to, the latest published release when empty.
+	Release string
+	// Replace deletes an existing asset with the same name first instead of failing.
+	Replace bool
+}
+
+// ReleaseAssetResult is the outcome of uploading an asset to a release of a repository.
+type ReleaseAssetResult struct {
+	RepoName string
+	// Release is the tag of the release the asset was uploaded to.
+	Release string
+	Name    string
+	Size    int64
+	// SHA256 is the checksum of the local file, which the uploaded asset was verified against.
+	SHA256 string
+	URL    string
+	Err    error
+}
+
+// RepoErr implements Result.
+func (r ReleaseAssetResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (ReleaseAssetResult) WithRepoErr(_, repoName string, err error) ReleaseAssetResult {
+	return ReleaseAssetResult{RepoName: repoName, Err: err}
+}
+
+// UploadReleaseAsset uploads a local file to a release of a repository and downloads it back to verify its
+// SHA-256 checksum. An asset that does not match is deleted again.
+func (s *Service) UploadReleaseAsset(ctx context.Context, owner, repoName string,
+	upload ReleaseAssetUpload,
+) (ReleaseAssetResult, error) {
+	result := ReleaseAssetResult{RepoName: repoName, Name: upload.Name}
+	if result.Name == "" {
+		result.Name = filepath.Base(upload.Path)
+	}
+
+	file, err := os.Open(upload.Path)
+	if err != nil {
+		return result, fmt.Errorf("failed to open %s: %w", upload.Path, err)
+	}
+	defer file.Close()
+
+	if result.SHA256, result.Size, err = checksum(file); err != nil {
+		return result, fmt.Errorf("failed to read %s: %w", upload.Path, err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return result, fmt.Errorf("failed to read %s: %w", upload.Path, err)
+	}
+
+	release, err := s.getRelease(ctx, owner, repoName, upload.Release)
+	if err != nil {
+		return result, err
+	}
+
+	result.Release = release.GetTagName()
+
+	for _, asset := range release.Assets {
+		if asset.GetName() != result.Name {
+			continue
+		}
+
+		if !upload.Replace {
+			return result, fmt.Errorf("asset %s already exists in release %s of %s/%s", result.Name, result.Release, owner, repoName)
+		}
+
+		_, err := s.client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, asset.GetID())
+		s.recordMutation(ctx, audit.Record{Operation: OperationDeleteReleaseAsset, Owner: owner, Repo: repoName,
+			Target: result.Release + "/" + result.Name}, err)
+		if err != nil {
+			return result, fmt.Errorf("failed to delete asset %s from release %s of %s/%s: %w", result.Name, result.Release, owner, repoName, err)
+		}
+	}
+
+	asset, _, err := s.client.Repositories.UploadReleaseAsset(ctx, owner, repoName, release.GetID(),
+		&github.UploadOptions{Name: result.Name}, file)
+	s.recordMutation(ctx, audit.Record{Operation: OperationUploadReleaseAsset, Owner: owner, Repo: repoName,
+		Target: result.Release + "/" + result.Name}, err)
+	if err != nil {
+		return result, fmt.Errorf("failed to upload %s to release %s of %s/%s: %w", result.Name, result.Release, owner, repoName, err)
+	}
+
+	result.URL = asset.GetBrowserDownloadURL()
+
+	if err := s.verifyReleaseAsset(ctx, owner, repoName, asset, result.SHA256); err != nil {
+		_, deleteErr := s.client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, asset.GetID())
+		s.recordMutation(ctx, audit.Record{Operation: OperationDeleteReleaseAsset, Owner: owner, Repo: repoName,
+			Target: result.Release + "/" + result.Name}, deleteErr)
+		if deleteErr != nil {
+			s.log.Error("Failed to delete unverified asset", "owner", owner, "repo", repoName, "asset", result.Name, "error", deleteErr)
+		}
+
+		result.URL = ""
+
+		return result, err
+	}
+
+	return result, nil
+}
+
+// UploadReleaseAssets uploads a rendered asset to a release of every given repository.
+func (s *Service) UploadReleaseAssets(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (ReleaseAssetUpload, error),
+) []ReleaseAssetResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) ReleaseAssetResult {
+		upload, err := render(repoName)
+		if err != nil {
+			return ReleaseAssetResult{RepoName: repoName, Err: err}
+		}
+
+		result, err := s.UploadReleaseAsset(ctx, owner, repoName, upload)
+		if err != nil {
+			s.log.Error("Failed to upload release asset", "owner", owner, "repo", repoName, "asset", result.Name, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		s.log.Info("Uploaded release asset", "owner", owner, "repo", repoName, "release", result.Release, "asset", result.Name, "sha256", result.SHA256)
+
+		return result
+	})
+}
+
+// getRelease returns the release of a repository with the given tag, or its latest published release when
+// tag is empty.
+func (s *Service) getRelease(ctx context.Context, owner, repoName, tag string) (*github.RepositoryRelease, error) {
+	if tag == "" {
+		release, err := s.GetLatestRelease(ctx, owner, repoName)
+		if err == nil && release == nil {
+			err = fmt.Errorf("%s/%s has no published release", owner, repoName)
+		}
+
+		return release, err
+	}
+
+	release, resp, err := s.client.Repositories.GetReleaseByTag(ctx, owner, repoName, tag)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%s/%s has no release %s", owner, repoName, tag)
+		}
+
+		return nil, fmt.Errorf("failed to get release %s of %s/%s: %w", tag, owner, repoName, err)
+	}
+
+	return release, nil
+}
+
+// verifyReleaseAsset downloads an uploaded asset and compares its SHA-256 checksum with the expected one.
+func (s *Service) verifyReleaseAsset(ctx context.Context, owner, repoName string, asset *github.ReleaseAsset,
+	expected string,
+) error {
+	content, _, err := s.client.Repositories.DownloadReleaseAsset(ctx, owner, repoName, asset.GetID(), http.DefaultClient)
+	if err != nil {
+		return fmt.Errorf("failed to download asset %s of %s/%s for verification: %w", asset.GetName(), owner, repoName, err)
+	}
+	defer content.Close()
+
+	actual, _, err := checksum(content)
+	if err != nil {
+		return fmt.Errorf("failed to download asset %s of %s/%s for verification: %w", asset.GetName(), owner, repoName, err)
+	}
+
+	if actual != expected {
+		return fmt.Errorf("checksum mismatch for asset %s of %s/%s: uploaded %s, stored %s", asset.GetName(), owner, repoName, expected, actual)
+	}
+
+	return nil
+}
+
+// checksum returns the hex encoded SHA-256 checksum and the size of the content of r.
+func checksum(r io.Reader) (string, int64, error) {
+	hash := sha256.New()
+
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
@@ -0,0 +1,99 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadReleaseAssets_WithMockServer(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	stored := map[string]string{}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch {
+		case r.URL.Path == "/repos/testorg/api/releases/latest":
+			json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), TagName: stringPtr("v1.0.0"),
+				Assets: []*github.ReleaseAsset{{ID: github.Int64(10), Name: stringPtr("sbom.json")}}})
+		case r.URL.Path == "/repos/testorg/corrupt/releases/tags/v2.0.0":
+			json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(2), TagName: stringPtr("v2.0.0")})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/assets"):
+			body, _ := io.ReadAll(r.Body)
+			stored[repoName] = string(body)
+			if repoName == "corrupt" {
+				stored[repoName] = "truncated"
+			}
+			json.NewEncoder(w).Encode(github.ReleaseAsset{ID: github.Int64(20), Name: stringPtr(r.URL.Query().Get("name")),
+				BrowserDownloadURL: stringPtr("https://github.com/testorg/" + repoName + "/releases/download/sbom.json")})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/releases/assets/20"):
+			io.WriteString(w, stored[repoName])
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+	service.client.UploadURL = service.client.BaseURL
+
+	dir := t.TempDir()
+	for _, repoName := range []string{"api", "corrupt", "norelease"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, repoName+".json"), []byte(`{"repo":"`+repoName+`"}`), 0o600))
+	}
+
+	results := service.UploadReleaseAssets(context.Background(), "testorg", []string{"api", "corrupt", "norelease"},
+		func(repoName string) (ReleaseAssetUpload, error) {
+			upload := ReleaseAssetUpload{Path: filepath.Join(dir, repoName+".json"), Name: "sbom.json", Replace: true}
+			if repoName == "corrupt" {
+				upload.Release = "v2.0.0"
+			}
+			return upload, nil
+		})
+
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, "v1.0.0", results[0].Release)
+	assert.Equal(t, int64(len(`{"repo":"api"}`)), results[0].Size)
+	assert.Len(t, results[0].SHA256, 64)
+	assert.Equal(t, `{"repo":"api"}`, stored["api"])
+	assert.Equal(t, "https://github.com/testorg/api/releases/download/sbom.json", results[0].URL)
+
+	assert.ErrorContains(t, results[1].Err, "checksum mismatch for asset sbom.json of testorg/corrupt")
+	assert.Empty(t, results[1].URL)
+	assert.ErrorContains(t, results[2].Err, "testorg/norelease has no published release")
+
+	assert.Contains(t, requests, "DELETE /repos/testorg/api/releases/assets/10")
+	assert.Contains(t, requests, "DELETE /repos/testorg/corrupt/releases/assets/20")
+}
+
+func TestUploadReleaseAsset_ExistingAsset(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/testorg/api/releases/latest" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(github.RepositoryRelease{ID: github.Int64(1), TagName: stringPtr("v1.0.0"),
+			Assets: []*github.ReleaseAsset{{ID: github.Int64(10), Name: stringPtr("sbom.json")}}})
+	}, 1)
+
+	path := filepath.Join(t.TempDir(), "sbom.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	_, err := service.UploadReleaseAsset(context.Background(), "testorg", "api", ReleaseAssetUpload{Path: path})
+	assert.ErrorContains(t, err, "asset sbom.json already exists in release v1.0.0 of testorg/api")
+}
@@ -0,0 +1,158 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// ReleasePruneOptions configures which releases of a repository are deleted.
+type ReleasePruneOptions struct {
+	// Drafts deletes every draft release.
+	Drafts bool
+	// Keep protects the newest published releases, pre-releases included, when greater than zero.
+	Keep int
+	// OlderThan deletes published releases published before this time when not zero. Combined with Keep, a
+	// release is only deleted when it is both outside the newest Keep and older than the cutoff.
+	OlderThan time.Time
+	// DeleteTags also deletes the tags of the deleted published releases.
+	DeleteTags bool
+	// DryRun only lists the releases that would be deleted.
+	DryRun bool
+}
+
+// PrunedRelease is a release selected for deletion and the outcome of deleting it.
+type PrunedRelease struct {
+	Tag         string
+	Name        string
+	Draft       bool
+	PublishedAt time.Time
+	Err         error
+}
+
+// RepoReleasePruneResult groups the pruned releases of a repository. Err is set when the releases of the
+// repository could not be listed.
+type RepoReleasePruneResult struct {
+	RepoName string
+	Releases []PrunedRelease
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RepoReleasePruneResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoReleasePruneResult) WithRepoErr(_, repoName string, err error) RepoReleasePruneResult {
+	return RepoReleasePruneResult{RepoName: repoName, Err: err}
+}
+
+// selectReleasesToPrune returns the releases the options select for deletion, drafts first and then the
+// published releases from newest to oldest.
+func selectReleasesToPrune(releases []*github.RepositoryRelease, opts ReleasePruneOptions) []*github.RepositoryRelease {
+	var drafts, published []*github.RepositoryRelease
+
+	for _, release := range releases {
+		if release.GetDraft() {
+			drafts = append(drafts, release)
+		} else {
+			published = append(published, release)
+		}
+	}
+
+	var selected []*github.RepositoryRelease
+	if opts.Drafts {
+		selected = append(selected, drafts...)
+	}
+
+	if opts.Keep <= 0 && opts.OlderThan.IsZero() {
+		return selected
+	}
+
+	sort.SliceStable(published, func(i, j int) bool {
+		return published[i].GetPublishedAt().After(published[j].GetPublishedAt().Time)
+	})
+
+	for i, release := range published {
+		if opts.Keep > 0 && i < opts.Keep {
+			continue
+		}
+
+		if !opts.OlderThan.IsZero() && !release.GetPublishedAt().Before(opts.OlderThan) {
+			continue
+		}
+
+		selected = append(selected, release)
+	}
+
+	return selected
+}
+
+// PruneReleases deletes the releases the options select in all the given repositories.
+func (s *Service) PruneReleases(ctx context.Context, owner string, repoNames []string,
+	opts ReleasePruneOptions,
+) []RepoReleasePruneResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoReleasePruneResult {
+		result := RepoReleasePruneResult{RepoName: repoName}
+
+		releases, err := s.ListReleases(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to list releases", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, release := range selectReleasesToPrune(releases, opts) {
+			pruned := PrunedRelease{
+				Tag:         release.GetTagName(),
+				Name:        release.GetName(),
+				Draft:       release.GetDraft(),
+				PublishedAt: release.GetPublishedAt().Time,
+			}
+
+			if !opts.DryRun {
+				pruned.Err = s.deleteRelease(ctx, owner, repoName, release, opts.DeleteTags && !release.GetDraft())
+			}
+
+			result.Releases = append(result.Releases, pruned)
+		}
+
+		return result
+	})
+}
+
+// deleteRelease deletes a release and, when deleteTag is set, its tag. A tag that no longer exists is not
+// an error.
+func (s *Service) deleteRelease(ctx context.Context, owner, repoName string, release *github.RepositoryRelease,
+	deleteTag bool,
+) error {
+	s.log.Info("Deleting release", "owner", owner, "repo", repoName, "tag", release.GetTagName(), "draft", release.GetDraft())
+
+	_, err := s.client.Repositories.DeleteRelease(ctx, owner, repoName, release.GetID())
+	s.recordMutation(ctx, audit.Record{Operation: OperationDeleteRelease, Owner: owner, Repo: repoName, Target: release.GetTagName()}, err)
+	if err != nil {
+		return fmt.Errorf("failed to delete release %s of %s/%s: %w", release.GetTagName(), owner, repoName, err)
+	}
+
+	if !deleteTag {
+		return nil
+	}
+
+	resp, err := s.client.Git.DeleteRef(ctx, owner, repoName, "tags/"+release.GetTagName())
+	if err != nil && resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusUnprocessableEntity) {
+		return nil
+	}
+
+	s.recordMutation(ctx, audit.Record{Operation: OperationDeleteTag, Owner: owner, Repo: repoName, Target: release.GetTagName()}, err)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag %s of %s/%s: %w", release.GetTagName(), owner, repoName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectReleasesToPrune(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	release := func(tag string, daysAgo int, draft bool) *github.RepositoryRelease {
+		r := &github.RepositoryRelease{TagName: github.String(tag), Draft: github.Bool(draft)}
+		if !draft {
+			r.PublishedAt = &github.Timestamp{Time: now.AddDate(0, 0, -daysAgo)}
+		}
+		return r
+	}
+
+	// Listed out of order on purpose
+	releases := []*github.RepositoryRelease{
+		release("v1.0.0", 400, false),
+		release("v3.0.0", 10, false),
+		release("draft", 0, true),
+		release("v2.0.0", 200, false),
+		release("v2.1.0", 100, false),
+	}
+
+	tags := func(selected []*github.RepositoryRelease) []string {
+		var result []string
+		for _, r := range selected {
+			result = append(result, r.GetTagName())
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		opts     ReleasePruneOptions
+		expected []string
+	}{
+		{"Drafts only", ReleasePruneOptions{Drafts: true}, []string{"draft"}},
+		{"Keep newest", ReleasePruneOptions{Keep: 2}, []string{"v2.0.0", "v1.0.0"}},
+		{"Older than", ReleasePruneOptions{OlderThan: now.AddDate(0, 0, -150)}, []string{"v2.0.0", "v1.0.0"}},
+		{"Keep and older than", ReleasePruneOptions{Keep: 3, OlderThan: now.AddDate(0, 0, -50)}, []string{"v1.0.0"}},
+		{"Everything", ReleasePruneOptions{Drafts: true, Keep: 1}, []string{"draft", "v2.1.0", "v2.0.0", "v1.0.0"}},
+		{"Nothing selected", ReleasePruneOptions{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tags(selectReleasesToPrune(releases, tt.opts)))
+		})
+	}
+}
+
+func TestPruneReleases_WithMockServer(t *testing.T) {
+	old := &github.Timestamp{Time: time.Now().AddDate(-1, 0, 0)}
+
+	var mu sync.Mutex
+	var requests []string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/releases":
+			json.NewEncoder(w).Encode([]*github.RepositoryRelease{
+				{ID: github.Int64(1), TagName: github.String("v2.0.0"), PublishedAt: &github.Timestamp{Time: time.Now()}},
+				{ID: github.Int64(2), TagName: github.String("v1.0.0"), PublishedAt: old},
+				{ID: github.Int64(3), TagName: github.String("v2.1.0"), Draft: github.Bool(true)},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/repos/testorg/repo1/git/refs/tags/v1.0.0":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	opts := ReleasePruneOptions{Drafts: true, Keep: 1, DeleteTags: true, DryRun: true}
+
+	results := service.PruneReleases(context.Background(), "testorg", []string{"repo1"}, opts)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Releases, 2)
+	assert.Equal(t, []string{"GET /repos/testorg/repo1/releases"}, requests)
+
+	requests = nil
+	opts.DryRun = false
+	results = service.PruneReleases(context.Background(), "testorg", []string{"repo1", "missing"}, opts)
+	require.Len(t, results, 2)
+	for _, release := range results[0].Releases {
+		assert.NoError(t, release.Err)
+	}
+	assert.Error(t, results[1].Err)
+	// The tag of the draft is left alone, and the already deleted tag of v1.0.0 is not an error
+	assert.Equal(t, []string{
+		"GET /repos/testorg/repo1/releases",
+		"DELETE /repos/testorg/repo1/releases/3",
+		"DELETE /repos/testorg/repo1/releases/2",
+		"DELETE /repos/testorg/repo1/git/refs/tags/v1.0.0",
+		"GET /repos/testorg/missing/releases",
+	}, requests)
+}
@@ -0,0 +1,196 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// ReleaseReport describes the latest published release of a repository.
+type ReleaseReport struct {
+	RepoName string
+	// Tag is the tag of the latest release, empty if the repository has never released.
+	Tag         string
+	Name        string
+	URL         string
+	PublishedAt time.Time
+	Err         error
+}
+
+// RepoErr implements Result.
+func (r ReleaseReport) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (ReleaseReport) WithRepoErr(_, repoName string, err error) ReleaseReport {
+	return ReleaseReport{RepoName: repoName, Err: err}
+}
+
+// NewRelease describes a release to create together with its tag.
+type NewRelease struct {
+	Tag  string
+	Name string
+	Body string
+	// GenerateNotes has GitHub generate the notes from the changes since the previous release. Body, when
+	// set, is placed above the generated notes.
+	GenerateNotes bool
+	Draft         bool
+	Prerelease    bool
+}
+
+// ReleaseResult is the outcome of creating a release in a repository.
+type ReleaseResult struct {
+	RepoName string
+	Tag      string
+	// Commit is the default branch head the tag points to.
+	Commit string
+	URL    string
+	Err    error
+}
+
+// RepoErr implements Result.
+func (r ReleaseResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (ReleaseResult) WithRepoErr(_, repoName string, err error) ReleaseResult {
+	return ReleaseResult{RepoName: repoName, Err: err}
+}
+
+// HasRelease reports whether the repository has a published release.
+func (r ReleaseReport) HasRelease() bool {
+	return r.Tag != ""
+}
+
+// ListReleases lists all releases of a repository, newest first, including drafts and pre-releases.
+func (s *Service) ListReleases(ctx context.Context, owner, repoName string) ([]*github.RepositoryRelease, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var releases []*github.RepositoryRelease
+
+	for {
+		page, resp, err := s.client.Repositories.ListReleases(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list releases for %s/%s: %w", owner, repoName, err)
+		}
+
+		releases = append(releases, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+// GetLatestRelease returns the latest published full release of a repository, or nil if it has none.
+func (s *Service) GetLatestRelease(ctx context.Context, owner, repoName string) (*github.RepositoryRelease, error) {
+	release, resp, err := s.client.Repositories.GetLatestRelease(ctx, owner, repoName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get latest release for %s/%s: %w", owner, repoName, err)
+	}
+
+	return release, nil
+}
+
+// GetReleaseReports reports the latest release of all the given repositories.
+func (s *Service) GetReleaseReports(ctx context.Context, owner string, repoNames []string) []ReleaseReport {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) ReleaseReport {
+		report := ReleaseReport{RepoName: repoName}
+
+		release, err := s.GetLatestRelease(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get latest release", "owner", owner, "repo", repoName, "error", err)
+			report.Err = err
+
+			return report
+		}
+
+		if release != nil {
+			report.Tag = release.GetTagName()
+			report.Name = release.GetName()
+			report.URL = release.GetHTMLURL()
+			report.PublishedAt = release.GetPublishedAt().Time
+		}
+
+		return report
+	})
+}
+
+// CreateRelease tags the head of the default branch of a repository and creates a release for the tag. It
+// fails when the tag already exists rather than publishing a release for an older commit.
+func (s *Service) CreateRelease(ctx context.Context, owner, repoName string, release NewRelease) (ReleaseResult, error) {
+	result := ReleaseResult{RepoName: repoName, Tag: release.Tag}
+
+	_, resp, err := s.client.Git.GetRef(ctx, owner, repoName, "tags/"+release.Tag)
+	if err == nil {
+		return result, fmt.Errorf("tag %s already exists in %s/%s", release.Tag, owner, repoName)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return result, fmt.Errorf("failed to get tag %s in %s/%s: %w", release.Tag, owner, repoName, err)
+	}
+
+	head, err := s.getBranchHead(ctx, owner, repoName, "")
+	if err != nil {
+		return result, err
+	}
+
+	result.Commit = head.ref.GetObject().GetSHA()
+
+	request := &github.RepositoryRelease{
+		TagName:              github.String(release.Tag),
+		TargetCommitish:      github.String(result.Commit),
+		Name:                 github.String(release.Name),
+		Draft:                github.Bool(release.Draft),
+		Prerelease:           github.Bool(release.Prerelease),
+		GenerateReleaseNotes: github.Bool(release.GenerateNotes),
+	}
+	if release.Body != "" {
+		request.Body = github.String(release.Body)
+	}
+
+	created, _, err := s.client.Repositories.CreateRelease(ctx, owner, repoName, request)
+	s.recordMutation(ctx, audit.Record{Operation: OperationCreateRelease, Owner: owner, Repo: repoName, Target: release.Tag,
+		CommitSHA: result.Commit}, err)
+	if err != nil {
+		return result, fmt.Errorf("failed to create release %s in %s/%s: %w", release.Tag, owner, repoName, err)
+	}
+
+	result.URL = created.GetHTMLURL()
+
+	return result, nil
+}
+
+// CreateReleases creates a rendered release in every given repository.
+func (s *Service) CreateReleases(ctx context.Context, owner string, repoNames []string,
+	render func(repoName string) (NewRelease, error),
+) []ReleaseResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) ReleaseResult {
+		release, err := render(repoName)
+		if err != nil {
+			return ReleaseResult{RepoName: repoName, Err: err}
+		}
+
+		result, err := s.CreateRelease(ctx, owner, repoName, release)
+		if err != nil {
+			s.log.Error("Failed to create release", "owner", owner, "repo", repoName, "tag", release.Tag, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		s.log.Info("Created release", "owner", owner, "repo", repoName, "tag", release.Tag, "url", result.URL)
+
+		return result
+	})
+}
@@ -0,0 +1,113 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReleaseReports_WithMockServer(t *testing.T) {
+	published := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/released/releases/latest":
+			json.NewEncoder(w).Encode(github.RepositoryRelease{
+				TagName:     stringPtr("v1.2.0"),
+				Name:        stringPtr("1.2.0"),
+				PublishedAt: &github.Timestamp{Time: published},
+			})
+		case "/repos/testorg/never/releases/latest":
+			http.NotFound(w, r)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}, 2)
+
+	reports := service.GetReleaseReports(context.Background(), "testorg", []string{"released", "never", "broken"})
+	require.Len(t, reports, 3)
+
+	assert.True(t, reports[0].HasRelease())
+	assert.Equal(t, "v1.2.0", reports[0].Tag)
+	assert.True(t, published.Equal(reports[0].PublishedAt))
+
+	require.NoError(t, reports[1].Err)
+	assert.False(t, reports[1].HasRelease())
+
+	assert.Error(t, reports[2].Err)
+}
+
+func TestListReleases_Pagination(t *testing.T) {
+	var serverURL string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/testorg/repo1/releases?page=2>; rel="next"`, serverURL))
+			json.NewEncoder(w).Encode([]*github.RepositoryRelease{{TagName: stringPtr("v2.0.0")}})
+
+			return
+		}
+
+		json.NewEncoder(w).Encode([]*github.RepositoryRelease{{TagName: stringPtr("v1.0.0")}})
+	}, 1)
+	serverURL = strings.TrimSuffix(service.client.BaseURL.String(), "/")
+
+	releases, err := service.ListReleases(context.Background(), "testorg", "repo1")
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, "v2.0.0", releases[0].GetTagName())
+	assert.Equal(t, "v1.0.0", releases[1].GetTagName())
+}
+
+func TestCreateReleases_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch {
+		case r.URL.Path == "/repos/testorg/tagged/git/ref/tags/v2.0.0":
+			json.NewEncoder(w).Encode(github.Reference{Ref: stringPtr("refs/tags/v2.0.0")})
+		case strings.Contains(r.URL.Path, "/git/ref/tags/"):
+			http.NotFound(w, r)
+		case r.URL.Path == "/repos/testorg/"+repoName:
+			json.NewEncoder(w).Encode(github.Repository{DefaultBranch: stringPtr("main")})
+		case strings.HasSuffix(r.URL.Path, "/git/ref/heads/main"):
+			json.NewEncoder(w).Encode(github.Reference{Object: &github.GitObject{SHA: stringPtr("abc123")}})
+		case strings.HasSuffix(r.URL.Path, "/git/commits/abc123"):
+			json.NewEncoder(w).Encode(github.Commit{SHA: stringPtr("abc123")})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/releases"):
+			var body github.RepositoryRelease
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "abc123", body.GetTargetCommitish())
+			assert.Equal(t, "Release v2.0.0 of "+repoName, body.GetName())
+			assert.True(t, body.GetGenerateReleaseNotes())
+			assert.Nil(t, body.Body)
+			json.NewEncoder(w).Encode(github.RepositoryRelease{HTMLURL: stringPtr("https://github.com/testorg/" + repoName + "/releases/tag/v2.0.0")})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.CreateReleases(context.Background(), "testorg", []string{"api", "tagged", "broken"},
+		func(repoName string) (NewRelease, error) {
+			if repoName == "broken" {
+				return NewRelease{}, fmt.Errorf("template error")
+			}
+			return NewRelease{Tag: "v2.0.0", Name: "Release v2.0.0 of " + repoName, GenerateNotes: true}, nil
+		})
+
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, ReleaseResult{
+		RepoName: "api", Tag: "v2.0.0", Commit: "abc123", URL: "https://github.com/testorg/api/releases/tag/v2.0.0",
+	}, results[0])
+	assert.ErrorContains(t, results[1].Err, "tag v2.0.0 already exists in testorg/tagged")
+	assert.ErrorContains(t, results[2].Err, "template error")
+}
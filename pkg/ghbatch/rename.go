@@ -0,0 +1,104 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// RepoRename describes a planned repository rename.
+type RepoRename struct {
+	OldName string
+	NewName string
+}
+
+// PlanRenames computes the renames produced by applying pattern/replacement to the candidate repository names.
+// Candidates that do not match, or whose name would not change, are left out of the plan.
+// existingNames must contain every repository name of the owner and is used for collision detection:
+// a rename collides when its new name is already taken by another repository or by another planned rename,
+// ignoring case as GitHub does. A rename that only changes the case of its own name does not collide.
+// The returned plan is sorted by old name; collisions are returned as human readable descriptions.
+func PlanRenames(candidates, existingNames []string, pattern *regexp.Regexp, replacement string) ([]RepoRename, []string) {
+	var plan []RepoRename
+
+	for _, name := range candidates {
+		if !pattern.MatchString(name) {
+			continue
+		}
+
+		newName := pattern.ReplaceAllString(name, replacement)
+		if newName == name {
+			continue
+		}
+
+		plan = append(plan, RepoRename{OldName: name, NewName: newName})
+	}
+
+	sort.Slice(plan, func(i, j int) bool { return plan[i].OldName < plan[j].OldName })
+
+	// Repository names are case-insensitive, so names are compared in lower case
+	existing := make(map[string]string, len(existingNames))
+	for _, name := range existingNames {
+		existing[strings.ToLower(name)] = name
+	}
+
+	var collisions []string
+
+	targets := make(map[string]string, len(plan))
+
+	for _, rename := range plan {
+		key := strings.ToLower(rename.NewName)
+
+		switch {
+		case rename.NewName == "":
+			collisions = append(collisions, fmt.Sprintf("%s would be renamed to an empty name", rename.OldName))
+		case existing[key] != "" && !strings.EqualFold(rename.NewName, rename.OldName):
+			collisions = append(collisions, fmt.Sprintf("%s -> %s: a repository named %s already exists",
+				rename.OldName, rename.NewName, existing[key]))
+		case targets[key] != "":
+			collisions = append(collisions, fmt.Sprintf("%s -> %s: also the target of %s",
+				rename.OldName, rename.NewName, targets[key]))
+		default:
+			targets[key] = rename.OldName
+		}
+	}
+
+	return plan, collisions
+}
+
+// RenameRepository renames a single repository.
+func (s *Service) RenameRepository(ctx context.Context, owner, oldName, newName string) error {
+	s.log.Info("Renaming repository", "owner", owner, "repo", oldName, "newName", newName)
+
+	_, _, err := s.client.Repositories.Edit(ctx, owner, oldName, &github.Repository{Name: github.String(newName)})
+	s.recordMutation(ctx, audit.Record{Operation: OperationRenameRepo, Owner: owner, Repo: oldName, Target: newName}, err)
+	if err != nil {
+		return fmt.Errorf("failed to rename repository %s/%s to %s: %w", owner, oldName, newName, err)
+	}
+
+	return nil
+}
+
+// RenameRepos applies all the given renames.
+func (s *Service) RenameRepos(ctx context.Context, owner string, renames []RepoRename,
+	onResult RepoResultFunc,
+) ([]string, []string) {
+	newNames := make(map[string]string, len(renames))
+	oldNames := make([]string, 0, len(renames))
+
+	for _, rename := range renames {
+		newNames[rename.OldName] = rename.NewName
+		oldNames = append(oldNames, rename.OldName)
+	}
+
+	return s.processReposConcurrently(ctx, "rename", oldNames,
+		func(ctx context.Context, repoName string) error {
+			return s.RenameRepository(ctx, owner, repoName, newNames[repoName])
+		}, onResult)
+}
@@ -0,0 +1,109 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanRenames(t *testing.T) {
+	pattern := regexp.MustCompile(`^svc-(.*)$`)
+
+	tests := []struct {
+		name               string
+		candidates         []string
+		existing           []string
+		expectedPlan       []RepoRename
+		expectedCollisions int
+	}{
+		{
+			name:       "Renames only matching repositories",
+			candidates: []string{"svc-b", "svc-a", "other"},
+			existing:   []string{"svc-a", "svc-b", "other"},
+			expectedPlan: []RepoRename{
+				{OldName: "svc-a", NewName: "service-a"},
+				{OldName: "svc-b", NewName: "service-b"},
+			},
+		},
+		{
+			name:       "Collision with existing repository",
+			candidates: []string{"svc-a"},
+			existing:   []string{"svc-a", "service-a"},
+			expectedPlan: []RepoRename{
+				{OldName: "svc-a", NewName: "service-a"},
+			},
+			expectedCollisions: 1,
+		},
+		{
+			name:       "No matches",
+			candidates: []string{"api", "web"},
+			existing:   []string{"api", "web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, collisions := PlanRenames(tt.candidates, tt.existing, pattern, "service-$1")
+
+			assert.Equal(t, tt.expectedPlan, plan)
+			assert.Len(t, collisions, tt.expectedCollisions)
+		})
+	}
+}
+
+func TestPlanRenames_DuplicateTargets(t *testing.T) {
+	// Both repositories collapse onto the same name
+	pattern := regexp.MustCompile(`^(svc|service)-api$`)
+
+	plan, collisions := PlanRenames([]string{"svc-api", "service-api"}, []string{"svc-api", "service-api"},
+		pattern, "api")
+
+	assert.Len(t, plan, 2)
+	assert.Len(t, collisions, 1)
+}
+
+func TestPlanRenames_IgnoresCase(t *testing.T) {
+	pattern := regexp.MustCompile(`^(.*)$`)
+
+	// Only the case of its own name changes
+	plan, collisions := PlanRenames([]string{"Svc-API"}, []string{"Svc-API"}, pattern, "svc-api")
+	assert.Equal(t, []RepoRename{{OldName: "Svc-API", NewName: "svc-api"}}, plan)
+	assert.Empty(t, collisions)
+
+	// Another repository differs from the new name only in case
+	pattern = regexp.MustCompile(`^svc-(.*)$`)
+	_, collisions = PlanRenames([]string{"svc-a"}, []string{"svc-a", "Service-A"}, pattern, "service-$1")
+	assert.Equal(t, []string{"svc-a -> service-a: a repository named Service-A already exists"}, collisions)
+
+	// Two renames whose targets differ only in case
+	pattern = regexp.MustCompile(`^svc[-_](.*)$`)
+	_, collisions = PlanRenames([]string{"svc-a", "svc_A"}, []string{"svc-a", "svc_A"}, pattern, "service-$1")
+	assert.Equal(t, []string{"svc_A -> service-A: also the target of svc-a"}, collisions)
+}
+
+func TestRenameRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var body github.Repository
+		json.NewDecoder(r.Body).Decode(&body)
+
+		if r.URL.Path == "/repos/testorg/svc-a" {
+			assert.Equal(t, "service-a", body.GetName())
+			json.NewEncoder(w).Encode(body)
+			return
+		}
+		http.NotFound(w, r)
+	}, 1)
+
+	successRepos, failedRepos := service.RenameRepos(context.Background(), "testorg", []RepoRename{
+		{OldName: "svc-a", NewName: "service-a"},
+		{OldName: "svc-missing", NewName: "service-missing"},
+	}, nil)
+
+	assert.Equal(t, []string{"svc-a"}, successRepos)
+	assert.Equal(t, []string{"svc-missing"}, failedRepos)
+}
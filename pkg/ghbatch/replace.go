@@ -0,0 +1,48 @@
+package ghbatch
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Substitution replaces text in file contents, e.g. a badge URL or an image tag.
+type Substitution struct {
+	// Find is the literal text to replace, or a regular expression when Regexp is set.
+	Find string
+	// Replace is the replacement text. With Regexp it may reference capture groups as $1 or ${name}.
+	Replace string
+	Regexp  bool
+}
+
+// Edit returns a FileRollout.Edit function applying the substitution to every match in a file. Files
+// without a match are skipped.
+func (s Substitution) Edit() (func(repoName, content string) (string, error), error) {
+	if s.Find == "" {
+		return nil, fmt.Errorf("the text to find must not be empty")
+	}
+
+	replace := func(content string) (string, bool) {
+		return strings.ReplaceAll(content, s.Find, s.Replace), strings.Contains(content, s.Find)
+	}
+
+	if s.Regexp {
+		pattern, err := regexp.Compile(s.Find)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", s.Find, err)
+		}
+
+		replace = func(content string) (string, bool) {
+			return pattern.ReplaceAllString(content, s.Replace), pattern.MatchString(content)
+		}
+	}
+
+	return func(_, content string) (string, error) {
+		replaced, matched := replace(content)
+		if !matched {
+			return "", ErrSkipFile
+		}
+
+		return replaced, nil
+	}, nil
+}
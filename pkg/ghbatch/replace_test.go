@@ -0,0 +1,115 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubstitution_Edit(t *testing.T) {
+	tests := []struct {
+		name         string
+		substitution Substitution
+		content      string
+		want         string
+		wantSkip     bool
+		wantErr      string
+	}{
+		{
+			name:         "Literal",
+			substitution: Substitution{Find: "img.shields.io/travis", Replace: "img.shields.io/github"},
+			content:      "[![build](https://img.shields.io/travis/a)] [![x](https://img.shields.io/travis/b)]",
+			want:         "[![build](https://img.shields.io/github/a)] [![x](https://img.shields.io/github/b)]",
+		},
+		{
+			name:         "Literal is not a pattern",
+			substitution: Substitution{Find: "a.c", Replace: "x"},
+			content:      "abc",
+			wantSkip:     true,
+		},
+		{
+			name:         "Regexp with capture group",
+			substitution: Substitution{Find: `golang:1\.(\d+)`, Replace: "golang:1.22 # was 1.$1", Regexp: true},
+			content:      "FROM golang:1.20\n",
+			want:         "FROM golang:1.22 # was 1.20\n",
+		},
+		{
+			name:         "Regexp without match",
+			substitution: Substitution{Find: `node:\d+`, Replace: "node:20", Regexp: true},
+			content:      "FROM golang:1.20\n",
+			wantSkip:     true,
+		},
+		{
+			name:         "Invalid regexp",
+			substitution: Substitution{Find: "(", Regexp: true},
+			wantErr:      "invalid regular expression",
+		},
+		{
+			name:         "Empty find",
+			substitution: Substitution{},
+			wantErr:      "must not be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			edit, err := tt.substitution.Edit()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := edit("repo", tt.content)
+			if tt.wantSkip {
+				assert.ErrorIs(t, err, ErrSkipFile)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestApplyFileToRepos_Edit(t *testing.T) {
+	var written *github.RepositoryContentFileOptions
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/api/contents/README.md":
+			json.NewEncoder(w).Encode(encodedFile("image: app:1.0\n", "readme-sha"))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/other/contents/README.md":
+			json.NewEncoder(w).Encode(encodedFile("nothing to see\n", "other-sha"))
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/testorg/api/contents/README.md":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&written))
+			json.NewEncoder(w).Encode(github.RepositoryContentResponse{})
+		case r.Method == http.MethodGet:
+			http.NotFound(w, r)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}, 1)
+
+	edit, err := Substitution{Find: `app:\d+\.\d+`, Replace: "app:2.0", Regexp: true}.Edit()
+	require.NoError(t, err)
+
+	results := service.ApplyFileToRepos(context.Background(), "testorg", []string{"api", "other", "missing"}, FileRollout{
+		Path:          "README.md",
+		Edit:          edit,
+		CommitMessage: "Bump app image",
+	})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, FileStatusUpdated, results[0].Status)
+	assert.Equal(t, FileStatusSkipped, results[1].Status, "files without a match are skipped")
+	assert.Equal(t, FileStatusSkipped, results[2].Status, "repositories without the file are skipped")
+
+	require.NotNil(t, written)
+	assert.Equal(t, "image: app:2.0\n", string(written.Content))
+	assert.Equal(t, "readme-sha", written.GetSHA())
+}
@@ -0,0 +1,30 @@
+package ghbatch
+
+import (
+	"context"
+)
+
+// RepoOperation is a custom operation on one repository, such as one implemented by a plugin.
+type RepoOperation func(ctx context.Context, repoName string) (RepoOperationResult, error)
+
+// RepoOperationResult is the outcome a custom operation reports for a repository.
+type RepoOperationResult struct {
+	// Changed reports whether the operation changed the repository.
+	Changed bool
+	// Message describes what the operation did, or would do in a dry run.
+	Message string
+}
+
+// RunRepoOperation runs a custom operation on all the given repositories.
+func (s *Service) RunRepoOperation(ctx context.Context, owner string, repoNames []string,
+	op RepoOperation,
+) []RepoResult[RepoOperationResult] {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoResult[RepoOperationResult] {
+		result, err := op(ctx, repoName)
+		if err != nil {
+			s.log.Error("Custom operation failed", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return RepoResult[RepoOperationResult]{Owner: owner, RepoName: repoName, Value: result, Err: err}
+	})
+}
@@ -0,0 +1,32 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunRepoOperation(t *testing.T) {
+	service := newTestService(t, http.NotFound, 2)
+
+	results := service.RunRepoOperation(context.Background(), "testorg", []string{"api", "web", "docs"},
+		func(ctx context.Context, repoName string) (RepoOperationResult, error) {
+			if repoName == "web" {
+				return RepoOperationResult{}, errors.New("boom")
+			}
+
+			return RepoOperationResult{Changed: repoName == "api", Message: "checked " + repoName}, nil
+		})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, RepoResult[RepoOperationResult]{Owner: "testorg", RepoName: "api",
+		Value: RepoOperationResult{Changed: true, Message: "checked api"}}, results[0])
+	assert.EqualError(t, results[1].Err, "boom")
+	assert.Equal(t, "web", results[1].RepoName)
+	assert.Equal(t, RepoOperationResult{Message: "checked docs"}, results[2].Value)
+	assert.NoError(t, results[2].Err)
+}
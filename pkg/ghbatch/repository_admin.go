@@ -0,0 +1,145 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// GetRepository gets a single repository.
+func (s *Service) GetRepository(ctx context.Context, owner, repoName string) (*github.Repository, error) {
+	repository, _, err := s.client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, repoName, err)
+	}
+
+	return repository, nil
+}
+
+// SetRepositoryArchived archives or unarchives a single repository.
+func (s *Service) SetRepositoryArchived(ctx context.Context, owner, repoName string, archived bool) error {
+	s.log.Info("Setting repository archived state", "owner", owner, "repo", repoName, "archived", archived)
+
+	operation := OperationArchiveRepo
+	if !archived {
+		operation = OperationUnarchiveRepo
+	}
+
+	_, _, err := s.client.Repositories.Edit(ctx, owner, repoName, &github.Repository{Archived: github.Bool(archived)})
+	s.recordMutation(ctx, audit.Record{Operation: operation, Owner: owner, Repo: repoName}, err)
+	if err != nil {
+		return fmt.Errorf("failed to set archived=%t for repository %s/%s: %w", archived, owner, repoName, err)
+	}
+
+	return nil
+}
+
+// SetArchivedForRepos archives or unarchives all the given repositories.
+func (s *Service) SetArchivedForRepos(ctx context.Context, owner string, repoNames []string,
+	archived bool,
+) ([]string, []string) {
+	successRepos, failedRepos := s.processReposConcurrently(ctx, "archive", repoNames,
+		func(ctx context.Context, repoName string) error {
+			return s.SetRepositoryArchived(ctx, owner, repoName, archived)
+		}, nil)
+
+	return successRepos, failedRepos
+}
+
+// DeleteRepository permanently deletes a single repository.
+func (s *Service) DeleteRepository(ctx context.Context, owner, repoName string) error {
+	s.log.Info("Deleting repository", "owner", owner, "repo", repoName)
+
+	_, err := s.client.Repositories.Delete(ctx, owner, repoName)
+	s.recordMutation(ctx, audit.Record{Operation: OperationDeleteRepo, Owner: owner, Repo: repoName}, err)
+	if err != nil {
+		return fmt.Errorf("failed to delete repository %s/%s: %w", owner, repoName, err)
+	}
+
+	return nil
+}
+
+// DeleteRepos permanently deletes all the given repositories.
+func (s *Service) DeleteRepos(ctx context.Context, owner string, repoNames []string,
+	onResult RepoResultFunc,
+) ([]string, []string) {
+	return s.processReposConcurrently(ctx, "delete", repoNames,
+		func(ctx context.Context, repoName string) error {
+			return s.DeleteRepository(ctx, owner, repoName)
+		}, onResult)
+}
+
+// SetRepositoryVisibility changes the visibility of a single repository.
+func (s *Service) SetRepositoryVisibility(ctx context.Context, owner, repoName, visibility string) error {
+	s.log.Info("Setting repository visibility", "owner", owner, "repo", repoName, "visibility", visibility)
+
+	_, _, err := s.client.Repositories.Edit(ctx, owner, repoName, &github.Repository{Visibility: github.String(visibility)})
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetVisibility, Owner: owner, Repo: repoName, Target: visibility}, err)
+	if err != nil {
+		return fmt.Errorf("failed to set visibility=%s for repository %s/%s: %w", visibility, owner, repoName, err)
+	}
+
+	return nil
+}
+
+// SetVisibilityForRepos changes the visibility of all the given repositories.
+func (s *Service) SetVisibilityForRepos(ctx context.Context, owner string, repoNames []string,
+	visibility string,
+) ([]string, []string) {
+	return s.processReposConcurrently(ctx, "visibility", repoNames,
+		func(ctx context.Context, repoName string) error {
+			return s.SetRepositoryVisibility(ctx, owner, repoName, visibility)
+		}, nil)
+}
+
+// TransferRepository transfers a single repository to another owner.
+func (s *Service) TransferRepository(ctx context.Context, owner, repoName, newOwner string, teamIDs []int64) error {
+	s.log.Info("Transferring repository", "owner", owner, "repo", repoName, "newOwner", newOwner)
+
+	_, _, err := s.client.Repositories.Transfer(ctx, owner, repoName, github.TransferRequest{
+		NewOwner: newOwner,
+		TeamID:   teamIDs,
+	})
+
+	// GitHub schedules the transfer in the background and answers with 202 Accepted
+	var acceptedErr *github.AcceptedError
+	if errors.As(err, &acceptedErr) {
+		s.log.Info("Repository transfer scheduled", "owner", owner, "repo", repoName, "newOwner", newOwner)
+
+		err = nil
+	}
+
+	s.recordMutation(ctx, audit.Record{Operation: OperationTransferRepo, Owner: owner, Repo: repoName, Target: newOwner}, err)
+	if err != nil {
+		return fmt.Errorf("failed to transfer repository %s/%s to %s: %w", owner, repoName, newOwner, err)
+	}
+
+	return nil
+}
+
+// TransferRepos transfers all the given repositories to another owner.
+func (s *Service) TransferRepos(ctx context.Context, owner string, repoNames []string, newOwner string,
+	teamIDs []int64, onResult RepoResultFunc,
+) ([]string, []string) {
+	return s.processReposConcurrently(ctx, "transfer", repoNames,
+		func(ctx context.Context, repoName string) error {
+			return s.TransferRepository(ctx, owner, repoName, newOwner, teamIDs)
+		}, onResult)
+}
+
+// GetRepositories gets the full repository objects of multiple repositories concurrently, including the
+// settings that listing leaves out.
+func (s *Service) GetRepositories(ctx context.Context, owner string, repoNames []string) []RepoResult[*github.Repository] {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoResult[*github.Repository] {
+		repository, err := s.GetRepository(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get repository", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return RepoResult[*github.Repository]{Owner: owner, RepoName: repoName, Value: repository, Err: err}
+	})
+}
@@ -0,0 +1,201 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper function to create a service pointing to a mock server
+func newTestService(t *testing.T, handler http.HandlerFunc, concurrency int, opts ...Option) *Service {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(nil)
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+	opts = append([]Option{WithConcurrency(concurrency), WithLogger(createTestLogger())}, opts...)
+
+	return New(client, opts...)
+}
+
+func TestGetRepository_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/testorg/testrepo" {
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("testrepo"), Archived: github.Bool(true)})
+			return
+		}
+		http.NotFound(w, r)
+	}, 1)
+
+	repository, err := service.GetRepository(context.Background(), "testorg", "testrepo")
+	require.NoError(t, err)
+	assert.Equal(t, "testrepo", repository.GetName())
+	assert.True(t, repository.GetArchived())
+
+	_, err = service.GetRepository(context.Background(), "testorg", "missing")
+	assert.Error(t, err)
+}
+
+func TestGetRepositories_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/testorg/api" {
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("api"), AllowSquashMerge: github.Bool(true)})
+			return
+		}
+		http.NotFound(w, r)
+	}, 2)
+
+	results := service.GetRepositories(context.Background(), "testorg", []string{"api", "missing"})
+	require.Len(t, results, 2)
+
+	require.NoError(t, results[0].Err)
+	assert.True(t, results[0].Value.GetAllowSquashMerge())
+	assert.Equal(t, "missing", results[1].RepoName)
+	assert.ErrorContains(t, results[1].Err, "failed to get repository testorg/missing")
+}
+
+func TestSetArchivedForRepos_WithMockServer(t *testing.T) {
+	tests := []struct {
+		name     string
+		archived bool
+	}{
+		{"Archive", true},
+		{"Unarchive", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					http.NotFound(w, r)
+					return
+				}
+
+				if strings.HasSuffix(r.URL.Path, "/broken-repo") {
+					w.WriteHeader(http.StatusForbidden)
+					json.NewEncoder(w).Encode(map[string]string{"message": "Forbidden"})
+					return
+				}
+
+				var body github.Repository
+				json.NewDecoder(r.Body).Decode(&body)
+				assert.Equal(t, tt.archived, body.GetArchived())
+				json.NewEncoder(w).Encode(body)
+			}, 2)
+
+			successRepos, failedRepos := service.SetArchivedForRepos(context.Background(), "testorg",
+				[]string{"repo1", "repo2", "broken-repo"}, tt.archived)
+
+			sort.Strings(successRepos)
+			assert.Equal(t, []string{"repo1", "repo2"}, successRepos)
+			assert.Equal(t, []string{"broken-repo"}, failedRepos)
+		})
+	}
+}
+
+func TestDeleteRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/repos/testorg/repo1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		json.NewEncoder(w).Encode(map[string]string{"message": "Must have admin rights"})
+	}, 2)
+
+	var mu sync.Mutex
+	reported := map[string]bool{}
+
+	successRepos, failedRepos := service.DeleteRepos(context.Background(), "testorg", []string{"repo1", "repo2"},
+		func(repoName string, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported[repoName] = err == nil
+		})
+
+	assert.Equal(t, []string{"repo1"}, successRepos)
+	assert.Equal(t, []string{"repo2"}, failedRepos)
+	assert.Equal(t, map[string]bool{"repo1": true, "repo2": false}, reported)
+}
+
+func TestSetVisibilityForRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var body github.Repository
+		json.NewDecoder(r.Body).Decode(&body)
+
+		if r.URL.Path == "/repos/testorg/fork-repo" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Forks cannot change visibility"})
+			return
+		}
+
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "private", body.GetVisibility())
+		json.NewEncoder(w).Encode(body)
+	}, 1)
+
+	successRepos, failedRepos := service.SetVisibilityForRepos(context.Background(), "testorg",
+		[]string{"repo1", "fork-repo"}, "private")
+
+	assert.Equal(t, []string{"repo1"}, successRepos)
+	assert.Equal(t, []string{"fork-repo"}, failedRepos)
+}
+
+func TestTransferRepos_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var body github.TransferRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "neworg", body.NewOwner)
+		assert.Equal(t, []int64{42}, body.TeamID)
+
+		switch r.URL.Path {
+		case "/repos/testorg/repo1/transfer":
+			// GitHub schedules transfers in the background
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("repo1")})
+		case "/repos/testorg/repo2/transfer":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Repository already exists"})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	successRepos, failedRepos := service.TransferRepos(context.Background(), "testorg",
+		[]string{"repo1", "repo2"}, "neworg", []int64{42}, nil)
+
+	assert.Equal(t, []string{"repo1"}, successRepos)
+	assert.Equal(t, []string{"repo2"}, failedRepos)
+}
+
+func TestGetTeamIDsBySlug_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/neworg/teams/platform":
+			json.NewEncoder(w).Encode(github.Team{ID: github.Int64(7)})
+		case "/orgs/neworg/teams/payments":
+			json.NewEncoder(w).Encode(github.Team{ID: github.Int64(9)})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	teamIDs, err := service.GetTeamIDsBySlug(context.Background(), "neworg", []string{"platform", "payments"})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7, 9}, teamIDs)
+
+	_, err = service.GetTeamIDsBySlug(context.Background(), "neworg", []string{"missing"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package ghbatch
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// loggingTransport logs every API request sent over the network at debug level with its status, duration
+// and remaining rate limit. Responses served from the response cache never reach it.
+type loggingTransport struct {
+	base http.RoundTripper
+	log  *slog.Logger
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.log.Enabled(req.Context(), slog.LevelDebug) {
+		return t.base.RoundTrip(req)
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	duration := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		t.log.Debug("API request failed", "method", req.Method, "url", req.URL.String(), "duration", duration, "error", err)
+
+		return resp, err
+	}
+
+	t.log.Debug("API request", "method", req.Method, "url", req.URL.String(), "status", resp.StatusCode,
+		"duration", duration, "rateLimitRemaining", resp.Header.Get("X-RateLimit-Remaining"))
+
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package ghbatch
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name   string
+		level  slog.Level
+		logged bool
+	}{
+		{"Debug", slog.LevelDebug, true},
+		{"Info", slog.LevelInfo, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			log := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: tt.level}))
+			client := &http.Client{Transport: &loggingTransport{base: http.DefaultTransport, log: log}}
+
+			resp, err := client.Get(server.URL + "/repos/testorg/api")
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			if !tt.logged {
+				assert.Empty(t, buf.String())
+				return
+			}
+
+			assert.Contains(t, buf.String(), "msg=\"API request\" method=GET url="+server.URL+"/repos/testorg/api status=404")
+			assert.Contains(t, buf.String(), "rateLimitRemaining=4999")
+		})
+	}
+}
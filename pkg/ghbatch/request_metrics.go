@@ -0,0 +1,36 @@
+package ghbatch
+
+import (
+	"net/http"
+	"strconv"
+
+	"go-repo-manager/internal/metrics"
+)
+
+// metricsTransport counts every API request sent over the network and records the remaining rate limit
+// GitHub reports with each response. Responses served from the response cache never reach it.
+type metricsTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		metrics.APIRequests.Inc(req.Method, "error")
+
+		return resp, err
+	}
+
+	metrics.APIRequests.Inc(req.Method, strconv.Itoa(resp.StatusCode))
+
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		resource := resp.Header.Get("X-RateLimit-Resource")
+		if resource == "" {
+			resource = "core"
+		}
+		metrics.RateLimitRemaining.Set(float64(remaining), resource)
+	}
+
+	return resp, nil
+}
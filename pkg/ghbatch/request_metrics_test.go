@@ -0,0 +1,38 @@
+package ghbatch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/metrics"
+)
+
+func TestMetricsTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4321")
+		w.Header().Set("X-RateLimit-Resource", "metrics-test")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &metricsTransport{base: http.DefaultTransport}}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodOptions, server.URL+"/repos/testorg/api", nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	var out strings.Builder
+	require.NoError(t, metrics.Default.WriteText(&out))
+
+	assert.Contains(t, out.String(), `grm_github_api_requests_total{method="OPTIONS",status="204"} 2`)
+	assert.Contains(t, out.String(), `grm_github_rate_limit_remaining{resource="metrics-test"} 4321`)
+}
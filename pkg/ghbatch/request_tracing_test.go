@@ -0,0 +1,85 @@
+package ghbatch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	spanExporter     = tracetest.NewInMemoryExporter()
+	installSpansOnce sync.Once
+)
+
+// recordSpans returns the exporter recording the spans ended during the test. The tracer provider is
+// installed only once: tracers obtained before the first provider is set keep delegating to it.
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	installSpansOnce.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter)))
+	})
+	spanExporter.Reset()
+	t.Cleanup(spanExporter.Reset)
+
+	return spanExporter
+}
+
+func TestTracingTransport(t *testing.T) {
+	recorder := recordSpans(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &tracingTransport{base: http.DefaultTransport}}
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/repos/testorg/api", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	parent.End()
+
+	spans := recorder.GetSpans()
+	require.Len(t, spans, 2)
+
+	span := spans[0]
+	assert.Equal(t, "GitHub API GET", span.Name)
+	assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	assert.Equal(t, codes.Error, span.Status.Code)
+	assert.Contains(t, span.Attributes, attribute.Int("http.response.status_code", http.StatusNotFound))
+	assert.Contains(t, span.Attributes, attribute.Int("github.rate_limit.remaining", 42))
+}
+
+func TestCollectConcurrently_TracesEachRepository(t *testing.T) {
+	recorder := recordSpans(t)
+
+	results := CollectConcurrently(context.Background(), 2, []string{"api", "web"},
+		func(ctx context.Context, repoName string) PolicyResult {
+			if repoName == "web" {
+				return PolicyResult{RepoName: repoName, Err: assert.AnError}
+			}
+
+			return PolicyResult{RepoName: repoName}
+		})
+	require.Len(t, results, 2)
+
+	statuses := map[string]codes.Code{}
+	for _, span := range recorder.GetSpans() {
+		statuses[span.Name] = span.Status.Code
+	}
+
+	assert.Equal(t, map[string]codes.Code{"repo api": codes.Unset, "repo web": codes.Error}, statuses)
+}
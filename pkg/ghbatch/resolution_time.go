@@ -0,0 +1,86 @@
+package ghbatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// IssueResolution describes how long an issue stayed open.
+type IssueResolution struct {
+	Number    int
+	CreatedAt time.Time
+	ClosedAt  time.Time
+}
+
+// ResolutionTime returns the time between the creation and the closing of the issue.
+func (r IssueResolution) ResolutionTime() time.Duration {
+	return r.ClosedAt.Sub(r.CreatedAt)
+}
+
+// RepoIssueResolutions groups the closed issues of a repository. Err is set when they could not be listed.
+type RepoIssueResolutions struct {
+	RepoName string
+	Issues   []IssueResolution
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RepoIssueResolutions) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoIssueResolutions) WithRepoErr(_, repoName string, err error) RepoIssueResolutions {
+	return RepoIssueResolutions{RepoName: repoName, Err: err}
+}
+
+// ResolutionTimes returns the resolution times of the issues.
+func (r RepoIssueResolutions) ResolutionTimes() []time.Duration {
+	durations := make([]time.Duration, 0, len(r.Issues))
+
+	for _, issue := range r.Issues {
+		durations = append(durations, issue.ResolutionTime())
+	}
+
+	return durations
+}
+
+// matchesClosed reports whether an issue was closed within the filter's date range.
+func (f IssueStatsFilter) matchesClosed(issue *github.Issue) bool {
+	closed := issue.GetClosedAt().Time
+
+	if closed.IsZero() || (!f.Since.IsZero() && closed.Before(f.Since)) {
+		return false
+	}
+
+	return f.Until.IsZero() || closed.Before(f.Until)
+}
+
+// GetIssueResolutionTimes lists the issues closed in the date range of the filter in each repository with
+// the time they stayed open.
+func (s *Service) GetIssueResolutionTimes(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) []RepoIssueResolutions {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoIssueResolutions {
+		result := RepoIssueResolutions{RepoName: repoName}
+
+		// An issue closed since a time has been updated since then too
+		issues, err := s.listMatchingIssues(ctx, owner, repoName, "closed", filter.Since, filter.matchesClosed)
+		if err != nil {
+			s.log.Error("Failed to list issues", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, issue := range issues {
+			result.Issues = append(result.Issues, IssueResolution{
+				Number:    issue.GetNumber(),
+				CreatedAt: issue.GetCreatedAt().Time,
+				ClosedAt:  issue.GetClosedAt().Time,
+			})
+		}
+
+		return result
+	})
+}
@@ -0,0 +1,48 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueResolutionTimes_WithMockServer(t *testing.T) {
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	created := since.AddDate(0, -1, 0)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Split(r.URL.Path, "/")[3] == "broken" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Equal(t, "closed", r.URL.Query().Get("state"))
+		assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+		json.NewEncoder(w).Encode([]*github.Issue{
+			{Number: github.Int(1), CreatedAt: &github.Timestamp{Time: created}, ClosedAt: &github.Timestamp{Time: since.Add(time.Hour)}},
+			{Number: github.Int(2), CreatedAt: &github.Timestamp{Time: created}, ClosedAt: &github.Timestamp{Time: since.Add(-time.Hour)}},
+			{Number: github.Int(3), CreatedAt: &github.Timestamp{Time: created}, ClosedAt: &github.Timestamp{Time: until}},
+			{Number: github.Int(4), CreatedAt: &github.Timestamp{Time: created}, ClosedAt: &github.Timestamp{Time: since.Add(time.Hour)},
+				PullRequestLinks: &github.PullRequestLinks{}},
+		})
+	}, 1)
+
+	results := service.GetIssueResolutionTimes(context.Background(), "testorg", []string{"api", "broken"},
+		IssueStatsFilter{Since: since, Until: until})
+	require.Len(t, results, 2)
+
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Issues, 1)
+	assert.Equal(t, 1, results[0].Issues[0].Number)
+	assert.Equal(t, []time.Duration{since.Add(time.Hour).Sub(created)}, results[0].ResolutionTimes())
+
+	assert.ErrorContains(t, results[1].Err, "failed to list issues for testorg/broken")
+}
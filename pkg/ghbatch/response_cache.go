@@ -0,0 +1,146 @@
+package ghbatch
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/cache"
+)
+
+var (
+	// cachedPaths matches the repository list, organization list and metadata endpoints whose responses are cached.
+	cachedPaths = regexp.MustCompile(`^/(orgs/[^/]+/repos|users/[^/]+/repos|user/repos|user/orgs|search/repositories|repos/[^/]+/[^/]+)$`)
+	// invalidatingPaths matches the endpoints whose writes change repository lists or metadata.
+	invalidatingPaths = regexp.MustCompile(`^/(orgs/[^/]+/repos|user/repos|repos/[^/]+/[^/]+(/transfer|/topics)?)$`)
+	// cachedHeaders are the response headers kept in the cache; rate limit headers are left out so cached
+	// responses do not replay a stale rate limit state.
+	cachedHeaders = []string{"Content-Type", "Link", "ETag"}
+)
+
+// ResponseCache is the on-disk cache of API responses taken by WithCache.
+type ResponseCache = cache.Cache
+
+// NewResponseCache returns a response cache storing its entries in dir, which are fresh for ttl.
+func NewResponseCache(dir string, ttl time.Duration) *ResponseCache {
+	return cache.New(dir, ttl)
+}
+
+// ClientOption configures a client created by NewGitHubClient.
+type ClientOption func(*clientConfig)
+
+// clientConfig holds the settings of a client created by NewGitHubClient.
+type clientConfig struct {
+	cache *cache.Cache
+}
+
+// WithCache serves repository lists and metadata from the on-disk response cache c while they are fresh.
+// The cache is part of the client rather than the service because its entries are keyed by the
+// credentials the client sends.
+func WithCache(c *ResponseCache) ClientOption {
+	return func(cfg *clientConfig) {
+		cfg.cache = c
+	}
+}
+
+// cachedResponse is a successful response stored in the cache.
+type cachedResponse struct {
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// cachingTransport serves repository lists and metadata from the on-disk cache while they are fresh.
+// Entries are keyed by URL and a hash of the credentials, so different tokens never share responses.
+// A successful write to a repository list or metadata endpoint clears the cache.
+type cachingTransport struct {
+	base  http.RoundTripper
+	cache *cache.Cache
+	log   *slog.Logger
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		resp, err := t.base.RoundTrip(req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest && invalidatingPaths.MatchString(req.URL.Path) {
+			if _, err := t.cache.Clear(); err != nil {
+				t.log.Warn("Failed to clear response cache", "error", err)
+			}
+		}
+
+		return resp, err
+	}
+
+	if !cachedPaths.MatchString(req.URL.Path) {
+		return t.base.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	if data, ok := t.cache.Get(key); ok {
+		var cached cachedResponse
+		if err := json.Unmarshal(data, &cached); err == nil {
+			t.log.Debug("Serving response from cache", "url", req.URL.String())
+			trace.SpanFromContext(req.Context()).SetAttributes(attribute.Bool("github.cache_hit", true))
+
+			return &http.Response{
+				Status:        "200 OK",
+				StatusCode:    http.StatusOK,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        cached.Header,
+				Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+				ContentLength: int64(len(cached.Body)),
+				Request:       req,
+			}, nil
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	cached := cachedResponse{Header: http.Header{}, Body: body}
+	for _, name := range cachedHeaders {
+		if value := resp.Header.Get(name); value != "" {
+			cached.Header.Set(name, value)
+		}
+	}
+
+	cached.Header.Set("Content-Length", strconv.Itoa(len(body)))
+
+	if data, err := json.Marshal(cached); err == nil {
+		if err := t.cache.Put(key, data); err != nil {
+			t.log.Warn("Failed to store response in cache", "error", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// cacheKey identifies a request by its URL and a hash of its credentials.
+func cacheKey(req *http.Request) string {
+	sum := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+
+	return hex.EncodeToString(sum[:8]) + " " + req.URL.String()
+}
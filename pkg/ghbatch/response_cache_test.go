@@ -0,0 +1,75 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/cache"
+)
+
+func TestCachingTransport(t *testing.T) {
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+
+		switch r.URL.Path {
+		case "/orgs/testorg/repos":
+			json.NewEncoder(w).Encode([]*github.Repository{{Name: github.String("api")}})
+		case "/repos/testorg/api":
+			json.NewEncoder(w).Encode(&github.Repository{Name: github.String("api"), Archived: github.Bool(r.Method == http.MethodPatch)})
+		case "/repos/testorg/api/issues":
+			json.NewEncoder(w).Encode([]*github.Issue{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	responses := cache.New(t.TempDir(), time.Hour)
+	newClient := func(token string) *github.Client {
+		transport := &cachingTransport{base: http.DefaultTransport, cache: responses, log: createTestLogger()}
+		client := github.NewClient(&http.Client{Transport: transport}).WithAuthToken(token)
+		client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+		return client
+	}
+
+	ctx := context.Background()
+	client := newClient("token")
+
+	for range 2 {
+		repos, _, err := client.Repositories.ListByOrg(ctx, "testorg", nil)
+		require.NoError(t, err)
+		require.Len(t, repos, 1)
+
+		_, _, err = client.Issues.ListByRepo(ctx, "testorg", "api", nil)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 1, requests["GET /orgs/testorg/repos"])
+	// Only repository lists and metadata are cached
+	assert.Equal(t, 2, requests["GET /repos/testorg/api/issues"])
+
+	// Another token does not share the cached responses
+	_, _, err := newClient("other").Repositories.ListByOrg(ctx, "testorg", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /orgs/testorg/repos"])
+
+	// Changing the repository invalidates the cache
+	_, _, err = client.Repositories.Get(ctx, "testorg", "api")
+	require.NoError(t, err)
+	_, _, err = client.Repositories.Edit(ctx, "testorg", "api", &github.Repository{Archived: github.Bool(true)})
+	require.NoError(t, err)
+	_, _, err = client.Repositories.Get(ctx, "testorg", "api")
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /repos/testorg/api"])
+}
@@ -0,0 +1,180 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// IssueResponse describes how long an issue waited for its first response.
+type IssueResponse struct {
+	Number    int
+	Title     string
+	URL       string
+	Author    string
+	CreatedAt time.Time
+	// FirstResponseAt is when someone other than the author first commented; zero when nobody has yet.
+	FirstResponseAt time.Time
+	Responder       string
+}
+
+// Responded reports whether anyone other than the author has commented on the issue.
+func (r IssueResponse) Responded() bool {
+	return !r.FirstResponseAt.IsZero()
+}
+
+// ResponseTime returns the time between the creation of the issue and its first response.
+func (r IssueResponse) ResponseTime() time.Duration {
+	return r.FirstResponseAt.Sub(r.CreatedAt)
+}
+
+// RepoIssueResponses groups the issue responses of a repository. Err is set when they could not be fetched.
+type RepoIssueResponses struct {
+	RepoName string
+	Issues   []IssueResponse
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RepoIssueResponses) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoIssueResponses) WithRepoErr(_, repoName string, err error) RepoIssueResponses {
+	return RepoIssueResponses{RepoName: repoName, Err: err}
+}
+
+// ResponseTimes returns the response times of the issues that got a response.
+func (r RepoIssueResponses) ResponseTimes() []time.Duration {
+	var durations []time.Duration
+
+	for _, issue := range r.Issues {
+		if issue.Responded() {
+			durations = append(durations, issue.ResponseTime())
+		}
+	}
+
+	return durations
+}
+
+// GetIssueResponseTimes finds the first response to every issue created in the date range of the filter in
+// each repository. A response is the first comment by someone other than the issue author; comments by bots
+// do not count.
+func (s *Service) GetIssueResponseTimes(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) []RepoIssueResponses {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoIssueResponses {
+		result := RepoIssueResponses{RepoName: repoName}
+
+		issues, err := s.listIssuesCreated(ctx, owner, repoName, filter)
+		if err != nil {
+			s.log.Error("Failed to list issues", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, issue := range issues {
+			response := IssueResponse{
+				Number:    issue.GetNumber(),
+				Title:     issue.GetTitle(),
+				URL:       issue.GetHTMLURL(),
+				Author:    issue.GetUser().GetLogin(),
+				CreatedAt: issue.GetCreatedAt().Time,
+			}
+
+			if issue.GetComments() > 0 {
+				comment, err := s.firstResponse(ctx, owner, repoName, issue)
+				if err != nil {
+					s.log.Error("Failed to list issue comments", "owner", owner, "repo", repoName, "issue", issue.GetNumber(),
+						"error", err)
+					result.Err = err
+
+					return result
+				}
+
+				if comment != nil {
+					response.FirstResponseAt = comment.GetCreatedAt().Time
+					response.Responder = comment.GetUser().GetLogin()
+				}
+			}
+
+			result.Issues = append(result.Issues, response)
+		}
+
+		return result
+	})
+}
+
+// listIssuesCreated lists the issues of a repository, without pull requests, created in the date range of
+// the filter.
+func (s *Service) listIssuesCreated(ctx context.Context, owner, repoName string, filter IssueStatsFilter) ([]*github.Issue, error) {
+	// An issue created since a time has been updated since then too
+	return s.listMatchingIssues(ctx, owner, repoName, "all", filter.Since, filter.matchesCreated)
+}
+
+// listMatchingIssues lists the issues of a repository, without pull requests, in a state and updated since
+// a time, keeping those that match.
+func (s *Service) listMatchingIssues(ctx context.Context, owner, repoName, state string, since time.Time,
+	match func(*github.Issue) bool,
+) ([]*github.Issue, error) {
+	var matching []*github.Issue
+
+	opts := &github.IssueListByRepoOptions{
+		State:       state,
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		issues, resp, err := s.client.Issues.ListByRepo(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, issue := range issues {
+			if issue.PullRequestLinks == nil && match(issue) {
+				matching = append(matching, issue)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return matching, nil
+}
+
+// firstResponse returns the first comment on an issue by someone other than its author and other than a bot,
+// or nil if there is none.
+func (s *Service) firstResponse(ctx context.Context, owner, repoName string, issue *github.Issue) (*github.IssueComment, error) {
+	author := issue.GetUser().GetLogin()
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.String("created"),
+		Direction:   github.String("asc"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		comments, resp, err := s.client.Issues.ListComments(ctx, owner, repoName, issue.GetNumber(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list comments for %s/%s#%d: %w", owner, repoName, issue.GetNumber(), err)
+		}
+
+		for _, comment := range comments {
+			if comment.GetUser().GetLogin() != author && comment.GetUser().GetType() != "Bot" {
+				return comment, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,59 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIssueResponseTimes_WithMockServer(t *testing.T) {
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	created := since.Add(24 * time.Hour)
+	author := &github.User{Login: github.String("reporter")}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch {
+		case repoName == "broken":
+			w.WriteHeader(http.StatusNotFound)
+		case strings.HasSuffix(r.URL.Path, "/issues"):
+			assert.Equal(t, since.Format(time.RFC3339), r.URL.Query().Get("since"))
+			json.NewEncoder(w).Encode([]*github.Issue{
+				{Number: github.Int(1), User: author, Comments: github.Int(3), CreatedAt: &github.Timestamp{Time: created}},
+				{Number: github.Int(2), User: author, Comments: github.Int(0), CreatedAt: &github.Timestamp{Time: created}},
+				{Number: github.Int(3), User: author, CreatedAt: &github.Timestamp{Time: since.Add(-time.Hour)}},
+				{Number: github.Int(4), User: author, CreatedAt: &github.Timestamp{Time: created}, PullRequestLinks: &github.PullRequestLinks{}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/issues/1/comments"):
+			assert.Equal(t, "asc", r.URL.Query().Get("direction"))
+			json.NewEncoder(w).Encode([]*github.IssueComment{
+				{User: author, CreatedAt: &github.Timestamp{Time: created.Add(time.Hour)}},
+				{User: &github.User{Login: github.String("triage[bot]"), Type: github.String("Bot")}, CreatedAt: &github.Timestamp{Time: created.Add(2 * time.Hour)}},
+				{User: &github.User{Login: github.String("maintainer")}, CreatedAt: &github.Timestamp{Time: created.Add(5 * time.Hour)}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	results := service.GetIssueResponseTimes(context.Background(), "testorg", []string{"api", "broken"}, IssueStatsFilter{Since: since})
+	require.Len(t, results, 2)
+
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Issues, 2)
+	assert.True(t, results[0].Issues[0].Responded())
+	assert.Equal(t, "maintainer", results[0].Issues[0].Responder)
+	assert.Equal(t, 5*time.Hour, results[0].Issues[0].ResponseTime())
+	assert.False(t, results[0].Issues[1].Responded())
+	assert.Equal(t, []time.Duration{5 * time.Hour}, results[0].ResponseTimes())
+
+	assert.ErrorContains(t, results[1].Err, "failed to list issues for testorg/broken")
+}
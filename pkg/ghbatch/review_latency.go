@@ -0,0 +1,269 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// PullRequestLatency describes how long a pull request waited for its first review and to be merged after it
+// was ready for review.
+type PullRequestLatency struct {
+	Number int
+	Author string
+	// Bot is set when the pull request was opened by a bot, such as Dependabot.
+	Bot bool
+	// ReadyAt is when the pull request was opened, or first marked ready for review if it was opened as a draft.
+	ReadyAt time.Time
+	// FirstReviewAt is when someone other than the author first reviewed the pull request after ReadyAt;
+	// zero when nobody has yet.
+	FirstReviewAt time.Time
+	// MergedAt is zero when the pull request is not merged.
+	MergedAt time.Time
+}
+
+// Reviewed reports whether the pull request got a review after it was ready for review.
+func (l PullRequestLatency) Reviewed() bool {
+	return !l.FirstReviewAt.IsZero()
+}
+
+// TimeToFirstReview returns the time between the pull request being ready for review and its first review.
+func (l PullRequestLatency) TimeToFirstReview() time.Duration {
+	return l.FirstReviewAt.Sub(l.ReadyAt)
+}
+
+// Merged reports whether the pull request is merged.
+func (l PullRequestLatency) Merged() bool {
+	return !l.MergedAt.IsZero()
+}
+
+// TimeToMerge returns the time between the pull request being ready for review and being merged.
+func (l PullRequestLatency) TimeToMerge() time.Duration {
+	return l.MergedAt.Sub(l.ReadyAt)
+}
+
+// RepoPullRequestLatencies groups the pull request latencies of a repository. Err is set when they could not
+// be fetched.
+type RepoPullRequestLatencies struct {
+	RepoName     string
+	PullRequests []PullRequestLatency
+	Err          error
+}
+
+// RepoErr implements Result.
+func (r RepoPullRequestLatencies) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoPullRequestLatencies) WithRepoErr(_, repoName string, err error) RepoPullRequestLatencies {
+	return RepoPullRequestLatencies{RepoName: repoName, Err: err}
+}
+
+// ReviewLatencyStats summarizes the latencies of a set of pull requests.
+type ReviewLatencyStats struct {
+	PullRequests int
+	FirstReview  DurationStats
+	Merge        DurationStats
+}
+
+// SummarizeReviewLatencies computes the time to first review of the reviewed pull requests and the time to
+// merge of the merged ones.
+func SummarizeReviewLatencies(prs []PullRequestLatency) ReviewLatencyStats {
+	var reviews, merges []time.Duration
+
+	for _, pr := range prs {
+		if pr.Reviewed() {
+			reviews = append(reviews, pr.TimeToFirstReview())
+		}
+		if pr.Merged() {
+			merges = append(merges, pr.TimeToMerge())
+		}
+	}
+
+	return ReviewLatencyStats{
+		PullRequests: len(prs),
+		FirstReview:  SummarizeDurations(reviews),
+		Merge:        SummarizeDurations(merges),
+	}
+}
+
+// GetPullRequestLatencies measures the time to first review and to merge of the pull requests opened in the
+// date range of the filter in each repository. Pull requests still in draft are left out.
+func (s *Service) GetPullRequestLatencies(ctx context.Context, owner string, repoNames []string,
+	filter IssueStatsFilter,
+) []RepoPullRequestLatencies {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RepoPullRequestLatencies {
+		result := RepoPullRequestLatencies{RepoName: repoName}
+
+		prs, err := s.listPullRequestsCreated(ctx, owner, repoName, filter)
+		if err != nil {
+			s.log.Error("Failed to list pull requests", "owner", owner, "repo", repoName, "error", err)
+			result.Err = err
+
+			return result
+		}
+
+		for _, pr := range prs {
+			if pr.GetDraft() {
+				continue
+			}
+
+			latency, err := s.pullRequestLatency(ctx, owner, repoName, pr)
+			if err != nil {
+				s.log.Error("Failed to get pull request timeline", "owner", owner, "repo", repoName, "pr", pr.GetNumber(),
+					"error", err)
+				result.Err = err
+
+				return result
+			}
+
+			result.PullRequests = append(result.PullRequests, latency)
+		}
+
+		return result
+	})
+}
+
+// listPullRequestsCreated lists the pull requests of a repository created in the date range of the filter.
+func (s *Service) listPullRequestsCreated(ctx context.Context, owner, repoName string,
+	filter IssueStatsFilter,
+) ([]*github.PullRequest, error) {
+	var matching []*github.PullRequest
+
+	opts := &github.PullRequestListOptions{
+		State:       "all",
+		Sort:        "created",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		prs, resp, err := s.client.PullRequests.List(ctx, owner, repoName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pull requests for %s/%s: %w", owner, repoName, err)
+		}
+
+		for _, pr := range prs {
+			created := pr.GetCreatedAt().Time
+
+			// Newest first, so the rest of the pull requests are older still
+			if !filter.Since.IsZero() && created.Before(filter.Since) {
+				return matching, nil
+			}
+
+			if filter.Until.IsZero() || created.Before(filter.Until) {
+				matching = append(matching, pr)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return matching, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+// pullRequestLatency reads the timeline of a pull request for when it became ready for review and its first
+// review by someone other than the author and other than a bot.
+func (s *Service) pullRequestLatency(ctx context.Context, owner, repoName string,
+	pr *github.PullRequest,
+) (PullRequestLatency, error) {
+	latency := PullRequestLatency{
+		Number:   pr.GetNumber(),
+		Author:   pr.GetUser().GetLogin(),
+		Bot:      pr.GetUser().GetType() == "Bot",
+		ReadyAt:  pr.GetCreatedAt().Time,
+		MergedAt: pr.GetMergedAt().Time,
+	}
+
+	var events []*github.Timeline
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		page, resp, err := s.client.Issues.ListIssueTimeline(ctx, owner, repoName, pr.GetNumber(), opts)
+		if err != nil {
+			return latency, fmt.Errorf("failed to list timeline for %s/%s#%d: %w", owner, repoName, pr.GetNumber(), err)
+		}
+
+		events = append(events, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	// The first draft change tells whether the pull request was opened as a draft: if it was first marked
+	// ready for review, it was
+	for _, event := range events {
+		if event.GetEvent() == "convert_to_draft" {
+			break
+		}
+		if event.GetEvent() == "ready_for_review" {
+			latency.ReadyAt = event.GetCreatedAt().Time
+			break
+		}
+	}
+
+	for _, event := range events {
+		if event.GetEvent() != "reviewed" || event.GetUser().GetLogin() == latency.Author || event.GetUser().GetType() == "Bot" {
+			continue
+		}
+
+		submitted := event.GetSubmittedAt().Time
+		if !submitted.Before(latency.ReadyAt) && (latency.FirstReviewAt.IsZero() || submitted.Before(latency.FirstReviewAt)) {
+			latency.FirstReviewAt = submitted
+		}
+	}
+
+	return latency, nil
+}
+
+// GetTeamMemberships maps the login of every member of an organization's teams to the slugs of their teams.
+func (s *Service) GetTeamMemberships(ctx context.Context, org string) (map[string][]string, error) {
+	var teams []*github.Team
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		page, resp, err := s.client.Teams.ListTeams(ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list teams for %s: %w", org, err)
+		}
+
+		teams = append(teams, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	memberships := make(map[string][]string)
+
+	for _, team := range teams {
+		memberOpts := &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+		for {
+			members, resp, err := s.client.Teams.ListTeamMembersBySlug(ctx, org, team.GetSlug(), memberOpts)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list members of team %s/%s: %w", org, team.GetSlug(), err)
+			}
+
+			for _, member := range members {
+				memberships[member.GetLogin()] = append(memberships[member.GetLogin()], team.GetSlug())
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			memberOpts.Page = resp.NextPage
+		}
+	}
+
+	return memberships, nil
+}
@@ -0,0 +1,89 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPullRequestLatencies_WithMockServer(t *testing.T) {
+	since := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	opened := since.Add(24 * time.Hour)
+	ts := func(d time.Duration) *github.Timestamp { return &github.Timestamp{Time: opened.Add(d)} }
+	author := &github.User{Login: github.String("dev")}
+	reviewer := &github.User{Login: github.String("lead")}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/pulls"):
+			assert.Equal(t, "created", r.URL.Query().Get("sort"))
+			json.NewEncoder(w).Encode([]*github.PullRequest{
+				{Number: github.Int(3), User: author, CreatedAt: ts(0), Draft: github.Bool(true)},
+				{Number: github.Int(2), User: author, CreatedAt: ts(0)},
+				{Number: github.Int(1), User: author, CreatedAt: ts(0), MergedAt: ts(10 * time.Hour)},
+				{Number: github.Int(0), User: author, CreatedAt: &github.Timestamp{Time: since.Add(-time.Hour)}},
+			})
+		case strings.HasSuffix(r.URL.Path, "/issues/1/timeline"):
+			// Opened as a draft and reviewed while still in draft
+			json.NewEncoder(w).Encode([]*github.Timeline{
+				{Event: github.String("reviewed"), User: reviewer, SubmittedAt: ts(time.Hour)},
+				{Event: github.String("ready_for_review"), CreatedAt: ts(2 * time.Hour)},
+				{Event: github.String("reviewed"), User: author, SubmittedAt: ts(3 * time.Hour)},
+				{Event: github.String("reviewed"), User: &github.User{Login: github.String("bot"), Type: github.String("Bot")}, SubmittedAt: ts(3 * time.Hour)},
+				{Event: github.String("reviewed"), User: reviewer, SubmittedAt: ts(6 * time.Hour)},
+			})
+		case strings.HasSuffix(r.URL.Path, "/issues/2/timeline"):
+			// Opened ready for review, then moved back to draft
+			json.NewEncoder(w).Encode([]*github.Timeline{
+				{Event: github.String("convert_to_draft"), CreatedAt: ts(time.Hour)},
+				{Event: github.String("ready_for_review"), CreatedAt: ts(2 * time.Hour)},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	results := service.GetPullRequestLatencies(context.Background(), "testorg", []string{"api"}, IssueStatsFilter{Since: since})
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].PullRequests, 2)
+
+	unreviewed, merged := results[0].PullRequests[0], results[0].PullRequests[1]
+	assert.Equal(t, opened, unreviewed.ReadyAt)
+	assert.False(t, unreviewed.Reviewed())
+	assert.False(t, unreviewed.Merged())
+
+	assert.Equal(t, 4*time.Hour, merged.TimeToFirstReview())
+	assert.Equal(t, 8*time.Hour, merged.TimeToMerge())
+
+	stats := SummarizeReviewLatencies(results[0].PullRequests)
+	assert.Equal(t, 2, stats.PullRequests)
+	assert.Equal(t, DurationStats{Count: 1, Median: 4 * time.Hour, P90: 4 * time.Hour}, stats.FirstReview)
+	assert.Equal(t, 1, stats.Merge.Count)
+}
+
+func TestGetTeamMemberships_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/orgs/testorg/teams":
+			json.NewEncoder(w).Encode([]*github.Team{{Slug: github.String("platform")}, {Slug: github.String("web")}})
+		case "/orgs/testorg/teams/platform/members":
+			json.NewEncoder(w).Encode([]*github.User{{Login: github.String("alice")}, {Login: github.String("bob")}})
+		case "/orgs/testorg/teams/web/members":
+			json.NewEncoder(w).Encode([]*github.User{{Login: github.String("bob")}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	memberships, err := service.GetTeamMemberships(context.Background(), "testorg")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"alice": {"platform"}, "bob": {"platform", "web"}}, memberships)
+}
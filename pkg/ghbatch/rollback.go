@@ -0,0 +1,289 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// File restore statuses.
+const (
+	RestoreStatusRestored  = "restored"
+	RestoreStatusDeleted   = "deleted"
+	RestoreStatusUnchanged = "unchanged"
+	RestoreStatusConflict  = "conflict"
+	RestoreStatusFailed    = "failed"
+)
+
+// FileRestore reverts a file to its state before a recorded change.
+type FileRestore struct {
+	Path string
+	// OldSHA is the blob SHA of the file before the change; empty when the change created the file, which
+	// is then deleted.
+	OldSHA string
+	// ExpectedSHA is the blob SHA the change left, empty when it deleted the file. A file that no longer has
+	// it was changed since and is reported as a conflict unless the restore is forced.
+	ExpectedSHA string
+}
+
+// RepoRestore lists the files to restore in a repository.
+type RepoRestore struct {
+	RepoName string
+	Files    []FileRestore
+}
+
+// RestoreOptions controls how files are restored.
+type RestoreOptions struct {
+	CommitMessage string
+	// Force restores files that were changed after the recorded change.
+	Force bool
+	// PullRequest proposes the restores through a pull request per repository when set; nil commits to
+	// the default branch.
+	PullRequest *PullRequestOptions
+}
+
+// FileRestoreResult is the outcome of restoring a single file.
+type FileRestoreResult struct {
+	Path string
+	// Status is one of the RestoreStatus constants.
+	Status string
+	Err    error
+}
+
+// RepoRestoreResult is the outcome of restoring the files of a repository.
+type RepoRestoreResult struct {
+	RepoName string
+	Files    []FileRestoreResult
+	// PullRequestURL is set when the restores were proposed through a pull request.
+	PullRequestURL string
+	// Err is set when the repository could not be processed at all, e.g. its pull request failed.
+	Err error
+}
+
+// RepoErr implements Result.
+func (r RepoRestoreResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RepoRestoreResult) WithRepoErr(_, repoName string, err error) RepoRestoreResult {
+	return RepoRestoreResult{RepoName: repoName, Err: err}
+}
+
+// RollbackPlan lists the file restores reverting the changes of a run.
+type RollbackPlan struct {
+	// Restores holds the repositories to restore per owner, sorted by name.
+	Restores map[string][]RepoRestore
+	// Proposed counts the changes made on pull request branches. They never reached the default branch and
+	// are reverted by closing their pull requests instead.
+	Proposed int
+}
+
+// PlanRollback builds the restores reverting the successful file changes recorded for a run. A file changed
+// several times by the run is restored to its state before the first change.
+func PlanRollback(records []audit.Record, runID string) RollbackPlan {
+	plan := RollbackPlan{Restores: map[string][]RepoRestore{}}
+
+	type fileKey struct{ owner, repo, path string }
+
+	files := map[fileKey]*FileRestore{}
+
+	var order []fileKey
+
+	for _, record := range records {
+		if record.RunID != runID || record.Status != AuditStatusSuccess ||
+			(record.Operation != OperationCommitFile && record.Operation != OperationDeleteFile) {
+			continue
+		}
+
+		if record.Branch != "" {
+			plan.Proposed++
+
+			continue
+		}
+
+		key := fileKey{record.Owner, record.Repo, record.Path}
+		if file, ok := files[key]; ok {
+			file.ExpectedSHA = record.NewSHA
+
+			continue
+		}
+
+		files[key] = &FileRestore{Path: record.Path, OldSHA: record.OldSHA, ExpectedSHA: record.NewSHA}
+		order = append(order, key)
+	}
+
+	repos := map[fileKey]*RepoRestore{}
+
+	for _, key := range order {
+		repoKey := fileKey{owner: key.owner, repo: key.repo}
+		if repos[repoKey] == nil {
+			repos[repoKey] = &RepoRestore{RepoName: key.repo}
+		}
+
+		repos[repoKey].Files = append(repos[repoKey].Files, *files[key])
+	}
+
+	for key, restore := range repos {
+		plan.Restores[key.owner] = append(plan.Restores[key.owner], *restore)
+	}
+
+	for _, restores := range plan.Restores {
+		sort.Slice(restores, func(i, j int) bool { return restores[i].RepoName < restores[j].RepoName })
+	}
+
+	return plan
+}
+
+// RestoreFiles restores files to their state before recorded changes across repositories.
+func (s *Service) RestoreFiles(ctx context.Context, owner string, restores []RepoRestore,
+	opts RestoreOptions,
+) []RepoRestoreResult {
+	byName := make(map[string]RepoRestore, len(restores))
+	names := make([]string, 0, len(restores))
+
+	for _, restore := range restores {
+		byName[restore.RepoName] = restore
+		names = append(names, restore.RepoName)
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) RepoRestoreResult {
+		result := s.restoreRepoFiles(ctx, owner, byName[repoName], opts)
+		if result.Err != nil {
+			s.log.Error("Failed to restore files", "owner", owner, "repo", repoName, "error", result.Err)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) restoreRepoFiles(ctx context.Context, owner string, restore RepoRestore,
+	opts RestoreOptions,
+) RepoRestoreResult {
+	result := RepoRestoreResult{RepoName: restore.RepoName}
+
+	var branch, baseBranch string
+
+	if opts.PullRequest != nil {
+		repository, err := s.GetRepository(ctx, owner, restore.RepoName)
+		if err != nil {
+			result.Err = err
+
+			return result
+		}
+
+		baseBranch = repository.GetDefaultBranch()
+		branch = opts.PullRequest.Branch
+
+		if err := s.ensureBranch(ctx, owner, restore.RepoName, branch, baseBranch); err != nil {
+			result.Err = err
+
+			return result
+		}
+	}
+
+	changed := false
+
+	for _, file := range restore.Files {
+		fileResult := s.restoreFile(ctx, owner, restore.RepoName, file, branch, opts)
+		if fileResult.Err != nil {
+			fileResult.Status = RestoreStatusFailed
+		}
+
+		changed = changed || fileResult.Status == RestoreStatusRestored || fileResult.Status == RestoreStatusDeleted
+		result.Files = append(result.Files, fileResult)
+	}
+
+	if changed && opts.PullRequest != nil {
+		result.PullRequestURL, result.Err = s.openPullRequest(ctx, owner, restore.RepoName, branch, baseBranch, opts.PullRequest)
+	}
+
+	return result
+}
+
+// restoreFile restores a single file, committing to branch or to the default branch when branch is empty.
+// The recorded state is always compared with the default branch.
+func (s *Service) restoreFile(ctx context.Context, owner, repoName string, file FileRestore, branch string,
+	opts RestoreOptions,
+) FileRestoreResult {
+	result := FileRestoreResult{Path: file.Path}
+
+	_, currentSHA, _, err := s.GetFileContent(ctx, owner, repoName, file.Path)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	if currentSHA == file.OldSHA {
+		result.Status = RestoreStatusUnchanged
+
+		return result
+	}
+
+	if currentSHA != file.ExpectedSHA && !opts.Force {
+		result.Status = RestoreStatusConflict
+
+		return result
+	}
+
+	// The pull request branch may differ from the default branch if an earlier rollback already touched it
+	targetSHA := currentSHA
+	if branch != "" {
+		if _, targetSHA, _, err = s.getFileContentOnRef(ctx, owner, repoName, file.Path, branch); err != nil {
+			result.Err = err
+
+			return result
+		}
+	}
+
+	if file.OldSHA == "" {
+		result.Status = RestoreStatusDeleted
+		if targetSHA != "" {
+			result.Err = s.deleteFileOnBranch(ctx, owner, repoName, file.Path, opts.CommitMessage, branch, targetSHA)
+		}
+
+		return result
+	}
+
+	content, err := s.getBlobContent(ctx, owner, repoName, file.OldSHA)
+	if err != nil {
+		result.Err = err
+
+		return result
+	}
+
+	var shaPtr *string
+	if targetSHA != "" {
+		shaPtr = github.String(targetSHA)
+	}
+
+	result.Status = RestoreStatusRestored
+	if targetSHA != file.OldSHA {
+		result.Err = s.createOrUpdateFileOnBranch(ctx, owner, repoName, file.Path, content, opts.CommitMessage, branch, shaPtr)
+	}
+
+	return result
+}
+
+// getBlobContent returns the content of a blob, which stays available after the file has changed.
+func (s *Service) getBlobContent(ctx context.Context, owner, repoName, sha string) (string, error) {
+	blob, _, err := s.client.Git.GetBlob(ctx, owner, repoName, sha)
+	if err != nil {
+		return "", fmt.Errorf("failed to get blob %s in %s/%s: %w", sha, owner, repoName, err)
+	}
+
+	if blob.GetEncoding() != "base64" {
+		return blob.GetContent(), nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(blob.GetContent())
+	if err != nil {
+		return "", fmt.Errorf("failed to decode blob %s in %s/%s: %w", sha, owner, repoName, err)
+	}
+
+	return string(content), nil
+}
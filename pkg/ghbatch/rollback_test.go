@@ -0,0 +1,124 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-repo-manager/internal/audit"
+)
+
+func TestRestoreFiles_DirectCommit(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		written = map[string]*github.RepositoryContentFileOptions{}
+		deleted = map[string]*github.RepositoryContentFileOptions{}
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(encodedFile("* @new", "new-sha"))
+		case r.URL.Path == "/repos/testorg/api/git/blobs/old-sha":
+			json.NewEncoder(w).Encode(&github.Blob{
+				Content:  github.String(base64.StdEncoding.EncodeToString([]byte("* @old"))),
+				Encoding: github.String("base64"),
+			})
+		case r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS" && r.Method == http.MethodPut:
+			var opts github.RepositoryContentFileOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+			written[r.URL.Path] = &opts
+			json.NewEncoder(w).Encode(&github.RepositoryContentResponse{Content: &github.RepositoryContent{SHA: github.String("old-sha")}})
+		case r.URL.Path == "/repos/testorg/api/contents/LICENSE" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(encodedFile("MIT", "license-sha"))
+		case r.URL.Path == "/repos/testorg/api/contents/LICENSE" && r.Method == http.MethodDelete:
+			var opts github.RepositoryContentFileOptions
+			json.NewDecoder(r.Body).Decode(&opts)
+			deleted[r.URL.Path] = &opts
+			json.NewEncoder(w).Encode(&github.RepositoryContentResponse{})
+		case r.URL.Path == "/repos/testorg/web/contents/.github/CODEOWNERS":
+			// Changed by someone else after the recorded change
+			json.NewEncoder(w).Encode(encodedFile("* @someone", "other-sha"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	restores := []RepoRestore{
+		{RepoName: "api", Files: []FileRestore{
+			{Path: ".github/CODEOWNERS", OldSHA: "old-sha", ExpectedSHA: "new-sha"},
+			{Path: "LICENSE", ExpectedSHA: "license-sha"},
+		}},
+		{RepoName: "web", Files: []FileRestore{{Path: ".github/CODEOWNERS", OldSHA: "old-sha", ExpectedSHA: "new-sha"}}},
+	}
+
+	results := service.RestoreFiles(context.Background(), "testorg", restores, RestoreOptions{CommitMessage: "Roll back run"})
+	require.Len(t, results, 2)
+
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []FileRestoreResult{
+		{Path: ".github/CODEOWNERS", Status: RestoreStatusRestored},
+		{Path: "LICENSE", Status: RestoreStatusDeleted},
+	}, results[0].Files)
+	assert.Equal(t, "* @old", string(written["/repos/testorg/api/contents/.github/CODEOWNERS"].Content))
+	assert.Equal(t, "new-sha", written["/repos/testorg/api/contents/.github/CODEOWNERS"].GetSHA())
+	assert.Equal(t, "license-sha", deleted["/repos/testorg/api/contents/LICENSE"].GetSHA())
+
+	assert.Equal(t, RestoreStatusConflict, results[1].Files[0].Status)
+}
+
+func TestRestoreFiles_Unchanged(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/api/contents/.github/CODEOWNERS":
+			json.NewEncoder(w).Encode(encodedFile("* @old", "old-sha"))
+		case "/repos/testorg/api/contents/LICENSE":
+			http.NotFound(w, r)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	restores := []RepoRestore{{RepoName: "api", Files: []FileRestore{
+		{Path: ".github/CODEOWNERS", OldSHA: "old-sha", ExpectedSHA: "new-sha"},
+		{Path: "LICENSE", ExpectedSHA: "license-sha"},
+	}}}
+
+	results := service.RestoreFiles(context.Background(), "testorg", restores, RestoreOptions{})
+	require.Len(t, results, 1)
+	assert.Equal(t, RestoreStatusUnchanged, results[0].Files[0].Status)
+	assert.Equal(t, RestoreStatusUnchanged, results[0].Files[1].Status)
+}
+
+func TestPlanRollback(t *testing.T) {
+	records := []audit.Record{
+		{RunID: "run1", Operation: OperationCommitFile, Owner: "testorg", Repo: "web", Path: "ci.yml", OldSHA: "a", NewSHA: "b", Status: AuditStatusSuccess},
+		{RunID: "run1", Operation: OperationCommitFile, Owner: "testorg", Repo: "api", Path: "LICENSE", NewSHA: "l1", Status: AuditStatusSuccess},
+		{RunID: "run1", Operation: OperationCommitFile, Owner: "testorg", Repo: "web", Path: "ci.yml", OldSHA: "b", NewSHA: "c", Status: AuditStatusSuccess},
+		{RunID: "run1", Operation: OperationCommitFile, Owner: "testorg", Repo: "docs", Path: "ci.yml", Status: AuditStatusFailed},
+		{RunID: "run1", Operation: OperationCommitFile, Owner: "testorg", Repo: "cli", Path: "ci.yml", Branch: "update", NewSHA: "x", Status: AuditStatusSuccess},
+		{RunID: "run2", Operation: OperationCommitFile, Owner: "testorg", Repo: "api", Path: "README.md", NewSHA: "r", Status: AuditStatusSuccess},
+		{RunID: "run1", Operation: "delete-repo", Owner: "testorg", Repo: "old", Status: AuditStatusSuccess},
+	}
+
+	plan := PlanRollback(records, "run1")
+	assert.Equal(t, 1, plan.Proposed)
+	assert.Equal(t, map[string][]RepoRestore{
+		"testorg": {
+			{RepoName: "api", Files: []FileRestore{{Path: "LICENSE", ExpectedSHA: "l1"}}},
+			{RepoName: "web", Files: []FileRestore{{Path: "ci.yml", OldSHA: "a", ExpectedSHA: "c"}}},
+		},
+	}, plan.Restores)
+}
@@ -0,0 +1,245 @@
+package ghbatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// File rollout statuses.
+const (
+	FileStatusCreated   = "created"
+	FileStatusUpdated   = "updated"
+	FileStatusUnchanged = "unchanged"
+	FileStatusSkipped   = "skipped"
+	FileStatusFailed    = "failed"
+)
+
+// ErrSkipFile can be returned by FileRollout.Render and FileRollout.Edit to leave a repository untouched.
+// The repository is reported as skipped rather than failed.
+var ErrSkipFile = errors.New("skip file for repository")
+
+// PullRequestOptions describes the pull request a change is proposed through instead of a direct commit.
+type PullRequestOptions struct {
+	// Branch is the head branch created from the default branch (reused if it already exists).
+	Branch string
+	Title  string
+	Body   string
+}
+
+// FileRollout describes a file to write to many repositories.
+type FileRollout struct {
+	// Path is the path of the file within each repository.
+	Path string
+	// Render returns the content of the file for a repository, allowing per-repository templating.
+	// Returning ErrSkipFile skips the repository.
+	Render func(repoName string) (string, error)
+	// Edit, when set instead of Render, returns the new content of the file from its current content.
+	// Repositories without the file are skipped. Returning ErrSkipFile skips the repository.
+	Edit func(repoName, content string) (string, error)
+	// CommitMessage is used for the commit that creates or updates the file.
+	CommitMessage string
+	// SkipIfExists skips repositories where Path or any of AlternatePaths already exists.
+	SkipIfExists bool
+	// AlternatePaths are other locations that count as the file already being present, e.g. LICENSE.md.
+	AlternatePaths []string
+	// PullRequest proposes the change through a pull request when set; nil commits to the default branch.
+	PullRequest *PullRequestOptions
+	// OnResult, when set, is called with the result of each repository as soon as it completes.
+	// It may be called concurrently from multiple workers.
+	OnResult func(result FileRolloutResult)
+}
+
+// Content returns the content the rollout writes to a repository whose file currently has the given
+// content, or ErrSkipFile when the repository is left untouched.
+func (r FileRollout) Content(repoName, current string, found bool) (string, error) {
+	if r.Edit == nil {
+		return r.Render(repoName)
+	}
+
+	if !found {
+		return "", ErrSkipFile
+	}
+
+	return r.Edit(repoName, current)
+}
+
+// FileRolloutResult is the outcome of a file rollout for a single repository.
+type FileRolloutResult struct {
+	RepoName string
+	// Status is one of the FileStatus constants.
+	Status string
+	// PullRequestURL is set when the change was proposed through a pull request.
+	PullRequestURL string
+	Err            error
+}
+
+// RepoErr implements Result.
+func (f FileRolloutResult) RepoErr() error { return f.Err }
+
+// WithRepoErr implements Result.
+func (FileRolloutResult) WithRepoErr(_, repoName string, err error) FileRolloutResult {
+	return FileRolloutResult{RepoName: repoName, Err: err}
+}
+
+// ApplyFileToRepos writes a file to all the given repositories.
+func (s *Service) ApplyFileToRepos(ctx context.Context, owner string, repoNames []string,
+	rollout FileRollout,
+) []FileRolloutResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) FileRolloutResult {
+		result := s.applyFile(ctx, owner, repoName, rollout)
+		if result.Err != nil {
+			result.Status = FileStatusFailed
+			s.log.Error("Failed to apply file to repository", "owner", owner, "repo", repoName,
+				"file", rollout.Path, "error", result.Err)
+		}
+
+		if rollout.OnResult != nil {
+			rollout.OnResult(result)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) applyFile(ctx context.Context, owner, repoName string, rollout FileRollout) FileRolloutResult {
+	result := FileRolloutResult{RepoName: repoName}
+
+	var (
+		existing, sha string
+		found         bool
+		err           error
+	)
+
+	// Edits need the current file, while rendered content is produced first so that skipped repositories
+	// cost no request.
+	if rollout.Edit != nil {
+		if existing, sha, found, err = s.GetFileContent(ctx, owner, repoName, rollout.Path); err != nil {
+			result.Err = err
+
+			return result
+		}
+	}
+
+	content, err := rollout.Content(repoName, existing, found)
+	if errors.Is(err, ErrSkipFile) {
+		result.Status = FileStatusSkipped
+
+		return result
+	}
+
+	if err != nil {
+		result.Err = fmt.Errorf("failed to render %s for %s: %w", rollout.Path, repoName, err)
+
+		return result
+	}
+
+	if rollout.Edit == nil {
+		if existing, sha, found, err = s.GetFileContent(ctx, owner, repoName, rollout.Path); err != nil {
+			result.Err = err
+
+			return result
+		}
+	}
+
+	if rollout.SkipIfExists {
+		exists, err := s.anyFileExists(ctx, owner, repoName, found, rollout.AlternatePaths)
+		if err != nil {
+			result.Err = err
+
+			return result
+		}
+
+		if exists {
+			result.Status = FileStatusSkipped
+
+			return result
+		}
+	}
+
+	if found && existing == content {
+		result.Status = FileStatusUnchanged
+
+		return result
+	}
+
+	result.Status = FileStatusCreated
+
+	var shaPtr *string
+
+	if found {
+		result.Status = FileStatusUpdated
+		shaPtr = github.String(sha)
+	}
+
+	if rollout.PullRequest == nil {
+		result.Err = s.createOrUpdateFileOnBranch(ctx, owner, repoName, rollout.Path, content, rollout.CommitMessage, "", shaPtr)
+
+		return result
+	}
+
+	result.PullRequestURL, result.Err = s.proposeFileChange(ctx, owner, repoName, rollout, content)
+
+	return result
+}
+
+// anyFileExists reports whether the primary file was found or any of the alternate paths exists.
+func (s *Service) anyFileExists(ctx context.Context, owner, repoName string, primaryFound bool,
+	alternatePaths []string,
+) (bool, error) {
+	if primaryFound {
+		return true, nil
+	}
+
+	for _, path := range alternatePaths {
+		_, _, found, err := s.GetFileContent(ctx, owner, repoName, path)
+		if err != nil {
+			return false, err
+		}
+
+		if found {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// proposeFileChange commits the file to the pull request branch and opens a pull request for it.
+func (s *Service) proposeFileChange(ctx context.Context, owner, repoName string, rollout FileRollout,
+	content string,
+) (string, error) {
+	repository, err := s.GetRepository(ctx, owner, repoName)
+	if err != nil {
+		return "", err
+	}
+
+	baseBranch := repository.GetDefaultBranch()
+	branch := rollout.PullRequest.Branch
+
+	if err := s.ensureBranch(ctx, owner, repoName, branch, baseBranch); err != nil {
+		return "", err
+	}
+
+	// The branch may already carry an earlier version of the change
+	existing, sha, found, err := s.getFileContentOnRef(ctx, owner, repoName, rollout.Path, branch)
+	if err != nil {
+		return "", err
+	}
+
+	if !found || existing != content {
+		var shaPtr *string
+		if found {
+			shaPtr = github.String(sha)
+		}
+
+		err = s.createOrUpdateFileOnBranch(ctx, owner, repoName, rollout.Path, content, rollout.CommitMessage, branch, shaPtr)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return s.openPullRequest(ctx, owner, repoName, branch, baseBranch, rollout.PullRequest)
+}
@@ -0,0 +1,143 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Helper function to encode a file the way the contents API returns it
+func encodedFile(content, sha string) *github.RepositoryContent {
+	return &github.RepositoryContent{
+		Type:     stringPtr("file"),
+		Encoding: stringPtr("base64"),
+		Content:  stringPtr(base64.StdEncoding.EncodeToString([]byte(content))),
+		SHA:      stringPtr(sha),
+	}
+}
+
+func TestApplyFileToRepos_DirectCommit(t *testing.T) {
+	var mu sync.Mutex
+	written := map[string]*github.RepositoryContentFileOptions{}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/unchanged/contents/LICENSE":
+			json.NewEncoder(w).Encode(encodedFile("MIT for unchanged", "sha-1"))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/outdated/contents/LICENSE":
+			json.NewEncoder(w).Encode(encodedFile("old text", "sha-2"))
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/has-md/contents/LICENSE.md":
+			json.NewEncoder(w).Encode(encodedFile("md license", "sha-3"))
+		case r.Method == http.MethodPut:
+			var body github.RepositoryContentFileOptions
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			written[strings.Split(r.URL.Path, "/")[3]] = &body
+			mu.Unlock()
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.RepositoryContentResponse{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	rollout := FileRollout{
+		Path:           "LICENSE",
+		Render:         func(repoName string) (string, error) { return "MIT for " + repoName, nil },
+		CommitMessage:  "Add LICENSE",
+		AlternatePaths: []string{"LICENSE.md"},
+	}
+
+	results := service.ApplyFileToRepos(context.Background(), "testorg",
+		[]string{"unchanged", "outdated", "missing", "has-md"}, rollout)
+
+	require.Len(t, results, 4)
+	assert.Equal(t, FileStatusUnchanged, results[0].Status)
+	assert.Equal(t, FileStatusUpdated, results[1].Status)
+	assert.Equal(t, FileStatusCreated, results[2].Status)
+	assert.Equal(t, FileStatusCreated, results[3].Status, "alternate paths only matter with SkipIfExists")
+
+	assert.Equal(t, "sha-2", written["outdated"].GetSHA())
+	assert.Equal(t, "MIT for missing", string(written["missing"].Content))
+	assert.Nil(t, written["missing"].SHA)
+
+	// With SkipIfExists any existing copy is left alone
+	rollout.SkipIfExists = true
+	results = service.ApplyFileToRepos(context.Background(), "testorg", []string{"outdated", "has-md", "missing"}, rollout)
+	assert.Equal(t, FileStatusSkipped, results[0].Status)
+	assert.Equal(t, FileStatusSkipped, results[1].Status)
+	assert.Equal(t, FileStatusCreated, results[2].Status)
+}
+
+func TestApplyFileToRepos_PullRequest(t *testing.T) {
+	var createdRef, committedBranch string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1":
+			json.NewEncoder(w).Encode(github.Repository{Name: stringPtr("repo1"), DefaultBranch: stringPtr("main")})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/git/ref/heads/main":
+			json.NewEncoder(w).Encode(github.Reference{Object: &github.GitObject{SHA: stringPtr("base-sha")}})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/git/refs":
+			var body map[string]string
+			json.NewDecoder(r.Body).Decode(&body)
+			createdRef = body["ref"]
+			assert.Equal(t, "base-sha", body["sha"])
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.Reference{})
+		case r.Method == http.MethodPut && r.URL.Path == "/repos/testorg/repo1/contents/LICENSE":
+			var body github.RepositoryContentFileOptions
+			json.NewDecoder(r.Body).Decode(&body)
+			committedBranch = body.GetBranch()
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.RepositoryContentResponse{})
+		case r.Method == http.MethodPost && r.URL.Path == "/repos/testorg/repo1/pulls":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(github.PullRequest{HTMLURL: stringPtr("https://github.com/testorg/repo1/pull/1")})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	results := service.ApplyFileToRepos(context.Background(), "testorg", []string{"repo1"}, FileRollout{
+		Path:          "LICENSE",
+		Render:        func(string) (string, error) { return "license", nil },
+		CommitMessage: "Add LICENSE",
+		PullRequest:   &PullRequestOptions{Branch: "add-license", Title: "Add LICENSE"},
+	})
+
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, FileStatusCreated, results[0].Status)
+	assert.Equal(t, "https://github.com/testorg/repo1/pull/1", results[0].PullRequestURL)
+	assert.Equal(t, "refs/heads/add-license", createdRef)
+	assert.Equal(t, "add-license", committedBranch)
+}
+
+func TestRenderLicense(t *testing.T) {
+	assert.Equal(t, "Copyright (c) 2024 Acme Inc", RenderLicense("Copyright (c) [year] [fullname]", 2024, "Acme Inc"))
+	assert.Equal(t, "Copyright 2024 Acme Inc", RenderLicense("Copyright [yyyy] [name of copyright owner]", 2024, "Acme Inc"))
+}
+
+func TestApplyFileToRepos_RenderSkip(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}, 1)
+
+	results := service.ApplyFileToRepos(context.Background(), "testorg", []string{"repo1"}, FileRollout{
+		Path:   ".github/dependabot.yml",
+		Render: func(string) (string, error) { return "", ErrSkipFile },
+	})
+
+	require.Len(t, results, 1)
+	assert.Equal(t, FileStatusSkipped, results[0].Status)
+	assert.NoError(t, results[0].Err)
+}
@@ -0,0 +1,316 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Ruleset statuses. In a dry run they describe the change that would be made.
+const (
+	RulesetStatusCreated   = "created"
+	RulesetStatusUpdated   = "updated"
+	RulesetStatusUnchanged = "unchanged"
+)
+
+// tagRefPrefix is the ref prefix of tags in ruleset conditions.
+const tagRefPrefix = "refs/tags/"
+
+// Ruleset API paths.
+const (
+	rulesetsPathFmt = "repos/%s/%s/rulesets"
+	rulesetPathFmt  = "repos/%s/%s/rulesets/%d"
+)
+
+// Ruleset is a repository ruleset in the shape of the rulesets API. Bypass actors, conditions and rules are
+// kept as generic JSON values, so that rule types the GitHub client does not know yet round-trip unchanged.
+type Ruleset struct {
+	ID   int64  `json:"id,omitempty"`
+	Name string `json:"name"`
+	// Target is branch or tag.
+	Target string `json:"target,omitempty"`
+	// Enforcement is active, evaluate or disabled.
+	Enforcement  string           `json:"enforcement"`
+	BypassActors []map[string]any `json:"bypass_actors,omitempty"`
+	Conditions   map[string]any   `json:"conditions,omitempty"`
+	Rules        []map[string]any `json:"rules,omitempty"`
+}
+
+// RulesetChange is the outcome of applying one ruleset to a repository.
+type RulesetChange struct {
+	Name string
+	// Status is one of the RulesetStatus constants.
+	Status string
+	// Current and Desired are the compared definitions as indented JSON; Current is empty for a new ruleset.
+	// Settings GitHub fills in but the definition leaves out are not part of Current.
+	Current string
+	Desired string
+}
+
+// RulesetResult is the outcome of applying rulesets to a repository.
+type RulesetResult struct {
+	RepoName string
+	Changes  []RulesetChange
+	Err      error
+}
+
+// RepoErr implements Result.
+func (r RulesetResult) RepoErr() error { return r.Err }
+
+// WithRepoErr implements Result.
+func (RulesetResult) WithRepoErr(_, repoName string, err error) RulesetResult {
+	return RulesetResult{RepoName: repoName, Err: err}
+}
+
+// Changed reports whether any ruleset was (or in a dry run would be) created or updated.
+func (r RulesetResult) Changed() bool {
+	for _, change := range r.Changes {
+		if change.Status != RulesetStatusUnchanged {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TagProtectionRuleset builds a tag ruleset that keeps the tags matching the patterns, e.g. v*, from being
+// deleted or moved. Rulesets replace the retired tag protection rules, which GitHub migrated to rulesets.
+func TagProtectionRuleset(name string, patterns []string) Ruleset {
+	include := make([]any, 0, len(patterns))
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern, tagRefPrefix) {
+			pattern = tagRefPrefix + pattern
+		}
+		include = append(include, pattern)
+	}
+
+	return Ruleset{
+		Name:        name,
+		Target:      "tag",
+		Enforcement: "active",
+		Conditions:  map[string]any{"ref_name": map[string]any{"include": include, "exclude": []any{}}},
+		Rules:       []map[string]any{{"type": "deletion"}, {"type": "update"}},
+	}
+}
+
+// ListRulesets gets the rulesets defined on a repository itself, with their rules and conditions.
+// Rulesets inherited from the organization are left out.
+func (s *Service) ListRulesets(ctx context.Context, owner, repoName string) ([]Ruleset, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf(rulesetsPathFmt, owner, repoName)+"?includes_parents=false", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summaries []Ruleset
+	if _, err := s.client.Do(ctx, req, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to list rulesets for %s/%s: %w", owner, repoName, err)
+	}
+
+	// The list endpoint leaves out rules and conditions
+	rulesets := make([]Ruleset, 0, len(summaries))
+	for _, summary := range summaries {
+		req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf(rulesetPathFmt, owner, repoName, summary.ID), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var ruleset Ruleset
+		if _, err := s.client.Do(ctx, req, &ruleset); err != nil {
+			return nil, fmt.Errorf("failed to get ruleset %s for %s/%s: %w", summary.Name, owner, repoName, err)
+		}
+
+		rulesets = append(rulesets, ruleset)
+	}
+
+	return rulesets, nil
+}
+
+// ApplyRulesets creates the rulesets missing from each repository and updates those whose definition
+// differs, matching rulesets by name. With dryRun nothing is changed and the changes are only reported.
+func (s *Service) ApplyRulesets(ctx context.Context, owner string, repoNames []string, rulesets []Ruleset,
+	dryRun bool,
+) []RulesetResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) RulesetResult {
+		result := RulesetResult{RepoName: repoName}
+
+		result.Changes, result.Err = s.applyRulesets(ctx, owner, repoName, rulesets, dryRun)
+		if result.Err != nil {
+			s.log.Error("Failed to apply rulesets", "owner", owner, "repo", repoName, "error", result.Err)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) applyRulesets(ctx context.Context, owner, repoName string, rulesets []Ruleset,
+	dryRun bool,
+) ([]RulesetChange, error) {
+	existing, err := s.ListRulesets(ctx, owner, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]Ruleset, len(existing))
+	for _, ruleset := range existing {
+		byName[ruleset.Name] = ruleset
+	}
+
+	var changes []RulesetChange
+	for _, desired := range rulesets {
+		desired.ID = 0
+		change := RulesetChange{Name: desired.Name, Status: RulesetStatusCreated}
+
+		if change.Desired, err = rulesetDefinition(desired, nil); err != nil {
+			return changes, err
+		}
+
+		method, path := http.MethodPost, fmt.Sprintf(rulesetsPathFmt, owner, repoName)
+		if current, ok := byName[desired.Name]; ok {
+			if change.Current, err = rulesetDefinition(current, &desired); err != nil {
+				return changes, err
+			}
+
+			change.Status = RulesetStatusUpdated
+			if change.Current == change.Desired {
+				change.Status = RulesetStatusUnchanged
+			}
+
+			method, path = http.MethodPut, fmt.Sprintf(rulesetPathFmt, owner, repoName, current.ID)
+		}
+
+		if change.Status != RulesetStatusUnchanged && !dryRun {
+			s.log.Info("Applying ruleset", "owner", owner, "repo", repoName, "ruleset", desired.Name, "status", change.Status)
+
+			req, err := s.client.NewRequest(method, path, desired)
+			if err != nil {
+				return changes, err
+			}
+
+			_, err = s.client.Do(ctx, req, nil)
+			s.recordMutation(ctx, audit.Record{Operation: OperationApplyRuleset, Owner: owner, Repo: repoName, Target: desired.Name}, err)
+			if err != nil {
+				return changes, fmt.Errorf("failed to apply ruleset %s to %s/%s: %w", desired.Name, owner, repoName, err)
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// rulesetDefinition renders the comparable part of a ruleset as indented JSON with sorted keys, leaving out
+// null values and empty lists. When shape is set, object keys missing from shape are left out too, so that
+// the defaults GitHub fills into a stored ruleset do not count as differences.
+func rulesetDefinition(ruleset Ruleset, shape *Ruleset) (string, error) {
+	ruleset.ID = 0
+
+	value, err := toJSONValue(ruleset)
+	if err != nil {
+		return "", err
+	}
+
+	if shape != nil {
+		shapeValue, err := toJSONValue(*shape)
+		if err != nil {
+			return "", err
+		}
+		value = projectJSON(value, shapeValue)
+	}
+
+	data, err := json.MarshalIndent(pruneJSON(value), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode ruleset %s: %w", ruleset.Name, err)
+	}
+
+	return string(data) + "\n", nil
+}
+
+// toJSONValue converts v to its generic JSON representation.
+func toJSONValue(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// projectJSON keeps the object keys of value that also appear in shape, descending into objects and into
+// lists of the same length.
+func projectJSON(value, shape any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		s, ok := shape.(map[string]any)
+		if !ok {
+			return value
+		}
+
+		projected := make(map[string]any, len(s))
+		for key, sv := range s {
+			if vv, ok := v[key]; ok {
+				projected[key] = projectJSON(vv, sv)
+			}
+		}
+
+		return projected
+	case []any:
+		s, ok := shape.([]any)
+		if !ok || len(s) != len(v) {
+			return value
+		}
+
+		projected := make([]any, len(v))
+		for i := range v {
+			projected[i] = projectJSON(v[i], s[i])
+		}
+
+		return projected
+	default:
+		return value
+	}
+}
+
+// pruneJSON removes null values, empty lists and empty objects from object fields.
+func pruneJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		pruned := make(map[string]any, len(v))
+		for key, item := range v {
+			item = pruneJSON(item)
+			switch i := item.(type) {
+			case nil:
+				continue
+			case []any:
+				if len(i) == 0 {
+					continue
+				}
+			case map[string]any:
+				if len(i) == 0 {
+					continue
+				}
+			}
+			pruned[key] = item
+		}
+
+		return pruned
+	case []any:
+		pruned := make([]any, len(v))
+		for i, item := range v {
+			pruned[i] = pruneJSON(item)
+		}
+
+		return pruned
+	default:
+		return value
+	}
+}
@@ -0,0 +1,114 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyRulesets_WithMockServer(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		writes []string
+	)
+
+	stored := map[string]any{
+		"id": 7, "name": "protect-main", "target": "branch", "enforcement": "active", "source_type": "Repository",
+		"conditions": map[string]any{"ref_name": map[string]any{"include": []string{"~DEFAULT_BRANCH"}, "exclude": []string{}}},
+		"rules": []any{
+			map[string]any{"type": "deletion"},
+			map[string]any{"type": "pull_request", "parameters": map[string]any{
+				"required_approving_review_count": 1, "dismiss_stale_reviews_on_push": false,
+			}},
+		},
+	}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/rulesets":
+			assert.Equal(t, "false", r.URL.Query().Get("includes_parents"))
+			json.NewEncoder(w).Encode([]map[string]any{{"id": 7, "name": "protect-main"}})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/rulesets/7":
+			json.NewEncoder(w).Encode(stored)
+		case r.Method == http.MethodPut || r.Method == http.MethodPost:
+			mu.Lock()
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			json.NewEncoder(w).Encode(map[string]any{"id": 8})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 1)
+
+	conditions := map[string]any{"ref_name": map[string]any{"include": []any{"~DEFAULT_BRANCH"}}}
+	unchanged := Ruleset{
+		Name: "protect-main", Target: "branch", Enforcement: "active", Conditions: conditions,
+		Rules: []map[string]any{
+			{"type": "deletion"},
+			{"type": "pull_request", "parameters": map[string]any{"required_approving_review_count": 1}},
+		},
+	}
+	tags := Ruleset{
+		Name: "protect-tags", Target: "tag", Enforcement: "active",
+		Conditions: map[string]any{"ref_name": map[string]any{"include": []any{"refs/tags/v*"}}},
+		Rules:      []map[string]any{{"type": "deletion"}},
+	}
+
+	results := service.ApplyRulesets(context.Background(), "testorg", []string{"repo1"}, []Ruleset{unchanged, tags}, false)
+	require.Len(t, results, 1)
+	require.NoError(t, results[0].Err)
+	require.Len(t, results[0].Changes, 2)
+	assert.Equal(t, RulesetStatusUnchanged, results[0].Changes[0].Status)
+	assert.Equal(t, RulesetStatusCreated, results[0].Changes[1].Status)
+	assert.Empty(t, results[0].Changes[1].Current)
+	assert.True(t, results[0].Changed())
+	assert.Equal(t, []string{"POST /repos/testorg/repo1/rulesets"}, writes)
+
+	// Raising the review count updates the stored ruleset; a dry run only reports it
+	updated := unchanged
+	updated.Rules = []map[string]any{
+		{"type": "deletion"},
+		{"type": "pull_request", "parameters": map[string]any{"required_approving_review_count": 2}},
+	}
+
+	writes = nil
+	results = service.ApplyRulesets(context.Background(), "testorg", []string{"repo1"}, []Ruleset{updated}, true)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, RulesetStatusUpdated, results[0].Changes[0].Status)
+	assert.Contains(t, results[0].Changes[0].Current, `"required_approving_review_count": 1`)
+	assert.Contains(t, results[0].Changes[0].Desired, `"required_approving_review_count": 2`)
+	assert.NotContains(t, results[0].Changes[0].Current, "dismiss_stale_reviews_on_push")
+	assert.Empty(t, writes)
+
+	results = service.ApplyRulesets(context.Background(), "testorg", []string{"repo1"}, []Ruleset{updated}, false)
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, []string{"PUT /repos/testorg/repo1/rulesets/7"}, writes)
+}
+
+func TestApplyRulesets_ListError(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+	}, 1)
+
+	results := service.ApplyRulesets(context.Background(), "testorg", []string{"repo1"}, []Ruleset{{Name: "x"}}, false)
+	require.Len(t, results, 1)
+	assert.ErrorContains(t, results[0].Err, "failed to list rulesets for testorg/repo1")
+}
+
+func TestTagProtectionRuleset(t *testing.T) {
+	ruleset := TagProtectionRuleset("protect-release-tags", []string{"v*", "refs/tags/release-*"})
+
+	assert.Equal(t, "protect-release-tags", ruleset.Name)
+	assert.Equal(t, "tag", ruleset.Target)
+	assert.Equal(t, "active", ruleset.Enforcement)
+	assert.Equal(t, map[string]any{"ref_name": map[string]any{
+		"include": []any{"refs/tags/v*", "refs/tags/release-*"},
+		"exclude": []any{},
+	}}, ruleset.Conditions)
+	assert.Equal(t, []map[string]any{{"type": "deletion"}, {"type": "update"}}, ruleset.Rules)
+}
@@ -0,0 +1,93 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// RunnerGroupVisibilitySelected is the visibility of runner groups that only selected repositories can use.
+const RunnerGroupVisibilitySelected = "selected"
+
+// GetRunnerGroup finds a self-hosted runner group of an organization by name.
+func (s *Service) GetRunnerGroup(ctx context.Context, org, name string) (*github.RunnerGroup, error) {
+	opts := &github.ListOrgRunnerGroupOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	for {
+		page, resp, err := s.client.Actions.ListOrganizationRunnerGroups(ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list runner groups of %s: %w", org, err)
+		}
+
+		for _, group := range page.RunnerGroups {
+			if group.GetName() == name {
+				return group, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, fmt.Errorf("runner group %q not found in %s", name, org)
+}
+
+// ListRunnerGroupRepos returns the names of the repositories that have access to a runner group with
+// selected visibility.
+func (s *Service) ListRunnerGroupRepos(ctx context.Context, org string, groupID int64) (map[string]bool, error) {
+	opts := &github.ListOptions{PerPage: 100}
+	names := map[string]bool{}
+
+	for {
+		page, resp, err := s.client.Actions.ListRepositoryAccessRunnerGroup(ctx, org, groupID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories of runner group %d in %s: %w", groupID, org, err)
+		}
+
+		for _, repository := range page.Repositories {
+			names[repository.GetName()] = true
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return names, nil
+}
+
+// AssignReposToRunnerGroup gives the repositories access to a runner group with selected visibility. It
+// returns the names of the repositories that succeeded and the names of the ones that failed.
+func (s *Service) AssignReposToRunnerGroup(ctx context.Context, org string, groupID int64,
+	repos []*github.Repository,
+) ([]string, []string) {
+	ids := make(map[string]int64, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		ids[repository.GetName()] = repository.GetID()
+		names = append(names, repository.GetName())
+	}
+
+	return s.processReposConcurrently(ctx, "assign runner group", names, func(ctx context.Context, repoName string) error {
+		s.log.Info("Assigning repository to runner group", "org", org, "repo", repoName, "group", groupID)
+
+		_, err := s.client.Actions.AddRepositoryAccessRunnerGroup(ctx, org, groupID, ids[repoName])
+		s.recordMutation(ctx, audit.Record{Operation: OperationAssignRunnerGroup, Owner: org, Repo: repoName,
+			Target: strconv.FormatInt(groupID, 10)}, err)
+		if err != nil {
+			return fmt.Errorf("failed to assign %s/%s to runner group %d: %w", org, repoName, groupID, err)
+		}
+
+		return nil
+	}, nil)
+}
@@ -0,0 +1,68 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunnerGroups_WithMockServer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		assigned []string
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/orgs/testorg/actions/runner-groups" && r.URL.Query().Get("page") == "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s?page=2>; rel="next"`, r.URL.Path))
+			json.NewEncoder(w).Encode(map[string]any{"total_count": 2, "runner_groups": []map[string]any{
+				{"id": 1, "name": "Default", "visibility": "all"},
+			}})
+		case r.URL.Path == "/orgs/testorg/actions/runner-groups":
+			json.NewEncoder(w).Encode(map[string]any{"total_count": 2, "runner_groups": []map[string]any{
+				{"id": 7, "name": "self-hosted", "visibility": "selected"},
+			}})
+		case r.URL.Path == "/orgs/testorg/actions/runner-groups/7/repositories":
+			json.NewEncoder(w).Encode(map[string]any{"total_count": 1, "repositories": []map[string]any{{"id": 10, "name": "repo1"}}})
+		case r.Method == http.MethodPut && r.URL.Path == "/orgs/testorg/actions/runner-groups/7/repositories/30":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodPut:
+			mu.Lock()
+			assigned = append(assigned, r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 2)
+
+	ctx := context.Background()
+
+	group, err := service.GetRunnerGroup(ctx, "testorg", "self-hosted")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), group.GetID())
+	assert.Equal(t, RunnerGroupVisibilitySelected, group.GetVisibility())
+
+	_, err = service.GetRunnerGroup(ctx, "testorg", "missing")
+	assert.ErrorContains(t, err, `runner group "missing" not found`)
+
+	repos, err := service.ListRunnerGroupRepos(ctx, "testorg", 7)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"repo1": true}, repos)
+
+	success, failed := service.AssignReposToRunnerGroup(ctx, "testorg", 7, []*github.Repository{
+		{ID: github.Int64(20), Name: github.String("repo2")},
+		{ID: github.Int64(30), Name: github.String("repo3")},
+	})
+	assert.Equal(t, []string{"repo2"}, success)
+	assert.Equal(t, []string{"repo3"}, failed)
+	assert.Equal(t, []string{"/orgs/testorg/actions/runner-groups/7/repositories/20"}, assigned)
+}
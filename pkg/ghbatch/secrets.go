@@ -0,0 +1,190 @@
+package ghbatch
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"golang.org/x/crypto/nacl/box"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Secret statuses.
+const (
+	SecretStatusCreated = "created"
+	SecretStatusUpdated = "updated"
+)
+
+// secretNamePattern matches the names GitHub accepts for Actions secrets.
+var secretNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SecretResult is the outcome of setting a secret in a repository.
+type SecretResult struct {
+	RepoName string
+	// Status is one of the SecretStatus constants.
+	Status string
+	// EnvironmentCreated reports that the environment of an environment secret did not exist and was created.
+	EnvironmentCreated bool
+	Err                error
+}
+
+// RepoErr implements Result.
+func (s SecretResult) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (SecretResult) WithRepoErr(_, repoName string, err error) SecretResult {
+	return SecretResult{RepoName: repoName, Err: err}
+}
+
+// ValidateSecretName checks a secret name against the naming rules of GitHub Actions secrets.
+func ValidateSecretName(name string) error {
+	if !secretNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q: only letters, digits and underscores are allowed and it must not start with a digit", name)
+	}
+	if strings.HasPrefix(strings.ToUpper(name), "GITHUB_") {
+		return fmt.Errorf("invalid secret name %q: the GITHUB_ prefix is reserved", name)
+	}
+
+	return nil
+}
+
+// EncryptSecret encrypts a secret value with a base64 encoded repository or environment public key, the way
+// the secrets API expects.
+func EncryptSecret(publicKey, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(decoded) != 32 {
+		return "", fmt.Errorf("invalid public key")
+	}
+
+	var recipient [32]byte
+	copy(recipient[:], decoded)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &recipient, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// SetSecretInRepos creates or rotates an Actions secret in all the given repositories. With an environment
+// the secret is scoped to that environment, which is created first in repositories that lack it.
+func (s *Service) SetSecretInRepos(ctx context.Context, owner string, repoNames []string,
+	environment, name, value string,
+) []SecretResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) SecretResult {
+		result := SecretResult{RepoName: repoName}
+
+		if environment == "" {
+			result.Status, result.Err = s.setRepoSecret(ctx, owner, repoName, name, value)
+		} else {
+			result.Status, result.EnvironmentCreated, result.Err = s.setEnvironmentSecret(ctx, owner, repoName, environment, name, value)
+		}
+
+		if result.Err != nil {
+			s.log.Error("Failed to set secret", "owner", owner, "repo", repoName, "environment", environment, "secret", name, "error", result.Err)
+		}
+
+		return result
+	})
+}
+
+func (s *Service) setRepoSecret(ctx context.Context, owner, repoName, name, value string) (string, error) {
+	key, _, err := s.client.Actions.GetRepoPublicKey(ctx, owner, repoName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get public key of %s/%s: %w", owner, repoName, err)
+	}
+
+	secret, err := encryptedSecret(key, name, value)
+	if err != nil {
+		return "", err
+	}
+
+	s.log.Info("Setting secret", "owner", owner, "repo", repoName, "secret", name)
+	resp, err := s.client.Actions.CreateOrUpdateRepoSecret(ctx, owner, repoName, secret)
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetSecret, Owner: owner, Repo: repoName, Target: name}, err)
+	if err != nil {
+		return "", fmt.Errorf("failed to set secret %s in %s/%s: %w", name, owner, repoName, err)
+	}
+
+	return secretStatus(resp), nil
+}
+
+func (s *Service) setEnvironmentSecret(ctx context.Context, owner, repoName, environment, name, value string) (string, bool, error) {
+	repository, _, err := s.client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get repository %s/%s: %w", owner, repoName, err)
+	}
+
+	created, err := s.ensureEnvironment(ctx, owner, repoName, environment)
+	if err != nil {
+		return "", false, err
+	}
+
+	// The environment secrets endpoints address the repository by ID
+	repoID := int(repository.GetID())
+
+	key, _, err := s.client.Actions.GetEnvPublicKey(ctx, repoID, environment)
+	if err != nil {
+		return "", created, fmt.Errorf("failed to get public key of environment %s in %s/%s: %w", environment, owner, repoName, err)
+	}
+
+	secret, err := encryptedSecret(key, name, value)
+	if err != nil {
+		return "", created, err
+	}
+
+	s.log.Info("Setting environment secret", "owner", owner, "repo", repoName, "environment", environment, "secret", name)
+	resp, err := s.client.Actions.CreateOrUpdateEnvSecret(ctx, repoID, environment, secret)
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetSecret, Owner: owner, Repo: repoName, Target: environment + "/" + name}, err)
+	if err != nil {
+		return "", created, fmt.Errorf("failed to set secret %s in environment %s of %s/%s: %w", name, environment, owner, repoName, err)
+	}
+
+	return secretStatus(resp), created, nil
+}
+
+// ensureEnvironment creates an environment without protection rules when the repository lacks it and reports
+// whether it did.
+func (s *Service) ensureEnvironment(ctx context.Context, owner, repoName, environment string) (bool, error) {
+	_, resp, err := s.client.Repositories.GetEnvironment(ctx, owner, repoName, environment)
+	if err == nil {
+		return false, nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return false, fmt.Errorf("failed to get environment %s of %s/%s: %w", environment, owner, repoName, err)
+	}
+
+	s.log.Info("Creating environment", "owner", owner, "repo", repoName, "environment", environment)
+	_, _, err = s.client.Repositories.CreateUpdateEnvironment(ctx, owner, repoName, environment, &github.CreateUpdateEnvironment{})
+	s.recordMutation(ctx, audit.Record{Operation: OperationSetEnvironment, Owner: owner, Repo: repoName, Target: environment}, err)
+	if err != nil {
+		return false, fmt.Errorf("failed to create environment %s in %s/%s: %w", environment, owner, repoName, err)
+	}
+
+	return true, nil
+}
+
+func encryptedSecret(key *github.PublicKey, name, value string) (*github.EncryptedSecret, error) {
+	encrypted, err := EncryptSecret(key.GetKey(), value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &github.EncryptedSecret{Name: name, KeyID: key.GetKeyID(), EncryptedValue: encrypted}, nil
+}
+
+// secretStatus tells a created secret from a rotated one by the status code of the response.
+func secretStatus(resp *github.Response) string {
+	if resp != nil && resp.StatusCode == http.StatusCreated {
+		return SecretStatusCreated
+	}
+
+	return SecretStatusUpdated
+}
@@ -0,0 +1,116 @@
+package ghbatch
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestValidateSecretName(t *testing.T) {
+	assert.NoError(t, ValidateSecretName("DEPLOY_TOKEN"))
+	assert.NoError(t, ValidateSecretName("_token2"))
+	assert.ErrorContains(t, ValidateSecretName("2FA"), "must not start with a digit")
+	assert.ErrorContains(t, ValidateSecretName("DEPLOY-TOKEN"), "only letters, digits and underscores")
+	assert.ErrorContains(t, ValidateSecretName("github_token"), "GITHUB_ prefix is reserved")
+}
+
+func TestEncryptSecret(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	encrypted, err := EncryptSecret(base64.StdEncoding.EncodeToString(publicKey[:]), "s3cret")
+	require.NoError(t, err)
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	require.NoError(t, err)
+	opened, ok := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+	require.True(t, ok)
+	assert.Equal(t, "s3cret", string(opened))
+
+	_, err = EncryptSecret("bm90IGEga2V5", "s3cret")
+	assert.ErrorContains(t, err, "invalid public key")
+}
+
+func TestSetSecretInRepos_WithMockServer(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key := map[string]any{"key_id": "k1", "key": base64.StdEncoding.EncodeToString(publicKey[:])}
+
+	var (
+		mu       sync.Mutex
+		requests []string
+		values   []string
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1":
+			json.NewEncoder(w).Encode(map[string]any{"id": 101, "name": "repo1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo2":
+			json.NewEncoder(w).Encode(map[string]any{"id": 102, "name": "repo2"})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo1/environments/production":
+			json.NewEncoder(w).Encode(map[string]any{"name": "production"})
+		case r.Method == http.MethodGet && r.URL.Path == "/repos/testorg/repo2/environments/production":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode(key)
+		case r.Method == http.MethodPut:
+			requests = append(requests, r.URL.Path)
+
+			var body struct {
+				KeyID          string `json:"key_id"`
+				EncryptedValue string `json:"encrypted_value"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			sealed, _ := base64.StdEncoding.DecodeString(body.EncryptedValue)
+			opened, _ := box.OpenAnonymous(nil, sealed, publicKey, privateKey)
+			values = append(values, body.KeyID+":"+string(opened))
+
+			switch r.URL.Path {
+			case "/repositories/101/environments/production/secrets/DEPLOY_TOKEN":
+				w.WriteHeader(http.StatusNoContent)
+			case "/repos/testorg/repo2/environments/production":
+				json.NewEncoder(w).Encode(map[string]any{"name": "production"})
+			default:
+				w.WriteHeader(http.StatusCreated)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 1)
+
+	results := service.SetSecretInRepos(context.Background(), "testorg", []string{"repo1", "repo2"}, "production", "DEPLOY_TOKEN", "s3cret")
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.NoError(t, result.Err)
+	}
+
+	assert.Equal(t, SecretStatusUpdated, results[0].Status)
+	assert.False(t, results[0].EnvironmentCreated)
+	assert.Equal(t, SecretStatusCreated, results[1].Status)
+	assert.True(t, results[1].EnvironmentCreated)
+
+	assert.Equal(t, []string{
+		"/repositories/101/environments/production/secrets/DEPLOY_TOKEN",
+		"/repos/testorg/repo2/environments/production",
+		"/repositories/102/environments/production/secrets/DEPLOY_TOKEN",
+	}, requests)
+	assert.Contains(t, values, "k1:s3cret")
+
+	requests = nil
+	results = service.SetSecretInRepos(context.Background(), "testorg", []string{"repo1"}, "", "DEPLOY_TOKEN", "s3cret")
+	require.NoError(t, results[0].Err)
+	assert.Equal(t, SecretStatusCreated, results[0].Status)
+	assert.Equal(t, []string{"/repos/testorg/repo1/actions/secrets/DEPLOY_TOKEN"}, requests)
+}
@@ -0,0 +1,198 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+
+	"go-repo-manager/internal/audit"
+)
+
+// Security feature statuses.
+const (
+	SecurityFeatureStatusEnabled        = "enabled"
+	SecurityFeatureStatusAlreadyEnabled = "already-enabled"
+	SecurityFeatureStatusFailed         = "failed"
+)
+
+// securityAndAnalysisEnabled is the status of an enabled security and analysis setting of a repository.
+const securityAndAnalysisEnabled = "enabled"
+
+// SecurityFeatureResult is the outcome of enabling a security feature in a repository.
+type SecurityFeatureResult struct {
+	RepoName string
+	// Status is one of the SecurityFeatureStatus constants.
+	Status string
+	Err    error
+}
+
+// RepoErr implements Result.
+func (s SecurityFeatureResult) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (SecurityFeatureResult) WithRepoErr(_, repoName string, err error) SecurityFeatureResult {
+	return SecurityFeatureResult{RepoName: repoName, Err: err}
+}
+
+// SecurityFeatureAudit is the state of a security feature in a repository.
+type SecurityFeatureAudit struct {
+	RepoName string
+	Enabled  bool
+	Err      error
+}
+
+// RepoErr implements Result.
+func (s SecurityFeatureAudit) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (SecurityFeatureAudit) WithRepoErr(_, repoName string, err error) SecurityFeatureAudit {
+	return SecurityFeatureAudit{RepoName: repoName, Err: err}
+}
+
+// enableSecurityFeature runs enable for all the given repositories and turns its outcome into results. enable
+// reports whether the feature was already on.
+func (s *Service) enableSecurityFeature(ctx context.Context, feature string, repoNames []string,
+	enable func(ctx context.Context, repoName string) (bool, error),
+) []SecurityFeatureResult {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) SecurityFeatureResult {
+		alreadyEnabled, err := enable(ctx, repoName)
+		switch {
+		case err != nil:
+			s.log.Error("Failed to enable security feature", "feature", feature, "repo", repoName, "error", err)
+			return SecurityFeatureResult{RepoName: repoName, Status: SecurityFeatureStatusFailed, Err: err}
+		case alreadyEnabled:
+			return SecurityFeatureResult{RepoName: repoName, Status: SecurityFeatureStatusAlreadyEnabled}
+		default:
+			return SecurityFeatureResult{RepoName: repoName, Status: SecurityFeatureStatusEnabled}
+		}
+	})
+}
+
+// EnableDependabotSecurityUpdates turns on vulnerability alerts and Dependabot security updates in all the
+// given repositories. Security updates depend on vulnerability alerts, so alerts are enabled first.
+func (s *Service) EnableDependabotSecurityUpdates(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult {
+	return s.enableSecurityFeature(ctx, "dependabot-security-updates", repoNames, func(ctx context.Context, repoName string) (bool, error) {
+		return s.enableDependabotSecurityUpdates(ctx, owner, repoName)
+	})
+}
+
+func (s *Service) enableDependabotSecurityUpdates(ctx context.Context, owner, repoName string) (bool, error) {
+	alertsEnabled, _, err := s.client.Repositories.GetVulnerabilityAlerts(ctx, owner, repoName)
+	if err != nil {
+		return false, fmt.Errorf("failed to get vulnerability alerts setting of %s/%s: %w", owner, repoName, err)
+	}
+
+	if alertsEnabled {
+		fixes, _, err := s.client.Repositories.GetAutomatedSecurityFixes(ctx, owner, repoName)
+		if err != nil {
+			return false, fmt.Errorf("failed to get security updates setting of %s/%s: %w", owner, repoName, err)
+		}
+		if fixes.GetEnabled() {
+			return true, nil
+		}
+	} else {
+		s.log.Info("Enabling vulnerability alerts", "owner", owner, "repo", repoName)
+		_, err := s.client.Repositories.EnableVulnerabilityAlerts(ctx, owner, repoName)
+		s.recordMutation(ctx, audit.Record{Operation: OperationEnableSecurityFeature, Owner: owner, Repo: repoName, Target: "vulnerability-alerts"}, err)
+		if err != nil {
+			return false, fmt.Errorf("failed to enable vulnerability alerts in %s/%s: %w", owner, repoName, err)
+		}
+	}
+
+	s.log.Info("Enabling Dependabot security updates", "owner", owner, "repo", repoName)
+	_, err = s.client.Repositories.EnableAutomatedSecurityFixes(ctx, owner, repoName)
+	s.recordMutation(ctx, audit.Record{Operation: OperationEnableSecurityFeature, Owner: owner, Repo: repoName, Target: "automated-security-fixes"}, err)
+	if err != nil {
+		return false, fmt.Errorf("failed to enable security updates in %s/%s: %w", owner, repoName, err)
+	}
+
+	return false, nil
+}
+
+// EnableSecretScanning turns on secret scanning, and with pushProtection also push protection, in all the
+// given repositories through their security and analysis settings.
+func (s *Service) EnableSecretScanning(ctx context.Context, owner string, repoNames []string, pushProtection bool) []SecurityFeatureResult {
+	return s.enableSecurityFeature(ctx, "secret-scanning", repoNames, func(ctx context.Context, repoName string) (bool, error) {
+		return s.enableSecretScanning(ctx, owner, repoName, pushProtection)
+	})
+}
+
+func (s *Service) enableSecretScanning(ctx context.Context, owner, repoName string, pushProtection bool) (bool, error) {
+	repository, _, err := s.client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return false, fmt.Errorf("failed to get repository %s/%s: %w", owner, repoName, err)
+	}
+
+	current := repository.GetSecurityAndAnalysis()
+	scanningEnabled := current.GetSecretScanning().GetStatus() == securityAndAnalysisEnabled
+	pushProtectionEnabled := current.GetSecretScanningPushProtection().GetStatus() == securityAndAnalysisEnabled
+
+	if scanningEnabled && (pushProtectionEnabled || !pushProtection) {
+		return true, nil
+	}
+
+	settings := &github.SecurityAndAnalysis{
+		SecretScanning: &github.SecretScanning{Status: github.String(securityAndAnalysisEnabled)},
+	}
+	if pushProtection {
+		settings.SecretScanningPushProtection = &github.SecretScanningPushProtection{Status: github.String(securityAndAnalysisEnabled)}
+	}
+
+	s.log.Info("Enabling secret scanning", "owner", owner, "repo", repoName, "push_protection", pushProtection)
+	target := "secret-scanning"
+	if pushProtection {
+		target += ",push-protection"
+	}
+
+	_, _, err = s.client.Repositories.Edit(ctx, owner, repoName, &github.Repository{SecurityAndAnalysis: settings})
+	s.recordMutation(ctx, audit.Record{Operation: OperationEnableSecurityFeature, Owner: owner, Repo: repoName, Target: target}, err)
+	if err != nil {
+		return false, fmt.Errorf("failed to enable secret scanning in %s/%s: %w", owner, repoName, err)
+	}
+
+	return false, nil
+}
+
+// EnablePrivateVulnerabilityReporting turns on private vulnerability reporting, which lets anyone report a
+// vulnerability privately to the maintainers, in all the given repositories.
+func (s *Service) EnablePrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureResult {
+	return s.enableSecurityFeature(ctx, "private-vulnerability-reporting", repoNames, func(ctx context.Context, repoName string) (bool, error) {
+		enabled, err := s.isPrivateVulnerabilityReportingEnabled(ctx, owner, repoName)
+		if err != nil || enabled {
+			return enabled, err
+		}
+
+		s.log.Info("Enabling private vulnerability reporting", "owner", owner, "repo", repoName)
+		_, err = s.client.Repositories.EnablePrivateReporting(ctx, owner, repoName)
+		s.recordMutation(ctx, audit.Record{Operation: OperationEnableSecurityFeature, Owner: owner, Repo: repoName,
+			Target: "private-vulnerability-reporting"}, err)
+		if err != nil {
+			return false, fmt.Errorf("failed to enable private vulnerability reporting in %s/%s: %w", owner, repoName, err)
+		}
+
+		return false, nil
+	})
+}
+
+// AuditPrivateVulnerabilityReporting checks whether private vulnerability reporting is on in all the given
+// repositories.
+func (s *Service) AuditPrivateVulnerabilityReporting(ctx context.Context, owner string, repoNames []string) []SecurityFeatureAudit {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) SecurityFeatureAudit {
+		enabled, err := s.isPrivateVulnerabilityReportingEnabled(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to check private vulnerability reporting", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return SecurityFeatureAudit{RepoName: repoName, Enabled: enabled, Err: err}
+	})
+}
+
+func (s *Service) isPrivateVulnerabilityReportingEnabled(ctx context.Context, owner, repoName string) (bool, error) {
+	enabled, _, err := s.client.Repositories.IsPrivateReportingEnabled(ctx, owner, repoName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check private vulnerability reporting of %s/%s: %w", owner, repoName, err)
+	}
+
+	return enabled, nil
+}
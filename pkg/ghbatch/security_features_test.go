@@ -0,0 +1,149 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableDependabotSecurityUpdates_WithMockServer(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		if r.Method == http.MethodPut {
+			mu.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			if repoName == "locked" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/vulnerability-alerts"):
+			if repoName == "off" || repoName == "locked" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case strings.HasSuffix(r.URL.Path, "/automated-security-fixes"):
+			json.NewEncoder(w).Encode(map[string]any{"enabled": repoName == "on", "paused": false})
+		}
+	}, 1)
+
+	results := service.EnableDependabotSecurityUpdates(context.Background(), "testorg", []string{"on", "alerts-only", "off", "locked"})
+	require.Len(t, results, 4)
+
+	assert.Equal(t, SecurityFeatureStatusAlreadyEnabled, results[0].Status)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[1].Status)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[2].Status)
+	assert.Equal(t, SecurityFeatureStatusFailed, results[3].Status)
+	assert.ErrorContains(t, results[3].Err, "failed to enable vulnerability alerts in testorg/locked")
+
+	assert.Equal(t, []string{
+		"PUT /repos/testorg/alerts-only/automated-security-fixes",
+		"PUT /repos/testorg/off/vulnerability-alerts",
+		"PUT /repos/testorg/off/automated-security-fixes",
+		"PUT /repos/testorg/locked/vulnerability-alerts",
+	}, requests)
+}
+
+func TestEnableSecretScanning_WithMockServer(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		patches = map[string]map[string]any{}
+	)
+
+	settings := map[string]map[string]any{
+		"off":      {"secret_scanning": map[string]any{"status": "disabled"}},
+		"scanning": {"secret_scanning": map[string]any{"status": "enabled"}, "secret_scanning_push_protection": map[string]any{"status": "disabled"}},
+		"both":     {"secret_scanning": map[string]any{"status": "enabled"}, "secret_scanning_push_protection": map[string]any{"status": "enabled"}},
+	}
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		if r.Method == http.MethodPatch {
+			var body struct {
+				SecurityAndAnalysis map[string]any `json:"security_and_analysis"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			mu.Lock()
+			patches[repoName] = body.SecurityAndAnalysis
+			mu.Unlock()
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"name": repoName, "security_and_analysis": settings[repoName]})
+	}, 2)
+
+	results := service.EnableSecretScanning(context.Background(), "testorg", []string{"off", "scanning", "both"}, false)
+	require.Len(t, results, 3)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[0].Status)
+	assert.Equal(t, SecurityFeatureStatusAlreadyEnabled, results[1].Status)
+	assert.Equal(t, SecurityFeatureStatusAlreadyEnabled, results[2].Status)
+	assert.Equal(t, map[string]map[string]any{
+		"off": {"secret_scanning": map[string]any{"status": "enabled"}},
+	}, patches)
+
+	patches = map[string]map[string]any{}
+	results = service.EnableSecretScanning(context.Background(), "testorg", []string{"off", "scanning", "both"}, true)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[0].Status)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[1].Status)
+	assert.Equal(t, SecurityFeatureStatusAlreadyEnabled, results[2].Status)
+	assert.Equal(t, map[string]any{
+		"secret_scanning":                 map[string]any{"status": "enabled"},
+		"secret_scanning_push_protection": map[string]any{"status": "enabled"},
+	}, patches["scanning"])
+	assert.Len(t, patches, 2)
+}
+
+func TestPrivateVulnerabilityReporting_WithMockServer(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		enabled []string
+	)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		repoName := strings.Split(r.URL.Path, "/")[3]
+
+		switch r.Method {
+		case http.MethodGet:
+			if repoName == "broken" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"enabled": repoName == "on"})
+		case http.MethodPut:
+			mu.Lock()
+			enabled = append(enabled, repoName)
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}, 2)
+
+	audits := service.AuditPrivateVulnerabilityReporting(context.Background(), "testorg", []string{"on", "off", "broken"})
+	require.Len(t, audits, 3)
+	assert.True(t, audits[0].Enabled)
+	assert.False(t, audits[1].Enabled)
+	assert.NoError(t, audits[1].Err)
+	assert.ErrorContains(t, audits[2].Err, "failed to check private vulnerability reporting of testorg/broken")
+
+	results := service.EnablePrivateVulnerabilityReporting(context.Background(), "testorg", []string{"on", "off"})
+	assert.Equal(t, SecurityFeatureStatusAlreadyEnabled, results[0].Status)
+	assert.Equal(t, SecurityFeatureStatusEnabled, results[1].Status)
+	assert.Equal(t, []string{"off"}, enabled)
+}
@@ -0,0 +1,36 @@
+package ghbatch
+
+import (
+	"context"
+)
+
+// SecurityPolicyPaths are the locations GitHub looks for a security policy, in order of precedence.
+var SecurityPolicyPaths = []string{".github/SECURITY.md", "SECURITY.md", "docs/SECURITY.md"}
+
+// SecurityPolicyAudit is the result of looking up a repository's security policy.
+type SecurityPolicyAudit struct {
+	RepoName string
+	// Path is the location of the SECURITY.md file, empty if the repository has none.
+	Path string
+	Err  error
+}
+
+// RepoErr implements Result.
+func (s SecurityPolicyAudit) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (SecurityPolicyAudit) WithRepoErr(_, repoName string, err error) SecurityPolicyAudit {
+	return SecurityPolicyAudit{RepoName: repoName, Err: err}
+}
+
+// AuditSecurityPolicies looks up the SECURITY.md file of all the given repositories.
+func (s *Service) AuditSecurityPolicies(ctx context.Context, owner string, repoNames []string) []SecurityPolicyAudit {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) SecurityPolicyAudit {
+		path, err := s.findFirstFile(ctx, owner, repoName, SecurityPolicyPaths)
+		if err != nil {
+			s.log.Error("Failed to look up security policy", "owner", owner, "repo", repoName, "error", err)
+		}
+
+		return SecurityPolicyAudit{RepoName: repoName, Path: path, Err: err}
+	})
+}
@@ -0,0 +1,37 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditSecurityPolicies_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/root/contents/SECURITY.md":
+			json.NewEncoder(w).Encode(encodedFile("# Security Policy\n", "sha1"))
+		case "/repos/testorg/dotgithub/contents/.github/SECURITY.md":
+			json.NewEncoder(w).Encode(encodedFile("# Security Policy\n", "sha2"))
+		case "/repos/testorg/broken/contents/.github/SECURITY.md":
+			http.Error(w, "boom", http.StatusForbidden)
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	audits := service.AuditSecurityPolicies(context.Background(), "testorg", []string{"root", "dotgithub", "missing", "broken"})
+	require.Len(t, audits, 4)
+
+	assert.Equal(t, "SECURITY.md", audits[0].Path)
+	assert.Equal(t, ".github/SECURITY.md", audits[1].Path)
+
+	require.NoError(t, audits[2].Err)
+	assert.Empty(t, audits[2].Path)
+
+	assert.Error(t, audits[3].Err)
+}
@@ -0,0 +1,88 @@
+package ghbatch
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// signedCommit is the outcome of a file change committed with a signature.
+type signedCommit struct {
+	// blobSHA is the blob SHA of the written file; empty for deletions.
+	blobSHA   string
+	commitSHA string
+	commitURL string
+}
+
+// commitFileSigned writes content to filePath, or deletes the file when content is nil, with a signed
+// commit on top of branch (the default branch when empty).
+func (s *Service) commitFileSigned(ctx context.Context, owner, repoName, filePath string, content *string,
+	commitMessage, branch string,
+) (*signedCommit, error) {
+	head, err := s.getBranchHead(ctx, owner, repoName, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &github.TreeEntry{Path: github.String(filePath), Mode: github.String("100644"), Type: github.String("blob"), Content: content}
+	_, commit, err := s.commitTree(ctx, owner, repoName, head, []*github.TreeEntry{entry}, commitMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &signedCommit{commitSHA: commit.GetSHA(), commitURL: commit.GetHTMLURL()}
+	if content != nil {
+		result.blobSHA = gitBlobSHA(*content)
+	}
+
+	return result, nil
+}
+
+// gitBlobSHA returns the SHA git assigns to a blob with the content. The tree returned by CreateTree only
+// lists top-level entries, so the SHA of a nested file is computed rather than looked up.
+func gitBlobSHA(content string) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "blob %d\x00", len(content))
+	io.WriteString(hash, content)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// commitAuthor returns the author of signed commits: the commit identity when set, otherwise the
+// authenticated user with their public email or, without one, their GitHub noreply address. The date is
+// truncated to seconds because the signature covers it at that precision.
+func (s *Service) commitAuthor(ctx context.Context) (*github.CommitAuthor, error) {
+	date := &github.Timestamp{Time: time.Now().UTC().Truncate(time.Second)}
+
+	if author := s.identityAuthor(); author != nil {
+		author.Date = date
+
+		return author, nil
+	}
+
+	user, err := s.authenticatedUser(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the commit author: %w", err)
+	}
+
+	name := user.GetName()
+	if name == "" {
+		name = user.GetLogin()
+	}
+
+	email := user.GetEmail()
+	if email == "" {
+		email = fmt.Sprintf("%d+%s@users.noreply.github.com", user.GetID(), user.GetLogin())
+	}
+
+	return &github.CommitAuthor{
+		Name:  github.String(name),
+		Email: github.String(email),
+		Date:  date,
+	}, nil
+}
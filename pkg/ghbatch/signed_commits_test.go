@@ -0,0 +1,123 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signedCommitHandler serves the Git Data API calls of a signed commit on the main branch and records the
+// created tree and commit.
+func signedCommitHandler(t *testing.T, tree, commit *map[string]any, refUpdated *bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /repos/testorg/api":
+			json.NewEncoder(w).Encode(&github.Repository{DefaultBranch: github.String("main")})
+		case "GET /repos/testorg/api/git/ref/heads/main":
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"parent-sha","type":"commit"}}`)
+		case "GET /repos/testorg/api/git/commits/parent-sha":
+			fmt.Fprint(w, `{"sha":"parent-sha","tree":{"sha":"base-tree"}}`)
+		case "POST /repos/testorg/api/git/trees":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(tree))
+			fmt.Fprint(w, `{"sha":"new-tree","tree":[{"path":".github","type":"tree","sha":"github-tree"}]}`)
+		case "GET /user":
+			fmt.Fprint(w, `{"login":"automation-bot","id":42}`)
+		case "POST /repos/testorg/api/git/commits":
+			require.NoError(t, json.NewDecoder(r.Body).Decode(commit))
+			fmt.Fprint(w, `{"sha":"signed-sha","html_url":"https://github.com/testorg/api/commit/signed-sha"}`)
+		case "PATCH /repos/testorg/api/git/refs/heads/main":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"sha":"signed-sha","force":false}`, string(body))
+			*refUpdated = true
+			fmt.Fprint(w, `{"ref":"refs/heads/main","object":{"sha":"signed-sha"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestCreateOrUpdateFile_Signed(t *testing.T) {
+	signer := github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		payload, _ := io.ReadAll(r)
+		assert.Contains(t, string(payload), "tree new-tree\nparent parent-sha\nauthor automation-bot <42+automation-bot@users.noreply.github.com>")
+		_, err := io.WriteString(w, "-----BEGIN SSH SIGNATURE-----\nsig\n-----END SSH SIGNATURE-----\n")
+		return err
+	})
+
+	var tree, commit map[string]any
+	var refUpdated bool
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS" {
+			http.NotFound(w, r)
+			return
+		}
+		signedCommitHandler(t, &tree, &commit, &refUpdated)(w, r)
+	}, 1, WithCommitSigner(signer))
+
+	err := service.CreateOrUpdateFile(context.Background(), "testorg", "api", ".github/CODEOWNERS", "* @team\n", "Add CODEOWNERS")
+	require.NoError(t, err)
+
+	assert.Equal(t, "base-tree", tree["base_tree"])
+	assert.Equal(t, []any{map[string]any{"path": ".github/CODEOWNERS", "mode": "100644", "type": "blob", "content": "* @team\n"}}, tree["tree"])
+	assert.Equal(t, "new-tree", commit["tree"])
+	assert.Equal(t, []any{"parent-sha"}, commit["parents"])
+	assert.Equal(t, "-----BEGIN SSH SIGNATURE-----\nsig\n-----END SSH SIGNATURE-----\n", commit["signature"])
+	assert.True(t, refUpdated)
+}
+
+func TestDeleteFileOnBranch_Signed(t *testing.T) {
+	signer := github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		_, err := io.WriteString(w, "signature")
+		return err
+	})
+
+	var tree, commit map[string]any
+	var refUpdated bool
+	service := newTestService(t, signedCommitHandler(t, &tree, &commit, &refUpdated), 1, WithCommitSigner(signer))
+
+	err := service.deleteFileOnBranch(context.Background(), "testorg", "api", ".github/CODEOWNERS", "Remove CODEOWNERS", "main", "old-blob")
+	require.NoError(t, err)
+
+	// A null SHA deletes the file from the tree
+	assert.Equal(t, []any{map[string]any{"path": ".github/CODEOWNERS", "mode": "100644", "type": "blob", "sha": nil}}, tree["tree"])
+	assert.Equal(t, "signature", commit["signature"])
+	assert.True(t, refUpdated)
+}
+
+func TestCreateOrUpdateFile_SignedBranchMoved(t *testing.T) {
+	signer := github.MessageSignerFunc(func(w io.Writer, r io.Reader) error {
+		_, err := io.WriteString(w, "signature")
+		return err
+	})
+
+	var tree, commit map[string]any
+	var refUpdated bool
+	handler := signedCommitHandler(t, &tree, &commit, &refUpdated)
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/repos/testorg/api/contents/.github/CODEOWNERS":
+			http.NotFound(w, r)
+		case r.Method == http.MethodPatch:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"Update is not a fast forward"}`)
+		default:
+			handler(w, r)
+		}
+	}, 1, WithCommitSigner(signer))
+
+	err := service.CreateOrUpdateFile(context.Background(), "testorg", "api", ".github/CODEOWNERS", "* @team\n", "Add CODEOWNERS")
+	assert.ErrorContains(t, err, "failed to update branch main of testorg/api")
+}
+
+func TestGitBlobSHA(t *testing.T) {
+	assert.Equal(t, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", gitBlobSHA(""))
+	assert.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", gitBlobSHA("hello\n"))
+}
@@ -0,0 +1,92 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// SizeReport holds the disk size and reference counts of a repository.
+type SizeReport struct {
+	RepoName string
+	// SizeKB is the disk size GitHub reports for the repository, in kilobytes.
+	SizeKB   int
+	Branches int
+	Tags     int
+	// LFS is true if the repository's .gitattributes routes files through Git LFS.
+	LFS bool
+	Err error
+}
+
+// RepoErr implements Result.
+func (s SizeReport) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (SizeReport) WithRepoErr(_, repoName string, err error) SizeReport {
+	return SizeReport{RepoName: repoName, Err: err}
+}
+
+// GetSizeReport collects the size, branch and tag counts and Git LFS usage of a repository.
+func (s *Service) GetSizeReport(ctx context.Context, owner string, repository *github.Repository) (*SizeReport, error) {
+	repoName := repository.GetName()
+	report := &SizeReport{RepoName: repoName, SizeKB: repository.GetSize()}
+
+	// With one item per page, the last page number is the total count
+	branches, resp, err := s.client.Repositories.ListBranches(ctx, owner, repoName, &github.BranchListOptions{
+		ListOptions: github.ListOptions{PerPage: 1},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches for %s/%s: %w", owner, repoName, err)
+	}
+
+	report.Branches = countFromSinglePage(len(branches), resp)
+
+	tags, resp, err := s.client.Repositories.ListTags(ctx, owner, repoName, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags for %s/%s: %w", owner, repoName, err)
+	}
+
+	report.Tags = countFromSinglePage(len(tags), resp)
+
+	attributes, _, found, err := s.GetFileContent(ctx, owner, repoName, ".gitattributes")
+	if err != nil {
+		return nil, err
+	}
+
+	report.LFS = found && strings.Contains(attributes, "filter=lfs")
+
+	return report, nil
+}
+
+// countFromSinglePage derives the total item count of a listing requested with one item per page.
+func countFromSinglePage(items int, resp *github.Response) int {
+	if resp != nil && resp.LastPage > 0 {
+		return resp.LastPage
+	}
+
+	return items
+}
+
+// GetSizeReports collects the size reports of all the given repositories.
+func (s *Service) GetSizeReports(ctx context.Context, owner string, repos []*github.Repository) []SizeReport {
+	byName := make(map[string]*github.Repository, len(repos))
+	names := make([]string, 0, len(repos))
+
+	for _, repository := range repos {
+		byName[repository.GetName()] = repository
+		names = append(names, repository.GetName())
+	}
+
+	return CollectConcurrently(ctx, s.maxConcurrency, names, func(ctx context.Context, repoName string) SizeReport {
+		report, err := s.GetSizeReport(ctx, owner, byName[repoName])
+		if err != nil {
+			s.log.Error("Failed to get repository size", "owner", owner, "repo", repoName, "error", err)
+
+			return SizeReport{RepoName: repoName, SizeKB: byName[repoName].GetSize(), Err: err}
+		}
+
+		return *report
+	})
+}
@@ -0,0 +1,53 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSizeReports_WithMockServer(t *testing.T) {
+	var serverURL string
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/monolith/branches":
+			assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/testorg/monolith/branches?per_page=1&page=42>; rel="last"`, serverURL))
+			json.NewEncoder(w).Encode([]*github.Branch{{Name: github.String("main")}})
+		case "/repos/testorg/monolith/tags":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repos/testorg/monolith/tags?per_page=1&page=7>; rel="last"`, serverURL))
+			json.NewEncoder(w).Encode([]*github.RepositoryTag{{Name: github.String("v1.0.0")}})
+		case "/repos/testorg/monolith/contents/.gitattributes":
+			json.NewEncoder(w).Encode(encodedFile("*.psd filter=lfs diff=lfs merge=lfs -text\n", "sha1"))
+		case "/repos/testorg/tiny/branches":
+			json.NewEncoder(w).Encode([]*github.Branch{{Name: github.String("main")}})
+		case "/repos/testorg/tiny/tags":
+			json.NewEncoder(w).Encode([]*github.RepositoryTag{})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+	serverURL = "http://" + service.client.BaseURL.Host
+
+	repos := []*github.Repository{
+		{Name: github.String("monolith"), Size: github.Int(2048000)},
+		{Name: github.String("tiny"), Size: github.Int(12)},
+		{Name: github.String("gone"), Size: github.Int(5)},
+	}
+
+	reports := service.GetSizeReports(context.Background(), "testorg", repos)
+	require.Len(t, reports, 3)
+
+	assert.Equal(t, SizeReport{RepoName: "monolith", SizeKB: 2048000, Branches: 42, Tags: 7, LFS: true}, reports[0])
+	assert.Equal(t, SizeReport{RepoName: "tiny", SizeKB: 12, Branches: 1}, reports[1])
+
+	assert.Error(t, reports[2].Err)
+	assert.Equal(t, 5, reports[2].SizeKB)
+}
@@ -0,0 +1,22 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetTeamIDsBySlug resolves team slugs within an organization to their numeric IDs.
+func (s *Service) GetTeamIDsBySlug(ctx context.Context, org string, slugs []string) ([]int64, error) {
+	teamIDs := make([]int64, 0, len(slugs))
+
+	for _, slug := range slugs {
+		team, _, err := s.client.Teams.GetTeamBySlug(ctx, org, slug)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team %s/%s: %w", org, slug, err)
+		}
+
+		teamIDs = append(teamIDs, team.GetID())
+	}
+
+	return teamIDs, nil
+}
@@ -0,0 +1,130 @@
+package ghbatch
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-repo-manager/internal/metrics"
+)
+
+const (
+	// defaultSecondaryRateLimitWait is how long to back off from a secondary rate limit that does not
+	// come with a Retry-After header, as recommended by GitHub.
+	defaultSecondaryRateLimitWait = time.Minute
+	// maxSecondaryRateLimitRetries bounds how often a throttled request is retried before the secondary
+	// rate limit error is returned to the caller.
+	maxSecondaryRateLimitRetries = 3
+)
+
+// throttleEvents counts the requests throttled by secondary rate limits in this process.
+var throttleEvents atomic.Int64
+
+// ThrottleEvents returns how many times requests were throttled by GitHub's secondary (abuse detection)
+// rate limits. Once the first one is hit, write requests are sent one at a time.
+func ThrottleEvents() int {
+	return int(throttleEvents.Load())
+}
+
+// throttleTransport retries requests rejected by GitHub's secondary rate limits after the Retry-After
+// delay. Secondary limits are mostly triggered by concurrent content writes, so once one is hit the
+// transport also serializes write requests for the rest of the process.
+type throttleTransport struct {
+	base      http.RoundTripper
+	log       *slog.Logger
+	serialize atomic.Bool
+	writeMu   sync.Mutex
+}
+
+func newThrottleTransport(base http.RoundTripper, log *slog.Logger) *throttleTransport {
+	return &throttleTransport{base: base, log: log}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead && t.serialize.Load() {
+		t.writeMu.Lock()
+		defer t.writeMu.Unlock()
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		wait, throttled := secondaryRateLimitWait(resp)
+		// A request whose body cannot be replayed is not retried
+		if !throttled || attempt > maxSecondaryRateLimitRetries || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		resp.Body.Close()
+		throttleEvents.Add(1)
+		metrics.SecondaryRateLimits.Inc()
+		trace.SpanFromContext(req.Context()).AddEvent("secondary rate limit", trace.WithAttributes(
+			attribute.String("retry_after", wait.String()), attribute.Int("attempt", attempt)))
+
+		if !t.serialize.Swap(true) {
+			t.log.Warn("Secondary rate limit hit, serializing write requests")
+		}
+
+		t.log.Warn("Throttled by secondary rate limit, retrying", "method", req.Method, "url", req.URL.String(),
+			"retryAfter", wait, "attempt", attempt)
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// secondaryRateLimitWait reports whether a response is a secondary rate limit rejection and how long to
+// wait before retrying. Primary rate limit exhaustion, which resets at a fixed time, is not retried.
+func secondaryRateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	// The body has to be inspected, so it is buffered and restored for the caller
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return 0, false
+	}
+
+	message := strings.ToLower(string(body))
+	if strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse detection") {
+		return defaultSecondaryRateLimitWait, true
+	}
+
+	return 0, false
+}
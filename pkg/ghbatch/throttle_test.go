@@ -0,0 +1,90 @@
+package ghbatch
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottleTransport_RetriesSecondaryRateLimit(t *testing.T) {
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		if len(bodies) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "You have exceeded a secondary rate limit."}`))
+
+			return
+		}
+
+		w.Write([]byte(`{"content": {"sha": "abc"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newThrottleTransport(http.DefaultTransport, createTestLogger())
+	client := github.NewClient(&http.Client{Transport: transport}).WithAuthToken("token")
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+	before := ThrottleEvents()
+	_, _, err := client.Repositories.CreateFile(context.Background(), "testorg", "api", "README.md",
+		&github.RepositoryContentFileOptions{Message: github.String("Add README"), Content: []byte("hello")})
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t, before+1, ThrottleEvents())
+	assert.True(t, transport.serialize.Load())
+}
+
+func TestThrottleTransport_PrimaryRateLimitNotRetried(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "API rate limit exceeded for user."}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(&http.Client{Transport: newThrottleTransport(http.DefaultTransport, createTestLogger())})
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+	_, _, err := client.Repositories.Get(context.Background(), "testorg", "api")
+
+	var rateLimitErr *github.RateLimitError
+	assert.ErrorAs(t, err, &rateLimitErr)
+	assert.Equal(t, 1, requests)
+}
+
+func TestThrottleTransport_GivesUpAfterRetries(t *testing.T) {
+	var requests int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "You have exceeded a secondary rate limit.",
+			"documentation_url": "https://docs.github.com/rest/overview/rate-limits-for-the-rest-api#about-secondary-rate-limits"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(&http.Client{Transport: newThrottleTransport(http.DefaultTransport, createTestLogger())})
+	client.BaseURL, _ = client.BaseURL.Parse(server.URL + "/")
+
+	_, _, err := client.Repositories.Get(context.Background(), "testorg", "api")
+
+	var abuseErr *github.AbuseRateLimitError
+	assert.ErrorAs(t, err, &abuseErr)
+	assert.Equal(t, maxSecondaryRateLimitRetries+1, requests)
+}
@@ -0,0 +1,70 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// RateStatus is the state of one rate limit of the token.
+type RateStatus struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// TokenInfo describes the token the service authenticates with.
+type TokenInfo struct {
+	// Login is the user the token belongs to.
+	Login string
+	// Scopes are the OAuth scopes of a classic token or OAuth token.
+	Scopes []string
+	// ScopesReported is false for tokens whose permissions are not expressed as scopes, such as
+	// fine-grained personal access tokens and GitHub App tokens.
+	ScopesReported bool
+	Core           RateStatus
+	Search         RateStatus
+	GraphQL        RateStatus
+}
+
+// GetTokenInfo validates the token and returns its user, scopes and rate limits.
+func (s *Service) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	user, resp, err := s.client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the authenticated user: %w", err)
+	}
+
+	info := &TokenInfo{Login: user.GetLogin()}
+
+	if header, ok := resp.Header["X-Oauth-Scopes"]; ok {
+		info.ScopesReported = true
+		for _, scope := range strings.Split(strings.Join(header, ","), ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				info.Scopes = append(info.Scopes, scope)
+			}
+		}
+	}
+
+	limits, _, err := s.client.RateLimit.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate limits: %w", err)
+	}
+
+	info.Core = rateStatus(limits.GetCore())
+	info.Search = rateStatus(limits.GetSearch())
+	info.GraphQL = rateStatus(limits.GetGraphQL())
+
+	return info, nil
+}
+
+// rateStatus converts a rate limit of the API; a limit missing from the response is zero.
+func rateStatus(rate *github.Rate) RateStatus {
+	if rate == nil {
+		return RateStatus{}
+	}
+
+	return RateStatus{Limit: rate.Limit, Remaining: rate.Remaining, Reset: rate.Reset.Time}
+}
@@ -0,0 +1,65 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTokenInfo_WithMockServer(t *testing.T) {
+	tests := []struct {
+		name           string
+		scopesHeader   []string
+		wantScopes     []string
+		scopesReported bool
+	}{
+		{"Classic token", []string{"repo, read:org, workflow"}, []string{"repo", "read:org", "workflow"}, true},
+		{"Classic token without scopes", []string{""}, nil, true},
+		{"Fine-grained token", nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/user":
+					for _, value := range tt.scopesHeader {
+						w.Header().Add("X-OAuth-Scopes", value)
+					}
+					fmt.Fprint(w, `{"login":"octocat"}`)
+				case "/rate_limit":
+					fmt.Fprint(w, `{"resources":{
+						"core":{"limit":5000,"remaining":4321,"reset":1700000000},
+						"search":{"limit":30,"remaining":29,"reset":1700000060},
+						"graphql":{"limit":5000,"remaining":4999,"reset":1700000000}}}`)
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}, 1)
+
+			info, err := service.GetTokenInfo(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, "octocat", info.Login)
+			assert.Equal(t, tt.wantScopes, info.Scopes)
+			assert.Equal(t, tt.scopesReported, info.ScopesReported)
+			assert.Equal(t, RateStatus{Limit: 5000, Remaining: 4321, Reset: info.Core.Reset}, info.Core)
+			assert.Equal(t, int64(1700000000), info.Core.Reset.Unix())
+			assert.Equal(t, 29, info.Search.Remaining)
+			assert.Equal(t, 4999, info.GraphQL.Remaining)
+		})
+	}
+}
+
+func TestGetTokenInfo_Unauthorized(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"Bad credentials"}`)
+	}, 1)
+
+	_, err := service.GetTokenInfo(context.Background())
+	assert.ErrorContains(t, err, "failed to get the authenticated user")
+}
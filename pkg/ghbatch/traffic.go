@@ -0,0 +1,59 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+)
+
+// TrafficReport holds the traffic totals of a repository over the last 14 days, the period GitHub retains.
+type TrafficReport struct {
+	RepoName       string
+	Views          int
+	UniqueVisitors int
+	Clones         int
+	UniqueCloners  int
+	Err            error
+}
+
+// RepoErr implements Result.
+func (t TrafficReport) RepoErr() error { return t.Err }
+
+// WithRepoErr implements Result.
+func (TrafficReport) WithRepoErr(_, repoName string, err error) TrafficReport {
+	return TrafficReport{RepoName: repoName, Err: err}
+}
+
+// GetTrafficReport collects the 14-day view and clone totals of a repository.
+func (s *Service) GetTrafficReport(ctx context.Context, owner, repoName string) (*TrafficReport, error) {
+	views, _, err := s.client.Repositories.ListTrafficViews(ctx, owner, repoName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get traffic views for %s/%s: %w", owner, repoName, err)
+	}
+
+	clones, _, err := s.client.Repositories.ListTrafficClones(ctx, owner, repoName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get traffic clones for %s/%s: %w", owner, repoName, err)
+	}
+
+	return &TrafficReport{
+		RepoName:       repoName,
+		Views:          views.GetCount(),
+		UniqueVisitors: views.GetUniques(),
+		Clones:         clones.GetCount(),
+		UniqueCloners:  clones.GetUniques(),
+	}, nil
+}
+
+// GetTrafficReports collects the traffic totals of all the given repositories.
+func (s *Service) GetTrafficReports(ctx context.Context, owner string, repoNames []string) []TrafficReport {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) TrafficReport {
+		report, err := s.GetTrafficReport(ctx, owner, repoName)
+		if err != nil {
+			s.log.Error("Failed to get traffic", "owner", owner, "repo", repoName, "error", err)
+
+			return TrafficReport{RepoName: repoName, Err: err}
+		}
+
+		return *report
+	})
+}
@@ -0,0 +1,33 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTrafficReports_WithMockServer(t *testing.T) {
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/tool/traffic/views":
+			json.NewEncoder(w).Encode(github.TrafficViews{Count: github.Int(120), Uniques: github.Int(15)})
+		case "/repos/testorg/tool/traffic/clones":
+			json.NewEncoder(w).Encode(github.TrafficClones{Count: github.Int(40), Uniques: github.Int(8)})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Must have push access to repository"})
+		}
+	}, 2)
+
+	reports := service.GetTrafficReports(context.Background(), "testorg", []string{"tool", "readonly"})
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, TrafficReport{RepoName: "tool", Views: 120, UniqueVisitors: 15, Clones: 40, UniqueCloners: 8}, reports[0])
+	assert.Equal(t, "readonly", reports[1].RepoName)
+	assert.Error(t, reports[1].Err)
+}
@@ -0,0 +1,93 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// branchHead is the head of a branch a tree commit is made on top of.
+type branchHead struct {
+	branch string
+	ref    *github.Reference
+	commit *github.Commit
+}
+
+// getBranchHead looks up the head commit of branch, or of the default branch when branch is empty.
+func (s *Service) getBranchHead(ctx context.Context, owner, repoName, branch string) (*branchHead, error) {
+	if branch == "" {
+		repository, _, err := s.client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, repoName, err)
+		}
+		branch = repository.GetDefaultBranch()
+	}
+
+	ref, _, err := s.client.Git.GetRef(ctx, owner, repoName, "heads/"+branch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch %s of %s/%s: %w", branch, owner, repoName, err)
+	}
+
+	commit, _, err := s.client.Git.GetCommit(ctx, owner, repoName, ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get head commit of %s/%s: %w", owner, repoName, err)
+	}
+
+	return &branchHead{branch: branch, ref: ref, commit: commit}, nil
+}
+
+// listTree returns every entry of the tree of the branch head, recursively.
+func (s *Service) listTree(ctx context.Context, owner, repoName string, head *branchHead) ([]*github.TreeEntry, error) {
+	tree, _, err := s.client.Git.GetTree(ctx, owner, repoName, head.commit.GetTree().GetSHA(), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of %s/%s: %w", owner, repoName, err)
+	}
+
+	if tree.GetTruncated() {
+		return nil, fmt.Errorf("tree of %s/%s is too large to be listed", owner, repoName)
+	}
+
+	return tree.Entries, nil
+}
+
+// commitTree commits the tree entries on top of the branch head in a single commit, signed when the
+// service has a signer, and fast-forwards the branch to it. An entry without content and SHA deletes the
+// file. The branch is only fast-forwarded, so the commit fails instead of overwriting changes pushed
+// concurrently. It returns the created tree and commit.
+func (s *Service) commitTree(ctx context.Context, owner, repoName string, head *branchHead,
+	entries []*github.TreeEntry, commitMessage string,
+) (*github.Tree, *github.Commit, error) {
+	tree, _, err := s.client.Git.CreateTree(ctx, owner, repoName, head.commit.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create tree for %s/%s: %w", owner, repoName, err)
+	}
+
+	newCommit := &github.Commit{
+		Message:   github.String(commitMessage),
+		Tree:      &github.Tree{SHA: tree.SHA},
+		Parents:   []*github.Commit{{SHA: head.commit.SHA}},
+		Author:    s.identityAuthor(),
+		Committer: s.identityAuthor(),
+	}
+
+	if s.signer != nil {
+		author, err := s.commitAuthor(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		newCommit.Author, newCommit.Committer = author, nil
+	}
+
+	commit, _, err := s.client.Git.CreateCommit(ctx, owner, repoName, newCommit, &github.CreateCommitOptions{Signer: s.signer})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create commit in %s/%s: %w", owner, repoName, err)
+	}
+
+	head.ref.Object.SHA = commit.SHA
+	if _, _, err := s.client.Git.UpdateRef(ctx, owner, repoName, head.ref, false); err != nil {
+		return nil, nil, fmt.Errorf("failed to update branch %s of %s/%s: %w", head.branch, owner, repoName, err)
+	}
+
+	return tree, commit, nil
+}
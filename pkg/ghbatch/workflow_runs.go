@@ -0,0 +1,162 @@
+package ghbatch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// maxRunsPerPage is the largest page size the workflow runs API accepts.
+const maxRunsPerPage = 100
+
+// WorkflowRunQuery selects the completed runs of a workflow to summarize.
+type WorkflowRunQuery struct {
+	// Workflow is the workflow file name, e.g. ci.yml.
+	Workflow string
+	// Branch limits the runs to a branch, all branches when empty.
+	Branch string
+	// Limit is the number of most recent completed runs to consider.
+	Limit int
+}
+
+// WorkflowRunStats summarizes the recent completed runs of a workflow in a repository.
+type WorkflowRunStats struct {
+	RepoName string
+	// Found is false if the repository does not have the workflow.
+	Found bool
+	Runs  int
+	// Succeeded and Failed count the runs that concluded success and failure, timed_out or startup_failure.
+	// Cancelled, skipped and other runs only count towards Runs.
+	Succeeded int
+	Failed    int
+	// AverageDuration is the mean wall-clock time from run start to completion.
+	AverageDuration time.Duration
+	// LastConclusion is the conclusion of the most recent run.
+	LastConclusion string
+	LastRunAt      time.Time
+	Err            error
+}
+
+// RepoErr implements Result.
+func (s WorkflowRunStats) RepoErr() error { return s.Err }
+
+// WithRepoErr implements Result.
+func (WorkflowRunStats) WithRepoErr(_, repoName string, err error) WorkflowRunStats {
+	return WorkflowRunStats{RepoName: repoName, Err: err}
+}
+
+// SuccessRate returns the percentage of succeeded runs among succeeded and failed runs, and false if
+// there are none.
+func (s WorkflowRunStats) SuccessRate() (float64, bool) {
+	decided := s.Succeeded + s.Failed
+	if decided == 0 {
+		return 0, false
+	}
+
+	return float64(s.Succeeded) / float64(decided) * 100, true
+}
+
+// GetWorkflowRunStats summarizes the most recent completed runs of a workflow in a repository.
+func (s *Service) GetWorkflowRunStats(ctx context.Context, owner, repoName string, query WorkflowRunQuery,
+) (*WorkflowRunStats, error) {
+	stats := &WorkflowRunStats{RepoName: repoName}
+
+	runs, err := s.listCompletedWorkflowRuns(ctx, owner, repoName, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if runs == nil {
+		return stats, nil
+	}
+
+	stats.Found = true
+
+	var (
+		total time.Duration
+		timed int
+	)
+
+	for _, run := range runs {
+		stats.Runs++
+
+		switch run.GetConclusion() {
+		case "success":
+			stats.Succeeded++
+		case "failure", "timed_out", "startup_failure":
+			stats.Failed++
+		}
+
+		if started := run.GetRunStartedAt().Time; !started.IsZero() && run.GetUpdatedAt().After(started) {
+			total += run.GetUpdatedAt().Sub(started)
+			timed++
+		}
+	}
+
+	if timed > 0 {
+		stats.AverageDuration = total / time.Duration(timed)
+	}
+
+	if len(runs) > 0 {
+		stats.LastConclusion = runs[0].GetConclusion()
+		stats.LastRunAt = runs[0].GetCreatedAt().Time
+	}
+
+	return stats, nil
+}
+
+// listCompletedWorkflowRuns lists the most recent completed runs of a workflow, newest first. It returns
+// nil if the repository does not have the workflow.
+func (s *Service) listCompletedWorkflowRuns(ctx context.Context, owner, repoName string, query WorkflowRunQuery,
+) ([]*github.WorkflowRun, error) {
+	runs := []*github.WorkflowRun{}
+	opts := &github.ListWorkflowRunsOptions{
+		Branch:      query.Branch,
+		Status:      "completed",
+		ListOptions: github.ListOptions{PerPage: min(query.Limit, maxRunsPerPage)},
+	}
+
+	for len(runs) < query.Limit {
+		page, resp, err := s.client.Actions.ListWorkflowRunsByFileName(ctx, owner, repoName, query.Workflow, opts)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("failed to list runs of workflow %s in %s/%s: %w", query.Workflow, owner, repoName, err)
+		}
+
+		runs = append(runs, page.WorkflowRuns...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	if len(runs) > query.Limit {
+		runs = runs[:query.Limit]
+	}
+
+	return runs, nil
+}
+
+// GetWorkflowRunStatsForRepos summarizes the recent runs of a workflow in all the given repositories.
+func (s *Service) GetWorkflowRunStatsForRepos(ctx context.Context, owner string, repoNames []string,
+	query WorkflowRunQuery,
+) []WorkflowRunStats {
+	return CollectConcurrently(ctx, s.maxConcurrency, repoNames, func(ctx context.Context, repoName string) WorkflowRunStats {
+		stats, err := s.GetWorkflowRunStats(ctx, owner, repoName, query)
+		if err != nil {
+			s.log.Error("Failed to get workflow runs", "owner", owner, "repo", repoName, "workflow", query.Workflow, "error", err)
+
+			return WorkflowRunStats{RepoName: repoName, Err: err}
+		}
+
+		return *stats
+	})
+}
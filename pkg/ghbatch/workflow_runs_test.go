@@ -0,0 +1,64 @@
+package ghbatch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func workflowRun(conclusion string, started time.Time, duration time.Duration) *github.WorkflowRun {
+	return &github.WorkflowRun{
+		Conclusion:   github.String(conclusion),
+		CreatedAt:    &github.Timestamp{Time: started},
+		RunStartedAt: &github.Timestamp{Time: started},
+		UpdatedAt:    &github.Timestamp{Time: started.Add(duration)},
+	}
+}
+
+func TestGetWorkflowRunStatsForRepos_WithMockServer(t *testing.T) {
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	service := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/testorg/api/actions/workflows/ci.yml/runs":
+			assert.Equal(t, "completed", r.URL.Query().Get("status"))
+			assert.Equal(t, "main", r.URL.Query().Get("branch"))
+			json.NewEncoder(w).Encode(github.WorkflowRuns{WorkflowRuns: []*github.WorkflowRun{
+				workflowRun("failure", start.Add(3*time.Hour), 4*time.Minute),
+				workflowRun("success", start.Add(2*time.Hour), 2*time.Minute),
+				workflowRun("cancelled", start.Add(time.Hour), 6*time.Minute),
+				workflowRun("success", start, 4*time.Minute),
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}, 2)
+
+	results := service.GetWorkflowRunStatsForRepos(context.Background(), "testorg", []string{"api", "docs"},
+		WorkflowRunQuery{Workflow: "ci.yml", Branch: "main", Limit: 3})
+	require.Len(t, results, 2)
+
+	api := results[0]
+	require.NoError(t, api.Err)
+	assert.True(t, api.Found)
+	assert.Equal(t, 3, api.Runs)
+	assert.Equal(t, 1, api.Succeeded)
+	assert.Equal(t, 1, api.Failed)
+	assert.Equal(t, 4*time.Minute, api.AverageDuration)
+	assert.Equal(t, "failure", api.LastConclusion)
+	rate, ok := api.SuccessRate()
+	assert.True(t, ok)
+	assert.InDelta(t, 50.0, rate, 0.01)
+
+	docs := results[1]
+	require.NoError(t, docs.Err)
+	assert.False(t, docs.Found)
+	_, ok = docs.SuccessRate()
+	assert.False(t, ok)
+}